@@ -19,14 +19,16 @@ package factory
 
 import (
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/remote"
 	"github.com/pkg/errors"
 )
 
 // FactoryOpts holds configuration information used to initialize factory implementations
 type FactoryOpts struct {
-	ProviderName string      `mapstructure:"default" json:"default" yaml:"Default"`
-	SwOpts       *SwOpts     `mapstructure:"SW,omitempty" json:"SW,omitempty" yaml:"SwOpts"`
-	PluginOpts   *PluginOpts `mapstructure:"PLUGIN,omitempty" json:"PLUGIN,omitempty" yaml:"PluginOpts"`
+	ProviderName string             `mapstructure:"default" json:"default" yaml:"Default"`
+	SwOpts       *SwOpts            `mapstructure:"SW,omitempty" json:"SW,omitempty" yaml:"SwOpts"`
+	PluginOpts   *PluginOpts        `mapstructure:"PLUGIN,omitempty" json:"PLUGIN,omitempty" yaml:"PluginOpts"`
+	RemoteOpts   *remote.RemoteOpts `mapstructure:"REMOTE,omitempty" json:"REMOTE,omitempty" yaml:"REMOTE"`
 }
 
 // InitFactories must be called before using factory interfaces
@@ -60,6 +62,15 @@ func InitFactories(config *FactoryOpts) error {
 			}
 		}
 
+		// Remote signer BCCSP
+		if config.RemoteOpts != nil {
+			f := &RemoteFactory{}
+			err := initBCCSP(f, config)
+			if err != nil {
+				factoriesInitError = errors.Wrapf(err, "Failed initializing REMOTE.BCCSP %s", factoriesInitError)
+			}
+		}
+
 		// BCCSP Plugin
 		if config.PluginOpts != nil {
 			f := &PluginFactory{}
@@ -87,6 +98,8 @@ func GetBCCSPFromOpts(config *FactoryOpts) (bccsp.BCCSP, error) {
 		f = &SWFactory{}
 	case "PLUGIN":
 		f = &PluginFactory{}
+	case "REMOTE":
+		f = &RemoteFactory{}
 	default:
 		return nil, errors.Errorf("Could not find BCCSP, no '%s' provider", config.ProviderName)
 	}
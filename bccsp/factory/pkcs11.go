@@ -20,6 +20,7 @@ package factory
 import (
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/pkcs11"
+	"github.com/hyperledger/fabric/bccsp/remote"
 	"github.com/pkg/errors"
 )
 
@@ -29,6 +30,7 @@ type FactoryOpts struct {
 	SwOpts       *SwOpts            `mapstructure:"SW,omitempty" json:"SW,omitempty" yaml:"SwOpts"`
 	PluginOpts   *PluginOpts        `mapstructure:"PLUGIN,omitempty" json:"PLUGIN,omitempty" yaml:"PluginOpts"`
 	Pkcs11Opts   *pkcs11.PKCS11Opts `mapstructure:"PKCS11,omitempty" json:"PKCS11,omitempty" yaml:"PKCS11"`
+	RemoteOpts   *remote.RemoteOpts `mapstructure:"REMOTE,omitempty" json:"REMOTE,omitempty" yaml:"REMOTE"`
 }
 
 // InitFactories must be called before using factory interfaces
@@ -78,6 +80,15 @@ func setFactories(config *FactoryOpts) error {
 		}
 	}
 
+	// Remote signer BCCSP
+	if config.RemoteOpts != nil {
+		f := &RemoteFactory{}
+		err := initBCCSP(f, config)
+		if err != nil {
+			factoriesInitError = errors.Wrapf(err, "Failed initializing REMOTE.BCCSP %s", factoriesInitError)
+		}
+	}
+
 	// BCCSP Plugin
 	if config.PluginOpts != nil {
 		f := &PluginFactory{}
@@ -106,6 +117,8 @@ func GetBCCSPFromOpts(config *FactoryOpts) (bccsp.BCCSP, error) {
 		f = &PKCS11Factory{}
 	case "PLUGIN":
 		f = &PluginFactory{}
+	case "REMOTE":
+		f = &RemoteFactory{}
 	default:
 		return nil, errors.Errorf("Could not find BCCSP, no '%s' provider", config.ProviderName)
 	}
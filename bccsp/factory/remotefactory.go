@@ -0,0 +1,54 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package factory
+
+import (
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/remote"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RemoteFactoryName is the name of the factory of the BCCSP
+	// implementation backed by a remote signer
+	RemoteFactoryName = "REMOTE"
+)
+
+// RemoteFactory is the factory of the BCCSP backed by a remote signer.
+type RemoteFactory struct{}
+
+// Name returns the name of this factory
+func (f *RemoteFactory) Name() string {
+	return RemoteFactoryName
+}
+
+// Get returns an instance of BCCSP using Opts.
+func (f *RemoteFactory) Get(config *FactoryOpts) (bccsp.BCCSP, error) {
+	// Validate arguments
+	if config == nil || config.RemoteOpts == nil {
+		return nil, errors.New("Invalid config. It must not be nil.")
+	}
+
+	remoteOpts := config.RemoteOpts
+
+	// The key store holds the references to the keys held by the remote signer
+	var ks bccsp.KeyStore
+	if remoteOpts.Ephemeral == true {
+		ks = sw.NewDummyKeyStore()
+	} else if remoteOpts.FileKeystore != nil {
+		fks, err := sw.NewFileBasedKeyStore(nil, remoteOpts.FileKeystore.KeyStorePath, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to initialize software key store")
+		}
+		ks = fks
+	} else {
+		// Default to DummyKeystore
+		ks = sw.NewDummyKeyStore()
+	}
+	return remote.New(*remoteOpts, ks)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package factory
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteFactoryName(t *testing.T) {
+	f := &RemoteFactory{}
+	assert.Equal(t, f.Name(), RemoteFactoryName)
+}
+
+func TestRemoteFactoryInvalidConfig(t *testing.T) {
+	f := &RemoteFactory{}
+	opts := &FactoryOpts{}
+
+	_, err := f.Get(nil)
+	assert.Error(t, err)
+
+	_, err = f.Get(opts)
+	assert.Error(t, err)
+
+	opts.RemoteOpts = &remote.RemoteOpts{SecLevel: 256, HashFamily: "SHA2"}
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Invalid config: missing remote signer address")
+
+	opts.RemoteOpts.Address = "127.0.0.1:7000"
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Invalid config: a client certificate and key are required to authenticate to the remote signer")
+}
+
+func TestRemoteFactoryFromOpts(t *testing.T) {
+	opts := &FactoryOpts{
+		ProviderName: "REMOTE",
+		RemoteOpts: &remote.RemoteOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+		},
+	}
+	_, err := GetBCCSPFromOpts(opts)
+	assert.EqualError(t, err, "Could not initialize BCCSP REMOTE: Invalid config: missing remote signer address")
+}
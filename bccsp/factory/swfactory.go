@@ -16,6 +16,11 @@ limitations under the License.
 package factory
 
 import (
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/sw"
 	"github.com/pkg/errors"
@@ -26,6 +31,14 @@ const (
 	SoftwareBasedFactoryName = "SW"
 )
 
+var (
+	// secretBackends holds the secret backends of the key stores, shared
+	// by all the BCCSP instances configured with the same backend, so that
+	// a single goroutine renews the lease of each token
+	secretBackends      = map[secretBackendKey]*sw.HTTPSecretBackend{}
+	secretBackendsMutex sync.Mutex
+)
+
 // SWFactory is the factory of the software-based BCCSP.
 type SWFactory struct{}
 
@@ -46,6 +59,12 @@ func (f *SWFactory) Get(config *FactoryOpts) (bccsp.BCCSP, error) {
 	var ks bccsp.KeyStore
 	if swOpts.Ephemeral == true {
 		ks = sw.NewDummyKeyStore()
+	} else if swOpts.SecretKeystore != nil {
+		sks, err := newSecretKeyStore(swOpts.SecretKeystore)
+		if err != nil {
+			return nil, errors.WithMessage(err, "Failed to initialize secret backend key store")
+		}
+		ks = sks
 	} else if swOpts.FileKeystore != nil {
 		fks, err := sw.NewFileBasedKeyStore(nil, swOpts.FileKeystore.KeyStorePath, false)
 		if err != nil {
@@ -69,10 +88,11 @@ type SwOpts struct {
 	HashFamily string `mapstructure:"hash" json:"hash" yaml:"Hash"`
 
 	// Keystore Options
-	Ephemeral     bool               `mapstructure:"tempkeys,omitempty" json:"tempkeys,omitempty"`
-	FileKeystore  *FileKeystoreOpts  `mapstructure:"filekeystore,omitempty" json:"filekeystore,omitempty" yaml:"FileKeyStore"`
-	DummyKeystore *DummyKeystoreOpts `mapstructure:"dummykeystore,omitempty" json:"dummykeystore,omitempty"`
-	InmemKeystore *InmemKeystoreOpts `mapstructure:"inmemkeystore,omitempty" json:"inmemkeystore,omitempty"`
+	Ephemeral      bool                `mapstructure:"tempkeys,omitempty" json:"tempkeys,omitempty"`
+	FileKeystore   *FileKeystoreOpts   `mapstructure:"filekeystore,omitempty" json:"filekeystore,omitempty" yaml:"FileKeyStore"`
+	SecretKeystore *SecretKeystoreOpts `mapstructure:"secretkeystore,omitempty" json:"secretkeystore,omitempty" yaml:"SecretKeyStore"`
+	DummyKeystore  *DummyKeystoreOpts  `mapstructure:"dummykeystore,omitempty" json:"dummykeystore,omitempty"`
+	InmemKeystore  *InmemKeystoreOpts  `mapstructure:"inmemkeystore,omitempty" json:"inmemkeystore,omitempty"`
 }
 
 // Pluggable Keystores, could add JKS, P12, etc..
@@ -80,7 +100,95 @@ type FileKeystoreOpts struct {
 	KeyStorePath string `mapstructure:"keystore" yaml:"KeyStore"`
 }
 
+// SecretKeystoreOpts configures a key store keeping the keys in a
+// HashiCorp-Vault-style secret backend reached over HTTP
+type SecretKeystoreOpts struct {
+	// Address is the base URL of the secret backend
+	Address string `mapstructure:"address" json:"address" yaml:"Address"`
+	// Token authenticates to the secret backend. If empty,
+	// the token is read from TokenFile.
+	Token     string `mapstructure:"token,omitempty" json:"token,omitempty" yaml:"Token"`
+	TokenFile string `mapstructure:"tokenfile,omitempty" json:"tokenfile,omitempty" yaml:"TokenFile"`
+	// Path is the path under which the keys are stored in the secret backend
+	Path string `mapstructure:"path" json:"path" yaml:"Path"`
+	// RootCAs are the files of the root certificates used
+	// to authenticate the secret backend over TLS
+	RootCAs []string `mapstructure:"rootcas,omitempty" json:"rootcas,omitempty" yaml:"RootCAs"`
+	// Timeout bounds each request to the secret backend
+	Timeout time.Duration `mapstructure:"timeout,omitempty" json:"timeout,omitempty" yaml:"Timeout"`
+	// KeyStorePath is the folder indexing the SKIs of the stored keys
+	KeyStorePath string `mapstructure:"keystore,omitempty" json:"keystore,omitempty" yaml:"KeyStore"`
+	// ImportKeys moves the private keys found in KeyStorePath to the secret backend
+	ImportKeys bool `mapstructure:"importkeys,omitempty" json:"importkeys,omitempty" yaml:"ImportKeys"`
+}
+
 type DummyKeystoreOpts struct{}
 
 // InmemKeystoreOpts - empty, as there is no config for the in-memory keystore
 type InmemKeystoreOpts struct{}
+
+func newSecretKeyStore(opts *SecretKeystoreOpts) (bccsp.KeyStore, error) {
+	token := opts.Token
+	if token == "" && opts.TokenFile != "" {
+		raw, err := ioutil.ReadFile(opts.TokenFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading secret backend token")
+		}
+		token = strings.TrimSpace(string(raw))
+	}
+
+	var rootCAs [][]byte
+	for _, file := range opts.RootCAs {
+		rootCA, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading secret backend root CA certificate")
+		}
+		rootCAs = append(rootCAs, rootCA)
+	}
+
+	backend, err := getSecretBackend(sw.HTTPSecretBackendConfig{
+		Address: opts.Address,
+		Token:   token,
+		RootCAs: rootCAs,
+		Timeout: opts.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sw.NewSecretBackendKeyStore(backend, opts.Path, opts.KeyStorePath, false, opts.ImportKeys)
+}
+
+// secretBackendKey identifies the configuration of a secret backend
+type secretBackendKey struct {
+	address string
+	token   string
+	rootCAs string
+	timeout time.Duration
+}
+
+// getSecretBackend returns the secret backend configured by conf,
+// creating it only if no key store uses it yet
+func getSecretBackend(conf sw.HTTPSecretBackendConfig) (*sw.HTTPSecretBackend, error) {
+	key := secretBackendKey{
+		address: conf.Address,
+		token:   conf.Token,
+		timeout: conf.Timeout,
+	}
+	for _, rootCA := range conf.RootCAs {
+		key.rootCAs += string(rootCA)
+	}
+
+	secretBackendsMutex.Lock()
+	defer secretBackendsMutex.Unlock()
+
+	if backend, ok := secretBackends[key]; ok {
+		return backend, nil
+	}
+	backend, err := sw.NewHTTPSecretBackend(conf)
+	if err != nil {
+		return nil, err
+	}
+	secretBackends[key] = backend
+	return backend, nil
+}
@@ -16,10 +16,17 @@ limitations under the License.
 package factory
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/bccsp/sw/secretserver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSWFactoryName(t *testing.T) {
@@ -68,3 +75,106 @@ func TestSWFactoryGet(t *testing.T) {
 	assert.NotNil(t, csp)
 
 }
+
+func TestSWFactoryGetSecretKeystore(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "swfactory")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server, err := secretserver.New(filepath.Join(tempDir, "secrets"), "token", 0)
+	require.NoError(t, err)
+	server.Start()
+	defer server.Stop()
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("token\n"), 0600))
+
+	f := &SWFactory{}
+	opts := &FactoryOpts{
+		SwOpts: &SwOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+			SecretKeystore: &SecretKeystoreOpts{
+				Address:      server.Address(),
+				TokenFile:    tokenFile,
+				Path:         "secret/fabric",
+				KeyStorePath: filepath.Join(tempDir, "keystore"),
+			},
+		},
+	}
+	csp, err := f.Get(opts)
+	require.NoError(t, err)
+
+	k, err := csp.KeyGen(&bccsp.ECDSAKeyGenOpts{Temporary: false})
+	require.NoError(t, err)
+	k2, err := csp.GetKey(k.SKI())
+	require.NoError(t, err)
+	assert.Equal(t, k, k2)
+
+	// The private key is only held by the secret backend
+	files, err := ioutil.ReadDir(filepath.Join(tempDir, "keystore"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Regexp(t, "_sk.ref$", files[0].Name())
+
+	opts.SwOpts.SecretKeystore.TokenFile = filepath.Join(tempDir, "missing")
+	_, err = f.Get(opts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to initialize secret backend key store: failed reading secret backend token")
+
+	opts.SwOpts.SecretKeystore.Token = "foo"
+	_, err = f.Get(opts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
+func TestSWFactoryGetSecretKeystoreSharesBackend(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "swfactory")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server, err := secretserver.New(filepath.Join(tempDir, "secrets"), "token", time.Hour)
+	require.NoError(t, err)
+	server.Start()
+	defer server.Stop()
+
+	conf := sw.HTTPSecretBackendConfig{
+		Address: server.Address(),
+		Token:   "token",
+	}
+	backend, err := getSecretBackend(conf)
+	require.NoError(t, err)
+	backend2, err := getSecretBackend(conf)
+	require.NoError(t, err)
+	assert.True(t, backend == backend2, "expected the backend to be shared")
+
+	conf.Timeout = time.Minute
+	backend3, err := getSecretBackend(conf)
+	require.NoError(t, err)
+	assert.False(t, backend == backend3, "expected a backend per configuration")
+
+	// Key stores with the same backend configuration share the backend
+	f := &SWFactory{}
+	opts := &FactoryOpts{
+		SwOpts: &SwOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+			SecretKeystore: &SecretKeystoreOpts{
+				Address:      server.Address(),
+				Token:        "token",
+				Path:         "secret/fabric",
+				KeyStorePath: filepath.Join(tempDir, "keystore"),
+			},
+		},
+	}
+	secretBackendsMutex.Lock()
+	backends := len(secretBackends)
+	secretBackendsMutex.Unlock()
+	for i := 0; i < 3; i++ {
+		_, err = f.Get(opts)
+		require.NoError(t, err)
+	}
+	secretBackendsMutex.Lock()
+	assert.Equal(t, backends, len(secretBackends))
+	secretBackendsMutex.Unlock()
+}
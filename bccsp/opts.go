@@ -32,6 +32,9 @@ const (
 	// ECDSAReRand ECDSA key re-randomization
 	ECDSAReRand = "ECDSA_RERAND"
 
+	// ED25519 Edwards-curve Digital Signature Algorithm over Curve25519 (key gen, import, sign, verify)
+	ED25519 = "ED25519"
+
 	// RSA at the default security level.
 	// Each BCCSP may or may not support default security level. If not supported than
 	// an error will be returned.
@@ -0,0 +1,143 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/hyperledger/fabric/bccsp/remote/protos"
+	"github.com/pkg/errors"
+)
+
+type signRequest struct {
+	ski    []byte
+	digest []byte
+	result chan signResult
+}
+
+type signResult struct {
+	signature []byte
+	err       error
+}
+
+// batcher groups concurrent signature requests into batches,
+// each of which is sent to the remote signer in a single request
+type batcher struct {
+	client       pb.RemoteSignerClient
+	timeout      time.Duration
+	maxBatchSize int
+	batchTimeout time.Duration
+
+	requests chan *signRequest
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+func newBatcher(client pb.RemoteSignerClient, timeout time.Duration, maxBatchSize int, batchTimeout time.Duration) *batcher {
+	b := &batcher{
+		client:       client,
+		timeout:      timeout,
+		maxBatchSize: maxBatchSize,
+		batchTimeout: batchTimeout,
+		requests:     make(chan *signRequest),
+		stop:         make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// sign returns the signature of digest with the key identified by ski
+func (b *batcher) sign(ski, digest []byte) ([]byte, error) {
+	req := &signRequest{ski: ski, digest: digest, result: make(chan signResult, 1)}
+	select {
+	case b.requests <- req:
+	case <-b.stop:
+		return nil, errors.New("remote signer client is closed")
+	}
+
+	res := <-req.result
+	return res.signature, res.err
+}
+
+func (b *batcher) close() {
+	b.stopOnce.Do(func() {
+		close(b.stop)
+	})
+}
+
+func (b *batcher) run() {
+	for {
+		var first *signRequest
+		select {
+		case first = <-b.requests:
+		case <-b.stop:
+			return
+		}
+
+		go b.send(b.fill([]*signRequest{first}))
+	}
+}
+
+// fill adds pending requests to batch until it is full or,
+// if a batch timeout is set, until the batch timeout expires
+func (b *batcher) fill(batch []*signRequest) []*signRequest {
+	if b.batchTimeout == 0 {
+		for len(batch) < b.maxBatchSize {
+			select {
+			case req := <-b.requests:
+				batch = append(batch, req)
+			default:
+				return batch
+			}
+		}
+		return batch
+	}
+
+	timer := time.NewTimer(b.batchTimeout)
+	defer timer.Stop()
+	for len(batch) < b.maxBatchSize {
+		select {
+		case req := <-b.requests:
+			batch = append(batch, req)
+		case <-timer.C:
+			return batch
+		case <-b.stop:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (b *batcher) send(batch []*signRequest) {
+	req := &pb.SignBatchRequest{}
+	for _, r := range batch {
+		req.Requests = append(req.Requests, &pb.SignRequest{Ski: r.ski, Digest: r.digest})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	resp, err := b.client.Sign(ctx, req)
+	if err == nil && len(resp.Responses) != len(batch) {
+		err = errors.Errorf("expected %d signatures, got %d", len(batch), len(resp.Responses))
+	}
+	if err != nil {
+		for _, r := range batch {
+			r.result <- signResult{err: errors.Wrap(err, "failed requesting signature to remote signer")}
+		}
+		return
+	}
+
+	for i, r := range batch {
+		if msg := resp.Responses[i].Error; msg != "" {
+			r.result <- signResult{err: errors.Errorf("remote signer failed signing: %s", msg)}
+			continue
+		}
+		r.result <- signResult{signature: resp.Responses[i].Signature}
+	}
+}
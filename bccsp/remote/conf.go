@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import "time"
+
+const (
+	defaultTimeout      = 5 * time.Second
+	defaultMaxBatchSize = 32
+)
+
+// RemoteOpts contains options for the RemoteFactory
+type RemoteOpts struct {
+	// Default algorithms when not specified (Deprecated?)
+	SecLevel   int    `mapstructure:"security" json:"security"`
+	HashFamily string `mapstructure:"hash" json:"hash"`
+
+	// Keystore options, the key store holds references
+	// to the keys held by the remote signer
+	Ephemeral     bool               `mapstructure:"tempkeys,omitempty" json:"tempkeys,omitempty"`
+	FileKeystore  *FileKeystoreOpts  `mapstructure:"filekeystore,omitempty" json:"filekeystore,omitempty"`
+	DummyKeystore *DummyKeystoreOpts `mapstructure:"dummykeystore,omitempty" json:"dummykeystore,omitempty"`
+
+	// Remote signer options
+	Address            string   `mapstructure:"address" json:"address"`
+	ServerNameOverride string   `mapstructure:"servernameoverride,omitempty" json:"servernameoverride,omitempty"`
+	ClientCert         string   `mapstructure:"clientcert" json:"clientcert"`
+	ClientKey          string   `mapstructure:"clientkey" json:"clientkey"`
+	RootCAs            []string `mapstructure:"rootcas" json:"rootcas"`
+	// Timeout bounds each request to the remote signer
+	Timeout time.Duration `mapstructure:"timeout,omitempty" json:"timeout,omitempty"`
+	// MaxBatchSize is the maximum number of signatures requested at once
+	MaxBatchSize int `mapstructure:"maxbatchsize,omitempty" json:"maxbatchsize,omitempty"`
+	// BatchTimeout is the time to wait for more signature requests to
+	// batch once a first one is pending. When zero, only the requests
+	// already pending are batched together.
+	BatchTimeout time.Duration `mapstructure:"batchtimeout,omitempty" json:"batchtimeout,omitempty"`
+}
+
+// FileKeystoreOpts configures the file keystore holding the key references
+type FileKeystoreOpts struct {
+	KeyStorePath string `mapstructure:"keystore" json:"keystore" yaml:"KeyStore"`
+}
+
+// DummyKeystoreOpts is placeholder for testing purposes
+type DummyKeystoreOpts struct{}
@@ -0,0 +1,266 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"io/ioutil"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	pb "github.com/hyperledger/fabric/bccsp/remote/protos"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var logger = flogging.MustGetLogger("bccsp_remote")
+
+// New returns a BCCSP that delegates key generation, key retrieval and
+// signing to a remote signer, reached over mutually authenticated gRPC.
+// The key store holds the public keys of the key pairs held by the
+// remote signer, as references to them; all the other operations are
+// performed by a software-based BCCSP.
+func New(opts RemoteOpts, keyStore bccsp.KeyStore) (bccsp.BCCSP, error) {
+	// Check KeyStore
+	if keyStore == nil {
+		return nil, errors.New("Invalid bccsp.KeyStore instance. It must be different from nil")
+	}
+
+	swCSP, err := sw.NewWithParams(opts.SecLevel, opts.HashFamily, keyStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed initializing fallback SW BCCSP")
+	}
+
+	if opts.Address == "" {
+		return nil, errors.New("Invalid config: missing remote signer address")
+	}
+	tlsConfig, err := clientTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(opts.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed connecting to remote signer at %s", opts.Address)
+	}
+
+	return newImpl(swCSP, keyStore, conn, opts), nil
+}
+
+func newImpl(swCSP bccsp.BCCSP, keyStore bccsp.KeyStore, conn *grpc.ClientConn, opts RemoteOpts) *impl {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	maxBatchSize := opts.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+
+	client := pb.NewRemoteSignerClient(conn)
+	return &impl{
+		BCCSP:   swCSP,
+		ks:      keyStore,
+		conn:    conn,
+		client:  client,
+		timeout: timeout,
+		batcher: newBatcher(client, timeout, maxBatchSize, opts.BatchTimeout),
+	}
+}
+
+func clientTLSConfig(opts RemoteOpts) (*tls.Config, error) {
+	if opts.ClientCert == "" || opts.ClientKey == "" {
+		return nil, errors.New("Invalid config: a client certificate and key are required to authenticate to the remote signer")
+	}
+	if len(opts.RootCAs) == 0 {
+		return nil, errors.New("Invalid config: root CAs are required to authenticate the remote signer")
+	}
+
+	cert, err := tls.LoadX509KeyPair(opts.ClientCert, opts.ClientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed loading client certificate and key")
+	}
+	roots := x509.NewCertPool()
+	for _, file := range opts.RootCAs {
+		pem, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed reading root CA %s", file)
+		}
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("Failed parsing root CA %s", file)
+		}
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      roots,
+		ServerName:   opts.ServerNameOverride,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+type impl struct {
+	bccsp.BCCSP
+
+	ks bccsp.KeyStore
+
+	conn    *grpc.ClientConn
+	client  pb.RemoteSignerClient
+	timeout time.Duration
+	batcher *batcher
+}
+
+// KeyGen generates a key using opts.
+func (csp *impl) KeyGen(opts bccsp.KeyGenOpts) (bccsp.Key, error) {
+	// Validate arguments
+	if opts == nil {
+		return nil, errors.New("Invalid Opts parameter. It must not be nil")
+	}
+
+	// Only signing keys are held by the remote signer
+	switch opts.(type) {
+	case *bccsp.ECDSAKeyGenOpts, *bccsp.ECDSAP256KeyGenOpts, *bccsp.ECDSAP384KeyGenOpts, *bccsp.ED25519KeyGenOpts:
+	default:
+		return csp.BCCSP.KeyGen(opts)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csp.timeout)
+	defer cancel()
+	info, err := csp.client.KeyGen(ctx, &pb.KeyGenRequest{Algorithm: opts.Algorithm(), Ephemeral: opts.Ephemeral()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed generating %s key with remote signer", opts.Algorithm())
+	}
+
+	k, err := csp.toRemoteKey(info)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Ephemeral() {
+		if err := csp.ks.StoreKey(k.pub); err != nil {
+			return nil, errors.Wrapf(err, "Failed storing reference to remote key")
+		}
+	}
+
+	return k, nil
+}
+
+// GetKey returns the key this CSP associates to
+// the Subject Key Identifier ski.
+func (csp *impl) GetKey(ski []byte) (bccsp.Key, error) {
+	if len(ski) == 0 {
+		return nil, errors.New("Invalid SKI. Cannot be of zero length.")
+	}
+
+	// A public key in the key store is a reference to a key held by the remote signer
+	if k, err := csp.ks.GetKey(ski); err == nil {
+		if k.Private() || k.Symmetric() {
+			return k, nil
+		}
+		return &remoteKey{ski: ski, pub: k}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csp.timeout)
+	defer cancel()
+	info, err := csp.client.GetKey(ctx, &pb.GetKeyRequest{Ski: ski})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed getting key with SKI [%s] from remote signer", hex.EncodeToString(ski))
+	}
+
+	k, err := csp.toRemoteKey(info)
+	if err != nil {
+		return nil, err
+	}
+
+	if !csp.ks.ReadOnly() {
+		if err := csp.ks.StoreKey(k.pub); err != nil {
+			logger.Warningf("Failed storing reference to remote key with SKI [%s]: %s", hex.EncodeToString(ski), err)
+		}
+	}
+
+	return k, nil
+}
+
+func (csp *impl) toRemoteKey(info *pb.KeyInfo) (*remoteKey, error) {
+	pk, err := x509.ParsePKIXPublicKey(info.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed parsing public key returned by remote signer")
+	}
+
+	var opts bccsp.KeyImportOpts
+	switch pk.(type) {
+	case *ecdsa.PublicKey:
+		opts = &bccsp.ECDSAGoPublicKeyImportOpts{Temporary: true}
+	case ed25519.PublicKey:
+		opts = &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true}
+	case *rsa.PublicKey:
+		opts = &bccsp.RSAGoPublicKeyImportOpts{Temporary: true}
+	default:
+		return nil, errors.Errorf("Unsupported public key type %T returned by remote signer", pk)
+	}
+
+	pub, err := csp.BCCSP.KeyImport(pk, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed importing public key returned by remote signer")
+	}
+
+	return &remoteKey{ski: info.Ski, pub: pub}, nil
+}
+
+// Sign signs digest using key k.
+// The opts argument should be appropriate for the primitive used.
+//
+// Note that when a signature of a hash of a larger message is needed,
+// the caller is responsible for hashing the larger message and passing
+// the hash (as digest).
+func (csp *impl) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	// Validate arguments
+	if k == nil {
+		return nil, errors.New("Invalid Key. It must not be nil")
+	}
+	if len(digest) == 0 {
+		return nil, errors.New("Invalid digest. Cannot be empty")
+	}
+
+	// Check key type
+	switch k := k.(type) {
+	case *remoteKey:
+		return csp.batcher.sign(k.ski, digest)
+	default:
+		return csp.BCCSP.Sign(k, digest, opts)
+	}
+}
+
+// Verify verifies signature against key k and digest
+func (csp *impl) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	// Validate arguments
+	if k == nil {
+		return false, errors.New("Invalid Key. It must not be nil")
+	}
+
+	// Check key type
+	switch k := k.(type) {
+	case *remoteKey:
+		return csp.BCCSP.Verify(k.pub, signature, digest, opts)
+	default:
+		return csp.BCCSP.Verify(k, signature, digest, opts)
+	}
+}
+
+// Close releases the connection to the remote signer
+func (csp *impl) Close() error {
+	csp.batcher.close()
+	return csp.conn.Close()
+}
@@ -0,0 +1,375 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	pb "github.com/hyperledger/fabric/bccsp/remote/protos"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// countingServer counts the requests served by a SignerServer
+// and optionally delays them
+type countingServer struct {
+	*SignerServer
+	delay      time.Duration
+	getKeys    int32
+	signs      int32
+	signatures int32
+}
+
+func (s *countingServer) GetKey(ctx context.Context, req *pb.GetKeyRequest) (*pb.KeyInfo, error) {
+	atomic.AddInt32(&s.getKeys, 1)
+	return s.SignerServer.GetKey(ctx, req)
+}
+
+func (s *countingServer) Sign(ctx context.Context, req *pb.SignBatchRequest) (*pb.SignBatchResponse, error) {
+	atomic.AddInt32(&s.signs, 1)
+	atomic.AddInt32(&s.signatures, int32(len(req.Requests)))
+	time.Sleep(s.delay)
+	return s.SignerServer.Sign(ctx, req)
+}
+
+type testEnv struct {
+	dir     string
+	address string
+	ca      tlsgen.CA
+	csp     bccsp.BCCSP
+	server  *countingServer
+	stop    func()
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	dir, err := ioutil.TempDir("", "remotebccsp")
+	require.NoError(t, err)
+
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	serverKP, err := ca.NewServerCertKeyPair("127.0.0.1")
+	require.NoError(t, err)
+	serverCert, err := tls.X509KeyPair(serverKP.Cert, serverKP.Key)
+	require.NoError(t, err)
+	clientRoots := x509.NewCertPool()
+	clientRoots.AppendCertsFromPEM(ca.CertBytes())
+
+	csp, err := sw.NewWithParams(256, "SHA2", sw.NewInMemoryKeyStore())
+	require.NoError(t, err)
+	server := &countingServer{SignerServer: NewSignerServer(csp)}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	gs := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientRoots,
+	})))
+	pb.RegisterRemoteSignerServer(gs, server)
+	go gs.Serve(lis)
+
+	env := &testEnv{
+		dir:     dir,
+		address: lis.Addr().String(),
+		ca:      ca,
+		csp:     csp,
+		server:  server,
+	}
+	env.stop = func() {
+		gs.Stop()
+		os.RemoveAll(dir)
+	}
+	return env
+}
+
+// opts returns client options with a client certificate issued by ca
+func (env *testEnv) opts(t *testing.T, ca tlsgen.CA) RemoteOpts {
+	clientKP, err := ca.NewClientCertKeyPair()
+	require.NoError(t, err)
+
+	write := func(name string, content []byte) string {
+		path := filepath.Join(env.dir, name)
+		require.NoError(t, ioutil.WriteFile(path, content, 0600))
+		return path
+	}
+
+	return RemoteOpts{
+		SecLevel:   256,
+		HashFamily: "SHA2",
+		Address:    env.address,
+		ClientCert: write("client.crt", clientKP.Cert),
+		ClientKey:  write("client.key", clientKP.Key),
+		RootCAs:    []string{write("ca.crt", env.ca.CertBytes())},
+		Timeout:    time.Second,
+	}
+}
+
+func (env *testEnv) newCSP(t *testing.T, opts RemoteOpts, ks bccsp.KeyStore) *impl {
+	csp, err := New(opts, ks)
+	require.NoError(t, err)
+	return csp.(*impl)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	opts := env.opts(t, env.ca)
+	_, err := New(opts, nil)
+	assert.EqualError(t, err, "Invalid bccsp.KeyStore instance. It must be different from nil")
+
+	badOpts := opts
+	badOpts.Address = ""
+	_, err = New(badOpts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid config: missing remote signer address")
+
+	badOpts = opts
+	badOpts.ClientKey = ""
+	_, err = New(badOpts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid config: a client certificate and key are required to authenticate to the remote signer")
+
+	badOpts = opts
+	badOpts.RootCAs = nil
+	_, err = New(badOpts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid config: root CAs are required to authenticate the remote signer")
+
+	badOpts = opts
+	badOpts.RootCAs = []string{filepath.Join(env.dir, "missing.crt")}
+	_, err = New(badOpts, sw.NewDummyKeyStore())
+	assert.Contains(t, err.Error(), "Failed reading root CA")
+}
+
+func TestKeyGenSignVerify(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	ksPath := filepath.Join(env.dir, "keystore")
+	ks, err := sw.NewFileBasedKeyStore(nil, ksPath, false)
+	require.NoError(t, err)
+	csp := env.newCSP(t, env.opts(t, env.ca), ks)
+	defer csp.Close()
+
+	for _, opts := range []bccsp.KeyGenOpts{
+		&bccsp.ECDSAP256KeyGenOpts{},
+		&bccsp.ECDSAP384KeyGenOpts{},
+		&bccsp.ED25519KeyGenOpts{},
+	} {
+		t.Run(opts.Algorithm(), func(t *testing.T) {
+			k, err := csp.KeyGen(opts)
+			require.NoError(t, err)
+			assert.True(t, k.Private())
+			assert.False(t, k.Symmetric())
+			_, err = k.Bytes()
+			assert.Error(t, err)
+
+			// The private key is held by the remote signer only
+			remoteKey, err := env.csp.GetKey(k.SKI())
+			require.NoError(t, err)
+			assert.True(t, remoteKey.Private())
+			_, err = ioutil.ReadFile(filepath.Join(ksPath, hex.EncodeToString(k.SKI())+"_sk"))
+			assert.True(t, os.IsNotExist(err))
+
+			msg := []byte("hello world")
+			digest := msg
+			if opts.Algorithm() != bccsp.ED25519 {
+				h := sha256.Sum256(msg)
+				digest = h[:]
+			}
+			signature, err := csp.Sign(k, digest, nil)
+			require.NoError(t, err)
+
+			valid, err := csp.Verify(k, signature, digest, nil)
+			require.NoError(t, err)
+			assert.True(t, valid)
+			pub, err := k.PublicKey()
+			require.NoError(t, err)
+			valid, err = csp.Verify(pub, signature, digest, nil)
+			require.NoError(t, err)
+			assert.True(t, valid)
+
+			// A reference to the key is stored by SKI in the key store,
+			// so that a new instance finds the key without asking the remote signer
+			csp2 := env.newCSP(t, env.opts(t, env.ca), ks)
+			defer csp2.Close()
+			getKeys := atomic.LoadInt32(&env.server.getKeys)
+			k2, err := csp2.GetKey(k.SKI())
+			require.NoError(t, err)
+			assert.True(t, k2.Private())
+			assert.Equal(t, getKeys, atomic.LoadInt32(&env.server.getKeys))
+			signature, err = csp2.Sign(k2, digest, nil)
+			require.NoError(t, err)
+			valid, err = csp2.Verify(k2, signature, digest, nil)
+			require.NoError(t, err)
+			assert.True(t, valid)
+		})
+	}
+}
+
+func TestEphemeralKeyGen(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	ks := sw.NewInMemoryKeyStore()
+	csp := env.newCSP(t, env.opts(t, env.ca), ks)
+	defer csp.Close()
+
+	k, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: true})
+	require.NoError(t, err)
+	_, err = ks.GetKey(k.SKI())
+	assert.Error(t, err)
+}
+
+func TestGetKeyFromRemoteSigner(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	remoteKey, err := env.csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	require.NoError(t, err)
+
+	ks := sw.NewInMemoryKeyStore()
+	csp := env.newCSP(t, env.opts(t, env.ca), ks)
+	defer csp.Close()
+
+	k, err := csp.GetKey(remoteKey.SKI())
+	require.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.Equal(t, remoteKey.SKI(), k.SKI())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&env.server.getKeys))
+
+	// The reference to the key has been stored
+	_, err = csp.GetKey(remoteKey.SKI())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&env.server.getKeys))
+
+	_, err = csp.GetKey([]byte{1, 2, 3})
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = csp.GetKey(nil)
+	assert.EqualError(t, err, "Invalid SKI. Cannot be of zero length.")
+}
+
+func TestSignBatching(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+	env.server.delay = 100 * time.Millisecond
+
+	opts := env.opts(t, env.ca)
+	opts.MaxBatchSize = 10
+	opts.BatchTimeout = 50 * time.Millisecond
+	csp := env.newCSP(t, opts, sw.NewInMemoryKeyStore())
+	defer csp.Close()
+
+	k, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("hello world"))
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			signature, err := csp.Sign(k, digest[:], nil)
+			assert.NoError(t, err)
+			valid, err := csp.Verify(k, signature, digest[:], nil)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(20), atomic.LoadInt32(&env.server.signatures))
+	assert.True(t, atomic.LoadInt32(&env.server.signs) < 20)
+}
+
+func TestSignErrors(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	opts := env.opts(t, env.ca)
+	opts.Timeout = 100 * time.Millisecond
+	csp := env.newCSP(t, opts, sw.NewInMemoryKeyStore())
+	defer csp.Close()
+
+	k, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("hello world"))
+
+	_, err = csp.Sign(nil, digest[:], nil)
+	assert.EqualError(t, err, "Invalid Key. It must not be nil")
+	_, err = csp.Sign(k, nil, nil)
+	assert.EqualError(t, err, "Invalid digest. Cannot be empty")
+
+	// Per-request errors are reported to the corresponding caller
+	_, err = csp.Sign(&remoteKey{ski: []byte{1, 2, 3}, pub: k.(*remoteKey).pub}, digest[:], nil)
+	assert.Contains(t, err.Error(), "remote signer failed signing: private key with SKI [010203] not found")
+
+	// Requests time out
+	env.server.delay = time.Second
+	_, err = csp.Sign(k, digest[:], nil)
+	assert.Contains(t, err.Error(), "failed requesting signature to remote signer")
+	assert.Contains(t, err.Error(), "DeadlineExceeded")
+
+	csp.Close()
+	_, err = csp.Sign(k, digest[:], nil)
+	assert.EqualError(t, err, "remote signer client is closed")
+}
+
+func TestUntrustedClient(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	otherCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	opts := env.opts(t, otherCA)
+	opts.Timeout = time.Second
+	csp := env.newCSP(t, opts, sw.NewInMemoryKeyStore())
+	defer csp.Close()
+
+	_, err = csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	assert.Contains(t, err.Error(), "Failed generating ECDSAP256 key with remote signer")
+}
+
+func TestSoftwareFallback(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.stop()
+
+	csp := env.newCSP(t, env.opts(t, env.ca), sw.NewInMemoryKeyStore())
+	defer csp.Close()
+
+	// Symmetric keys are not held by the remote signer
+	k, err := csp.KeyGen(&bccsp.AESKeyGenOpts{})
+	require.NoError(t, err)
+	_, err = env.csp.GetKey(k.SKI())
+	assert.Error(t, err)
+
+	ciphertext, err := csp.Encrypt(k, []byte("hello world"), &bccsp.AESCBCPKCS7ModeOpts{})
+	require.NoError(t, err)
+	plaintext, err := csp.Decrypt(k, ciphertext, &bccsp.AESCBCPKCS7ModeOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), plaintext)
+
+	k2, err := csp.GetKey(k.SKI())
+	require.NoError(t, err)
+	assert.Equal(t, k, k2)
+}
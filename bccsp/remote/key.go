@@ -0,0 +1,48 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric/bccsp"
+)
+
+// remoteKey is a reference to a private key held by the remote signer
+type remoteKey struct {
+	ski []byte
+	pub bccsp.Key
+}
+
+// Bytes converts this key to its byte representation,
+// if this operation is allowed.
+func (k *remoteKey) Bytes() ([]byte, error) {
+	return nil, errors.New("Not supported.")
+}
+
+// SKI returns the subject key identifier of this key.
+func (k *remoteKey) SKI() []byte {
+	return k.ski
+}
+
+// Symmetric returns true if this key is a symmetric key,
+// false if this key is asymmetric
+func (k *remoteKey) Symmetric() bool {
+	return false
+}
+
+// Private returns true if this key is a private key,
+// false otherwise.
+func (k *remoteKey) Private() bool {
+	return true
+}
+
+// PublicKey returns the corresponding public key part of an asymmetric public/private key pair.
+// This method returns an error in symmetric key schemes.
+func (k *remoteKey) PublicKey() (bccsp.Key, error) {
+	return k.pub, nil
+}
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: remote.proto
+
+package protos // import "github.com/hyperledger/fabric/bccsp/remote/protos"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// KeyGenRequest requests the generation of a key pair
+type KeyGenRequest struct {
+	// algorithm is the BCCSP key generation algorithm, e.g. ECDSAP256
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// ephemeral is true if the key pair does not need to be persisted
+	Ephemeral            bool     `protobuf:"varint,2,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyGenRequest) Reset()         { *m = KeyGenRequest{} }
+func (m *KeyGenRequest) String() string { return proto.CompactTextString(m) }
+func (*KeyGenRequest) ProtoMessage()    {}
+func (*KeyGenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{0}
+}
+func (m *KeyGenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyGenRequest.Unmarshal(m, b)
+}
+func (m *KeyGenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyGenRequest.Marshal(b, m, deterministic)
+}
+func (dst *KeyGenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyGenRequest.Merge(dst, src)
+}
+func (m *KeyGenRequest) XXX_Size() int {
+	return xxx_messageInfo_KeyGenRequest.Size(m)
+}
+func (m *KeyGenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyGenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyGenRequest proto.InternalMessageInfo
+
+func (m *KeyGenRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *KeyGenRequest) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+// GetKeyRequest requests the key pair with the given SKI
+type GetKeyRequest struct {
+	Ski                  []byte   `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeyRequest) Reset()         { *m = GetKeyRequest{} }
+func (m *GetKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeyRequest) ProtoMessage()    {}
+func (*GetKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{1}
+}
+func (m *GetKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyRequest.Unmarshal(m, b)
+}
+func (m *GetKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyRequest.Merge(dst, src)
+}
+func (m *GetKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeyRequest.Size(m)
+}
+func (m *GetKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyRequest proto.InternalMessageInfo
+
+func (m *GetKeyRequest) GetSki() []byte {
+	if m != nil {
+		return m.Ski
+	}
+	return nil
+}
+
+// KeyInfo describes a key pair held by the remote signer
+type KeyInfo struct {
+	Ski []byte `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	// public_key is the PKIX, ASN.1 DER encoded public key
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
+func (m *KeyInfo) String() string { return proto.CompactTextString(m) }
+func (*KeyInfo) ProtoMessage()    {}
+func (*KeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{2}
+}
+func (m *KeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyInfo.Unmarshal(m, b)
+}
+func (m *KeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyInfo.Marshal(b, m, deterministic)
+}
+func (dst *KeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyInfo.Merge(dst, src)
+}
+func (m *KeyInfo) XXX_Size() int {
+	return xxx_messageInfo_KeyInfo.Size(m)
+}
+func (m *KeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyInfo proto.InternalMessageInfo
+
+func (m *KeyInfo) GetSki() []byte {
+	if m != nil {
+		return m.Ski
+	}
+	return nil
+}
+
+func (m *KeyInfo) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+// SignRequest requests a signature of digest with the key of the given SKI
+type SignRequest struct {
+	Ski                  []byte   `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Digest               []byte   `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{3}
+}
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRequest.Unmarshal(m, b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(dst, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return xxx_messageInfo_SignRequest.Size(m)
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetSki() []byte {
+	if m != nil {
+		return m.Ski
+	}
+	return nil
+}
+
+func (m *SignRequest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// SignResponse carries either a signature or, if the signing failed,
+// the reason of the failure
+type SignResponse struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{4}
+}
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (dst *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(dst, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SignBatchRequest carries several sign requests, which are processed
+// independently of each other
+type SignBatchRequest struct {
+	Requests             []*SignRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SignBatchRequest) Reset()         { *m = SignBatchRequest{} }
+func (m *SignBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SignBatchRequest) ProtoMessage()    {}
+func (*SignBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{5}
+}
+func (m *SignBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignBatchRequest.Unmarshal(m, b)
+}
+func (m *SignBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBatchRequest.Merge(dst, src)
+}
+func (m *SignBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_SignBatchRequest.Size(m)
+}
+func (m *SignBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignBatchRequest proto.InternalMessageInfo
+
+func (m *SignBatchRequest) GetRequests() []*SignRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// SignBatchResponse carries one response per request of a
+// SignBatchRequest, in the same order
+type SignBatchResponse struct {
+	Responses            []*SignResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SignBatchResponse) Reset()         { *m = SignBatchResponse{} }
+func (m *SignBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SignBatchResponse) ProtoMessage()    {}
+func (*SignBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_c8e64172b6377b03, []int{6}
+}
+func (m *SignBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignBatchResponse.Unmarshal(m, b)
+}
+func (m *SignBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SignBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBatchResponse.Merge(dst, src)
+}
+func (m *SignBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SignBatchResponse.Size(m)
+}
+func (m *SignBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignBatchResponse proto.InternalMessageInfo
+
+func (m *SignBatchResponse) GetResponses() []*SignResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KeyGenRequest)(nil), "remote.KeyGenRequest")
+	proto.RegisterType((*GetKeyRequest)(nil), "remote.GetKeyRequest")
+	proto.RegisterType((*KeyInfo)(nil), "remote.KeyInfo")
+	proto.RegisterType((*SignRequest)(nil), "remote.SignRequest")
+	proto.RegisterType((*SignResponse)(nil), "remote.SignResponse")
+	proto.RegisterType((*SignBatchRequest)(nil), "remote.SignBatchRequest")
+	proto.RegisterType((*SignBatchResponse)(nil), "remote.SignBatchResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	// KeyGen generates a new key pair and returns its public part
+	KeyGen(ctx context.Context, in *KeyGenRequest, opts ...grpc.CallOption) (*KeyInfo, error)
+	// GetKey returns the public part of the key pair with the given SKI
+	GetKey(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error)
+	// Sign signs a batch of digests, each with the key of the given SKI
+	Sign(ctx context.Context, in *SignBatchRequest, opts ...grpc.CallOption) (*SignBatchResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) KeyGen(ctx context.Context, in *KeyGenRequest, opts ...grpc.CallOption) (*KeyInfo, error) {
+	out := new(KeyInfo)
+	err := c.cc.Invoke(ctx, "/remote.RemoteSigner/KeyGen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) GetKey(ctx context.Context, in *GetKeyRequest, opts ...grpc.CallOption) (*KeyInfo, error) {
+	out := new(KeyInfo)
+	err := c.cc.Invoke(ctx, "/remote.RemoteSigner/GetKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *SignBatchRequest, opts ...grpc.CallOption) (*SignBatchResponse, error) {
+	out := new(SignBatchResponse)
+	err := c.cc.Invoke(ctx, "/remote.RemoteSigner/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	// KeyGen generates a new key pair and returns its public part
+	KeyGen(context.Context, *KeyGenRequest) (*KeyInfo, error)
+	// GetKey returns the public part of the key pair with the given SKI
+	GetKey(context.Context, *GetKeyRequest) (*KeyInfo, error)
+	// Sign signs a batch of digests, each with the key of the given SKI
+	Sign(context.Context, *SignBatchRequest) (*SignBatchResponse, error)
+}
+
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_KeyGen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyGenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).KeyGen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.RemoteSigner/KeyGen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).KeyGen(ctx, req.(*KeyGenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_GetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).GetKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.RemoteSigner/GetKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).GetKey(ctx, req.(*GetKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.RemoteSigner/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*SignBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "remote.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "KeyGen",
+			Handler:    _RemoteSigner_KeyGen_Handler,
+		},
+		{
+			MethodName: "GetKey",
+			Handler:    _RemoteSigner_GetKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSigner_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "remote.proto",
+}
+
+func init() { proto.RegisterFile("remote.proto", fileDescriptor_remote_c8e64172b6377b03) }
+
+var fileDescriptor_remote_c8e64172b6377b03 = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0x9b, 0x40,
+	0x10, 0xc6, 0x45, 0xdd, 0x52, 0x33, 0xc6, 0xaa, 0xbb, 0x75, 0x2b, 0x6a, 0xb5, 0x92, 0xcb, 0xc9,
+	0x27, 0xe3, 0xda, 0x87, 0x4a, 0xcd, 0xcd, 0x39, 0x58, 0x11, 0xb7, 0xcd, 0x2d, 0x97, 0x08, 0xf0,
+	0x18, 0x56, 0x06, 0x96, 0xec, 0x2e, 0x07, 0x9e, 0x2a, 0xaf, 0x18, 0xc1, 0x42, 0xc0, 0x72, 0x72,
+	0x62, 0xfe, 0x7d, 0x3f, 0x3e, 0x66, 0x00, 0x5b, 0x60, 0xc6, 0x15, 0xae, 0x0b, 0xc1, 0x15, 0x27,
+	0xa6, 0xce, 0x5c, 0x1f, 0xa6, 0x3e, 0x56, 0x07, 0xcc, 0x29, 0x3e, 0x95, 0x28, 0x15, 0xf9, 0x05,
+	0x56, 0x90, 0xc6, 0x5c, 0x30, 0x95, 0x64, 0x8e, 0xb1, 0x34, 0x56, 0x16, 0xed, 0x0b, 0x75, 0x17,
+	0x8b, 0x04, 0x33, 0x14, 0x41, 0xea, 0x7c, 0x58, 0x1a, 0xab, 0x31, 0xed, 0x0b, 0xee, 0x1f, 0x98,
+	0x1e, 0x50, 0xf9, 0x58, 0x75, 0xb0, 0x19, 0x8c, 0xe4, 0x99, 0x35, 0x18, 0x9b, 0xd6, 0xa1, 0xfb,
+	0x1f, 0x3e, 0xfb, 0x58, 0xdd, 0xe5, 0x27, 0x7e, 0xdd, 0x24, 0xbf, 0x01, 0x8a, 0x32, 0x4c, 0x59,
+	0xf4, 0x78, 0xc6, 0xaa, 0xc1, 0xdb, 0xd4, 0xd2, 0x15, 0x1f, 0x2b, 0xf7, 0x1f, 0x4c, 0xee, 0x59,
+	0x9c, 0xbf, 0x0b, 0x27, 0x3f, 0xc0, 0x3c, 0xb2, 0x18, 0xa5, 0x6a, 0xb5, 0x6d, 0xe6, 0xee, 0xc1,
+	0xd6, 0x42, 0x59, 0xf0, 0x5c, 0x62, 0xfd, 0x15, 0x92, 0xc5, 0x79, 0xa0, 0x4a, 0x81, 0xad, 0xbe,
+	0x2f, 0x90, 0x39, 0x7c, 0x42, 0x21, 0xb8, 0x68, 0x20, 0x16, 0xd5, 0x89, 0x7b, 0x0b, 0xb3, 0x9a,
+	0xb1, 0x0f, 0x54, 0x94, 0x74, 0x0e, 0x3c, 0x18, 0x0b, 0x1d, 0x4a, 0xc7, 0x58, 0x8e, 0x56, 0x93,
+	0xed, 0xb7, 0x75, 0xbb, 0xe5, 0x81, 0x51, 0xfa, 0x3a, 0xe4, 0x1e, 0xe0, 0xeb, 0x00, 0xd2, 0xba,
+	0xd9, 0x82, 0x25, 0xda, 0xb8, 0xc3, 0xcc, 0x2f, 0x31, 0xba, 0x49, 0xfb, 0xb1, 0xed, 0xb3, 0x01,
+	0x36, 0x6d, 0x46, 0xea, 0x09, 0x14, 0x64, 0x03, 0xa6, 0xbe, 0x23, 0xf9, 0xde, 0x69, 0x2f, 0xee,
+	0xba, 0xf8, 0x32, 0x28, 0x37, 0xeb, 0xdf, 0x80, 0xa9, 0x8f, 0xd5, 0x2b, 0x2e, 0x8e, 0x77, 0xad,
+	0xb8, 0x81, 0x8f, 0xf5, 0xdb, 0x88, 0x33, 0x74, 0x37, 0x5c, 0xc8, 0xe2, 0xe7, 0x1b, 0x1d, 0x6d,
+	0x79, 0xbf, 0x7b, 0xf8, 0x1b, 0x33, 0x95, 0x94, 0xe1, 0x3a, 0xe2, 0x99, 0x97, 0x54, 0x05, 0x8a,
+	0x14, 0x8f, 0x31, 0x0a, 0xef, 0x14, 0x84, 0x82, 0x45, 0x5e, 0x18, 0x45, 0xb2, 0xf0, 0xb4, 0xde,
+	0x6b, 0x7e, 0x52, 0x19, 0x9a, 0xcd, 0x73, 0xf7, 0x32, 0x00, 0x06, 0x5f, 0x61, 0x52, 0xbc, 0x02,
+	0x00, 0x00,
+}
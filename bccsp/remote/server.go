@@ -0,0 +1,97 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/hyperledger/fabric/bccsp"
+	pb "github.com/hyperledger/fabric/bccsp/remote/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SignerServer is a reference implementation of the remote signer
+// service that holds its keys in a local BCCSP. It is meant for
+// testing: production deployments are expected to front a
+// key-management service or an HSM, and to only serve clients
+// authenticated via mutual TLS.
+type SignerServer struct {
+	csp bccsp.BCCSP
+}
+
+// NewSignerServer returns a SignerServer that holds its keys in csp
+func NewSignerServer(csp bccsp.BCCSP) *SignerServer {
+	return &SignerServer{csp: csp}
+}
+
+// KeyGen generates a new key pair and returns its public part
+func (s *SignerServer) KeyGen(ctx context.Context, req *pb.KeyGenRequest) (*pb.KeyInfo, error) {
+	var opts bccsp.KeyGenOpts
+	switch req.Algorithm {
+	case bccsp.ECDSA:
+		opts = &bccsp.ECDSAKeyGenOpts{Temporary: req.Ephemeral}
+	case bccsp.ECDSAP256:
+		opts = &bccsp.ECDSAP256KeyGenOpts{Temporary: req.Ephemeral}
+	case bccsp.ECDSAP384:
+		opts = &bccsp.ECDSAP384KeyGenOpts{Temporary: req.Ephemeral}
+	case bccsp.ED25519:
+		opts = &bccsp.ED25519KeyGenOpts{Temporary: req.Ephemeral}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported key generation algorithm %s", req.Algorithm)
+	}
+
+	k, err := s.csp.KeyGen(opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed generating key: %s", err)
+	}
+	return keyInfo(k)
+}
+
+// GetKey returns the public part of the key pair with the given SKI
+func (s *SignerServer) GetKey(ctx context.Context, req *pb.GetKeyRequest) (*pb.KeyInfo, error) {
+	k, err := s.csp.GetKey(req.Ski)
+	if err != nil || !k.Private() {
+		return nil, status.Errorf(codes.NotFound, "private key with SKI [%s] not found", hex.EncodeToString(req.Ski))
+	}
+	return keyInfo(k)
+}
+
+// Sign signs a batch of digests, each with the key of the given SKI
+func (s *SignerServer) Sign(ctx context.Context, req *pb.SignBatchRequest) (*pb.SignBatchResponse, error) {
+	resp := &pb.SignBatchResponse{}
+	for _, r := range req.Requests {
+		resp.Responses = append(resp.Responses, s.sign(r))
+	}
+	return resp, nil
+}
+
+func (s *SignerServer) sign(req *pb.SignRequest) *pb.SignResponse {
+	k, err := s.csp.GetKey(req.Ski)
+	if err != nil || !k.Private() {
+		return &pb.SignResponse{Error: "private key with SKI [" + hex.EncodeToString(req.Ski) + "] not found"}
+	}
+
+	signature, err := s.csp.Sign(k, req.Digest, nil)
+	if err != nil {
+		return &pb.SignResponse{Error: err.Error()}
+	}
+	return &pb.SignResponse{Signature: signature}
+}
+
+func keyInfo(k bccsp.Key) (*pb.KeyInfo, error) {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed getting public key: %s", err)
+	}
+	raw, err := pub.Bytes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed marshaling public key: %s", err)
+	}
+	return &pb.KeyInfo{Ski: k.SKI(), PublicKey: raw}, nil
+}
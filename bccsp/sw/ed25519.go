@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/hyperledger/fabric/bccsp"
+)
+
+// Ed25519 hashes the message as part of the signature scheme,
+// therefore the signers and verifiers below operate on the message itself
+// rather than on a digest of it.
+
+func signED25519(k ed25519.PrivateKey, msg []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return ed25519.Sign(k, msg), nil
+}
+
+func verifyED25519(k ed25519.PublicKey, signature, msg []byte, opts bccsp.SignerOpts) (bool, error) {
+	if len(signature) != ed25519.SignatureSize {
+		return false, fmt.Errorf("Invalid signature length [%d]. Must be %d bytes", len(signature), ed25519.SignatureSize)
+	}
+	return ed25519.Verify(k, msg, signature), nil
+}
+
+type ed25519Signer struct{}
+
+func (s *ed25519Signer) Sign(k bccsp.Key, msg []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return signED25519(k.(*ed25519PrivateKey).privKey, msg, opts)
+}
+
+type ed25519PrivateKeyVerifier struct{}
+
+func (v *ed25519PrivateKeyVerifier) Verify(k bccsp.Key, signature, msg []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifyED25519(k.(*ed25519PrivateKey).privKey.Public().(ed25519.PublicKey), signature, msg, opts)
+}
+
+type ed25519PublicKeyKeyVerifier struct{}
+
+func (v *ed25519PublicKeyKeyVerifier) Verify(k bccsp.Key, signature, msg []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifyED25519(k.(*ed25519PublicKey).pubKey, signature, msg, opts)
+}
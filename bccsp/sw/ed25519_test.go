@@ -0,0 +1,166 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyED25519(t *testing.T) {
+	t.Parallel()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	msg := []byte("hello world")
+	sigma, err := signED25519(privKey, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := verifyED25519(pubKey, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifyED25519(pubKey, sigma, []byte("hello world!"), nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = verifyED25519(pubKey, sigma[1:], msg, nil)
+	assert.EqualError(t, err, "Invalid signature length [63]. Must be 64 bytes")
+}
+
+func TestED25519KeyGenAndSign(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	_, err = k.Bytes()
+	assert.Error(t, err)
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.False(t, pk.Private())
+	assert.Equal(t, k.SKI(), pk.SKI())
+
+	msg := []byte("Hello World")
+	signature, err := provider.Sign(k, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := provider.Verify(k, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// The key is stored in the key store, and can be retrieved by its SKI
+	k2, err := provider.GetKey(k.SKI())
+	assert.NoError(t, err)
+	assert.True(t, k2.Private())
+	assert.Equal(t, k.SKI(), k2.SKI())
+	valid, err = provider.Verify(k2, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestED25519KeyImport(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	msg := []byte("Hello World")
+	signature := ed25519.Sign(privKey, msg)
+
+	rawPubKey, err := x509.MarshalPKIXPublicKey(pubKey)
+	assert.NoError(t, err)
+	pk, err := provider.KeyImport(rawPubKey, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	valid, err := provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	pkBytes, err := pk.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, rawPubKey, pkBytes)
+
+	pk2, err := provider.KeyImport(pubKey, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), pk2.SKI())
+
+	rawPrivKey, err := x509.MarshalPKCS8PrivateKey(privKey)
+	assert.NoError(t, err)
+	k, err := provider.KeyImport(rawPrivKey, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.NoError(t, err)
+	assert.Equal(t, pk.SKI(), k.SKI())
+
+	_, err = provider.KeyImport(pubKey, &bccsp.ED25519PKIXPublicKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected byte array.")
+
+	_, err = provider.KeyImport([]byte{1, 2, 3}, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed converting PKCS#8 to ED25519 private key")
+
+	_, err = provider.KeyImport(rawPubKey, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected ed25519.PublicKey.")
+}
+
+func TestKeyImportFromX509ED25519PublicKey(t *testing.T) {
+	t.Parallel()
+	provider, _, cleanup := currentTestConfig.Provider(t)
+	defer cleanup()
+
+	k, err := provider.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: false})
+	assert.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test.example.com"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	cryptoSigner, err := signer.New(provider, k)
+	assert.NoError(t, err)
+
+	certRaw, err := x509.CreateCertificate(rand.Reader, &template, &template, cryptoSigner.Public(), cryptoSigner)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+	assert.NoError(t, cert.CheckSignatureFrom(cert))
+
+	pk, err := provider.KeyImport(cert, &bccsp.X509PublicKeyImportOpts{Temporary: false})
+	assert.NoError(t, err)
+	assert.Equal(t, k.SKI(), pk.SKI())
+
+	msg := []byte("Hello World")
+	signature, err := provider.Sign(k, msg, nil)
+	assert.NoError(t, err)
+	valid, err := provider.Verify(pk, signature, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultSecretBackendTimeout = 5 * time.Second
+	secretBackendTokenHeader    = "X-Vault-Token"
+)
+
+// minTokenRenewInterval bounds how often the token lease is renewed
+var minTokenRenewInterval = 100 * time.Millisecond
+
+// ErrSecretNotFound is returned by a SecretBackend when no secret
+// is stored at the requested path
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretBackend stores secrets, as sets of key-value pairs, by path.
+type SecretBackend interface {
+	// ReadSecret returns the secret stored at path, or ErrSecretNotFound
+	ReadSecret(path string) (map[string]string, error)
+
+	// WriteSecret stores the secret data at path
+	WriteSecret(path string, data map[string]string) error
+}
+
+// HTTPSecretBackendConfig configures a SecretBackend reached over HTTP
+type HTTPSecretBackendConfig struct {
+	// Address is the base URL of the secret backend, e.g. https://vault:8200
+	Address string
+	// Token authenticates requests to the secret backend
+	Token string
+	// RootCAs are the PEM encoded root certificates used to
+	// authenticate the secret backend over TLS
+	RootCAs [][]byte
+	// Timeout bounds each request to the secret backend
+	Timeout time.Duration
+}
+
+// HTTPSecretBackend is a SecretBackend speaking the HTTP API of a
+// HashiCorp Vault key-value secrets engine. Requests are authenticated
+// with a token, whose lease is renewed in the background as long as
+// the backend is not closed.
+type HTTPSecretBackend struct {
+	address string
+	client  *http.Client
+
+	mutex sync.RWMutex
+	token string
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+type secretResponse struct {
+	Data          map[string]string `json:"data"`
+	LeaseDuration int64             `json:"lease_duration"`
+	Errors        []string          `json:"errors"`
+}
+
+type tokenLookupResponse struct {
+	Data struct {
+		TTL       int64 `json:"ttl"`
+		Renewable bool  `json:"renewable"`
+	} `json:"data"`
+}
+
+type tokenRenewResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+// NewHTTPSecretBackend returns a SecretBackend for the secret backend
+// at the configured address. If the token is renewable, its lease is
+// renewed in the background until Close is called.
+func NewHTTPSecretBackend(conf HTTPSecretBackendConfig) (*HTTPSecretBackend, error) {
+	if conf.Address == "" {
+		return nil, errors.New("secret backend address must be specified")
+	}
+	if conf.Token == "" {
+		return nil, errors.New("secret backend token must be specified")
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = defaultSecretBackendTimeout
+	}
+
+	transport := &http.Transport{}
+	if len(conf.RootCAs) != 0 {
+		certPool := x509.NewCertPool()
+		for _, rootCA := range conf.RootCAs {
+			if !certPool.AppendCertsFromPEM(rootCA) {
+				return nil, errors.New("failed appending secret backend root CA certificate")
+			}
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool}
+	}
+
+	b := &HTTPSecretBackend{
+		address: strings.TrimSuffix(conf.Address, "/"),
+		client:  &http.Client{Transport: transport, Timeout: conf.Timeout},
+		token:   conf.Token,
+		stop:    make(chan struct{}),
+	}
+
+	lookup := &tokenLookupResponse{}
+	if err := b.do(http.MethodGet, "auth/token/lookup-self", nil, lookup); err != nil {
+		return nil, errors.WithMessage(err, "failed looking up secret backend token")
+	}
+	if lookup.Data.Renewable && lookup.Data.TTL > 0 {
+		go b.renewToken(time.Duration(lookup.Data.TTL) * time.Second)
+	}
+
+	return b, nil
+}
+
+// ReadSecret returns the secret stored at path, or ErrSecretNotFound
+func (b *HTTPSecretBackend) ReadSecret(path string) (map[string]string, error) {
+	resp := &secretResponse{}
+	if err := b.do(http.MethodGet, path, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// WriteSecret stores the secret data at path
+func (b *HTTPSecretBackend) WriteSecret(path string, data map[string]string) error {
+	return b.do(http.MethodPut, path, data, nil)
+}
+
+// Close stops the renewal of the token lease
+func (b *HTTPSecretBackend) Close() {
+	b.stopOnce.Do(func() { close(b.stop) })
+}
+
+// renewToken renews the token lease halfway through its duration,
+// retrying sooner when a renewal fails, until the backend is closed.
+func (b *HTTPSecretBackend) renewToken(ttl time.Duration) {
+	for {
+		wait := ttl / 2
+		if wait < minTokenRenewInterval {
+			wait = minTokenRenewInterval
+		}
+
+		select {
+		case <-b.stop:
+			return
+		case <-time.After(wait):
+		}
+
+		resp := &tokenRenewResponse{}
+		if err := b.do(http.MethodPost, "auth/token/renew-self", nil, resp); err != nil {
+			logger.Warningf("Failed renewing secret backend token: %s", err)
+			ttl = wait
+			continue
+		}
+
+		if resp.Auth.ClientToken != "" {
+			b.mutex.Lock()
+			b.token = resp.Auth.ClientToken
+			b.mutex.Unlock()
+		}
+		if !resp.Auth.Renewable || resp.Auth.LeaseDuration <= 0 {
+			logger.Debugf("Secret backend token is no longer renewable")
+			return
+		}
+		ttl = time.Duration(resp.Auth.LeaseDuration) * time.Second
+		logger.Debugf("Renewed secret backend token for %s", ttl)
+	}
+}
+
+func (b *HTTPSecretBackend) do(method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return errors.Wrap(err, "failed marshaling request")
+		}
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/v1/%s", b.address, strings.TrimPrefix(path, "/")), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed creating request")
+	}
+	b.mutex.RLock()
+	req.Header.Set(secretBackendTokenHeader, b.token)
+	b.mutex.RUnlock()
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed requesting %s", path)
+	}
+	defer resp.Body.Close()
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading response for %s", path)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrSecretNotFound
+	case resp.StatusCode >= 300:
+		errResp := &secretResponse{}
+		if json.Unmarshal(raw, errResp) == nil && len(errResp.Errors) != 0 {
+			return errors.Errorf("request for %s failed with status %d: %s", path, resp.StatusCode, strings.Join(errResp.Errors, ", "))
+		}
+		return errors.Errorf("request for %s failed with status %d", path, resp.StatusCode)
+	}
+
+	if out == nil || len(raw) == 0 {
+		return nil
+	}
+	return errors.Wrapf(json.Unmarshal(raw, out), "failed unmarshaling response for %s", path)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	secretKeyValueField = "value"
+
+	// suffix of the local files referencing the keys held by the secret backend
+	secretKeyRefSuffix = ".ref"
+)
+
+// secretKeyTypes are the types of keys, named after the suffixes of the
+// file-based KeyStore, in the order they are looked up for a given SKI
+var secretKeyTypes = []string{"sk", "pk", "key"}
+
+// NewSecretBackendKeyStore returns a KeyStore keeping the keys in the given
+// secret backend, each under prefix followed by the key's SKI and type.
+// If indexPath is not empty, the SKIs of the stored keys are recorded in
+// that folder, and only the keys referenced there are looked up in the
+// secret backend. If importKeys is true, the PEM encoded private keys found
+// in indexPath are moved to the secret backend and replaced by their SKI.
+// A KeyStore can be read only to avoid the overwriting of keys.
+func NewSecretBackendKeyStore(backend SecretBackend, prefix, indexPath string, readOnly, importKeys bool) (bccsp.KeyStore, error) {
+	if backend == nil {
+		return nil, errors.New("secret backend must not be nil")
+	}
+	if importKeys && (indexPath == "" || readOnly) {
+		return nil, errors.New("importing keys requires a writable index path")
+	}
+
+	ks := &secretBackendKeyStore{
+		backend:   backend,
+		prefix:    strings.Trim(prefix, "/"),
+		indexPath: indexPath,
+		readOnly:  readOnly,
+	}
+
+	if indexPath != "" {
+		if err := os.MkdirAll(indexPath, 0755); err != nil {
+			return nil, errors.Wrapf(err, "failed creating key index at %s", indexPath)
+		}
+	}
+	if importKeys {
+		if err := ks.importKeys(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ks, nil
+}
+
+// secretBackendKeyStore is a KeyStore storing keys in a SecretBackend.
+// Each key is stored as a secret holding the PEM encoded key, whose path
+// ends with the key's SKI and type. Only the SKIs of the keys, if any,
+// are kept locally.
+type secretBackendKeyStore struct {
+	backend   SecretBackend
+	prefix    string
+	indexPath string
+	readOnly  bool
+
+	m sync.Mutex
+}
+
+// ReadOnly returns true if this KeyStore is read only, false otherwise.
+// If ReadOnly is true then StoreKey will fail.
+func (ks *secretBackendKeyStore) ReadOnly() bool {
+	return ks.readOnly
+}
+
+// GetKey returns a key object whose SKI is the one passed.
+func (ks *secretBackendKeyStore) GetKey(ski []byte) (bccsp.Key, error) {
+	if len(ski) == 0 {
+		return nil, errors.New("invalid SKI. Cannot be of zero length")
+	}
+
+	alias := hex.EncodeToString(ski)
+	for _, keyType := range secretKeyTypes {
+		if ks.indexPath != "" {
+			if _, err := os.Stat(ks.getPathForAlias(alias, keyType)); err != nil {
+				continue
+			}
+		}
+
+		secret, err := ks.backend.ReadSecret(ks.getSecretPath(alias, keyType))
+		if err == ErrSecretNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed loading key [%s]", alias))
+		}
+
+		k, err := pemToKey(keyType, []byte(secret[secretKeyValueField]))
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed parsing key [%s]", alias))
+		}
+		return k, nil
+	}
+
+	if ks.indexPath != "" {
+		return nil, errors.Errorf("key with SKI %s not found in %s", alias, ks.indexPath)
+	}
+	return nil, errors.Errorf("key with SKI %s not found in secret backend", alias)
+}
+
+// StoreKey stores the key k in this KeyStore.
+// If this KeyStore is read only then the method will fail.
+func (ks *secretBackendKeyStore) StoreKey(k bccsp.Key) error {
+	if ks.readOnly {
+		return errors.New("read only KeyStore")
+	}
+	if k == nil {
+		return errors.New("invalid key. It must be different from nil")
+	}
+
+	keyType, raw, err := keyToPEM(k)
+	if err != nil {
+		return err
+	}
+
+	ks.m.Lock()
+	defer ks.m.Unlock()
+	return ks.storeKey(hex.EncodeToString(k.SKI()), keyType, raw)
+}
+
+func (ks *secretBackendKeyStore) storeKey(alias, keyType string, raw []byte) error {
+	err := ks.backend.WriteSecret(ks.getSecretPath(alias, keyType), map[string]string{
+		secretKeyValueField: string(raw),
+	})
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed storing key [%s]", alias))
+	}
+
+	if ks.indexPath == "" {
+		return nil
+	}
+	err = ioutil.WriteFile(ks.getPathForAlias(alias, keyType), nil, 0600)
+	return errors.Wrapf(err, "failed indexing key [%s]", alias)
+}
+
+// importKeys moves the PEM encoded private keys found in the index folder,
+// such as the ones of a file-based KeyStore, to the secret backend.
+func (ks *secretBackendKeyStore) importKeys() error {
+	files, err := ioutil.ReadDir(ks.indexPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading %s", ks.indexPath)
+	}
+
+	for _, f := range files {
+		if f.IsDir() || strings.HasSuffix(f.Name(), secretKeyRefSuffix) {
+			continue
+		}
+
+		file := filepath.Join(ks.indexPath, f.Name())
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed reading %s", file)
+		}
+		k, err := pemToKey("sk", raw)
+		if err != nil {
+			logger.Debugf("Skipping %s, not a private key: %s", file, err)
+			continue
+		}
+
+		keyType, raw, err := keyToPEM(k)
+		if err != nil {
+			return err
+		}
+		if err := ks.storeKey(hex.EncodeToString(k.SKI()), keyType, raw); err != nil {
+			return err
+		}
+		if err := os.Remove(file); err != nil {
+			return errors.Wrapf(err, "failed removing imported key %s", file)
+		}
+		logger.Infof("Imported private key %s into the secret backend", file)
+	}
+
+	return nil
+}
+
+func (ks *secretBackendKeyStore) getSecretPath(alias, keyType string) string {
+	return path.Join(ks.prefix, alias+"_"+keyType)
+}
+
+func (ks *secretBackendKeyStore) getPathForAlias(alias, keyType string) string {
+	return filepath.Join(ks.indexPath, alias+"_"+keyType+secretKeyRefSuffix)
+}
+
+// keyToPEM returns the PEM encoding of k along with the type
+// of the key, named after the suffixes of the file-based KeyStore
+func keyToPEM(k bccsp.Key) (string, []byte, error) {
+	var err error
+	var raw []byte
+	var keyType string
+
+	switch kk := k.(type) {
+	case *ecdsaPrivateKey:
+		keyType = "sk"
+		raw, err = utils.PrivateKeyToPEM(kk.privKey, nil)
+	case *rsaPrivateKey:
+		keyType = "sk"
+		raw, err = utils.PrivateKeyToPEM(kk.privKey, nil)
+	case *ed25519PrivateKey:
+		keyType = "sk"
+		raw, err = utils.PrivateKeyToPEM(kk.privKey, nil)
+	case *ecdsaPublicKey:
+		keyType = "pk"
+		raw, err = utils.PublicKeyToPEM(kk.pubKey, nil)
+	case *rsaPublicKey:
+		keyType = "pk"
+		raw, err = utils.PublicKeyToPEM(kk.pubKey, nil)
+	case *ed25519PublicKey:
+		keyType = "pk"
+		raw, err = utils.PublicKeyToPEM(kk.pubKey, nil)
+	case *aesPrivateKey:
+		keyType = "key"
+		raw = utils.AEStoPEM(kk.privKey)
+	default:
+		return "", nil, errors.Errorf("key type not recognized [%s]", k)
+	}
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed converting key to PEM")
+	}
+
+	return keyType, raw, nil
+}
+
+// pemToKey parses the PEM encoded key of the given type
+func pemToKey(keyType string, raw []byte) (bccsp.Key, error) {
+	switch keyType {
+	case "key":
+		key, err := utils.PEMtoAES(raw, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &aesPrivateKey{key, false}, nil
+	case "sk":
+		key, err := utils.PEMtoPrivateKey(raw, nil)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case *ecdsa.PrivateKey:
+			return &ecdsaPrivateKey{key}, nil
+		case *rsa.PrivateKey:
+			return &rsaPrivateKey{key}, nil
+		case ed25519.PrivateKey:
+			return &ed25519PrivateKey{key}, nil
+		default:
+			return nil, errors.New("secret key type not recognized")
+		}
+	case "pk":
+		key, err := utils.PEMtoPublicKey(raw, nil)
+		if err != nil {
+			return nil, err
+		}
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			return &ecdsaPublicKey{key}, nil
+		case *rsa.PublicKey:
+			return &rsaPublicKey{key}, nil
+		case ed25519.PublicKey:
+			return &ed25519PublicKey{key}, nil
+		default:
+			return nil, errors.New("public key type not recognized")
+		}
+	default:
+		return nil, errors.Errorf("key type [%s] not recognized", keyType)
+	}
+}
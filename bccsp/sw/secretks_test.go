@@ -0,0 +1,215 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sw
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw/secretserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSecretServer(t *testing.T, dir string, ttl time.Duration) *secretserver.Server {
+	server, err := secretserver.New(filepath.Join(dir, "secrets"), "token", ttl)
+	require.NoError(t, err)
+	server.Start()
+	return server
+}
+
+func TestSecretBackendKeyStore(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "secretks")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server := newSecretServer(t, tempDir, 0)
+	defer server.Stop()
+
+	backend, err := NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "token"})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	indexPath := filepath.Join(tempDir, "keystore")
+	ks, err := NewSecretBackendKeyStore(backend, "secret/fabric/", indexPath, false, false)
+	require.NoError(t, err)
+	assert.False(t, ks.ReadOnly())
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	aesKey, err := GetRandomBytes(32)
+	require.NoError(t, err)
+
+	keys := []bccsp.Key{
+		&ecdsaPrivateKey{ecKey},
+		&ecdsaPublicKey{&ecKey.PublicKey},
+		&rsaPrivateKey{rsaKey},
+		&aesPrivateKey{aesKey, false},
+	}
+	for _, k := range keys {
+		require.NoError(t, ks.StoreKey(k))
+	}
+
+	for _, k := range keys {
+		// Private keys are preferred over public keys of the same SKI
+		expected := k
+		if !k.Private() {
+			expected = keys[0]
+		}
+		key, err := ks.GetKey(k.SKI())
+		require.NoError(t, err)
+		assert.Equal(t, expected, key)
+
+		// The key is held by the secret backend, only its SKI is kept locally
+		keyType, _, err := keyToPEM(k)
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(indexPath, hex.EncodeToString(k.SKI())+"_"+keyType+".ref"))
+		assert.NoError(t, err)
+		_, err = os.Stat(filepath.Join(tempDir, "secrets", "secret", "fabric", hex.EncodeToString(k.SKI())+"_"+keyType+".json"))
+		assert.NoError(t, err)
+	}
+	files, err := ioutil.ReadDir(indexPath)
+	require.NoError(t, err)
+	assert.Len(t, files, len(keys))
+
+	// A key not referenced by the index is not looked up
+	_, err = ks.GetKey([]byte("foo"))
+	assert.EqualError(t, err, "key with SKI 666f6f not found in "+indexPath)
+
+	// Without an index, keys are looked up in the secret backend
+	ks, err = NewSecretBackendKeyStore(backend, "secret/fabric", "", true, false)
+	require.NoError(t, err)
+	assert.True(t, ks.ReadOnly())
+	key, err := ks.GetKey(keys[0].SKI())
+	require.NoError(t, err)
+	assert.Equal(t, keys[0], key)
+	_, err = ks.GetKey([]byte("foo"))
+	assert.EqualError(t, err, "key with SKI 666f6f not found in secret backend")
+	assert.EqualError(t, ks.StoreKey(keys[0]), "read only KeyStore")
+}
+
+func TestSecretBackendKeyStoreInvalid(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "secretks")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server := newSecretServer(t, tempDir, 0)
+	defer server.Stop()
+
+	_, err = NewHTTPSecretBackend(HTTPSecretBackendConfig{Token: "token"})
+	assert.EqualError(t, err, "secret backend address must be specified")
+	_, err = NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address()})
+	assert.EqualError(t, err, "secret backend token must be specified")
+	_, err = NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "foo"})
+	assert.EqualError(t, err, "failed looking up secret backend token: request for auth/token/lookup-self failed with status 403: permission denied")
+	_, err = NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "token", RootCAs: [][]byte{[]byte("foo")}})
+	assert.EqualError(t, err, "failed appending secret backend root CA certificate")
+
+	_, err = NewSecretBackendKeyStore(nil, "", "", false, false)
+	assert.EqualError(t, err, "secret backend must not be nil")
+
+	backend, err := NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "token"})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	_, err = NewSecretBackendKeyStore(backend, "", "", false, true)
+	assert.EqualError(t, err, "importing keys requires a writable index path")
+
+	ks, err := NewSecretBackendKeyStore(backend, "secret", "", false, false)
+	require.NoError(t, err)
+	_, err = ks.GetKey(nil)
+	assert.EqualError(t, err, "invalid SKI. Cannot be of zero length")
+	assert.EqualError(t, ks.StoreKey(nil), "invalid key. It must be different from nil")
+
+	// Requests fail once the secret backend is gone
+	server.Stop()
+	_, err = ks.GetKey([]byte("foo"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed loading key [666f6f]")
+}
+
+func TestSecretBackendKeyStoreImport(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "secretks")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server := newSecretServer(t, tempDir, 0)
+	defer server.Stop()
+
+	backend, err := NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "token"})
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// Populate a file-based key store
+	keyStorePath := filepath.Join(tempDir, "keystore")
+	fks, err := NewFileBasedKeyStore(nil, keyStorePath, false)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	privKey := &ecdsaPrivateKey{ecKey}
+	require.NoError(t, fks.StoreKey(privKey))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(keyStorePath, "foo"), []byte("bar"), 0600))
+
+	ks, err := NewSecretBackendKeyStore(backend, "secret", keyStorePath, false, true)
+	require.NoError(t, err)
+
+	// The private key has been replaced by its SKI
+	alias := hex.EncodeToString(privKey.SKI())
+	_, err = os.Stat(filepath.Join(keyStorePath, alias+"_sk"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(keyStorePath, alias+"_sk.ref"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(keyStorePath, "foo"))
+	assert.NoError(t, err)
+
+	key, err := ks.GetKey(privKey.SKI())
+	require.NoError(t, err)
+	assert.Equal(t, privKey, key)
+}
+
+func TestSecretBackendTokenRenewal(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "secretks")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	server := newSecretServer(t, tempDir, time.Second)
+	defer server.Stop()
+
+	backend, err := NewHTTPSecretBackend(HTTPSecretBackendConfig{Address: server.Address(), Token: "token"})
+	require.NoError(t, err)
+
+	ks, err := NewSecretBackendKeyStore(backend, "secret", "", false, false)
+	require.NoError(t, err)
+	aesKey, err := GetRandomBytes(32)
+	require.NoError(t, err)
+	key := &aesPrivateKey{aesKey, false}
+	require.NoError(t, ks.StoreKey(key))
+
+	// The token outlives its initial lease as it is renewed
+	time.Sleep(2 * time.Second)
+	assert.True(t, server.Renewals() >= 2)
+	_, err = ks.GetKey(key.SKI())
+	assert.NoError(t, err)
+
+	// Once renewals stop, the token expires
+	backend.Close()
+	time.Sleep(1500 * time.Millisecond)
+	_, err = ks.GetKey(key.SKI())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
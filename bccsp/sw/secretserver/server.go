@@ -0,0 +1,230 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package secretserver implements a file-based stand-in for the HTTP API
+// of a HashiCorp Vault key-value secrets engine, to be used in tests of
+// the secret backend key store.
+package secretserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const tokenHeader = "X-Vault-Token"
+
+// Server serves secrets stored as JSON files in a folder. Requests must
+// carry the server's token, which expires unless renewed within its TTL.
+type Server struct {
+	dir   string
+	token string
+	ttl   time.Duration
+
+	listener net.Listener
+	server   *http.Server
+
+	mutex    sync.Mutex
+	expiry   time.Time
+	renewals int
+}
+
+// New returns a Server storing secrets in dir and accepting the given token.
+// If ttl is zero, the token never expires and cannot be renewed.
+func New(dir, token string, ttl time.Duration) (*Server, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed creating %s", dir)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating listener")
+	}
+
+	s := &Server{
+		dir:      dir,
+		token:    token,
+		ttl:      ttl,
+		listener: listener,
+	}
+	if ttl != 0 {
+		s.expiry = time.Now().Add(ttl)
+	}
+	s.server = &http.Server{Handler: s}
+	return s, nil
+}
+
+// Start serves requests in the background until Stop is called
+func (s *Server) Start() {
+	go s.server.Serve(s.listener)
+}
+
+// Stop stops the server
+func (s *Server) Stop() {
+	s.server.Close()
+}
+
+// Address returns the base URL of the server
+func (s *Server) Address() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// Renewals returns the number of times the token has been renewed
+func (s *Server) Renewals() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.renewals
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r.Header.Get(tokenHeader)) {
+		writeResponse(w, http.StatusForbidden, errorsResponse("permission denied"))
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+	switch {
+	case path == "auth/token/lookup-self" && r.Method == http.MethodGet:
+		s.lookupToken(w)
+	case path == "auth/token/renew-self" && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		s.renewToken(w)
+	case strings.HasPrefix(path, "auth/") || path == "" || strings.Contains(path, ".."):
+		writeResponse(w, http.StatusBadRequest, errorsResponse("unsupported path"))
+	case r.Method == http.MethodGet:
+		s.readSecret(w, path)
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		s.writeSecret(w, r, path)
+	case r.Method == http.MethodDelete:
+		s.deleteSecret(w, path)
+	default:
+		writeResponse(w, http.StatusMethodNotAllowed, errorsResponse("unsupported method"))
+	}
+}
+
+func (s *Server) authorized(token string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if token != s.token {
+		return false
+	}
+	return s.expiry.IsZero() || time.Now().Before(s.expiry)
+}
+
+func (s *Server) lookupToken(w http.ResponseWriter) {
+	s.mutex.Lock()
+	var ttl int64
+	if !s.expiry.IsZero() {
+		ttl = int64(time.Until(s.expiry).Round(time.Second) / time.Second)
+	}
+	s.mutex.Unlock()
+
+	writeResponse(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"ttl":       ttl,
+			"renewable": s.ttl != 0,
+		},
+	})
+}
+
+func (s *Server) renewToken(w http.ResponseWriter) {
+	if s.ttl == 0 {
+		writeResponse(w, http.StatusBadRequest, errorsResponse("lease is not renewable"))
+		return
+	}
+
+	s.mutex.Lock()
+	s.expiry = time.Now().Add(s.ttl)
+	s.renewals++
+	s.mutex.Unlock()
+
+	writeResponse(w, http.StatusOK, map[string]interface{}{
+		"auth": map[string]interface{}{
+			"client_token":   s.token,
+			"lease_duration": int64(s.ttl / time.Second),
+			"renewable":      true,
+		},
+	})
+}
+
+func (s *Server) readSecret(w http.ResponseWriter, path string) {
+	raw, err := ioutil.ReadFile(s.secretFile(path))
+	if os.IsNotExist(err) {
+		writeResponse(w, http.StatusNotFound, errorsResponse())
+		return
+	}
+	if err != nil {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+
+	data := map[string]string{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+	writeResponse(w, http.StatusOK, map[string]interface{}{
+		"data":           data,
+		"lease_duration": int64(s.ttl / time.Second),
+	})
+}
+
+func (s *Server) writeSecret(w http.ResponseWriter, r *http.Request, path string) {
+	data := map[string]string{}
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		writeResponse(w, http.StatusBadRequest, errorsResponse(err.Error()))
+		return
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+
+	file := s.secretFile(path)
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+	if err := ioutil.WriteFile(file, raw, 0600); err != nil {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSecret(w http.ResponseWriter, path string) {
+	if err := os.Remove(s.secretFile(path)); err != nil && !os.IsNotExist(err) {
+		writeResponse(w, http.StatusInternalServerError, errorsResponse(err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) secretFile(path string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(path)+".json")
+}
+
+func errorsResponse(errs ...string) map[string]interface{} {
+	if errs == nil {
+		errs = []string{}
+	}
+	return map[string]interface{}{"errors": errs}
+}
+
+func writeResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -318,6 +319,47 @@ func TestECDSAKeys(t *testing.T) {
 	}
 }
 
+func TestED25519Keys(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	// Private key without password
+	pemPrivKey, err := PrivateKeyToPEM(privKey, nil)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(pemPrivKey)
+	assert.Equal(t, "PRIVATE KEY", block.Type)
+	key, err := PEMtoPrivateKey(pemPrivKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, key)
+
+	// Private key with password
+	encPEMPrivKey, err := PrivateKeyToPEM(privKey, []byte("passwd"))
+	assert.NoError(t, err)
+	_, err = PEMtoPrivateKey(encPEMPrivKey, nil)
+	assert.Error(t, err)
+	key, err = PEMtoPrivateKey(encPEMPrivKey, []byte("passwd"))
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, key)
+
+	// Public key
+	pemPubKey, err := PublicKeyToPEM(pubKey, nil)
+	assert.NoError(t, err)
+	key, err = PEMtoPublicKey(pemPubKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, key)
+
+	derPubKey, err := PublicKeyToDER(pubKey)
+	assert.NoError(t, err)
+	key, err = DERToPublicKey(derPubKey)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, key)
+
+	_, err = PrivateKeyToPEM(ed25519.PrivateKey(nil), nil)
+	assert.EqualError(t, err, "Invalid ed25519 private key. It must be different from nil.")
+	_, err = PublicKeyToPEM(ed25519.PublicKey(nil), nil)
+	assert.EqualError(t, err, "Invalid ed25519 public key. It must be different from nil.")
+}
+
 func TestAESKey(t *testing.T) {
 	k := []byte{0, 1, 2, 3, 4, 5}
 	pem := AEStoPEM(k)
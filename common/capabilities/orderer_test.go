@@ -21,6 +21,7 @@ func TestOrdererV10(t *testing.T) {
 	assert.False(t, op.Resubmission())
 	assert.False(t, op.ExpirationCheck())
 	assert.False(t, op.ConsensusTypeMigration())
+	assert.False(t, op.ExtendedOrdererConfig())
 }
 
 func TestOrdererV11(t *testing.T) {
@@ -43,6 +44,19 @@ func TestOrdererV142(t *testing.T) {
 	assert.True(t, op.Resubmission())
 	assert.True(t, op.ExpirationCheck())
 	assert.True(t, op.ConsensusTypeMigration())
+	assert.False(t, op.ExtendedOrdererConfig())
+}
+
+func TestOrdererV143(t *testing.T) {
+	op := NewOrdererProvider(map[string]*cb.Capability{
+		OrdererV1_4_3: {},
+	})
+	assert.NoError(t, op.Supported())
+	assert.True(t, op.PredictableChannelTemplate())
+	assert.True(t, op.Resubmission())
+	assert.True(t, op.ExpirationCheck())
+	assert.True(t, op.ConsensusTypeMigration())
+	assert.True(t, op.ExtendedOrdererConfig())
 }
 
 func TestNotSuported(t *testing.T) {
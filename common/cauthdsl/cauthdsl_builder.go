@@ -94,6 +94,34 @@ func SignedByMspPeer(mspId string) *cb.SignaturePolicyEnvelope {
 	return signedByFabricEntity(mspId, msp.MSPRole_PEER)
 }
 
+// SignedByMspOrderer creates a SignaturePolicyEnvelope
+// requiring 1 signature from any orderer of the specified MSP
+func SignedByMspOrderer(mspId string) *cb.SignaturePolicyEnvelope {
+	return signedByFabricEntity(mspId, msp.MSPRole_ORDERER)
+}
+
+// SignedByMspRoles creates a SignaturePolicyEnvelope requiring 1 signature
+// from any identity of the specified MSP having one of the given roles
+func SignedByMspRoles(mspId string, roles ...msp.MSPRole_MSPRoleType) *cb.SignaturePolicyEnvelope {
+	principals := make([]*msp.MSPPrincipal, len(roles))
+	sigspolicy := make([]*cb.SignaturePolicy, len(roles))
+	for i, role := range roles {
+		principals[i] = &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ROLE,
+			Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: role, MspIdentifier: mspId})}
+		sigspolicy[i] = SignedBy(int32(i))
+	}
+
+	// create the policy: it requires exactly 1 signature from any of the principals
+	p := &cb.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       NOutOf(1, sigspolicy),
+		Identities: principals,
+	}
+
+	return p
+}
+
 // SignedByFabricEntity creates a SignaturePolicyEnvelope
 // requiring 1 signature from any fabric entity, having the passed role, of the specified MSP
 func signedByFabricEntity(mspId string, role msp.MSPRole_MSPRoleType) *cb.SignaturePolicyEnvelope {
@@ -130,6 +158,23 @@ func SignedByMspAdmin(mspId string) *cb.SignaturePolicyEnvelope {
 	return p
 }
 
+// SignedByMspAttribute creates a SignaturePolicyEnvelope requiring 1 signature
+// from any identity of the specified MSP disclosing the given attribute value
+func SignedByMspAttribute(mspId, name, value string) *cb.SignaturePolicyEnvelope {
+	principal := &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: mspId, Name: name, Value: value})}
+
+	// create the policy: it requires exactly 1 signature from the first (and only) principal
+	p := &cb.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       NOutOf(1, []*cb.SignaturePolicy{SignedBy(0)}),
+		Identities: []*msp.MSPPrincipal{principal},
+	}
+
+	return p
+}
+
 //wrapper for generating "any of a given role" type policies
 func signedByAnyOfGivenRole(role msp.MSPRole_MSPRoleType, ids []string) *cb.SignaturePolicyEnvelope {
 	// we create an array of principals, one principal
@@ -175,6 +220,13 @@ func SignedByAnyPeer(ids []string) *cb.SignaturePolicyEnvelope {
 	return signedByAnyOfGivenRole(msp.MSPRole_PEER, ids)
 }
 
+// SignedByAnyOrderer returns a policy that requires one valid
+// signature from an orderer of any of the orgs whose ids are
+// listed in the supplied string array
+func SignedByAnyOrderer(ids []string) *cb.SignaturePolicyEnvelope {
+	return signedByAnyOfGivenRole(msp.MSPRole_ORDERER, ids)
+}
+
 // SignedByAnyAdmin returns a policy that requires one valid
 // signature from a admin of any of the orgs whose ids are
 // listed in the supplied string array
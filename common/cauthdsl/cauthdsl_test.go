@@ -337,6 +337,43 @@ func TestSignedByMspPeer(t *testing.T) {
 	assert.Equal(t, role.Role, mb.MSPRole_PEER)
 }
 
+func TestSignedByMspOrderer(t *testing.T) {
+	e := SignedByMspOrderer("A")
+	assert.Equal(t, 1, len(e.Identities))
+
+	role := &mb.MSPRole{}
+	err := proto.Unmarshal(e.Identities[0].Principal, role)
+	assert.NoError(t, err)
+
+	assert.Equal(t, role.MspIdentifier, "A")
+	assert.Equal(t, role.Role, mb.MSPRole_ORDERER)
+
+	e = SignedByAnyOrderer([]string{"A"})
+	assert.Equal(t, 1, len(e.Identities))
+
+	role = &mb.MSPRole{}
+	err = proto.Unmarshal(e.Identities[0].Principal, role)
+	assert.NoError(t, err)
+
+	assert.Equal(t, role.MspIdentifier, "A")
+	assert.Equal(t, role.Role, mb.MSPRole_ORDERER)
+}
+
+func TestSignedByMspRoles(t *testing.T) {
+	e := SignedByMspRoles("A", mb.MSPRole_ADMIN, mb.MSPRole_CLIENT)
+	assert.Equal(t, 2, len(e.Identities))
+	assert.Equal(t, NOutOf(1, []*cb.SignaturePolicy{SignedBy(0), SignedBy(1)}), e.Rule)
+
+	for i, expected := range []mb.MSPRole_MSPRoleType{mb.MSPRole_ADMIN, mb.MSPRole_CLIENT} {
+		role := &mb.MSPRole{}
+		err := proto.Unmarshal(e.Identities[i].Principal, role)
+		assert.NoError(t, err)
+
+		assert.Equal(t, role.MspIdentifier, "A")
+		assert.Equal(t, role.Role, expected)
+	}
+}
+
 func TestReturnNil(t *testing.T) {
 	policy := Envelope(And(SignedBy(-1), SignedBy(-2)), signers)
 
@@ -38,21 +38,32 @@ const (
 
 // Role values for principals
 const (
-	RoleAdmin  = "admin"
-	RoleMember = "member"
-	RoleClient = "client"
-	RolePeer   = "peer"
-	// RoleOrderer = "orderer" TODO
+	RoleAdmin   = "admin"
+	RoleMember  = "member"
+	RoleClient  = "client"
+	RolePeer    = "peer"
+	RoleOrderer = "orderer"
 )
 
+// AttributePrefix introduces attribute principals, e.g. 'Org1.attr.OU=sales'
+const AttributePrefix = "attr"
+
 var (
 	regex = regexp.MustCompile(
-		fmt.Sprintf("^([[:alnum:].-]+)([.])(%s|%s|%s|%s)$",
-			RoleAdmin, RoleMember, RoleClient, RolePeer),
+		fmt.Sprintf("^([[:alnum:].-]+)([.])(%s|%s|%s|%s|%s)$",
+			RoleAdmin, RoleMember, RoleClient, RolePeer, RoleOrderer),
+	)
+	regexAttr = regexp.MustCompile(
+		fmt.Sprintf("^([[:alnum:].-]+)[.]%s[.]([[:alnum:]_-]+)=([^']+)$", AttributePrefix),
 	)
 	regexErr = regexp.MustCompile("^No parameter '([^']+)' found[.]$")
 )
 
+// isPrincipal returns true if the given string denotes a principal
+func isPrincipal(s string) bool {
+	return regex.MatchString(s) || regexAttr.MatchString(s)
+}
+
 // a stub function - it returns the same string as it's passed.
 // This will be evaluated by second/third passes to convert to a proto policy
 func outof(args ...interface{}) (interface{}, error) {
@@ -77,7 +88,7 @@ func outof(args ...interface{}) (interface{}, error) {
 		toret += ", "
 		switch t := arg.(type) {
 		case string:
-			if regex.MatchString(t) {
+			if isPrincipal(t) {
 				toret += "'" + t + "'"
 			} else {
 				toret += t
@@ -105,7 +116,7 @@ func firstPass(args ...interface{}) (interface{}, error) {
 		toret += ", "
 		switch t := arg.(type) {
 		case string:
-			if regex.MatchString(t) {
+			if isPrincipal(t) {
 				toret += "'" + t + "'"
 			} else {
 				toret += t
@@ -163,6 +174,18 @@ func secondPass(args ...interface{}) (interface{}, error) {
 		   <MSP_ID> . <ROLE>, where MSP_ID is the MSP identifier
 		   and ROLE is either a member, an admin, a client, a peer or an orderer*/
 		case string:
+			/* attribute principals are formed as
+			   <MSP_ID> . attr . <NAME> = <VALUE> */
+			if subm := regexAttr.FindStringSubmatch(t); subm != nil {
+				p := &msp.MSPPrincipal{
+					PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+					Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: subm[1], Name: subm[2], Value: subm[3]})}
+				ctx.principals = append(ctx.principals, p)
+				policies = append(policies, SignedBy(int32(ctx.IDNum)))
+				ctx.IDNum++
+				continue
+			}
+
 			/* split the string */
 			subm := regex.FindAllStringSubmatch(t, -1)
 			if subm == nil || len(subm) != 1 || len(subm[0]) != 4 {
@@ -180,6 +203,8 @@ func secondPass(args ...interface{}) (interface{}, error) {
 				r = msp.MSPRole_CLIENT
 			case RolePeer:
 				r = msp.MSPRole_PEER
+			case RoleOrderer:
+				r = msp.MSPRole_ORDERER
 			default:
 				return nil, fmt.Errorf("Error parsing role %s", t)
 			}
@@ -241,6 +266,15 @@ func newContext() *context {
 //	- ORG is a string (representing the MSP identifier)
 //	- ROLE takes the value of any of the RoleXXX constants representing
 //    the required role
+//
+// or, to require an identity disclosing an attribute with a given value, as:
+//
+// ORG.attr.NAME=VALUE
+//
+// where:
+//	- NAME is the name of the attribute, e.g. OU, Role or EnrollmentID
+//	  for idemix identities
+//	- VALUE is the required value of the attribute
 func FromString(policy string) (*common.SignaturePolicyEnvelope, error) {
 	// first we translate the and/or business into outof gates
 	intermediate, err := govaluate.NewEvaluableExpressionWithFunctions(
@@ -86,7 +86,7 @@ func TestAnd(t *testing.T) {
 }
 
 func TestAndClientPeerOrderer(t *testing.T) {
-	p1, err := FromString("AND('A.client', 'B.peer')")
+	p1, err := FromString("AND('A.client', 'B.peer', 'C.orderer')")
 	assert.NoError(t, err)
 
 	principals := make([]*msp.MSPPrincipal, 0)
@@ -99,9 +99,13 @@ func TestAndClientPeerOrderer(t *testing.T) {
 		PrincipalClassification: msp.MSPPrincipal_ROLE,
 		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_PEER, MspIdentifier: "B"})})
 
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_ORDERER, MspIdentifier: "C"})})
+
 	p2 := &common.SignaturePolicyEnvelope{
 		Version:    0,
-		Rule:       And(SignedBy(0), SignedBy(1)),
+		Rule:       NOutOf(3, []*common.SignaturePolicy{SignedBy(0), SignedBy(1), SignedBy(2)}),
 		Identities: principals,
 	}
 
@@ -217,6 +221,36 @@ func TestMSPIDWIthSpecialChars(t *testing.T) {
 	assert.Equal(t, p1, p2)
 }
 
+func TestAttribute(t *testing.T) {
+	p1, err := FromString("OR('A.attr.OU=sales dept', AND('B.org.attr.EnrollmentID=alice', 'B.org.admin'))")
+	assert.NoError(t, err)
+
+	principals := make([]*msp.MSPPrincipal, 0)
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: "B.org", Name: "EnrollmentID", Value: "alice"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_ADMIN, MspIdentifier: "B.org"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: "A", Name: "OU", Value: "sales dept"})})
+
+	p2 := &common.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       NOutOf(1, []*common.SignaturePolicy{SignedBy(2), NOutOf(2, []*common.SignaturePolicy{SignedBy(0), SignedBy(1)})}),
+		Identities: principals,
+	}
+
+	assert.Equal(t, p1, p2)
+
+	_, err = FromString("OR('A.attr.OU=')")
+	assert.Error(t, err)
+}
+
 func TestBadStringsNoPanic(t *testing.T) {
 	_, err := FromString("OR('A.member', Bmember)") // error after 1st Evaluate()
 	assert.EqualError(t, err, "unrecognized token 'Bmember' in policy string")
@@ -111,6 +111,18 @@ type Orderer interface {
 	// used for ordering
 	KafkaBrokers() []string
 
+	// RateLimits returns the limits of the rate at which transactions are accepted on the channel
+	RateLimits() *ab.RateLimits
+
+	// BatchPriorities returns the priority classes among which the transactions of every batch are shared
+	BatchPriorities() *ab.BatchPriorities
+
+	// AdaptiveBatching returns the bounds within which the batch timeout and the preferred max bytes are tuned
+	AdaptiveBatching() *ab.AdaptiveBatching
+
+	// DuplicateTxIDWindow returns the number of last blocks whose transaction IDs may not be reused
+	DuplicateTxIDWindow() uint32
+
 	// Organizations returns the organizations for the ordering service
 	Organizations() map[string]OrdererOrg
 
@@ -205,6 +217,10 @@ type OrdererCapabilities interface {
 
 	// ConsensusTypeMigration checks whether the orderer permits a consensus-type migration.
 	ConsensusTypeMigration() bool
+
+	// ExtendedOrdererConfig specifies whether the RateLimits, BatchPriorities, AdaptiveBatching
+	// and DuplicateTxIDWindow values may be set in the orderer config.
+	ExtendedOrdererConfig() bool
 }
 
 // PolicyMapper is an interface for
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/capabilities"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -40,10 +41,26 @@ const (
 	// KafkaBrokersKey is the cb.ConfigItem type key name for the KafkaBrokers message.
 	KafkaBrokersKey = "KafkaBrokers"
 
+	// RateLimitsKey is the cb.ConfigItem type key name for the RateLimits message.
+	RateLimitsKey = "RateLimits"
+
+	// BatchPrioritiesKey is the cb.ConfigItem type key name for the BatchPriorities message.
+	BatchPrioritiesKey = "BatchPriorities"
+
+	// AdaptiveBatchingKey is the cb.ConfigItem type key name for the AdaptiveBatching message.
+	AdaptiveBatchingKey = "AdaptiveBatching"
+
+	// DuplicateTxIDWindowKey is the cb.ConfigItem type key name for the DuplicateTxIDWindow message.
+	DuplicateTxIDWindowKey = "DuplicateTxIDWindow"
+
 	// EndpointsKey is the cb.COnfigValue key name for the Endpoints message in the OrdererOrgGroup.
 	EndpointsKey = "Endpoints"
 )
 
+// MaxDuplicateTxIDWindow is the maximum number of blocks of the duplicate
+// transaction ID window, whose transaction IDs every orderer keeps in memory.
+const MaxDuplicateTxIDWindow = 1000
+
 // OrdererProtos is used as the source of the OrdererConfig.
 type OrdererProtos struct {
 	ConsensusType       *ab.ConsensusType
@@ -51,6 +68,10 @@ type OrdererProtos struct {
 	BatchTimeout        *ab.BatchTimeout
 	KafkaBrokers        *ab.KafkaBrokers
 	ChannelRestrictions *ab.ChannelRestrictions
+	RateLimits          *ab.RateLimits
+	BatchPriorities     *ab.BatchPriorities
+	AdaptiveBatching    *ab.AdaptiveBatching
+	DuplicateTxIDWindow *ab.DuplicateTxIDWindow
 	Capabilities        *cb.Capabilities
 }
 
@@ -130,6 +151,14 @@ func NewOrdererConfig(ordererGroup *cb.ConfigGroup, mspConfig *MSPConfigHandler,
 		return nil, errors.Wrap(err, "failed to deserialize values")
 	}
 
+	if !oc.Capabilities().ExtendedOrdererConfig() {
+		for _, key := range []string{RateLimitsKey, BatchPrioritiesKey, AdaptiveBatchingKey, DuplicateTxIDWindowKey} {
+			if _, ok := ordererGroup.Values[key]; ok {
+				return nil, errors.Errorf("Orderer config cannot contain %s value until V1_4_3+ orderer capabilities have been enabled", key)
+			}
+		}
+	}
+
 	if err := oc.Validate(); err != nil {
 		return nil, err
 	}
@@ -180,6 +209,34 @@ func (oc *OrdererConfig) MaxChannelsCount() uint64 {
 	return oc.protos.ChannelRestrictions.MaxCount
 }
 
+// RateLimits returns the limits of the rate at which transactions are
+// accepted on the channel. Limits which are not set are left to the local
+// config of the orderer.
+func (oc *OrdererConfig) RateLimits() *ab.RateLimits {
+	return oc.protos.RateLimits
+}
+
+// BatchPriorities returns the priority classes among which the
+// transactions of every batch are shared, if any.
+func (oc *OrdererConfig) BatchPriorities() *ab.BatchPriorities {
+	return oc.protos.BatchPriorities
+}
+
+// AdaptiveBatching returns the bounds within which the batch timeout and
+// the preferred max bytes of the batches are tuned, or nil if they are not.
+func (oc *OrdererConfig) AdaptiveBatching() *ab.AdaptiveBatching {
+	if oc.protos.AdaptiveBatching.GetMinBatchTimeout() == "" {
+		return nil
+	}
+	return oc.protos.AdaptiveBatching
+}
+
+// DuplicateTxIDWindow returns the number of last blocks of the channel
+// whose transaction IDs may not be reused, or 0 if they may.
+func (oc *OrdererConfig) DuplicateTxIDWindow() uint32 {
+	return oc.protos.DuplicateTxIDWindow.GetBlocks()
+}
+
 // Organizations returns a map of the orgs in the channel.
 func (oc *OrdererConfig) Organizations() map[string]OrdererOrg {
 	return oc.orgs
@@ -195,6 +252,9 @@ func (oc *OrdererConfig) Validate() error {
 		oc.validateBatchSize,
 		oc.validateBatchTimeout,
 		oc.validateKafkaBrokers,
+		oc.validateBatchPriorities,
+		oc.validateAdaptiveBatching,
+		oc.validateDuplicateTxIDWindow,
 	} {
 		if err := validator(); err != nil {
 			return err
@@ -241,6 +301,55 @@ func (oc *OrdererConfig) validateKafkaBrokers() error {
 	return nil
 }
 
+func (oc *OrdererConfig) validateBatchPriorities() error {
+	names := map[string]struct{}{}
+	for _, class := range oc.protos.BatchPriorities.GetClasses() {
+		if class.Name == "" {
+			return fmt.Errorf("Attempted to set a batch priority class without a name")
+		}
+		if _, ok := names[class.Name]; ok {
+			return fmt.Errorf("Attempted to set the batch priority class %s more than once", class.Name)
+		}
+		names[class.Name] = struct{}{}
+		if class.Weight == 0 {
+			return fmt.Errorf("Attempted to set the weight of the batch priority class %s to an invalid value: 0", class.Name)
+		}
+		for _, headerType := range class.HeaderTypes {
+			if _, ok := cb.HeaderType_value[headerType]; !ok {
+				return fmt.Errorf("Attempted to set the batch priority class %s to an unknown header type: %s", class.Name, headerType)
+			}
+		}
+	}
+	return nil
+}
+
+func (oc *OrdererConfig) validateAdaptiveBatching() error {
+	if oc.protos.AdaptiveBatching == nil || proto.Equal(oc.protos.AdaptiveBatching, &ab.AdaptiveBatching{}) {
+		return nil
+	}
+
+	minBatchTimeout, err := time.ParseDuration(oc.protos.AdaptiveBatching.MinBatchTimeout)
+	if err != nil {
+		return fmt.Errorf("Attempted to set the min batch timeout to a invalid value: %s", err)
+	}
+	if minBatchTimeout <= 0 || minBatchTimeout > oc.batchTimeout {
+		return fmt.Errorf("Attempted to set the min batch timeout to %s, outside of (0, %s]", minBatchTimeout, oc.batchTimeout)
+	}
+
+	maxPreferredMaxBytes := oc.protos.AdaptiveBatching.MaxPreferredMaxBytes
+	if maxPreferredMaxBytes != 0 && (maxPreferredMaxBytes < oc.protos.BatchSize.PreferredMaxBytes || maxPreferredMaxBytes > oc.protos.BatchSize.AbsoluteMaxBytes) {
+		return fmt.Errorf("Attempted to set the max preferred max bytes to %v, outside of [%v, %v]", maxPreferredMaxBytes, oc.protos.BatchSize.PreferredMaxBytes, oc.protos.BatchSize.AbsoluteMaxBytes)
+	}
+	return nil
+}
+
+func (oc *OrdererConfig) validateDuplicateTxIDWindow() error {
+	if blocks := oc.protos.DuplicateTxIDWindow.GetBlocks(); blocks > MaxDuplicateTxIDWindow {
+		return fmt.Errorf("Attempted to set the duplicate transaction ID window to %d blocks, greater than the maximum of %d", blocks, MaxDuplicateTxIDWindow)
+	}
+	return nil
+}
+
 // This does just a barebones sanity check.
 func brokerEntrySeemsValid(broker string) bool {
 	if !strings.Contains(broker, ":") {
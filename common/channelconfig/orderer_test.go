@@ -8,8 +8,12 @@ package channelconfig
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hyperledger/fabric/common/capabilities"
+	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +53,89 @@ func TestKafkaBrokers(t *testing.T) {
 	oc = &OrdererConfig{protos: &OrdererProtos{KafkaBrokers: &ab.KafkaBrokers{Brokers: []string{"127.0.0.1", "foo.bar", "127.0.0.1:-1", "localhost:65536", "foo.bar.:9092", ".127.0.0.1:9092", "-foo.bar:9092"}}}}
 	assert.Error(t, oc.validateKafkaBrokers(), "Invalid kafka brokers")
 }
+
+func TestBatchPriorities(t *testing.T) {
+	oc := &OrdererConfig{protos: &OrdererProtos{}}
+	assert.NoError(t, oc.validateBatchPriorities(), "No batch priorities")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchPriorities: &ab.BatchPriorities{Classes: []*ab.PriorityClass{
+		{Name: "fast", Weight: 3, MspIds: []string{"Org1MSP"}},
+		{Name: "bulk", Weight: 1, HeaderTypes: []string{"ENDORSER_TRANSACTION"}},
+	}}}}
+	assert.NoError(t, oc.validateBatchPriorities(), "Valid batch priorities")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchPriorities: &ab.BatchPriorities{Classes: []*ab.PriorityClass{{Weight: 1}}}}}
+	assert.EqualError(t, oc.validateBatchPriorities(), "Attempted to set a batch priority class without a name")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchPriorities: &ab.BatchPriorities{Classes: []*ab.PriorityClass{{Name: "fast", Weight: 1}, {Name: "fast", Weight: 2}}}}}
+	assert.EqualError(t, oc.validateBatchPriorities(), "Attempted to set the batch priority class fast more than once")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchPriorities: &ab.BatchPriorities{Classes: []*ab.PriorityClass{{Name: "fast"}}}}}
+	assert.EqualError(t, oc.validateBatchPriorities(), "Attempted to set the weight of the batch priority class fast to an invalid value: 0")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchPriorities: &ab.BatchPriorities{Classes: []*ab.PriorityClass{{Name: "fast", Weight: 1, HeaderTypes: []string{"FOO"}}}}}}
+	assert.EqualError(t, oc.validateBatchPriorities(), "Attempted to set the batch priority class fast to an unknown header type: FOO")
+}
+
+func TestAdaptiveBatching(t *testing.T) {
+	batchSize := &ab.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 1000, PreferredMaxBytes: 500}
+	newConfig := func(adaptiveBatching *ab.AdaptiveBatching) *OrdererConfig {
+		return &OrdererConfig{batchTimeout: 2 * time.Second, protos: &OrdererProtos{BatchSize: batchSize, AdaptiveBatching: adaptiveBatching}}
+	}
+
+	assert.NoError(t, newConfig(nil).validateAdaptiveBatching(), "No adaptive batching")
+	assert.NoError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "100ms"}).validateAdaptiveBatching(), "Valid adaptive batching")
+	assert.NoError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "2s", MaxPreferredMaxBytes: 1000}).validateAdaptiveBatching(), "Valid adaptive batching")
+
+	assert.Error(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "foo"}).validateAdaptiveBatching(), "Invalid min batch timeout")
+	assert.EqualError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "0s"}).validateAdaptiveBatching(), "Attempted to set the min batch timeout to 0s, outside of (0, 2s]")
+	assert.EqualError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "3s"}).validateAdaptiveBatching(), "Attempted to set the min batch timeout to 3s, outside of (0, 2s]")
+	assert.EqualError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "1s", MaxPreferredMaxBytes: 400}).validateAdaptiveBatching(), "Attempted to set the max preferred max bytes to 400, outside of [500, 1000]")
+	assert.EqualError(t, newConfig(&ab.AdaptiveBatching{MinBatchTimeout: "1s", MaxPreferredMaxBytes: 1001}).validateAdaptiveBatching(), "Attempted to set the max preferred max bytes to 1001, outside of [500, 1000]")
+}
+
+func TestDuplicateTxIDWindow(t *testing.T) {
+	assert.Equal(t, uint32(0), (&OrdererConfig{protos: &OrdererProtos{}}).DuplicateTxIDWindow(), "No duplicate transaction ID window")
+	assert.Equal(t, uint32(100), (&OrdererConfig{protos: &OrdererProtos{DuplicateTxIDWindow: &ab.DuplicateTxIDWindow{Blocks: 100}}}).DuplicateTxIDWindow(), "Duplicate transaction ID window")
+
+	newConfig := func(blocks uint32) *OrdererConfig {
+		return &OrdererConfig{protos: &OrdererProtos{DuplicateTxIDWindow: &ab.DuplicateTxIDWindow{Blocks: blocks}}}
+	}
+	assert.NoError(t, (&OrdererConfig{protos: &OrdererProtos{}}).validateDuplicateTxIDWindow(), "No duplicate transaction ID window")
+	assert.NoError(t, newConfig(MaxDuplicateTxIDWindow).validateDuplicateTxIDWindow(), "Maximum duplicate transaction ID window")
+	assert.EqualError(t, newConfig(MaxDuplicateTxIDWindow+1).validateDuplicateTxIDWindow(), "Attempted to set the duplicate transaction ID window to 1001 blocks, greater than the maximum of 1000")
+}
+
+func TestExtendedOrdererConfigCapability(t *testing.T) {
+	newOrdererGroup := func(values ...*StandardConfigValue) *cb.ConfigGroup {
+		ordererGroup := cb.NewConfigGroup()
+		values = append(values,
+			BatchSizeValue(10, 1000, 500),
+			BatchTimeoutValue("2s"),
+			KafkaBrokersValue(nil),
+		)
+		for _, value := range values {
+			ordererGroup.Values[value.Key()] = &cb.ConfigValue{Value: utils.MarshalOrPanic(value.Value())}
+		}
+		return ordererGroup
+	}
+
+	for _, value := range []*StandardConfigValue{
+		RateLimitsValue(&ab.RateLimits{Channel: &ab.RateLimit{Rate: 10}}),
+		BatchPrioritiesValue(&ab.BatchPriorities{Classes: []*ab.PriorityClass{{Name: "fast", Weight: 1}}}),
+		AdaptiveBatchingValue(&ab.AdaptiveBatching{MinBatchTimeout: "1s"}),
+		DuplicateTxIDWindowValue(100),
+	} {
+		_, err := NewOrdererConfig(newOrdererGroup(value), nil, nil)
+		assert.EqualError(t, err, "Orderer config cannot contain "+value.Key()+" value until V1_4_3+ orderer capabilities have been enabled")
+
+		_, err = NewOrdererConfig(newOrdererGroup(value, CapabilitiesValue(map[string]bool{capabilities.OrdererV1_4_2: true})), nil, nil)
+		assert.Error(t, err)
+
+		_, err = NewOrdererConfig(newOrdererGroup(value, CapabilitiesValue(map[string]bool{capabilities.OrdererV1_4_3: true})), nil, nil)
+		assert.NoError(t, err)
+	}
+
+	_, err := NewOrdererConfig(newOrdererGroup(), nil, nil)
+	assert.NoError(t, err)
+}
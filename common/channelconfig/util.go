@@ -137,6 +137,44 @@ func BatchTimeoutValue(timeout string) *StandardConfigValue {
 	}
 }
 
+// RateLimitsValue returns the config definition for the orderer rate limits.
+// It is a value for the /Channel/Orderer group.
+func RateLimitsValue(rateLimits *ab.RateLimits) *StandardConfigValue {
+	return &StandardConfigValue{
+		key:   RateLimitsKey,
+		value: rateLimits,
+	}
+}
+
+// BatchPrioritiesValue returns the config definition for the orderer batch priorities.
+// It is a value for the /Channel/Orderer group.
+func BatchPrioritiesValue(batchPriorities *ab.BatchPriorities) *StandardConfigValue {
+	return &StandardConfigValue{
+		key:   BatchPrioritiesKey,
+		value: batchPriorities,
+	}
+}
+
+// AdaptiveBatchingValue returns the config definition for the orderer adaptive batching.
+// It is a value for the /Channel/Orderer group.
+func AdaptiveBatchingValue(adaptiveBatching *ab.AdaptiveBatching) *StandardConfigValue {
+	return &StandardConfigValue{
+		key:   AdaptiveBatchingKey,
+		value: adaptiveBatching,
+	}
+}
+
+// DuplicateTxIDWindowValue returns the config definition for the orderer duplicate transaction ID window.
+// It is a value for the /Channel/Orderer group.
+func DuplicateTxIDWindowValue(blocks uint32) *StandardConfigValue {
+	return &StandardConfigValue{
+		key: DuplicateTxIDWindowKey,
+		value: &ab.DuplicateTxIDWindow{
+			Blocks: blocks,
+		},
+	}
+}
+
 // ChannelRestrictionsValue returns the config definition for the orderer channel restrictions.
 // It is a value for the /Channel/Orderer group.
 func ChannelRestrictionsValue(maxChannelCount uint64) *StandardConfigValue {
@@ -0,0 +1,244 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configtx
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// PolicyStatus reports whether the mod_policy governing some of the
+// elements modified by a config update is satisfied by its signatures
+type PolicyStatus struct {
+	// Path is the fully qualified path of the policy
+	Path string `json:"path"`
+	// Elements are the modified elements the policy governs
+	Elements []string `json:"elements"`
+	// Satisfied is true if the signatures satisfy the policy
+	Satisfied bool `json:"satisfied"`
+	// Reason explains why the policy is not satisfied
+	Reason string `json:"reason,omitempty"`
+}
+
+// SignatureCollection accumulates the signatures over a config update, such
+// as the ones of the administrators each signing a copy of the update.
+// Each signature is verified against the channel as it arrives, and the
+// collection reports which of the mod_policies of the elements modified by
+// the update remain unsatisfied. The accumulated signatures are held in a
+// ConfigUpdateEnvelope, so that the result can be submitted as is.
+type SignatureCollection struct {
+	envelope     *cb.ConfigUpdateEnvelope
+	deserializer msp.IdentityDeserializer
+	creators     map[string]struct{}
+	modPolicies  []*modPolicy
+}
+
+type modPolicy struct {
+	path     string
+	elements []string
+	policy   policies.Policy
+}
+
+// NewSignatureCollection returns a SignatureCollection for the config update
+// of the given envelope, holding the signatures of the envelope. Identities
+// are deserialized with deserializer, normally the channel's MSP manager.
+// It returns an error if the update could never be applied to the current
+// config, or if any of the signatures of the envelope is not valid.
+func (vi *ValidatorImpl) NewSignatureCollection(configUpdateEnv *cb.ConfigUpdateEnvelope, deserializer msp.IdentityDeserializer) (*SignatureCollection, error) {
+	if configUpdateEnv == nil {
+		return nil, errors.Errorf("cannot process nil ConfigUpdateEnvelope")
+	}
+
+	_, deltaSet, err := vi.computeDelta(configUpdateEnv)
+	if err != nil {
+		return nil, err
+	}
+	if len(deltaSet) == 0 {
+		return nil, errors.Errorf("delta set was empty -- update would have no effect")
+	}
+
+	modPolicies := map[string]*modPolicy{}
+	for key, value := range deltaSet {
+		existing, ok, err := vi.existingForDelta(key, value)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// New elements are governed by the mod_policy of the closest
+			// existing group containing them
+			existing, ok = vi.existingGroupOf(value)
+			if !ok {
+				return nil, errors.Errorf("no existing group contains new element %s", key)
+			}
+		}
+
+		path := modPolicyPath(existing)
+		mp, ok := modPolicies[path]
+		if !ok {
+			policy, ok := vi.policyForItem(existing)
+			if !ok {
+				return nil, errors.Errorf("unexpected missing policy %s for item %s", existing.modPolicy(), key)
+			}
+			mp = &modPolicy{path: path, policy: policy}
+			modPolicies[path] = mp
+		}
+		mp.elements = append(mp.elements, key)
+	}
+
+	sc := &SignatureCollection{
+		envelope:     &cb.ConfigUpdateEnvelope{ConfigUpdate: configUpdateEnv.ConfigUpdate},
+		deserializer: deserializer,
+		creators:     map[string]struct{}{},
+	}
+	for _, mp := range modPolicies {
+		sort.Strings(mp.elements)
+		sc.modPolicies = append(sc.modPolicies, mp)
+	}
+	sort.Slice(sc.modPolicies, func(i, j int) bool {
+		return sc.modPolicies[i].path < sc.modPolicies[j].path
+	})
+
+	for i, sig := range configUpdateEnv.Signatures {
+		if err := sc.Add(sig); err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid signature at index %d", i))
+		}
+	}
+
+	return sc, nil
+}
+
+// Add verifies the signature and adds it to the collection. The signature
+// must be over the config update, by a valid identity which has not
+// signed the update yet.
+func (sc *SignatureCollection) Add(sig *cb.ConfigSignature) error {
+	if sig == nil {
+		return errors.New("nil signature")
+	}
+
+	sigHeader := &cb.SignatureHeader{}
+	if err := proto.Unmarshal(sig.SignatureHeader, sigHeader); err != nil {
+		return errors.Wrap(err, "failed unmarshaling signature header")
+	}
+	if _, exists := sc.creators[string(sigHeader.Creator)]; exists {
+		return errors.New("config update already signed by this identity")
+	}
+
+	identity, err := sc.deserializer.DeserializeIdentity(sigHeader.Creator)
+	if err != nil {
+		return errors.WithMessage(err, "failed deserializing signer")
+	}
+	if err := identity.Validate(); err != nil {
+		return errors.WithMessage(err, "signer is not valid")
+	}
+	err = identity.Verify(util.ConcatenateBytes(sig.SignatureHeader, sc.envelope.ConfigUpdate), sig.Signature)
+	if err != nil {
+		return errors.WithMessage(err, "signature does not verify")
+	}
+
+	sc.creators[string(sigHeader.Creator)] = struct{}{}
+	sc.envelope.Signatures = append(sc.envelope.Signatures, sig)
+	return nil
+}
+
+// Merge adds the signatures of an envelope for the same config update,
+// skipping the ones of identities which already signed the update.
+func (sc *SignatureCollection) Merge(configUpdateEnv *cb.ConfigUpdateEnvelope) error {
+	if configUpdateEnv == nil {
+		return errors.Errorf("cannot process nil ConfigUpdateEnvelope")
+	}
+	if !bytes.Equal(configUpdateEnv.ConfigUpdate, sc.envelope.ConfigUpdate) {
+		return errors.New("signatures are over a different config update")
+	}
+
+	for _, sig := range configUpdateEnv.Signatures {
+		sigHeader := &cb.SignatureHeader{}
+		if err := proto.Unmarshal(sig.SignatureHeader, sigHeader); err == nil {
+			if _, exists := sc.creators[string(sigHeader.Creator)]; exists {
+				continue
+			}
+		}
+		if err := sc.Add(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status reports, for each mod_policy governing the elements modified by
+// the config update, whether it is satisfied by the collected signatures
+func (sc *SignatureCollection) Status() []*PolicyStatus {
+	signedData, _ := sc.envelope.AsSignedData()
+
+	var statuses []*PolicyStatus
+	for _, mp := range sc.modPolicies {
+		status := &PolicyStatus{
+			Path:     mp.path,
+			Elements: mp.elements,
+		}
+		if err := mp.policy.Evaluate(signedData); err != nil {
+			status.Reason = err.Error()
+		} else {
+			status.Satisfied = true
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Unsatisfied returns the paths of the mod_policies which are not satisfied
+// by the collected signatures yet
+func (sc *SignatureCollection) Unsatisfied() []string {
+	var paths []string
+	for _, status := range sc.Status() {
+		if !status.Satisfied {
+			paths = append(paths, status.Path)
+		}
+	}
+	return paths
+}
+
+// Envelope returns the config update along with the collected signatures
+func (sc *SignatureCollection) Envelope() *cb.ConfigUpdateEnvelope {
+	return &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: sc.envelope.ConfigUpdate,
+		Signatures:   append([]*cb.ConfigSignature(nil), sc.envelope.Signatures...),
+	}
+}
+
+// existingGroupOf returns the closest group of the current config which
+// contains item
+func (vi *ValidatorImpl) existingGroupOf(item comparable) (comparable, bool) {
+	for path := item.path; len(path) > 0; path = path[:len(path)-1] {
+		if group, ok := vi.configMap[groupPrefix+pathSeparator+strings.Join(path, pathSeparator)]; ok {
+			return group, true
+		}
+	}
+	return comparable{}, false
+}
+
+// modPolicyPath returns the fully qualified path of the mod_policy of item
+func modPolicyPath(item comparable) string {
+	modPolicy := item.modPolicy()
+	if len(modPolicy) > 0 && modPolicy[0] == policies.PathSeparator[0] {
+		return modPolicy
+	}
+
+	path := append([]string{}, item.path...)
+	if item.ConfigGroup != nil {
+		path = append(path, item.key)
+	}
+	return pathSeparator + strings.Join(append(path, modPolicy), pathSeparator)
+}
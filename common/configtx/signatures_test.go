@@ -0,0 +1,251 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configtx
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	mockpolicies "github.com/hyperledger/fabric/common/mocks/policies"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// thresholdPolicy is satisfied by a minimum number of signatures
+type thresholdPolicy int
+
+func (p thresholdPolicy) Evaluate(signatureSet []*cb.SignedData) error {
+	if len(signatureSet) < int(p) {
+		return fmt.Errorf("%d signatures out of %d", len(signatureSet), p)
+	}
+	return nil
+}
+
+// signer identities are valid unless named "invalid",
+// and sign messages by appending their name to them
+type signer struct {
+	msp.Identity
+	name []byte
+}
+
+func (s *signer) Validate() error {
+	if string(s.name) == "invalid" {
+		return fmt.Errorf("invalid identity")
+	}
+	return nil
+}
+
+func (s *signer) Verify(msg, sig []byte) error {
+	if !bytes.Equal(sig, append(msg, s.name...)) {
+		return fmt.Errorf("bad signature")
+	}
+	return nil
+}
+
+type signerDeserializer struct {
+	msp.IdentityDeserializer
+}
+
+func (signerDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	if len(serializedIdentity) == 0 {
+		return nil, fmt.Errorf("empty identity")
+	}
+	return &signer{name: serializedIdentity}, nil
+}
+
+func sign(name string, configUpdate []byte) *cb.ConfigSignature {
+	sigHeader := utils.MarshalOrPanic(&cb.SignatureHeader{Creator: []byte(name)})
+	return &cb.ConfigSignature{
+		SignatureHeader: sigHeader,
+		Signature:       append(util.ConcatenateBytes(sigHeader, configUpdate), name...),
+	}
+}
+
+func TestSignatureCollection(t *testing.T) {
+	pm := &mockpolicies.Manager{
+		PolicyMap: map[string]policies.Policy{
+			"Admins":           thresholdPolicy(2),
+			"/Channel/Readers": thresholdPolicy(1),
+		},
+	}
+	config := makeConfig(
+		makeConfigPair("foo", "Admins", 0, []byte("foo")),
+		makeConfigPair("bar", "/Channel/Readers", 0, []byte("bar")),
+		makeConfigPair("baz", "Admins", 0, []byte("baz")),
+	)
+	config.ChannelGroup.ModPolicy = "Admins"
+	vi, err := NewValidatorImpl(defaultChain, config, "Channel", pm)
+	require.NoError(t, err)
+
+	configUpdate := utils.MarshalOrPanic(&cb.ConfigUpdate{
+		ChannelId: defaultChain,
+		ReadSet:   makeConfigSet(),
+		WriteSet: makeConfigSet(
+			makeConfigPair("foo", "Admins", 1, []byte("foo2")),
+			makeConfigPair("bar", "Admins", 1, []byte("bar2")),
+			makeConfigPair("baz", "Admins", 1, []byte("baz2")),
+			makeConfigPair("qux", "Admins", 0, []byte("qux")),
+		),
+	})
+
+	sc, err := vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: configUpdate,
+		Signatures:   []*cb.ConfigSignature{sign("alice", configUpdate)},
+	}, signerDeserializer{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*PolicyStatus{
+		{
+			Path:      "/Channel/Admins",
+			Elements:  []string{"[Value]  /Channel/baz", "[Value]  /Channel/foo", "[Value]  /Channel/qux"},
+			Satisfied: false,
+			Reason:    "1 signatures out of 2",
+		},
+		{
+			Path:      "/Channel/Readers",
+			Elements:  []string{"[Value]  /Channel/bar"},
+			Satisfied: true,
+		},
+	}, sc.Status())
+	assert.Equal(t, []string{"/Channel/Admins"}, sc.Unsatisfied())
+
+	// Signatures are verified as they arrive
+	assert.EqualError(t, sc.Add(nil), "nil signature")
+	assert.EqualError(t, sc.Add(sign("alice", configUpdate)), "config update already signed by this identity")
+	assert.EqualError(t, sc.Add(sign("", configUpdate)), "failed deserializing signer: empty identity")
+	assert.EqualError(t, sc.Add(sign("invalid", configUpdate)), "signer is not valid: invalid identity")
+	assert.EqualError(t, sc.Add(sign("bob", []byte("foo"))), "signature does not verify: bad signature")
+	assert.Equal(t, []string{"/Channel/Admins"}, sc.Unsatisfied())
+
+	// Signatures collected over copies of the update are merged
+	assert.EqualError(t, sc.Merge(&cb.ConfigUpdateEnvelope{ConfigUpdate: []byte("foo")}), "signatures are over a different config update")
+	err = sc.Merge(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: configUpdate,
+		Signatures:   []*cb.ConfigSignature{sign("alice", configUpdate), sign("bob", configUpdate)},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, sc.Unsatisfied())
+
+	env := sc.Envelope()
+	assert.Equal(t, configUpdate, env.ConfigUpdate)
+	assert.Len(t, env.Signatures, 2)
+
+	// The collected signatures authorize the update
+	_, err = vi.authorizeUpdate(env)
+	assert.NoError(t, err)
+}
+
+func TestSignatureCollectionNewElements(t *testing.T) {
+	pm := &mockpolicies.Manager{
+		PolicyMap: map[string]policies.Policy{"Admins": thresholdPolicy(3)},
+		SubManagersMap: map[string]*mockpolicies.Manager{
+			"Application": {PolicyMap: map[string]policies.Policy{"Admins": thresholdPolicy(2)}},
+		},
+	}
+	config := makeConfig()
+	config.ChannelGroup.ModPolicy = "Admins"
+	config.ChannelGroup.Groups["Application"] = &cb.ConfigGroup{
+		Values:    map[string]*cb.ConfigValue{"foo": {ModPolicy: "Admins", Value: []byte("foo")}},
+		ModPolicy: "Admins",
+	}
+	vi, err := NewValidatorImpl(defaultChain, config, "Channel", pm)
+	require.NoError(t, err)
+
+	application := cb.NewConfigGroup()
+	application.ModPolicy = "Admins"
+	application.Values["bar"] = &cb.ConfigValue{ModPolicy: "Admins", Value: []byte("bar")}
+	org := cb.NewConfigGroup()
+	org.ModPolicy = "Admins"
+	org.Values["MSP"] = &cb.ConfigValue{ModPolicy: "Admins", Value: []byte("msp")}
+	application.Groups["Org1"] = org
+	readSet := cb.NewConfigGroup()
+	readSet.Groups["Application"] = cb.NewConfigGroup()
+	writeSet := cb.NewConfigGroup()
+	writeSet.Groups["Application"] = application
+	configUpdate := utils.MarshalOrPanic(&cb.ConfigUpdate{
+		ChannelId: defaultChain,
+		ReadSet:   readSet,
+		WriteSet:  writeSet,
+	})
+
+	sc, err := vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: configUpdate,
+		Signatures:   []*cb.ConfigSignature{sign("alice", configUpdate)},
+	}, signerDeserializer{})
+	require.NoError(t, err)
+
+	// The new value and the new org are governed by the mod_policy of the
+	// Application group, even though its version is not bumped
+	assert.Equal(t, []*PolicyStatus{
+		{
+			Path: "/Channel/Application/Admins",
+			Elements: []string{
+				"[Group]  /Channel/Application/Org1",
+				"[Value]  /Channel/Application/Org1/MSP",
+				"[Value]  /Channel/Application/bar",
+			},
+			Satisfied: false,
+			Reason:    "1 signatures out of 2",
+		},
+	}, sc.Status())
+	assert.Equal(t, []string{"/Channel/Application/Admins"}, sc.Unsatisfied())
+
+	assert.NoError(t, sc.Add(sign("bob", configUpdate)))
+	assert.Empty(t, sc.Unsatisfied())
+}
+
+func TestSignatureCollectionInvalid(t *testing.T) {
+	vi, err := NewValidatorImpl(
+		defaultChain,
+		makeConfig(makeConfigPair("foo", "foo", 0, []byte("foo"))),
+		"Channel",
+		defaultPolicyManager())
+	require.NoError(t, err)
+
+	_, err = vi.NewSignatureCollection(nil, signerDeserializer{})
+	assert.EqualError(t, err, "cannot process nil ConfigUpdateEnvelope")
+
+	_, err = vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(&cb.ConfigUpdate{ChannelId: "foo"}),
+	}, signerDeserializer{})
+	assert.EqualError(t, err, "ConfigUpdate for channel 'foo' but envelope for channel 'default.chain.id'")
+
+	_, err = vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(&cb.ConfigUpdate{
+			ChannelId: defaultChain,
+			ReadSet:   makeConfigSet(makeConfigPair("foo", "foo", 0, []byte("foo"))),
+			WriteSet:  makeConfigSet(makeConfigPair("foo", "foo", 0, []byte("foo"))),
+		}),
+	}, signerDeserializer{})
+	assert.EqualError(t, err, "delta set was empty -- update would have no effect")
+
+	_, err = vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(&cb.ConfigUpdate{
+			ChannelId: defaultChain,
+			ReadSet:   makeConfigSet(),
+			WriteSet:  makeConfigSet(makeConfigPair("foo", "foo", 2, []byte("foo"))),
+		}),
+	}, signerDeserializer{})
+	assert.EqualError(t, err, "attempt to set key [Value]  /Channel/foo to version 2, but key is at version 0")
+
+	configUpdate := utils.MarshalOrPanic(&cb.ConfigUpdate{
+		ChannelId: defaultChain,
+		ReadSet:   makeConfigSet(),
+		WriteSet:  makeConfigSet(makeConfigPair("foo", "foo", 1, []byte("foo"))),
+	})
+	_, err = vi.NewSignatureCollection(&cb.ConfigUpdateEnvelope{
+		ConfigUpdate: configUpdate,
+		Signatures:   []*cb.ConfigSignature{sign("alice", configUpdate), sign("invalid", configUpdate)},
+	}, signerDeserializer{})
+	assert.EqualError(t, err, "invalid signature at index 1: signer is not valid: invalid identity")
+}
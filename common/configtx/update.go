@@ -71,21 +71,13 @@ func (vi *ValidatorImpl) verifyDeltaSet(deltaSet map[string]comparable, signedDa
 
 	for key, value := range deltaSet {
 		logger.Debugf("Processing change to key: %s", key)
-		if err := validateModPolicy(value.modPolicy()); err != nil {
-			return errors.Wrapf(err, "invalid mod_policy for element %s", key)
+		existing, ok, err := vi.existingForDelta(key, value)
+		if err != nil {
+			return err
 		}
-
-		existing, ok := vi.configMap[key]
 		if !ok {
-			if value.version() != 0 {
-				return errors.Errorf("attempted to set key %s to version %d, but key does not exist", key, value.version())
-			}
-
 			continue
 		}
-		if value.version() != existing.version()+1 {
-			return errors.Errorf("attempt to set key %s to version %d, but key is at version %d", key, value.version(), existing.version())
-		}
 
 		policy, ok := vi.policyForItem(existing)
 		if !ok {
@@ -100,6 +92,29 @@ func (vi *ValidatorImpl) verifyDeltaSet(deltaSet map[string]comparable, signedDa
 	return nil
 }
 
+// existingForDelta checks the change of the element at key to value, and
+// returns the existing element it modifies, whose mod_policy governs the
+// change, and false if the element is a new one.
+func (vi *ValidatorImpl) existingForDelta(key string, value comparable) (comparable, bool, error) {
+	if err := validateModPolicy(value.modPolicy()); err != nil {
+		return comparable{}, false, errors.Wrapf(err, "invalid mod_policy for element %s", key)
+	}
+
+	existing, ok := vi.configMap[key]
+	if !ok {
+		if value.version() != 0 {
+			return comparable{}, false, errors.Errorf("attempted to set key %s to version %d, but key does not exist", key, value.version())
+		}
+
+		return comparable{}, false, nil
+	}
+	if value.version() != existing.version()+1 {
+		return comparable{}, false, errors.Errorf("attempt to set key %s to version %d, but key is at version %d", key, value.version(), existing.version())
+	}
+
+	return existing, true, nil
+}
+
 func verifyFullProposedConfig(writeSet, fullProposedConfig map[string]comparable) error {
 	for key := range writeSet {
 		if _, ok := fullProposedConfig[key]; !ok {
@@ -116,30 +131,11 @@ func (vi *ValidatorImpl) authorizeUpdate(configUpdateEnv *cb.ConfigUpdateEnvelop
 		return nil, errors.Errorf("cannot process nil ConfigUpdateEnvelope")
 	}
 
-	configUpdate, err := UnmarshalConfigUpdate(configUpdateEnv.ConfigUpdate)
+	writeSet, deltaSet, err := vi.computeDelta(configUpdateEnv)
 	if err != nil {
 		return nil, err
 	}
 
-	if configUpdate.ChannelId != vi.channelID {
-		return nil, errors.Errorf("ConfigUpdate for channel '%s' but envelope for channel '%s'", configUpdate.ChannelId, vi.channelID)
-	}
-
-	readSet, err := mapConfig(configUpdate.ReadSet, vi.namespace)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error mapping ReadSet")
-	}
-	err = vi.verifyReadSet(readSet)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error validating ReadSet")
-	}
-
-	writeSet, err := mapConfig(configUpdate.WriteSet, vi.namespace)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error mapping WriteSet")
-	}
-
-	deltaSet := computeDeltaSet(readSet, writeSet)
 	signedData, err := configUpdateEnv.AsSignedData()
 	if err != nil {
 		return nil, err
@@ -157,6 +153,35 @@ func (vi *ValidatorImpl) authorizeUpdate(configUpdateEnv *cb.ConfigUpdateEnvelop
 	return fullProposedConfig, nil
 }
 
+// computeDelta validates the read set of the config update against the
+// current config and returns the write set and delta set of the update
+func (vi *ValidatorImpl) computeDelta(configUpdateEnv *cb.ConfigUpdateEnvelope) (writeSet, deltaSet map[string]comparable, err error) {
+	configUpdate, err := UnmarshalConfigUpdate(configUpdateEnv.ConfigUpdate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if configUpdate.ChannelId != vi.channelID {
+		return nil, nil, errors.Errorf("ConfigUpdate for channel '%s' but envelope for channel '%s'", configUpdate.ChannelId, vi.channelID)
+	}
+
+	readSet, err := mapConfig(configUpdate.ReadSet, vi.namespace)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error mapping ReadSet")
+	}
+	err = vi.verifyReadSet(readSet)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error validating ReadSet")
+	}
+
+	writeSet, err = mapConfig(configUpdate.WriteSet, vi.namespace)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error mapping WriteSet")
+	}
+
+	return writeSet, computeDeltaSet(readSet, writeSet), nil
+}
+
 func (vi *ValidatorImpl) policyForItem(item comparable) (policies.Policy, bool) {
 	manager := vi.pm
 
@@ -7,14 +7,25 @@ SPDX-License-Identifier: Apache-2.0
 package crypto
 
 import (
+	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
 )
 
+var logger = flogging.MustGetLogger("crypto")
+
 // ExpiresAt returns when the given identity expires, or a zero time.Time
 // in case we cannot determine that
 func ExpiresAt(identityBytes []byte) time.Time {
@@ -34,3 +45,146 @@ func ExpiresAt(identityBytes []byte) time.Time {
 	}
 	return cert.NotAfter
 }
+
+// TLSCertificateExpiresAt returns when the leaf certificate of the given
+// TLS key pair expires, or a zero time.Time in case we cannot determine that
+func TLSCertificateExpiresAt(cert tls.Certificate) time.Time {
+	if len(cert.Certificate) == 0 {
+		return time.Time{}
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return time.Time{}
+	}
+	return leaf.NotAfter
+}
+
+var (
+	certExpirationTimeOpts = metrics.GaugeOpts{
+		Namespace:    "crypto",
+		Name:         "certificate_expiration_time",
+		Help:         "The expiration time of a certificate of the node, in seconds since the Unix epoch.",
+		LabelNames:   []string{"type"},
+		StatsdFormat: "%{#fqname}.%{type}",
+	}
+
+	certExpiringOpts = metrics.GaugeOpts{
+		Namespace:    "crypto",
+		Name:         "certificate_expiring",
+		Help:         "Whether a certificate of the node has expired (2), expires within the warning threshold (1) or not (0).",
+		LabelNames:   []string{"type"},
+		StatsdFormat: "%{#fqname}.%{type}",
+	}
+)
+
+// ExpirationTracker tracks the expiration of the certificates of a node,
+// such as its enrollment and TLS certificates. It reports their expiration
+// as metrics, warns when they are about to expire, and fails health checks
+// once one of them has expired.
+type ExpirationTracker struct {
+	warningThreshold time.Duration
+	now              func() time.Time
+
+	expirationTime metrics.Gauge
+	expiring       metrics.Gauge
+
+	mutex       sync.Mutex
+	expirations map[string]time.Time
+	stopOnce    sync.Once
+	stop        chan struct{}
+}
+
+// NewExpirationTracker returns an ExpirationTracker that warns about
+// certificates expiring within warningThreshold
+func NewExpirationTracker(p metrics.Provider, warningThreshold time.Duration) *ExpirationTracker {
+	return &ExpirationTracker{
+		warningThreshold: warningThreshold,
+		now:              time.Now,
+		expirationTime:   p.NewGauge(certExpirationTimeOpts),
+		expiring:         p.NewGauge(certExpiringOpts),
+		expirations:      map[string]time.Time{},
+		stop:             make(chan struct{}),
+	}
+}
+
+// Track starts tracking, or updates, the expiration time of the
+// certificate of the given type. A zero expiresAt stops tracking it.
+func (t *ExpirationTracker) Track(certType string, expiresAt time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if expiresAt.IsZero() {
+		delete(t.expirations, certType)
+		return
+	}
+	t.expirations[certType] = expiresAt
+	t.expirationTime.With("type", certType).Set(float64(expiresAt.Unix()))
+	t.check(certType, expiresAt)
+}
+
+// Check updates the metrics of all the tracked certificates, and
+// warns about those that have expired or are about to expire
+func (t *ExpirationTracker) Check() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for certType, expiresAt := range t.expirations {
+		t.check(certType, expiresAt)
+	}
+}
+
+func (t *ExpirationTracker) check(certType string, expiresAt time.Time) {
+	timeLeft := expiresAt.Sub(t.now())
+	switch {
+	case timeLeft <= 0:
+		t.expiring.With("type", certType).Set(2)
+		logger.Errorf("The %s certificate has expired at %s", certType, expiresAt)
+	case timeLeft <= t.warningThreshold:
+		t.expiring.With("type", certType).Set(1)
+		logger.Warningf("The %s certificate expires within %s, at %s", certType, timeLeft.Round(time.Second), expiresAt)
+	default:
+		t.expiring.With("type", certType).Set(0)
+	}
+}
+
+// Start periodically checks the tracked certificates until Stop is called
+func (t *ExpirationTracker) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.Check()
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic checks of the tracked certificates
+func (t *ExpirationTracker) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+}
+
+// HealthCheck returns an error if any of the tracked certificates has expired
+func (t *ExpirationTracker) HealthCheck(ctx context.Context) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := t.now()
+	var expired []string
+	for certType, expiresAt := range t.expirations {
+		if !now.Before(expiresAt) {
+			expired = append(expired, fmt.Sprintf("%s (at %s)", certType, expiresAt))
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	sort.Strings(expired)
+	return errors.Errorf("expired certificates: %s", strings.Join(expired, ", "))
+}
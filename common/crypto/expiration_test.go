@@ -7,13 +7,19 @@ SPDX-License-Identifier: Apache-2.0
 package crypto
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
 	"github.com/hyperledger/fabric/protos/msp"
+	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,3 +68,85 @@ func TestInvalidIdentityExpiresAt(t *testing.T) {
 	expirationTime := ExpiresAt([]byte{1, 2, 3})
 	assert.True(t, expirationTime.IsZero())
 }
+
+func TestTLSCertificateExpiresAt(t *testing.T) {
+	certBytes, err := ioutil.ReadFile(filepath.Join("testdata", "cert.pem"))
+	assert.NoError(t, err)
+	bl, _ := pem.Decode(certBytes)
+	cert := tls.Certificate{Certificate: [][]byte{bl.Bytes}}
+	assert.Equal(t, time.Date(2027, 8, 17, 12, 19, 48, 0, time.UTC), TLSCertificateExpiresAt(cert))
+
+	assert.True(t, TLSCertificateExpiresAt(tls.Certificate{}).IsZero())
+	assert.True(t, TLSCertificateExpiresAt(tls.Certificate{Certificate: [][]byte{{1, 2, 3}}}).IsZero())
+}
+
+func TestExpirationTracker(t *testing.T) {
+	provider := &metricsfakes.Provider{}
+	expirationTime := &metricsfakes.Gauge{}
+	expirationTime.WithReturns(expirationTime)
+	expiring := &metricsfakes.Gauge{}
+	expiring.WithReturns(expiring)
+	provider.NewGaugeStub = func(o metrics.GaugeOpts) metrics.Gauge {
+		if o.Name == "certificate_expiring" {
+			return expiring
+		}
+		return expirationTime
+	}
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := NewExpirationTracker(provider, 24*time.Hour)
+	tracker.now = func() time.Time { return now }
+
+	tracker.Track("enrollment", now.Add(48*time.Hour))
+	assert.Equal(t, []string{"type", "enrollment"}, expirationTime.WithArgsForCall(0))
+	assert.Equal(t, float64(now.Add(48*time.Hour).Unix()), expirationTime.SetArgsForCall(0))
+	assert.Equal(t, float64(0), expiring.SetArgsForCall(0))
+	assert.NoError(t, tracker.HealthCheck(context.Background()))
+
+	tracker.Track("tls", now.Add(time.Hour))
+	assert.Equal(t, []string{"type", "tls"}, expiring.WithArgsForCall(1))
+	assert.Equal(t, float64(1), expiring.SetArgsForCall(1))
+	assert.NoError(t, tracker.HealthCheck(context.Background()))
+
+	// The TLS certificate expires
+	now = now.Add(2 * time.Hour)
+	tracker.Check()
+	assert.Equal(t, 4, expiring.SetCallCount())
+	for i := 2; i < 4; i++ {
+		switch expiring.WithArgsForCall(i)[1] {
+		case "tls":
+			assert.Equal(t, float64(2), expiring.SetArgsForCall(i))
+		case "enrollment":
+			assert.Equal(t, float64(0), expiring.SetArgsForCall(i))
+		}
+	}
+	assert.EqualError(t, tracker.HealthCheck(context.Background()), "expired certificates: tls (at 2020-01-01 01:00:00 +0000 UTC)")
+
+	// The TLS certificate is renewed
+	tracker.Track("tls", now.Add(72*time.Hour))
+	assert.Equal(t, float64(0), expiring.SetArgsForCall(4))
+	assert.NoError(t, tracker.HealthCheck(context.Background()))
+
+	// A certificate that is no longer tracked does not fail health checks
+	now = now.Add(100 * time.Hour)
+	assert.Error(t, tracker.HealthCheck(context.Background()))
+	tracker.Track("enrollment", time.Time{})
+	tracker.Track("tls", time.Time{})
+	assert.NoError(t, tracker.HealthCheck(context.Background()))
+}
+
+func TestExpirationTrackerStart(t *testing.T) {
+	provider := &metricsfakes.Provider{}
+	gauge := &metricsfakes.Gauge{}
+	gauge.WithReturns(gauge)
+	provider.NewGaugeReturns(gauge)
+
+	tracker := NewExpirationTracker(provider, time.Hour)
+	tracker.Track("tls", time.Now().Add(24*time.Hour))
+	tracker.Start(10 * time.Millisecond)
+	defer tracker.Stop()
+
+	// Both gauges are set at each check
+	gt := NewGomegaWithT(t)
+	gt.Eventually(gauge.SetCallCount).Should(BeNumerically(">", 4))
+}
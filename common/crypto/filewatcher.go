@@ -0,0 +1,115 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileWatcher polls a set of files and directories, and invokes a callback
+// whenever their content changes. Directories are watched for changes of
+// the regular files they directly contain. If the callback fails, the
+// change is considered again at the next poll, which allows files that
+// are updated together, such as a certificate and its key, to be swapped
+// in only once they are consistent.
+type FileWatcher struct {
+	paths    []string
+	interval time.Duration
+	onChange func() error
+
+	digest   []byte
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewFileWatcher returns a FileWatcher that polls paths every interval
+// and calls onChange when their content differs from the current one
+func NewFileWatcher(interval time.Duration, onChange func() error, paths ...string) *FileWatcher {
+	return &FileWatcher{
+		paths:    paths,
+		interval: interval,
+		onChange: onChange,
+		digest:   digestFiles(paths),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start polls the watched files until Stop is called
+func (w *FileWatcher) Start() {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.poll()
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops polling the watched files
+func (w *FileWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+func (w *FileWatcher) poll() {
+	digest := digestFiles(w.paths)
+	if bytes.Equal(digest, w.digest) {
+		return
+	}
+
+	logger.Infof("Detected changes in %v", w.paths)
+	if err := w.onChange(); err != nil {
+		logger.Warningf("Failed applying changes in %v, will retry: %s", w.paths, err)
+		return
+	}
+	w.digest = digest
+}
+
+// digestFiles returns a digest of the names and contents of the given files,
+// and of the regular files in the given directories
+func digestFiles(paths []string) []byte {
+	h := sha256.New()
+	digest := func(path string) {
+		h.Write([]byte(path))
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			h.Write([]byte{0})
+			return
+		}
+		h.Write([]byte{1})
+		h.Write(content)
+	}
+
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil || !fi.IsDir() {
+			digest(path)
+			continue
+		}
+		files, _ := ioutil.ReadDir(path)
+		for _, f := range files {
+			// Follow symbolic links, which are commonly used to update files atomically
+			file := filepath.Join(path, f.Name())
+			if fi, err := os.Stat(file); err == nil && fi.Mode().IsRegular() {
+				digest(file)
+			}
+		}
+	}
+	return h.Sum(nil)
+}
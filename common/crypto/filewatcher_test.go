@@ -0,0 +1,86 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package crypto
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filewatcher")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certsDir := filepath.Join(dir, "certs")
+	assert.NoError(t, os.Mkdir(certsDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(certsDir, "cert.pem"), []byte("cert"), 0644))
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("key"), 0600))
+
+	var changes int
+	var failure error
+	w := NewFileWatcher(time.Hour, func() error {
+		changes++
+		return failure
+	}, certsDir, keyFile)
+
+	w.poll()
+	assert.Equal(t, 0, changes)
+
+	// A change of a file in a watched directory
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(certsDir, "cert.pem"), []byte("renewed cert"), 0644))
+	w.poll()
+	assert.Equal(t, 1, changes)
+	w.poll()
+	assert.Equal(t, 1, changes)
+
+	// A new file in a watched directory, which is not applied at first
+	failure = errors.New("inconsistent key pair")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(certsDir, "cert2.pem"), []byte("cert"), 0644))
+	w.poll()
+	assert.Equal(t, 2, changes)
+	failure = nil
+	w.poll()
+	assert.Equal(t, 3, changes)
+	w.poll()
+	assert.Equal(t, 3, changes)
+
+	// Files replaced through symbolic links
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "key2.pem"), []byte("renewed key"), 0600))
+	assert.NoError(t, os.Remove(keyFile))
+	w.poll()
+	assert.Equal(t, 4, changes)
+	assert.NoError(t, os.Symlink(filepath.Join(dir, "key2.pem"), keyFile))
+	w.poll()
+	assert.Equal(t, 5, changes)
+}
+
+func TestFileWatcherStart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filewatcher")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	changes := make(chan struct{}, 1)
+	w := NewFileWatcher(10*time.Millisecond, func() error {
+		changes <- struct{}{}
+		return nil
+	}, dir)
+	w.Start()
+	defer w.Stop()
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "cert.pem"), []byte("cert"), 0644))
+	gt := NewGomegaWithT(t)
+	gt.Eventually(changes).Should(Receive())
+}
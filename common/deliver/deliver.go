@@ -116,6 +116,15 @@ type Filtered interface {
 	IsFiltered() bool
 }
 
+// Checkpointer is implemented by response senders which resume the delivery
+// of named consumers from their checkpoint
+type Checkpointer interface {
+	// ResumeFrom returns the number of the block the delivery of the consumer
+	// of the creator of the request resumes from, and false if the consumer
+	// has no checkpoint on the channel
+	ResumeFrom(channelID, consumerID string, creator []byte) (uint64, bool, error)
+}
+
 // Server is a polymorphic structure to support generalization of this handler
 // to be able to deliver different type of responses.
 type Server struct {
@@ -271,7 +280,35 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 
 	logger.Debugf("[channel: %s] Received seekInfo (%p) %v from %s", chdr.ChannelId, seekInfo, seekInfo, addr)
 
-	cursor, number := chain.Reader().Iterator(seekInfo.Start)
+	start := seekInfo.Start
+	if checkpoint := start.GetCheckpoint(); checkpoint != nil {
+		checkpointer, ok := srv.ResponseSender.(Checkpointer)
+		if !ok {
+			logger.Warningf("[channel: %s] Received seekInfo message from %s with unsupported checkpoint start", chdr.ChannelId, addr)
+			return cb.Status_BAD_REQUEST, nil
+		}
+		shdr, err := utils.GetSignatureHeader(payload.Header.SignatureHeader)
+		if err != nil {
+			logger.Warningf("[channel: %s] Failed to unmarshal signature header from %s: %s", chdr.ChannelId, addr, err)
+			return cb.Status_BAD_REQUEST, nil
+		}
+		number, found, err := checkpointer.ResumeFrom(chdr.ChannelId, checkpoint.ConsumerId, shdr.Creator)
+		if err != nil {
+			logger.Errorf("[channel: %s] Error reading the checkpoint of consumer %s: %s", chdr.ChannelId, checkpoint.ConsumerId, err)
+			return cb.Status_INTERNAL_SERVER_ERROR, nil
+		}
+		if !found {
+			logger.Debugf("[channel: %s] Rejecting deliver for %s because consumer %s has no checkpoint", chdr.ChannelId, addr, checkpoint.ConsumerId)
+			return cb.Status_NOT_FOUND, nil
+		}
+		start = &ab.SeekPosition{Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: number}}}
+	}
+	if seekInfo.Stop.GetCheckpoint() != nil {
+		logger.Warningf("[channel: %s] Received seekInfo message from %s with unsupported checkpoint stop", chdr.ChannelId, addr)
+		return cb.Status_BAD_REQUEST, nil
+	}
+
+	cursor, number := chain.Reader().Iterator(start)
 	defer cursor.Close()
 	var stopNum uint64
 	switch stop := seekInfo.Stop.Type.(type) {
@@ -331,7 +368,15 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 
 		logger.Debugf("[channel: %s] Delivering block for (%p) for %s", chdr.ChannelId, seekInfo, addr)
 
-		if err := srv.SendBlockResponse(block); err != nil {
+		block2send := block
+		if seekInfo.ContentType == ab.SeekInfo_HEADER_WITH_SIG && !utils.IsConfigBlock(block) {
+			block2send = &cb.Block{
+				Header:   block.Header,
+				Metadata: block.Metadata,
+			}
+		}
+
+		if err := srv.SendBlockResponse(block2send); err != nil {
 			logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
 			return cb.Status_INTERNAL_SERVER_ERROR, err
 		}
@@ -20,6 +20,12 @@ type filteredResponseSender interface {
 	deliver.Filtered
 }
 
+//go:generate counterfeiter -o mock/checkpoint_response_sender.go -fake-name CheckpointResponseSender . checkpointResponseSender
+type checkpointResponseSender interface {
+	deliver.ResponseSender
+	deliver.Checkpointer
+}
+
 func TestDeliver(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Deliver Suite")
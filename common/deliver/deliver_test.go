@@ -826,6 +826,178 @@ var _ = Describe("Deliver", func() {
 			})
 		})
 
+		Context("when only block headers are requested", func() {
+			var (
+				block       *cb.Block
+				configBlock *cb.Block
+			)
+
+			BeforeEach(func() {
+				block = &cb.Block{
+					Header: &cb.BlockHeader{Number: 100, DataHash: []byte("data-hash")},
+					Data: &cb.BlockData{
+						Data: [][]byte{utils.MarshalOrPanic(&cb.Envelope{})},
+					},
+					Metadata: &cb.BlockMetadata{Metadata: [][]byte{[]byte("signatures")}},
+				}
+				configBlock = &cb.Block{
+					Header: &cb.BlockHeader{Number: 101},
+					Data: &cb.BlockData{
+						Data: [][]byte{utils.MarshalOrPanic(&cb.Envelope{
+							Payload: utils.MarshalOrPanic(&cb.Payload{
+								Header: &cb.Header{
+									ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{
+										Type: int32(cb.HeaderType_CONFIG),
+									}),
+								},
+							}),
+						})},
+					},
+					Metadata: &cb.BlockMetadata{Metadata: [][]byte{[]byte("signatures")}},
+				}
+				fakeBlockIterator.NextReturnsOnCall(0, block, cb.Status_SUCCESS)
+				fakeBlockIterator.NextReturnsOnCall(1, configBlock, cb.Status_SUCCESS)
+
+				seekInfo.ContentType = ab.SeekInfo_HEADER_WITH_SIG
+				seekInfo.Stop = &ab.SeekPosition{
+					Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 101}},
+				}
+			})
+
+			It("sends the header and metadata of the blocks, and config blocks whole", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(2))
+				Expect(fakeResponseSender.SendBlockResponseArgsForCall(0)).To(Equal(&cb.Block{
+					Header:   block.Header,
+					Metadata: block.Metadata,
+				}))
+				Expect(fakeResponseSender.SendBlockResponseArgsForCall(1)).To(Equal(configBlock))
+				Expect(block.Data).NotTo(BeNil())
+			})
+		})
+
+		Context("when the start is a checkpoint", func() {
+			var fakeResponseSender *mock.CheckpointResponseSender
+
+			BeforeEach(func() {
+				fakeResponseSender = &mock.CheckpointResponseSender{}
+				fakeResponseSender.ResumeFromReturns(99, true, nil)
+				server.ResponseSender = fakeResponseSender
+				seekInfo.Start = &ab.SeekPosition{
+					Type: &ab.SeekPosition_Checkpoint{
+						Checkpoint: &ab.SeekCheckpoint{ConsumerId: "consumer"},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				envelope.Payload = utils.MarshalOrPanic(&cb.Payload{
+					Header: &cb.Header{
+						ChannelHeader:   channelHeaderPayload,
+						SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{Creator: []byte("creator")}),
+					},
+					Data: seekInfoPayload,
+				})
+			})
+
+			It("resumes from the block returned by the response sender", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeResponseSender.ResumeFromCallCount()).To(Equal(1))
+				channelID, consumerID, creator := fakeResponseSender.ResumeFromArgsForCall(0)
+				Expect(channelID).To(Equal("chain-id"))
+				Expect(consumerID).To(Equal("consumer"))
+				Expect(creator).To(Equal([]byte("creator")))
+
+				Expect(fakeBlockReader.IteratorCallCount()).To(Equal(1))
+				start := fakeBlockReader.IteratorArgsForCall(0)
+				Expect(start).To(Equal(&ab.SeekPosition{
+					Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 99}},
+				}))
+				Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+			})
+
+			Context("when the consumer has no checkpoint", func() {
+				BeforeEach(func() {
+					fakeResponseSender.ResumeFromReturns(0, false, nil)
+				})
+
+				It("sends status not found", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBlockReader.IteratorCallCount()).To(Equal(0))
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+					Expect(resp).To(Equal(cb.Status_NOT_FOUND))
+				})
+			})
+
+			Context("when reading the checkpoint fails", func() {
+				BeforeEach(func() {
+					fakeResponseSender.ResumeFromReturns(0, false, errors.New("boom"))
+				})
+
+				It("sends status internal server error", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+					Expect(resp).To(Equal(cb.Status_INTERNAL_SERVER_ERROR))
+				})
+			})
+
+			Context("when the response sender does not support checkpoints", func() {
+				BeforeEach(func() {
+					server.ResponseSender = &mock.ResponseSender{}
+				})
+
+				It("sends status bad request", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					responseSender := server.ResponseSender.(*mock.ResponseSender)
+					Expect(responseSender.SendStatusResponseCallCount()).To(Equal(1))
+					resp := responseSender.SendStatusResponseArgsForCall(0)
+					Expect(resp).To(Equal(cb.Status_BAD_REQUEST))
+				})
+			})
+		})
+
+		Context("when the stop is a checkpoint", func() {
+			BeforeEach(func() {
+				seekInfo.Stop = &ab.SeekPosition{
+					Type: &ab.SeekPosition_Checkpoint{
+						Checkpoint: &ab.SeekCheckpoint{ConsumerId: "consumer"},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				envelope.Payload = utils.MarshalOrPanic(&cb.Payload{
+					Header: &cb.Header{
+						ChannelHeader:   channelHeaderPayload,
+						SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{Creator: []byte("creator")}),
+					},
+					Data: seekInfoPayload,
+				})
+			})
+
+			It("sends status bad request", func() {
+				err := handler.Handle(context.Background(), server)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBlockReader.IteratorCallCount()).To(Equal(0))
+				Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+				resp := fakeResponseSender.SendStatusResponseArgsForCall(0)
+				Expect(resp).To(Equal(cb.Status_BAD_REQUEST))
+			})
+		})
+
 		Context("when unmarshaling seek info fails", func() {
 			BeforeEach(func() {
 				seekInfoPayload = []byte("complete-nonsense")
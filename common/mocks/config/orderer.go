@@ -28,6 +28,14 @@ type Orderer struct {
 	BatchTimeoutVal time.Duration
 	// KafkaBrokersVal is returned as the result of KafkaBrokers()
 	KafkaBrokersVal []string
+	// RateLimitsVal is returned as the result of RateLimits()
+	RateLimitsVal *ab.RateLimits
+	// BatchPrioritiesVal is returned as the result of BatchPriorities()
+	BatchPrioritiesVal *ab.BatchPriorities
+	// AdaptiveBatchingVal is returned as the result of AdaptiveBatching()
+	AdaptiveBatchingVal *ab.AdaptiveBatching
+	// DuplicateTxIDWindowVal is returned as the result of DuplicateTxIDWindow()
+	DuplicateTxIDWindowVal uint32
 	// MaxChannelsCountVal is returns as the result of MaxChannelsCount()
 	MaxChannelsCountVal uint64
 	// OrganizationsVal is returned as the result of Organizations()
@@ -66,6 +74,26 @@ func (o *Orderer) KafkaBrokers() []string {
 	return o.KafkaBrokersVal
 }
 
+// RateLimits returns the RateLimitsVal
+func (o *Orderer) RateLimits() *ab.RateLimits {
+	return o.RateLimitsVal
+}
+
+// BatchPriorities returns the BatchPrioritiesVal
+func (o *Orderer) BatchPriorities() *ab.BatchPriorities {
+	return o.BatchPrioritiesVal
+}
+
+// AdaptiveBatching returns the AdaptiveBatchingVal
+func (o *Orderer) AdaptiveBatching() *ab.AdaptiveBatching {
+	return o.AdaptiveBatchingVal
+}
+
+// DuplicateTxIDWindow returns the DuplicateTxIDWindowVal
+func (o *Orderer) DuplicateTxIDWindow() uint32 {
+	return o.DuplicateTxIDWindowVal
+}
+
 // MaxChannelsCount returns the MaxChannelsCountVal
 func (o *Orderer) MaxChannelsCount() uint64 {
 	return o.MaxChannelsCountVal
@@ -96,6 +124,9 @@ type OrdererCapabilities struct {
 	ExpirationVal bool
 
 	ConsensusTypeMigrationVal bool
+
+	// ExtendedOrdererConfigVal is returned by ExtendedOrdererConfig()
+	ExtendedOrdererConfigVal bool
 }
 
 // Supported returns SupportedErr
@@ -123,3 +154,8 @@ func (oc *OrdererCapabilities) ExpirationCheck() bool {
 func (oc *OrdererCapabilities) ConsensusTypeMigration() bool {
 	return oc.ConsensusTypeMigrationVal
 }
+
+// ExtendedOrdererConfig returns ExtendedOrdererConfigVal
+func (oc *OrdererCapabilities) ExtendedOrdererConfig() bool {
+	return oc.ExtendedOrdererConfigVal
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/msp"
 	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -123,6 +125,44 @@ func addSignaturePolicyDefaults(cg *cb.ConfigGroup, mspID string, devMode bool)
 	addPolicy(cg, policies.SignaturePolicy(channelconfig.WritersPolicyKey, cauthdsl.SignedByMspMember(mspID)), channelconfig.AdminsPolicyKey)
 }
 
+// addNodeOUSignaturePolicyDefaults adds the Readers/Writers/Admins policies as signature policies which tell the identities
+// of the given mspID apart by their NodeOU classification. The Admins policy requires an admin, the Readers policy an admin,
+// a client or a node of the given role, and the Writers policy an admin or a client, plus an orderer for orderer orgs.
+func addNodeOUSignaturePolicyDefaults(cg *cb.ConfigGroup, mspID string, nodeRole mspprotos.MSPRole_MSPRoleType) {
+	writers := []mspprotos.MSPRole_MSPRoleType{mspprotos.MSPRole_ADMIN, mspprotos.MSPRole_CLIENT}
+	if nodeRole == mspprotos.MSPRole_ORDERER {
+		writers = append(writers, mspprotos.MSPRole_ORDERER)
+	}
+	addPolicy(cg, policies.SignaturePolicy(channelconfig.AdminsPolicyKey, cauthdsl.SignedByMspAdmin(mspID)), channelconfig.AdminsPolicyKey)
+	addPolicy(cg, policies.SignaturePolicy(channelconfig.ReadersPolicyKey, cauthdsl.SignedByMspRoles(mspID, mspprotos.MSPRole_ADMIN, nodeRole, mspprotos.MSPRole_CLIENT)), channelconfig.AdminsPolicyKey)
+	addPolicy(cg, policies.SignaturePolicy(channelconfig.WritersPolicyKey, cauthdsl.SignedByMspRoles(mspID, writers...)), channelconfig.AdminsPolicyKey)
+}
+
+// addOrgPolicyDefaults adds the default policies of an org group. If the org's MSP classifies its identities with NodeOUs,
+// including an admin OU, the policies are expressed in terms of those roles, so that admins are recognized without
+// distributing admin certificates. Otherwise, the plain signature policy defaults are used.
+func addOrgPolicyDefaults(cg *cb.ConfigGroup, conf *genesisconfig.Organization, mspConfig *mspprotos.MSPConfig, nodeRole mspprotos.MSPRole_MSPRoleType) {
+	devMode := conf.AdminPrincipal != genesisconfig.AdminRoleAdminPrincipal
+	if devMode || !adminOUEnabled(mspConfig) {
+		addSignaturePolicyDefaults(cg, conf.ID, devMode)
+		return
+	}
+	addNodeOUSignaturePolicyDefaults(cg, conf.ID, nodeRole)
+}
+
+// adminOUEnabled returns whether the given MSP configuration enables NodeOUs with an admin OU identifier.
+func adminOUEnabled(mspConfig *mspprotos.MSPConfig) bool {
+	if mspConfig.Type != int32(msp.FABRIC) {
+		return false
+	}
+	fabricConfig := &mspprotos.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.Config, fabricConfig); err != nil {
+		return false
+	}
+	nodeOUs := fabricConfig.FabricNodeOus
+	return nodeOUs != nil && nodeOUs.Enable && nodeOUs.AdminOuIdentifier != nil
+}
+
 // NewChannelGroup defines the root of the channel configuration.  It defines basic operating principles like the hashing
 // algorithm used for the blocks, as well as the location of the ordering service.  It will recursively call into the
 // NewOrdererGroup, NewConsortiumsGroup, and NewApplicationGroup depending on whether these sub-elements are set in the
@@ -202,8 +242,26 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 		conf.BatchSize.PreferredMaxBytes,
 	), channelconfig.AdminsPolicyKey)
 	addValue(ordererGroup, channelconfig.BatchTimeoutValue(conf.BatchTimeout.String()), channelconfig.AdminsPolicyKey)
+	if conf.AdaptiveBatching != nil {
+		addValue(ordererGroup, channelconfig.AdaptiveBatchingValue(&ab.AdaptiveBatching{
+			MinBatchTimeout:      conf.AdaptiveBatching.MinBatchTimeout.String(),
+			MaxPreferredMaxBytes: conf.AdaptiveBatching.MaxPreferredMaxBytes,
+		}), channelconfig.AdminsPolicyKey)
+	}
 	addValue(ordererGroup, channelconfig.ChannelRestrictionsValue(conf.MaxChannels), channelconfig.AdminsPolicyKey)
 
+	if conf.DuplicateTxIDWindow != 0 {
+		addValue(ordererGroup, channelconfig.DuplicateTxIDWindowValue(conf.DuplicateTxIDWindow), channelconfig.AdminsPolicyKey)
+	}
+
+	if conf.RateLimits != nil {
+		addValue(ordererGroup, channelconfig.RateLimitsValue(rateLimits(conf.RateLimits)), channelconfig.AdminsPolicyKey)
+	}
+
+	if conf.BatchPriorities != nil {
+		addValue(ordererGroup, channelconfig.BatchPrioritiesValue(batchPriorities(conf.BatchPriorities)), channelconfig.AdminsPolicyKey)
+	}
+
 	if len(conf.Capabilities) > 0 {
 		addValue(ordererGroup, channelconfig.CapabilitiesValue(conf.Capabilities), channelconfig.AdminsPolicyKey)
 	}
@@ -237,6 +295,30 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 	return ordererGroup, nil
 }
 
+func rateLimits(conf *genesisconfig.RateLimits) *ab.RateLimits {
+	rateLimits := &ab.RateLimits{}
+	if conf.Channel != nil {
+		rateLimits.Channel = &ab.RateLimit{Rate: conf.Channel.Rate, Burst: conf.Channel.Burst}
+	}
+	if conf.Client != nil {
+		rateLimits.Client = &ab.RateLimit{Rate: conf.Client.Rate, Burst: conf.Client.Burst}
+	}
+	return rateLimits
+}
+
+func batchPriorities(conf *genesisconfig.BatchPriorities) *ab.BatchPriorities {
+	batchPriorities := &ab.BatchPriorities{MaxPendingBatches: conf.MaxPendingBatches}
+	for _, class := range conf.Classes {
+		batchPriorities.Classes = append(batchPriorities.Classes, &ab.PriorityClass{
+			Name:        class.Name,
+			Weight:      class.Weight,
+			MspIds:      class.MSPIDs,
+			HeaderTypes: class.HeaderTypes,
+		})
+	}
+	return batchPriorities
+}
+
 // NewConsortiumsGroup returns an org component of the channel configuration.  It defines the crypto material for the
 // organization (its MSP).  It sets the mod_policy of all elements to "Admins".
 func NewConsortiumOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, error) {
@@ -248,7 +330,7 @@ func NewConsortiumOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, e
 	consortiumOrgGroup := cb.NewConfigGroup()
 	if len(conf.Policies) == 0 {
 		logger.Warningf("Default policy emission is deprecated, please include policy specifications for the orderer org group %s in configtx.yaml", conf.Name)
-		addSignaturePolicyDefaults(consortiumOrgGroup, conf.ID, conf.AdminPrincipal != genesisconfig.AdminRoleAdminPrincipal)
+		addOrgPolicyDefaults(consortiumOrgGroup, conf, mspConfig, mspprotos.MSPRole_PEER)
 	} else {
 		if err := addPolicies(consortiumOrgGroup, conf.Policies, channelconfig.AdminsPolicyKey); err != nil {
 			return nil, errors.Wrapf(err, "error adding policies to orderer org group '%s'", conf.Name)
@@ -273,7 +355,7 @@ func NewOrdererOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, erro
 	ordererOrgGroup := cb.NewConfigGroup()
 	if len(conf.Policies) == 0 {
 		logger.Warningf("Default policy emission is deprecated, please include policy specifications for the orderer org group %s in configtx.yaml", conf.Name)
-		addSignaturePolicyDefaults(ordererOrgGroup, conf.ID, conf.AdminPrincipal != genesisconfig.AdminRoleAdminPrincipal)
+		addOrgPolicyDefaults(ordererOrgGroup, conf, mspConfig, mspprotos.MSPRole_ORDERER)
 	} else {
 		if err := addPolicies(ordererOrgGroup, conf.Policies, channelconfig.AdminsPolicyKey); err != nil {
 			return nil, errors.Wrapf(err, "error adding policies to orderer org group '%s'", conf.Name)
@@ -335,7 +417,7 @@ func NewApplicationOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup,
 	applicationOrgGroup := cb.NewConfigGroup()
 	if len(conf.Policies) == 0 {
 		logger.Warningf("Default policy emission is deprecated, please include policy specifications for the application org group %s in configtx.yaml", conf.Name)
-		addSignaturePolicyDefaults(applicationOrgGroup, conf.ID, conf.AdminPrincipal != genesisconfig.AdminRoleAdminPrincipal)
+		addOrgPolicyDefaults(applicationOrgGroup, conf, mspConfig, mspprotos.MSPRole_PEER)
 	} else {
 		if err := addPolicies(applicationOrgGroup, conf.Policies, channelconfig.AdminsPolicyKey); err != nil {
 			return nil, errors.Wrapf(err, "error adding policies to application org group %s", conf.Name)
@@ -8,6 +8,7 @@ package encoder_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -229,6 +230,89 @@ var _ = Describe("Encoder", func() {
 			})
 		})
 
+		Context("when rate limits are set", func() {
+			BeforeEach(func() {
+				conf.RateLimits = &genesisconfig.RateLimits{
+					Client: &genesisconfig.RateLimit{Rate: 10, Burst: 20},
+				}
+			})
+
+			It("adds the rate limits key", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(6))
+				rateLimits := &ab.RateLimits{}
+				err = proto.Unmarshal(cg.Values["RateLimits"].Value, rateLimits)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rateLimits.Channel).To(BeNil())
+				Expect(proto.Equal(rateLimits.Client, &ab.RateLimit{Rate: 10, Burst: 20})).To(BeTrue())
+			})
+		})
+
+		Context("when adaptive batching is set", func() {
+			BeforeEach(func() {
+				conf.AdaptiveBatching = &genesisconfig.AdaptiveBatching{
+					MinBatchTimeout:      100 * time.Millisecond,
+					MaxPreferredMaxBytes: 2048,
+				}
+			})
+
+			It("adds the adaptive batching key", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(6))
+				adaptiveBatching := &ab.AdaptiveBatching{}
+				err = proto.Unmarshal(cg.Values["AdaptiveBatching"].Value, adaptiveBatching)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proto.Equal(adaptiveBatching, &ab.AdaptiveBatching{
+					MinBatchTimeout:      "100ms",
+					MaxPreferredMaxBytes: 2048,
+				})).To(BeTrue())
+			})
+		})
+
+		Context("when a duplicate transaction ID window is set", func() {
+			BeforeEach(func() {
+				conf.DuplicateTxIDWindow = 100
+			})
+
+			It("adds the duplicate transaction ID window key", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(6))
+				duplicateTxIDWindow := &ab.DuplicateTxIDWindow{}
+				err = proto.Unmarshal(cg.Values["DuplicateTxIDWindow"].Value, duplicateTxIDWindow)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(duplicateTxIDWindow.Blocks).To(Equal(uint32(100)))
+			})
+		})
+
+		Context("when batch priorities are set", func() {
+			BeforeEach(func() {
+				conf.BatchPriorities = &genesisconfig.BatchPriorities{
+					Classes: []*genesisconfig.PriorityClass{
+						{Name: "Payments", Weight: 3, MSPIDs: []string{"Org1MSP"}},
+					},
+					MaxPendingBatches: 2,
+				}
+			})
+
+			It("adds the batch priorities key", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(cg.Values)).To(Equal(6))
+				batchPriorities := &ab.BatchPriorities{}
+				err = proto.Unmarshal(cg.Values["BatchPriorities"].Value, batchPriorities)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proto.Equal(batchPriorities, &ab.BatchPriorities{
+					Classes: []*ab.PriorityClass{
+						{Name: "Payments", Weight: 3, MspIds: []string{"Org1MSP"}},
+					},
+					MaxPendingBatches: 2,
+				})).To(BeTrue())
+			})
+		})
+
 		Context("when the consensus type is Kafka", func() {
 			BeforeEach(func() {
 				conf.OrdererType = "kafka"
@@ -487,6 +571,34 @@ var _ = Describe("Encoder", func() {
 				Expect(cg.Policies["Admins"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
 			})
 
+			Context("when the MSP classifies admins by OU", func() {
+				BeforeEach(func() {
+					conf.MSPDir = "../../../../msp/testdata/nodeouadmin"
+				})
+
+				It("encodes NodeOU policies", func() {
+					cg, err := encoder.NewOrdererOrgGroup(conf)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(cg.Policies)).To(Equal(3))
+					Expect(policyRoles(cg.Policies["Admins"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN}))
+					Expect(policyRoles(cg.Policies["Readers"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN, msp.MSPRole_ORDERER, msp.MSPRole_CLIENT}))
+					Expect(policyRoles(cg.Policies["Writers"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN, msp.MSPRole_CLIENT, msp.MSPRole_ORDERER}))
+				})
+
+				Context("when dev mode is enabled", func() {
+					BeforeEach(func() {
+						conf.AdminPrincipal = "Member"
+					})
+
+					It("encodes default policies", func() {
+						cg, err := encoder.NewOrdererOrgGroup(conf)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(policyRoles(cg.Policies["Admins"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_MEMBER}))
+						Expect(policyRoles(cg.Policies["Readers"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_MEMBER}))
+					})
+				})
+			})
+
 			Context("when dev mode is enabled", func() {
 				BeforeEach(func() {
 					conf.AdminPrincipal = "Member"
@@ -601,6 +713,21 @@ var _ = Describe("Encoder", func() {
 				Expect(cg.Policies["Writers"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
 				Expect(cg.Policies["Admins"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
 			})
+
+			Context("when the MSP classifies admins by OU", func() {
+				BeforeEach(func() {
+					conf.MSPDir = "../../../../msp/testdata/nodeouadmin"
+				})
+
+				It("encodes NodeOU policies", func() {
+					cg, err := encoder.NewApplicationOrgGroup(conf)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(cg.Policies)).To(Equal(3))
+					Expect(policyRoles(cg.Policies["Admins"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN}))
+					Expect(policyRoles(cg.Policies["Readers"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN, msp.MSPRole_PEER, msp.MSPRole_CLIENT}))
+					Expect(policyRoles(cg.Policies["Writers"])).To(Equal([]msp.MSPRole_MSPRoleType{msp.MSPRole_ADMIN, msp.MSPRole_CLIENT}))
+				})
+			})
 		})
 
 		Context("when the policy definition is bad", func() {
@@ -1257,3 +1384,17 @@ var _ = Describe("Encoder", func() {
 		})
 	})
 })
+
+func policyRoles(policy *cb.ConfigPolicy) []msp.MSPRole_MSPRoleType {
+	signaturePolicy := &cb.SignaturePolicyEnvelope{}
+	err := proto.Unmarshal(policy.Policy.Value, signaturePolicy)
+	Expect(err).NotTo(HaveOccurred())
+	var roles []msp.MSPRole_MSPRoleType
+	for _, principal := range signaturePolicy.Identities {
+		role := &msp.MSPRole{}
+		err := proto.Unmarshal(principal.Principal, role)
+		Expect(err).NotTo(HaveOccurred())
+		roles = append(roles, role.Role)
+	}
+	return roles
+}
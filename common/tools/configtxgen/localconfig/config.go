@@ -156,16 +156,49 @@ type AnchorPeer struct {
 // Orderer contains configuration which is used for the
 // bootstrapping of an orderer by the provisional bootstrapper.
 type Orderer struct {
-	OrdererType   string                   `yaml:"OrdererType"`
-	Addresses     []string                 `yaml:"Addresses"`
-	BatchTimeout  time.Duration            `yaml:"BatchTimeout"`
-	BatchSize     BatchSize                `yaml:"BatchSize"`
-	Kafka         Kafka                    `yaml:"Kafka"`
-	EtcdRaft      *etcdraft.ConfigMetadata `yaml:"EtcdRaft"`
-	Organizations []*Organization          `yaml:"Organizations"`
-	MaxChannels   uint64                   `yaml:"MaxChannels"`
-	Capabilities  map[string]bool          `yaml:"Capabilities"`
-	Policies      map[string]*Policy       `yaml:"Policies"`
+	OrdererType         string                   `yaml:"OrdererType"`
+	Addresses           []string                 `yaml:"Addresses"`
+	BatchTimeout        time.Duration            `yaml:"BatchTimeout"`
+	BatchSize           BatchSize                `yaml:"BatchSize"`
+	AdaptiveBatching    *AdaptiveBatching        `yaml:"AdaptiveBatching"`
+	Kafka               Kafka                    `yaml:"Kafka"`
+	EtcdRaft            *etcdraft.ConfigMetadata `yaml:"EtcdRaft"`
+	Organizations       []*Organization          `yaml:"Organizations"`
+	MaxChannels         uint64                   `yaml:"MaxChannels"`
+	RateLimits          *RateLimits              `yaml:"RateLimits"`
+	BatchPriorities     *BatchPriorities         `yaml:"BatchPriorities"`
+	DuplicateTxIDWindow uint32                   `yaml:"DuplicateTxIDWindow"`
+	Capabilities        map[string]bool          `yaml:"Capabilities"`
+	Policies            map[string]*Policy       `yaml:"Policies"`
+}
+
+// RateLimits contains the limits of the rate at which the orderer accepts
+// transactions broadcast on the channel.
+type RateLimits struct {
+	Channel *RateLimit `yaml:"Channel"`
+	Client  *RateLimit `yaml:"Client"`
+}
+
+// RateLimit contains the rate and burst of a token bucket.
+type RateLimit struct {
+	Rate  uint32 `yaml:"Rate"`
+	Burst uint32 `yaml:"Burst"`
+}
+
+// BatchPriorities contains the priority classes among which the
+// transactions of every batch are shared.
+type BatchPriorities struct {
+	Classes           []*PriorityClass `yaml:"Classes"`
+	MaxPendingBatches uint32           `yaml:"MaxPendingBatches"`
+}
+
+// PriorityClass contains the weight of a class of transactions, along with
+// the MSP IDs of their creators and the types of their headers it matches.
+type PriorityClass struct {
+	Name        string   `yaml:"Name"`
+	Weight      uint32   `yaml:"Weight"`
+	MSPIDs      []string `yaml:"MSPIDs"`
+	HeaderTypes []string `yaml:"HeaderTypes"`
 }
 
 // BatchSize contains configuration affecting the size of batches.
@@ -175,6 +208,13 @@ type BatchSize struct {
 	PreferredMaxBytes uint32 `yaml:"PreferredMaxBytes"`
 }
 
+// AdaptiveBatching contains the bounds within which the batch timeout and
+// the preferred max bytes of the batches are tuned to the arrival rate.
+type AdaptiveBatching struct {
+	MinBatchTimeout      time.Duration `yaml:"MinBatchTimeout"`
+	MaxPreferredMaxBytes uint32        `yaml:"MaxPreferredMaxBytes"`
+}
+
 // Kafka contains configuration for the Kafka-based orderer.
 type Kafka struct {
 	Brokers []string `yaml:"Brokers"`
@@ -7,7 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -17,7 +19,9 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/metadata"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/policysim"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/rest"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/signatures"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
 	"github.com/hyperledger/fabric/common/tools/protolator"
 	_ "github.com/hyperledger/fabric/protos/common"
@@ -57,6 +61,17 @@ var (
 	computeUpdateChannelID = computeUpdate.Flag("channel_id", "The name of the channel for this update.").Required().String()
 	computeUpdateDest      = computeUpdate.Flag("output", "A file to write the JSON document to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 
+	collectSigs        = app.Command("collect_signatures", "Merges the signatures of copies of a config update envelope and reports which mod_policies of the update they satisfy.")
+	collectSigsConfig  = collectSigs.Flag("config", "The current config message of the channel.").Required().File()
+	collectSigsUpdates = collectSigs.Flag("update", "A config update envelope, as signed with 'peer channel signconfigtx' (may be repeated).").Required().ExistingFiles()
+	collectSigsDest    = collectSigs.Flag("output", "A file to write the config update envelope carrying all the signatures to.").String()
+
+	evalPolicy             = app.Command("evaluate_policy", "Evaluates a policy of a channel against a set of identities and reports the sets of principals satisfying it.")
+	evalPolicyBlock        = evalPolicy.Flag("config_block", "A config block of the channel.").Required().File()
+	evalPolicyPath         = evalPolicy.Flag("policy", "The fully qualified path of the policy.  For example, '/Channel/Application/Writers'.").Required().String()
+	evalPolicyIdentities   = evalPolicy.Flag("identity", "An identity of the form MSPID.role, such as 'Org1MSP.admin' (may be repeated).").Strings()
+	evalPolicyCertificates = evalPolicy.Flag("certificate", "A PEM encoded certificate issued by an MSP of the channel (may be repeated).").ExistingFiles()
+
 	version = app.Command("version", "Show version information")
 )
 
@@ -91,6 +106,18 @@ func main() {
 		if err != nil {
 			app.Fatalf("Error computing update: %s", err)
 		}
+	case collectSigs.FullCommand():
+		defer (*collectSigsConfig).Close()
+		err := collectSignatures(*collectSigsConfig, *collectSigsUpdates, *collectSigsDest, os.Stdout)
+		if err != nil {
+			app.Fatalf("Error collecting signatures: %s", err)
+		}
+	case evalPolicy.FullCommand():
+		defer (*evalPolicyBlock).Close()
+		err := evaluatePolicy(*evalPolicyBlock, *evalPolicyPath, *evalPolicyIdentities, *evalPolicyCertificates, os.Stdout)
+		if err != nil {
+			app.Fatalf("Error evaluating policy: %s", err)
+		}
 	// "version" command
 	case version.FullCommand():
 		printVersion()
@@ -218,3 +245,96 @@ func computeUpdt(original, updated, output *os.File, channelID string) error {
 
 	return nil
 }
+
+func collectSignatures(config *os.File, updates []string, output string, status io.Writer) error {
+	confIn, err := ioutil.ReadAll(config)
+	if err != nil {
+		return errors.Wrapf(err, "error reading config")
+	}
+
+	conf := &cb.Config{}
+	err = proto.Unmarshal(confIn, conf)
+	if err != nil {
+		return errors.Wrapf(err, "error unmarshaling config")
+	}
+
+	var envelopes []*cb.Envelope
+	for _, update := range updates {
+		updtIn, err := ioutil.ReadFile(update)
+		if err != nil {
+			return errors.Wrapf(err, "error reading config update envelope")
+		}
+
+		env := &cb.Envelope{}
+		err = proto.Unmarshal(updtIn, env)
+		if err != nil {
+			return errors.Wrapf(err, "error unmarshaling config update envelope %s", update)
+		}
+		envelopes = append(envelopes, env)
+	}
+
+	env, sigStatus, err := signatures.Collect(conf, envelopes...)
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		outBytes, err := proto.Marshal(env)
+		if err != nil {
+			return errors.Wrapf(err, "error marshaling config update envelope")
+		}
+
+		err = ioutil.WriteFile(output, outBytes, 0600)
+		if err != nil {
+			return errors.Wrapf(err, "error writing config update envelope to output")
+		}
+	}
+
+	statusBytes, err := json.MarshalIndent(sigStatus, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "error marshaling status")
+	}
+	_, err = fmt.Fprintln(status, string(statusBytes))
+	return errors.Wrapf(err, "error writing status")
+}
+
+func evaluatePolicy(configBlock *os.File, policyPath string, identities, certificates []string, result io.Writer) error {
+	blockIn, err := ioutil.ReadAll(configBlock)
+	if err != nil {
+		return errors.Wrapf(err, "error reading config block")
+	}
+
+	block := &cb.Block{}
+	err = proto.Unmarshal(blockIn, block)
+	if err != nil {
+		return errors.Wrapf(err, "error unmarshaling config block")
+	}
+
+	var ids []*policysim.Identity
+	for _, identity := range identities {
+		id, err := policysim.ParseIdentity(identity)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	for _, certificate := range certificates {
+		cert, err := ioutil.ReadFile(certificate)
+		if err != nil {
+			return errors.Wrapf(err, "error reading certificate")
+		}
+		ids = append(ids, &policysim.Identity{Certificate: cert})
+	}
+
+	res, err := policysim.Evaluate(block, policyPath, ids...)
+	if err != nil {
+		return err
+	}
+
+	resBytes, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "error marshaling result")
+	}
+	_, err = fmt.Fprintln(result, string(resBytes))
+	return errors.Wrapf(err, "error writing result")
+}
@@ -0,0 +1,489 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policysim
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/policies/inquire"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// Identity is an identity the policy is evaluated against. It is either
+// an X.509 certificate, issued by one of the MSPs of the channel, or an
+// MSP ID along with a role, standing for any identity of that MSP with
+// that role.
+type Identity struct {
+	// Certificate is a PEM encoded certificate
+	Certificate []byte `json:"certificate,omitempty"`
+	// MSPID is the MSP of the identity, inferred from the certificate if not set
+	MSPID string `json:"msp_id,omitempty"`
+	// Role is one of member, admin, client, peer or orderer
+	Role string `json:"role,omitempty"`
+}
+
+// ParseIdentity parses an identity of the form 'MSPID.role', as used for
+// principals by the signature policy language, such as 'Org1MSP.admin'
+func ParseIdentity(s string) (*Identity, error) {
+	i := strings.LastIndex(s, ".")
+	if i <= 0 || i == len(s)-1 {
+		return nil, errors.Errorf("invalid identity '%s', expected MSPID.role", s)
+	}
+	if _, err := parseRole(s[i+1:]); err != nil {
+		return nil, err
+	}
+	return &Identity{MSPID: s[:i], Role: s[i+1:]}, nil
+}
+
+// Result reports whether the identities satisfy a policy, along with the
+// minimal sets of principals satisfying the policy
+type Result struct {
+	ChannelID  string   `json:"channel_id"`
+	Policy     string   `json:"policy"`
+	Type       string   `json:"type"`
+	Identities []string `json:"identities"`
+	Satisfied  bool     `json:"satisfied"`
+	// Reason explains why the policy is not satisfied
+	Reason string `json:"reason,omitempty"`
+	// PrincipalSets are the sets of principals satisfying the policy,
+	// none of which contains another
+	PrincipalSets [][]string `json:"principal_sets"`
+}
+
+// Evaluate evaluates the policy at the given fully qualified path, such as
+// '/Channel/Application/Writers', of the channel configured by the config
+// block against the identities. As no signatures are involved, every
+// identity is deemed to have signed whatever the policy is evaluated over.
+func Evaluate(block *cb.Block, policyPath string, identities ...*Identity) (*Result, error) {
+	channelID, config, err := configFromBlock(block)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid config block")
+	}
+
+	bundle, err := channelconfig.NewBundle(channelID, config)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid channel config")
+	}
+
+	configPolicy, path, err := lookupPolicy(config, policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	deserializer := &simulatedDeserializer{
+		IdentityDeserializer: bundle.MSPManager(),
+		identities:           map[string]msp.Identity{},
+	}
+	result := &Result{
+		ChannelID:     channelID,
+		Policy:        policyPath,
+		Type:          cb.Policy_PolicyType(configPolicy.Policy.Type).String(),
+		Identities:    []string{},
+		PrincipalSets: [][]string{},
+	}
+	var signedData []*cb.SignedData
+	for i, identity := range identities {
+		serialized, description, err := deserializer.add(i, identity, bundle.MSPManager())
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid identity at index %d", i))
+		}
+		result.Identities = append(result.Identities, description)
+		signedData = append(signedData, &cb.SignedData{Identity: serialized})
+	}
+
+	// The policies are those of the bundle, verifying signatures with the
+	// simulated identities
+	policyManager, err := policies.NewManagerImpl(channelconfig.RootGroupKey, map[int32]policies.Provider{
+		int32(cb.Policy_SIGNATURE): cauthdsl.NewPolicyProvider(deserializer),
+	}, config.ChannelGroup)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid channel config")
+	}
+	policy, _ := policyManager.GetPolicy(policyPath)
+	if err := policy.Evaluate(signedData); err != nil {
+		result.Reason = err.Error()
+	} else {
+		result.Satisfied = true
+	}
+
+	principalSets, err := principalSetsOf(config.ChannelGroup, path[:len(path)-1], path[len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	for _, principalSet := range principalSets {
+		var principals []string
+		for _, principal := range principalSet.ToPrincipalSet() {
+			principals = append(principals, principalString(principal))
+		}
+		sort.Strings(principals)
+		result.PrincipalSets = append(result.PrincipalSets, principals)
+	}
+
+	return result, nil
+}
+
+func configFromBlock(block *cb.Block) (string, *cb.Config, error) {
+	if block == nil || block.Data == nil || len(block.Data.Data) == 0 {
+		return "", nil, errors.New("block contains no transactions")
+	}
+
+	env, err := utils.ExtractEnvelope(block, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return "", nil, err
+	}
+	if payload.Header == nil {
+		return "", nil, errors.New("missing header")
+	}
+	channelHeader, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, err
+	}
+	if channelHeader.Type != int32(cb.HeaderType_CONFIG) {
+		return "", nil, errors.Errorf("transaction of type %s instead of %s", cb.HeaderType(channelHeader.Type), cb.HeaderType_CONFIG)
+	}
+
+	configEnv, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	if err != nil {
+		return "", nil, err
+	}
+	if configEnv.Config == nil || configEnv.Config.ChannelGroup == nil {
+		return "", nil, errors.New("config envelope carries no config")
+	}
+	return channelHeader.ChannelId, configEnv.Config, nil
+}
+
+// lookupPolicy returns the policy at the given fully qualified path along
+// with the path split into its elements
+func lookupPolicy(config *cb.Config, policyPath string) (*cb.ConfigPolicy, []string, error) {
+	path := strings.Split(policyPath, policies.PathSeparator)
+	if len(path) < 3 || path[0] != "" || path[1] != channelconfig.RootGroupKey {
+		return nil, nil, errors.Errorf("invalid policy path '%s', it must be of the form /%s/[group/]policy", policyPath, channelconfig.RootGroupKey)
+	}
+	path = path[1:]
+
+	group := config.ChannelGroup
+	for _, groupName := range path[1 : len(path)-1] {
+		subGroup, ok := group.Groups[groupName]
+		if !ok {
+			return nil, nil, errors.Errorf("policy %s not found: no group %s", policyPath, groupName)
+		}
+		group = subGroup
+	}
+	configPolicy, ok := group.Policies[path[len(path)-1]]
+	if !ok || configPolicy.Policy == nil {
+		return nil, nil, errors.Errorf("policy %s not found", policyPath)
+	}
+	return configPolicy, path, nil
+}
+
+// principalSetsOf returns the minimal sets of principals satisfying the
+// policy of the given name of the group at the given path
+func principalSetsOf(root *cb.ConfigGroup, path []string, policyName string) (inquire.ComparablePrincipalSets, error) {
+	group := root
+	for _, groupName := range path[1:] {
+		group = group.Groups[groupName]
+	}
+
+	configPolicy, ok := group.Policies[policyName]
+	if !ok || configPolicy.Policy == nil {
+		// A missing policy is satisfied by no principals
+		return nil, nil
+	}
+	policyPath := policies.PathSeparator + strings.Join(append(append([]string{}, path...), policyName), policies.PathSeparator)
+
+	switch cb.Policy_PolicyType(configPolicy.Policy.Type) {
+	case cb.Policy_SIGNATURE:
+		sigPolicy := &cb.SignaturePolicyEnvelope{}
+		if err := proto.Unmarshal(configPolicy.Policy.Value, sigPolicy); err != nil {
+			return nil, errors.Wrapf(err, "failed unmarshaling policy %s", policyPath)
+		}
+
+		var res inquire.ComparablePrincipalSets
+		for _, principalSet := range inquire.NewInquireableSignaturePolicy(sigPolicy).SatisfiedBy() {
+			comparable := inquire.NewComparablePrincipalSet(principalSet)
+			if comparable == nil {
+				// Only role and OU principals can be compared
+				continue
+			}
+			res = append(res, comparable)
+		}
+		return res.Reduce(), nil
+	case cb.Policy_IMPLICIT_META:
+		implicitMeta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(configPolicy.Policy.Value, implicitMeta); err != nil {
+			return nil, errors.Wrapf(err, "failed unmarshaling policy %s", policyPath)
+		}
+
+		var groupNames []string
+		for groupName := range group.Groups {
+			groupNames = append(groupNames, groupName)
+		}
+		sort.Strings(groupNames)
+		if len(groupNames) == 0 {
+			// Satisfied by anyone, as there are no sub-policies
+			return inquire.ComparablePrincipalSets{{}}, nil
+		}
+
+		subPolicySets := make([]inquire.ComparablePrincipalSets, len(groupNames))
+		for i, groupName := range groupNames {
+			sets, err := principalSetsOf(root, append(append([]string{}, path...), groupName), implicitMeta.SubPolicy)
+			if err != nil {
+				return nil, err
+			}
+			subPolicySets[i] = sets
+		}
+
+		var threshold int
+		switch implicitMeta.Rule {
+		case cb.ImplicitMetaPolicy_ANY:
+			threshold = 1
+		case cb.ImplicitMetaPolicy_ALL:
+			threshold = len(groupNames)
+		case cb.ImplicitMetaPolicy_MAJORITY:
+			threshold = len(groupNames)/2 + 1
+		}
+
+		// The policy is satisfied by the principals satisfying the
+		// sub-policies of any threshold sized combination of the groups
+		var res inquire.ComparablePrincipalSets
+		for _, combination := range combinations(len(groupNames), threshold) {
+			merged := subPolicySets[combination[0]]
+			for _, i := range combination[1:] {
+				if len(merged) == 0 {
+					break
+				}
+				merged = inquire.Merge(merged, subPolicySets[i])
+			}
+			res = append(res, merged...)
+		}
+		return res.Reduce(), nil
+	default:
+		return nil, errors.Errorf("policy %s is of unsupported type %s", policyPath, cb.Policy_PolicyType(configPolicy.Policy.Type))
+	}
+}
+
+// combinations returns all the combinations of k elements out of n
+func combinations(n, k int) [][]int {
+	if k == 0 {
+		return [][]int{{}}
+	}
+
+	var res [][]int
+	for i := k - 1; i < n; i++ {
+		for _, combination := range combinations(i, k-1) {
+			res = append(res, append(combination, i))
+		}
+	}
+	return res
+}
+
+func principalString(principal *mspproto.MSPPrincipal) string {
+	switch principal.PrincipalClassification {
+	case mspproto.MSPPrincipal_ROLE:
+		role := &mspproto.MSPRole{}
+		if err := proto.Unmarshal(principal.Principal, role); err == nil {
+			return fmt.Sprintf("%s.%s", role.MspIdentifier, strings.ToLower(role.Role.String()))
+		}
+	case mspproto.MSPPrincipal_ORGANIZATION_UNIT:
+		ou := &mspproto.OrganizationUnit{}
+		if err := proto.Unmarshal(principal.Principal, ou); err == nil {
+			return fmt.Sprintf("%s.OU=%s", ou.MspIdentifier, ou.OrganizationalUnitIdentifier)
+		}
+	}
+	return principal.PrincipalClassification.String()
+}
+
+func parseRole(role string) (mspproto.MSPRole_MSPRoleType, error) {
+	value, ok := mspproto.MSPRole_MSPRoleType_value[strings.ToUpper(role)]
+	if !ok {
+		return 0, errors.Errorf("unknown role '%s'", role)
+	}
+	return mspproto.MSPRole_MSPRoleType(value), nil
+}
+
+// simulatedDeserializer deserializes the identities the policy is
+// evaluated against, whose signatures are not verified
+type simulatedDeserializer struct {
+	msp.IdentityDeserializer
+	identities map[string]msp.Identity
+}
+
+func (sd *simulatedDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	identity, ok := sd.identities[string(serializedIdentity)]
+	if !ok {
+		return nil, errors.New("unknown identity")
+	}
+	return identity, nil
+}
+
+// add registers the identity at the given index, returning its serialized
+// form and a description of it
+func (sd *simulatedDeserializer) add(index int, identity *Identity, mspManager msp.MSPManager) ([]byte, string, error) {
+	if identity == nil {
+		return nil, "", errors.New("nil identity")
+	}
+
+	var id msp.Identity
+	var description string
+	if len(identity.Certificate) != 0 {
+		certIdentity, err := certificateIdentity(identity, mspManager)
+		if err != nil {
+			return nil, "", err
+		}
+		id = certIdentity
+		description = fmt.Sprintf("%s %s", certIdentity.GetMSPIdentifier(), certIdentity.subject)
+	} else {
+		role, err := parseRole(identity.Role)
+		if err != nil {
+			return nil, "", err
+		}
+		msps, err := mspManager.GetMSPs()
+		if err != nil {
+			return nil, "", err
+		}
+		if _, ok := msps[identity.MSPID]; !ok {
+			return nil, "", errors.Errorf("MSP %s is not defined in the channel", identity.MSPID)
+		}
+		id = &roleIdentity{
+			id:   &msp.IdentityIdentifier{Mspid: identity.MSPID, Id: fmt.Sprintf("%s#%d", role, index)},
+			role: role,
+		}
+		description = fmt.Sprintf("%s.%s", identity.MSPID, strings.ToLower(role.String()))
+	}
+
+	serialized, err := proto.Marshal(&mspproto.SerializedIdentity{
+		Mspid:   id.GetIdentifier().Mspid,
+		IdBytes: []byte(id.GetIdentifier().Id),
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed serializing identity")
+	}
+	sd.identities[string(serialized)] = id
+	return serialized, description, nil
+}
+
+// certificateIdentity returns the identity of the certificate, as
+// deserialized by the MSP of the identity or, if not set, by the first
+// MSP of the channel it is valid for
+func certificateIdentity(identity *Identity, mspManager msp.MSPManager) (*unverifiedIdentity, error) {
+	block, _ := pem.Decode(identity.Certificate)
+	if block == nil {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing certificate")
+	}
+
+	msps, err := mspManager.GetMSPs()
+	if err != nil {
+		return nil, err
+	}
+	var mspIDs []string
+	for mspID := range msps {
+		if identity.MSPID == "" || identity.MSPID == mspID {
+			mspIDs = append(mspIDs, mspID)
+		}
+	}
+	if len(mspIDs) == 0 {
+		return nil, errors.Errorf("MSP %s is not defined in the channel", identity.MSPID)
+	}
+	sort.Strings(mspIDs)
+
+	var lastErr error
+	for _, mspID := range mspIDs {
+		serialized, err := proto.Marshal(&mspproto.SerializedIdentity{Mspid: mspID, IdBytes: identity.Certificate})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed serializing identity")
+		}
+		id, err := msps[mspID].DeserializeIdentity(serialized)
+		if err == nil {
+			err = id.Validate()
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return &unverifiedIdentity{Identity: id, subject: cert.Subject.String()}, nil
+	}
+	if identity.MSPID != "" {
+		return nil, errors.WithMessage(lastErr, fmt.Sprintf("certificate is not valid for MSP %s", identity.MSPID))
+	}
+	return nil, errors.New("certificate is not valid for any MSP of the channel")
+}
+
+// unverifiedIdentity is an identity of the channel deemed to have produced
+// any signature
+type unverifiedIdentity struct {
+	msp.Identity
+	subject string
+}
+
+func (id *unverifiedIdentity) Verify(msg []byte, sig []byte) error {
+	return nil
+}
+
+// roleIdentity stands for any identity of an MSP with a given role,
+// deemed to have produced any signature
+type roleIdentity struct {
+	msp.Identity
+	id   *msp.IdentityIdentifier
+	role mspproto.MSPRole_MSPRoleType
+}
+
+func (id *roleIdentity) GetIdentifier() *msp.IdentityIdentifier {
+	return id.id
+}
+
+func (id *roleIdentity) GetMSPIdentifier() string {
+	return id.id.Mspid
+}
+
+func (id *roleIdentity) Validate() error {
+	return nil
+}
+
+func (id *roleIdentity) Verify(msg []byte, sig []byte) error {
+	return nil
+}
+
+// SatisfiesPrincipal checks whether the role satisfies a role principal,
+// the member role being satisfied by any role of the MSP
+func (id *roleIdentity) SatisfiesPrincipal(principal *mspproto.MSPPrincipal) error {
+	if principal.PrincipalClassification != mspproto.MSPPrincipal_ROLE {
+		return errors.Errorf("principals of type %s cannot be satisfied by a role", principal.PrincipalClassification)
+	}
+
+	role := &mspproto.MSPRole{}
+	if err := proto.Unmarshal(principal.Principal, role); err != nil {
+		return errors.Wrap(err, "could not unmarshal MSPRole from principal")
+	}
+	if role.MspIdentifier != id.id.Mspid {
+		return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", role.MspIdentifier, id.id.Mspid)
+	}
+	if role.Role != mspproto.MSPRole_MEMBER && role.Role != id.role {
+		return errors.Errorf("the identity is a %s, not a %s", id.role, role.Role)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policysim
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/core/config/configtest"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeConfigBlock returns the config block of a channel whose application
+// is made of SampleOrg, with 'OR(SampleOrg.member)' as admin policy, and
+// of Org2MSP, sharing the MSP of SampleOrg
+func makeConfigBlock(t *testing.T) *cb.Block {
+	profile := configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)
+	sampleOrg := profile.Application.Organizations[0]
+	profile.Application.Organizations = append(profile.Application.Organizations, &genesisconfig.Organization{
+		Name:    "Org2",
+		ID:      "Org2MSP",
+		MSPDir:  sampleOrg.MSPDir,
+		MSPType: sampleOrg.MSPType,
+		Policies: map[string]*genesisconfig.Policy{
+			"Readers": {Type: "Signature", Rule: "OR('Org2MSP.member')"},
+			"Writers": {Type: "Signature", Rule: "OR('Org2MSP.member')"},
+			"Admins":  {Type: "Signature", Rule: "OR('Org2MSP.admin')"},
+		},
+	})
+	return encoder.New(profile).GenesisBlockForChannel("foo")
+}
+
+func parseIdentities(t *testing.T, identities ...string) []*Identity {
+	var res []*Identity
+	for _, s := range identities {
+		identity, err := ParseIdentity(s)
+		require.NoError(t, err)
+		res = append(res, identity)
+	}
+	return res
+}
+
+func TestEvaluate(t *testing.T) {
+	block := makeConfigBlock(t)
+
+	result, err := Evaluate(block, "/Channel/Application/Admins", parseIdentities(t, "SampleOrg.member")...)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", result.ChannelID)
+	assert.Equal(t, "IMPLICIT_META", result.Type)
+	assert.Equal(t, []string{"SampleOrg.member"}, result.Identities)
+	assert.False(t, result.Satisfied)
+	assert.Contains(t, result.Reason, "1 sub-policies were satisfied, but this policy requires 2")
+	assert.Equal(t, [][]string{{"Org2MSP.admin", "SampleOrg.member"}}, result.PrincipalSets)
+
+	result, err = Evaluate(block, "/Channel/Application/Admins", parseIdentities(t, "SampleOrg.client", "Org2MSP.admin")...)
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+	assert.Empty(t, result.Reason)
+
+	// Any role is a member
+	result, err = Evaluate(block, "/Channel/Application/Writers", parseIdentities(t, "Org2MSP.peer")...)
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+	assert.Equal(t, [][]string{{"Org2MSP.member"}, {"SampleOrg.member"}}, result.PrincipalSets)
+
+	// No identities
+	result, err = Evaluate(block, "/Channel/Application/Org2/Admins")
+	require.NoError(t, err)
+	assert.Equal(t, "SIGNATURE", result.Type)
+	assert.Empty(t, result.Identities)
+	assert.False(t, result.Satisfied)
+	assert.Equal(t, [][]string{{"Org2MSP.admin"}}, result.PrincipalSets)
+
+	// Nested implicit meta policies are expanded down to the organizations
+	result, err = Evaluate(block, "/Channel/Admins")
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.PrincipalSets)
+	for _, principalSet := range result.PrincipalSets {
+		assert.Contains(t, principalSet, "Org2MSP.admin")
+	}
+}
+
+func TestEvaluateCertificate(t *testing.T) {
+	block := makeConfigBlock(t)
+
+	mspDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	cert, err := ioutil.ReadFile(filepath.Join(mspDir, "admincerts", "admincert.pem"))
+	require.NoError(t, err)
+
+	// The role of the certificate is established by its MSP
+	result, err := Evaluate(block, "/Channel/Application/Org2/Admins", parseIdentities(t, "Org2MSP.peer")...)
+	require.NoError(t, err)
+	assert.False(t, result.Satisfied)
+	result, err = Evaluate(block, "/Channel/Application/Org2/Admins", &Identity{Certificate: cert, MSPID: "Org2MSP"})
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+	require.Len(t, result.Identities, 1)
+	assert.Contains(t, result.Identities[0], "Org2MSP ")
+
+	// Without an MSP ID, the certificate belongs to the first MSP it is valid for
+	result, err = Evaluate(block, "/Channel/Application/SampleOrg/Admins", &Identity{Certificate: cert})
+	require.NoError(t, err)
+	assert.False(t, result.Satisfied)
+	result, err = Evaluate(block, "/Channel/Application/Org2/Admins", &Identity{Certificate: cert})
+	require.NoError(t, err)
+	assert.True(t, result.Satisfied)
+
+	_, err = Evaluate(block, "/Channel/Application/Admins", &Identity{Certificate: []byte("foo")})
+	assert.EqualError(t, err, "invalid identity at index 0: certificate is not PEM encoded")
+	_, err = Evaluate(block, "/Channel/Application/Admins", &Identity{Certificate: cert, MSPID: "foo"})
+	assert.EqualError(t, err, "invalid identity at index 0: MSP foo is not defined in the channel")
+}
+
+func TestEvaluateInvalid(t *testing.T) {
+	block := makeConfigBlock(t)
+
+	_, err := Evaluate(nil, "/Channel/Admins")
+	assert.EqualError(t, err, "invalid config block: block contains no transactions")
+
+	for _, path := range []string{"", "/Channel", "Channel/Admins", "/Foo/Admins"} {
+		_, err = Evaluate(block, path)
+		assert.EqualError(t, err, "invalid policy path '"+path+"', it must be of the form /Channel/[group/]policy")
+	}
+	_, err = Evaluate(block, "/Channel/Foo/Admins")
+	assert.EqualError(t, err, "policy /Channel/Foo/Admins not found: no group Foo")
+	_, err = Evaluate(block, "/Channel/Application/Foo")
+	assert.EqualError(t, err, "policy /Channel/Application/Foo not found")
+
+	_, err = Evaluate(block, "/Channel/Admins", &Identity{MSPID: "Org3MSP", Role: "admin"})
+	assert.EqualError(t, err, "invalid identity at index 0: MSP Org3MSP is not defined in the channel")
+	_, err = Evaluate(block, "/Channel/Admins", nil)
+	assert.EqualError(t, err, "invalid identity at index 0: nil identity")
+
+	_, err = ParseIdentity("Org1MSP")
+	assert.EqualError(t, err, "invalid identity 'Org1MSP', expected MSPID.role")
+	_, err = ParseIdentity("Org1MSP.foo")
+	assert.EqualError(t, err, "unknown role 'foo'")
+}
+
+func TestCombinations(t *testing.T) {
+	assert.Equal(t, [][]int{{}}, combinations(3, 0))
+	assert.Equal(t, [][]int{{0}, {1}, {2}}, combinations(3, 1))
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {1, 2}}, combinations(3, 2))
+	assert.Equal(t, [][]int{{0, 1, 2}}, combinations(3, 3))
+}
@@ -23,7 +23,9 @@ import (
 	"net/http"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/policysim"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/sanitycheck"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/signatures"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
 	cb "github.com/hyperledger/fabric/protos/common"
 )
@@ -122,3 +124,166 @@ func SanityCheckConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write(resBytes)
 }
+
+func fieldEnvelopeProtos(fieldName string, r *http.Request) ([]*cb.Envelope, error) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		return nil, fmt.Errorf("error parsing form: %s", err)
+	}
+
+	var envelopes []*cb.Envelope
+	for _, fileHeader := range r.MultipartForm.File[fieldName] {
+		fieldFile, err := fileHeader.Open()
+		if err != nil {
+			return nil, fmt.Errorf("error opening field file: %s", err)
+		}
+		fieldBytes, err := ioutil.ReadAll(fieldFile)
+		fieldFile.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading field bytes: %s", err)
+		}
+
+		envelope := &cb.Envelope{}
+		err = proto.Unmarshal(fieldBytes, envelope)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling field bytes: %s", err)
+		}
+		envelopes = append(envelopes, envelope)
+	}
+
+	if len(envelopes) == 0 {
+		return nil, fmt.Errorf("no file supplied")
+	}
+	return envelopes, nil
+}
+
+func collectSignatures(w http.ResponseWriter, r *http.Request) (*cb.Envelope, *signatures.Status, bool) {
+	config, err := fieldConfigProto("config", r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'config': %s\n", err)
+		return nil, nil, false
+	}
+
+	envelopes, err := fieldEnvelopeProtos("update", r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'update': %s\n", err)
+		return nil, nil, false
+	}
+
+	envelope, status, err := signatures.Collect(config, envelopes...)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error collecting signatures: %s\n", err)
+		return nil, nil, false
+	}
+
+	return envelope, status, true
+}
+
+// SignatureStatus reports which of the mod_policies of a config update are
+// satisfied by the signatures of the supplied copies of the update
+func SignatureStatus(w http.ResponseWriter, r *http.Request) {
+	_, status, ok := collectSignatures(w, r)
+	if !ok {
+		return
+	}
+
+	resBytes, err := json.Marshal(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error marshaling result to JSON: %s\n", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(resBytes)
+}
+
+// CollectSignatures merges the signatures of the supplied copies of a
+// config update into a single config update envelope
+func CollectSignatures(w http.ResponseWriter, r *http.Request) {
+	envelope, _, ok := collectSignatures(w, r)
+	if !ok {
+		return
+	}
+
+	encoded, err := proto.Marshal(envelope)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error marshaling envelope: %s\n", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	w.Write(encoded)
+}
+
+// EvaluatePolicy evaluates a policy of the channel configured by the
+// supplied config block against the supplied identities and certificates
+func EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error parsing form: %s\n", err)
+		return
+	}
+
+	blockBytes, err := fieldBytes("config_block", r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'config_block': error reading field bytes: %s\n", err)
+		return
+	}
+	block := &cb.Block{}
+	err = proto.Unmarshal(blockBytes, block)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'config_block': error unmarshaling field bytes: %s\n", err)
+		return
+	}
+
+	var identities []*policysim.Identity
+	for _, value := range r.MultipartForm.Value["identity"] {
+		identity, err := policysim.ParseIdentity(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error with field 'identity': %s\n", err)
+			return
+		}
+		identities = append(identities, identity)
+	}
+	for _, fileHeader := range r.MultipartForm.File["certificate"] {
+		certFile, err := fileHeader.Open()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error with field 'certificate': error opening field file: %s\n", err)
+			return
+		}
+		cert, err := ioutil.ReadAll(certFile)
+		certFile.Close()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error with field 'certificate': error reading field bytes: %s\n", err)
+			return
+		}
+		identities = append(identities, &policysim.Identity{Certificate: cert})
+	}
+
+	result, err := policysim.Evaluate(block, r.FormValue("policy"), identities...)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error evaluating policy: %s\n", err)
+		return
+	}
+
+	resBytes, err := json.Marshal(result)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error marshaling result to JSON: %s\n", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(resBytes)
+}
@@ -24,10 +24,19 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/policysim"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/sanitycheck"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/signatures"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProtolatorComputeConfigUpdate(t *testing.T) {
@@ -181,3 +190,143 @@ func TestConfigtxlatorSanityCheckMalformedConfig(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
+
+func signatureCollectionRequest(t *testing.T, path string, config []byte, updates ...[]byte) *httptest.ResponseRecorder {
+	buffer := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buffer)
+
+	if config != nil {
+		ffw, err := mpw.CreateFormFile("config", "config")
+		require.NoError(t, err)
+		_, err = bytes.NewReader(config).WriteTo(ffw)
+		require.NoError(t, err)
+	}
+
+	for _, update := range updates {
+		ffw, err := mpw.CreateFormFile("update", "update")
+		require.NoError(t, err)
+		_, err = bytes.NewReader(update).WriteTo(ffw)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, mpw.Close())
+
+	req, err := http.NewRequest("POST", path, buffer)
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", mpw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	r := NewRouter()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConfigtxlatorSignatureStatus(t *testing.T) {
+	channelGroup, err := encoder.NewChannelGroup(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile))
+	require.NoError(t, err)
+	config := &cb.Config{ChannelGroup: channelGroup}
+
+	updated := proto.Clone(config).(*cb.Config)
+	updated.ChannelGroup.Groups["Orderer"].Values["BatchTimeout"].Value = utils.MarshalOrPanic(&ab.BatchTimeout{Timeout: "1s"})
+	configUpdate, err := update.Compute(config, updated)
+	require.NoError(t, err)
+	configUpdate.ChannelId = "foo"
+	env, err := utils.CreateSignedEnvelope(cb.HeaderType_CONFIG_UPDATE, "foo", nil, &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(configUpdate),
+	}, 0, 0)
+	require.NoError(t, err)
+
+	rec := signatureCollectionRequest(t, "/configtxlator/config/signature-status", utils.MarshalOrPanic(config), utils.MarshalOrPanic(env))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	status := &signatures.Status{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), status))
+	assert.False(t, status.Satisfied)
+	assert.Equal(t, []string{"/Channel/Orderer/Admins"}, status.Unsatisfied)
+
+	rec = signatureCollectionRequest(t, "/configtxlator/config/collect-signatures", utils.MarshalOrPanic(config), utils.MarshalOrPanic(env), utils.MarshalOrPanic(env))
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	collected := &cb.Envelope{}
+	require.NoError(t, proto.Unmarshal(rec.Body.Bytes(), collected))
+	assert.True(t, proto.Equal(env, collected))
+}
+
+func TestConfigtxlatorSignatureStatusBadRequest(t *testing.T) {
+	rec := signatureCollectionRequest(t, "/configtxlator/config/signature-status", nil, []byte("foo"))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'config'")
+
+	rec = signatureCollectionRequest(t, "/configtxlator/config/signature-status", utils.MarshalOrPanic(&cb.Config{}))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'update': no file supplied")
+
+	rec = signatureCollectionRequest(t, "/configtxlator/config/collect-signatures", utils.MarshalOrPanic(&cb.Config{}), []byte("Garbage"))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'update'")
+
+	rec = signatureCollectionRequest(t, "/configtxlator/config/collect-signatures", utils.MarshalOrPanic(&cb.Config{}), utils.MarshalOrPanic(&cb.Envelope{}))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error collecting signatures")
+}
+
+func evaluatePolicyRequest(t *testing.T, block []byte, policy string, identities ...string) *httptest.ResponseRecorder {
+	buffer := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buffer)
+
+	if block != nil {
+		ffw, err := mpw.CreateFormFile("config_block", "config_block")
+		require.NoError(t, err)
+		_, err = bytes.NewReader(block).WriteTo(ffw)
+		require.NoError(t, err)
+	}
+	require.NoError(t, mpw.WriteField("policy", policy))
+	for _, identity := range identities {
+		require.NoError(t, mpw.WriteField("identity", identity))
+	}
+
+	require.NoError(t, mpw.Close())
+
+	req, err := http.NewRequest("POST", "/configtxlator/config/evaluate-policy", buffer)
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", mpw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	r := NewRouter()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConfigtxlatorEvaluatePolicy(t *testing.T) {
+	block := encoder.New(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile)).GenesisBlockForChannel("foo")
+
+	rec := evaluatePolicyRequest(t, utils.MarshalOrPanic(block), "/Channel/Orderer/Admins", "SampleOrg.member")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	result := &policysim.Result{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.False(t, result.Satisfied)
+	assert.Equal(t, [][]string{{"SampleOrg.admin"}}, result.PrincipalSets)
+
+	rec = evaluatePolicyRequest(t, utils.MarshalOrPanic(block), "/Channel/Orderer/Admins", "SampleOrg.member", "SampleOrg.admin")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), result))
+	assert.True(t, result.Satisfied)
+}
+
+func TestConfigtxlatorEvaluatePolicyBadRequest(t *testing.T) {
+	block := encoder.New(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile)).GenesisBlockForChannel("foo")
+
+	rec := evaluatePolicyRequest(t, nil, "/Channel/Admins")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'config_block'")
+
+	rec = evaluatePolicyRequest(t, []byte("Garbage"), "/Channel/Admins")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'config_block'")
+
+	rec = evaluatePolicyRequest(t, utils.MarshalOrPanic(block), "/Channel/Admins", "SampleOrg")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error with field 'identity'")
+
+	rec = evaluatePolicyRequest(t, utils.MarshalOrPanic(block), "/Channel/Foo")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Error evaluating policy: policy /Channel/Foo not found")
+}
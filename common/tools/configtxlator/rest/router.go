@@ -35,6 +35,15 @@ func NewRouter() *mux.Router {
 	router.
 		HandleFunc("/configtxlator/config/verify", SanityCheckConfig).
 		Methods("POST")
+	router.
+		HandleFunc("/configtxlator/config/signature-status", SignatureStatus).
+		Methods("POST")
+	router.
+		HandleFunc("/configtxlator/config/collect-signatures", CollectSignatures).
+		Methods("POST")
+	router.
+		HandleFunc("/configtxlator/config/evaluate-policy", EvaluatePolicy).
+		Methods("POST")
 
 	return router
 }
@@ -0,0 +1,130 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package signatures
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// Status reports the signatures collected over a config update and
+// the mod_policies of the update they satisfy
+type Status struct {
+	ChannelID   string                   `json:"channel_id"`
+	Signatures  int                      `json:"signatures"`
+	Satisfied   bool                     `json:"satisfied"`
+	Unsatisfied []string                 `json:"unsatisfied"`
+	Policies    []*configtx.PolicyStatus `json:"policies"`
+}
+
+// Collect merges the signatures of config update envelopes carrying the same
+// config update, such as the copies signed with 'peer channel signconfigtx'
+// by different administrators, verifying them against the current config of
+// the channel. It returns an envelope carrying the config update along with
+// all the signatures, and the status of the mod_policies of the update.
+func Collect(config *cb.Config, envelopes ...*cb.Envelope) (*cb.Envelope, *Status, error) {
+	if config == nil {
+		return nil, nil, errors.New("channel config must not be nil")
+	}
+	if len(envelopes) == 0 {
+		return nil, nil, errors.New("no config update envelope")
+	}
+
+	var payload *cb.Payload
+	var configUpdateEnvs []*cb.ConfigUpdateEnvelope
+	for i, env := range envelopes {
+		p, configUpdateEnv, err := unmarshalConfigUpdateEnvelope(env)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, fmt.Sprintf("invalid envelope at index %d", i))
+		}
+		if payload == nil {
+			payload = p
+		}
+		configUpdateEnvs = append(configUpdateEnvs, configUpdateEnv)
+	}
+
+	configUpdate, err := configtx.UnmarshalConfigUpdate(configUpdateEnvs[0].ConfigUpdate)
+	if err != nil {
+		return nil, nil, err
+	}
+	channelID := configUpdate.ChannelId
+
+	bundle, err := channelconfig.NewBundle(channelID, config)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "invalid channel config")
+	}
+	validator, err := configtx.NewValidatorImpl(channelID, config, channelconfig.RootGroupKey, bundle.PolicyManager())
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "invalid channel config")
+	}
+
+	collection, err := validator.NewSignatureCollection(configUpdateEnvs[0], bundle.MSPManager())
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "invalid envelope at index 0")
+	}
+	for i, configUpdateEnv := range configUpdateEnvs[1:] {
+		if err := collection.Merge(configUpdateEnv); err != nil {
+			return nil, nil, errors.WithMessage(err, fmt.Sprintf("invalid envelope at index %d", i+1))
+		}
+	}
+
+	collected := collection.Envelope()
+	payload.Data, err = proto.Marshal(collected)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed marshaling config update envelope")
+	}
+	rawPayload, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed marshaling payload")
+	}
+
+	status := &Status{
+		ChannelID:   channelID,
+		Signatures:  len(collected.Signatures),
+		Policies:    collection.Status(),
+		Unsatisfied: []string{},
+	}
+	for _, policyStatus := range status.Policies {
+		if !policyStatus.Satisfied {
+			status.Unsatisfied = append(status.Unsatisfied, policyStatus.Path)
+		}
+	}
+	status.Satisfied = len(status.Unsatisfied) == 0
+
+	// The envelope is signed again when the update is submitted
+	return &cb.Envelope{Payload: rawPayload}, status, nil
+}
+
+func unmarshalConfigUpdateEnvelope(env *cb.Envelope) (*cb.Payload, *cb.ConfigUpdateEnvelope, error) {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if payload.Header == nil {
+		return nil, nil, errors.New("missing header")
+	}
+
+	channelHeader, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+	if channelHeader.Type != int32(cb.HeaderType_CONFIG_UPDATE) {
+		return nil, nil, errors.Errorf("envelope of type %s instead of %s", cb.HeaderType(channelHeader.Type), cb.HeaderType_CONFIG_UPDATE)
+	}
+
+	configUpdateEnv, err := configtx.UnmarshalConfigUpdateEnvelope(payload.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return payload, configUpdateEnv, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package signatures
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/msp/mgmt/testtools"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeConfigUpdate returns a channel config and an unsigned
+// config update envelope changing its batch size
+func makeConfigUpdate(t *testing.T) (*cb.Config, *cb.Envelope) {
+	channelGroup, err := encoder.NewChannelGroup(configtxgentest.Load(genesisconfig.SampleSingleMSPSoloProfile))
+	require.NoError(t, err)
+	config := &cb.Config{ChannelGroup: channelGroup}
+
+	updated := proto.Clone(config).(*cb.Config)
+	updated.ChannelGroup.Groups["Orderer"].Values["BatchSize"].Value = utils.MarshalOrPanic(&ab.BatchSize{
+		MaxMessageCount:   5,
+		AbsoluteMaxBytes:  10 * 1024 * 1024,
+		PreferredMaxBytes: 512 * 1024,
+	})
+	configUpdate, err := update.Compute(config, updated)
+	require.NoError(t, err)
+	configUpdate.ChannelId = "foo"
+
+	env, err := utils.CreateSignedEnvelope(cb.HeaderType_CONFIG_UPDATE, "foo", nil, &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(configUpdate),
+	}, 0, 0)
+	require.NoError(t, err)
+	return config, env
+}
+
+// signConfigUpdate signs the config update envelope as 'peer channel signconfigtx' does
+func signConfigUpdate(t *testing.T, env *cb.Envelope) *cb.Envelope {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	configUpdateEnv, err := configtx.UnmarshalConfigUpdateEnvelope(payload.Data)
+	require.NoError(t, err)
+
+	signer := localmsp.NewSigner()
+	sigHeader, err := signer.NewSignatureHeader()
+	require.NoError(t, err)
+	configSig := &cb.ConfigSignature{SignatureHeader: utils.MarshalOrPanic(sigHeader)}
+	configSig.Signature, err = signer.Sign(util.ConcatenateBytes(configSig.SignatureHeader, configUpdateEnv.ConfigUpdate))
+	require.NoError(t, err)
+	configUpdateEnv.Signatures = append(configUpdateEnv.Signatures, configSig)
+
+	payload.Data = utils.MarshalOrPanic(configUpdateEnv)
+	return &cb.Envelope{Payload: utils.MarshalOrPanic(payload)}
+}
+
+func TestCollect(t *testing.T) {
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+
+	config, unsigned := makeConfigUpdate(t)
+
+	_, status, err := Collect(config, unsigned)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", status.ChannelID)
+	assert.Equal(t, 0, status.Signatures)
+	assert.False(t, status.Satisfied)
+	assert.Equal(t, []string{"/Channel/Orderer/Admins"}, status.Unsatisfied)
+	require.Len(t, status.Policies, 1)
+	assert.Equal(t, []string{"[Value]  /Channel/Orderer/BatchSize"}, status.Policies[0].Elements)
+	assert.NotEmpty(t, status.Policies[0].Reason)
+
+	// The signatures of the copies are merged, once per identity
+	signed := signConfigUpdate(t, unsigned)
+	env, status, err := Collect(config, unsigned, signed, signed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Signatures)
+	assert.True(t, status.Satisfied)
+	assert.Empty(t, status.Unsatisfied)
+
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	configUpdateEnv, err := configtx.UnmarshalConfigUpdateEnvelope(payload.Data)
+	require.NoError(t, err)
+	assert.Len(t, configUpdateEnv.Signatures, 1)
+	channelHeader, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	require.NoError(t, err)
+	assert.Equal(t, int32(cb.HeaderType_CONFIG_UPDATE), channelHeader.Type)
+}
+
+func TestCollectInvalid(t *testing.T) {
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+
+	config, unsigned := makeConfigUpdate(t)
+
+	_, _, err := Collect(nil, unsigned)
+	assert.EqualError(t, err, "channel config must not be nil")
+
+	_, _, err = Collect(config)
+	assert.EqualError(t, err, "no config update envelope")
+
+	tx, err := utils.CreateSignedEnvelope(cb.HeaderType_ENDORSER_TRANSACTION, "foo", nil, &cb.ConfigUpdateEnvelope{}, 0, 0)
+	require.NoError(t, err)
+	_, _, err = Collect(config, unsigned, tx)
+	assert.EqualError(t, err, "invalid envelope at index 1: envelope of type ENDORSER_TRANSACTION instead of CONFIG_UPDATE")
+
+	// Copies must carry the same config update
+	other, err := utils.CreateSignedEnvelope(cb.HeaderType_CONFIG_UPDATE, "foo", nil, &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: []byte("foo"),
+	}, 0, 0)
+	require.NoError(t, err)
+	_, _, err = Collect(config, unsigned, other)
+	assert.EqualError(t, err, "invalid envelope at index 1: signatures are over a different config update")
+
+	// Signatures must be valid
+	signed := signConfigUpdate(t, unsigned)
+	payload, err := utils.UnmarshalPayload(signed.Payload)
+	require.NoError(t, err)
+	configUpdateEnv, err := configtx.UnmarshalConfigUpdateEnvelope(payload.Data)
+	require.NoError(t, err)
+	configUpdateEnv.Signatures[0].Signature = []byte("foo")
+	payload.Data = utils.MarshalOrPanic(configUpdateEnv)
+	signed.Payload = utils.MarshalOrPanic(payload)
+	_, _, err = Collect(config, signed)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid envelope at index 0: invalid signature at index 0: signature does not verify")
+}
@@ -7,6 +7,7 @@ package ca_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"net"
 	"os"
@@ -49,7 +50,7 @@ func TestLoadCertificateECDSA(t *testing.T) {
 	assert.NotNil(t, ecPubKey, "Failed to generate signed certificate")
 
 	// create our CA
-	rootCA, err := ca.NewCA(caDir, testCA3Name, testCA3Name, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	rootCA, err := ca.NewCA(caDir, testCA3Name, testCA3Name, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 
 	cert, err := rootCA.SignCertificate(certDir, testName3, nil, nil, ecPubKey,
@@ -71,7 +72,7 @@ func TestLoadCertificateECDSA(t *testing.T) {
 func TestNewCA(t *testing.T) {
 
 	caDir := filepath.Join(testDir, "ca")
-	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 	assert.NotNil(t, rootCA, "Failed to return CA")
 	assert.NotNil(t, rootCA.Signer,
@@ -101,6 +102,29 @@ func TestNewCA(t *testing.T) {
 
 }
 
+func TestNewCAED25519(t *testing.T) {
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	defer cleanup(testDir)
+
+	rootCA, err := ca.NewCA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ED25519)
+	assert.NoError(t, err, "Error generating CA")
+	assert.Equal(t, csp.ED25519, rootCA.KeyAlgorithm)
+	assert.Equal(t, x509.Ed25519, rootCA.SignCert.PublicKeyAlgorithm)
+	assert.Equal(t, x509.PureEd25519, rootCA.SignCert.SignatureAlgorithm)
+
+	priv, _, err := csp.GeneratePrivateKeyWithAlgorithm(certDir, csp.ED25519)
+	assert.NoError(t, err, "Failed to generate private key")
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key")
+
+	cert, err := rootCA.SignCertificate(certDir, testName, nil, nil, pubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+	assert.NoError(t, err, "Failed to generate signed certificate")
+	assert.IsType(t, ed25519.PublicKey{}, cert.PublicKey)
+	assert.NoError(t, cert.CheckSignatureFrom(rootCA.SignCert))
+}
+
 func TestGenerateSignCertificate(t *testing.T) {
 
 	caDir := filepath.Join(testDir, "ca")
@@ -115,7 +139,7 @@ func TestGenerateSignCertificate(t *testing.T) {
 	assert.NotNil(t, ecPubKey, "Failed to generate signed certificate")
 
 	// create our CA
-	rootCA, err := ca.NewCA(caDir, testCA2Name, testCA2Name, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	rootCA, err := ca.NewCA(caDir, testCA2Name, testCA2Name, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 
 	cert, err := rootCA.SignCertificate(certDir, testName, nil, nil, ecPubKey,
@@ -167,6 +191,75 @@ func TestGenerateSignCertificate(t *testing.T) {
 
 }
 
+func TestCrossSign(t *testing.T) {
+	defer cleanup(testDir)
+
+	oldCA, err := ca.NewCA(filepath.Join(testDir, "ca"), testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	newCA, err := ca.NewCA(filepath.Join(testDir, "newca"), testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+
+	crossDir := filepath.Join(testDir, "cross")
+	assert.NoError(t, os.MkdirAll(crossDir, 0755))
+	crossCert, err := oldCA.CrossSign(crossDir, newCA)
+	assert.NoError(t, err, "Failed to cross-sign CA")
+	assert.True(t, checkForFile(filepath.Join(crossDir, testCAName+"-cert.pem")))
+	assert.True(t, crossCert.IsCA)
+	assert.Equal(t, newCA.SignCert.SubjectKeyId, crossCert.SubjectKeyId)
+	assert.Equal(t, newCA.SignCert.Subject.String(), crossCert.Subject.String())
+	assert.NoError(t, crossCert.CheckSignatureFrom(oldCA.SignCert))
+
+	// identities issued by the new CA chain up to the previous one
+	priv, _, err := csp.GeneratePrivateKey(filepath.Join(testDir, "certs"))
+	assert.NoError(t, err, "Failed to generate private key")
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key")
+	cert, err := newCA.SignCertificate(filepath.Join(testDir, "certs"), testName, nil, nil, pubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+	assert.NoError(t, err, "Failed to generate signed certificate")
+
+	roots := x509.NewCertPool()
+	roots.AddCert(oldCA.SignCert)
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(crossCert)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+	assert.NoError(t, err)
+}
+
+func TestReissueCertificate(t *testing.T) {
+	defer cleanup(testDir)
+
+	rootCA, err := ca.NewCA(filepath.Join(testDir, "ca"), testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+
+	certDir := filepath.Join(testDir, "certs")
+	priv, _, err := csp.GeneratePrivateKey(certDir)
+	assert.NoError(t, err, "Failed to generate private key")
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key")
+	cert, err := rootCA.SignCertificate(certDir, testName, []string{"peer"}, []string{testName, testIP}, pubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+	assert.NoError(t, err, "Failed to generate signed certificate")
+
+	path := filepath.Join(certDir, testName+"-cert.pem")
+	newCert, err := rootCA.ReissueCertificate(path, cert)
+	assert.NoError(t, err, "Failed to re-issue certificate")
+	assert.NotEqual(t, cert.SerialNumber, newCert.SerialNumber)
+	assert.Equal(t, cert.PublicKey, newCert.PublicKey)
+	assert.Equal(t, cert.Subject.String(), newCert.Subject.String())
+	assert.Equal(t, cert.KeyUsage, newCert.KeyUsage)
+	assert.Equal(t, cert.ExtKeyUsage, newCert.ExtKeyUsage)
+	assert.Equal(t, cert.DNSNames, newCert.DNSNames)
+	assert.True(t, newCert.IPAddresses[0].Equal(net.ParseIP(testIP)))
+
+	loaded, err := ca.LoadCertificate(path)
+	assert.NoError(t, err)
+	assert.Equal(t, newCert.Raw, loaded.Raw)
+
+	_, err = ca.LoadCertificate(filepath.Join(certDir, "missing.pem"))
+	assert.Error(t, err)
+}
+
 func cleanup(dir string) {
 	os.RemoveAll(dir)
 }
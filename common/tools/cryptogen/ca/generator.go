@@ -7,7 +7,6 @@ package ca
 
 import (
 	"crypto"
-	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -22,6 +21,7 @@ import (
 
 	"github.com/hyperledger/fabric/bccsp/utils"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
+	"github.com/pkg/errors"
 )
 
 type CA struct {
@@ -32,25 +32,31 @@ type CA struct {
 	OrganizationalUnit string
 	StreetAddress      string
 	PostalCode         string
+	// KeyAlgorithm is the algorithm of the CA key (csp.ECDSA or csp.ED25519)
+	// and of the keys generated for the identities it issues
+	KeyAlgorithm string
 	//SignKey  *ecdsa.PrivateKey
 	Signer   crypto.Signer
 	SignCert *x509.Certificate
 }
 
 // NewCA creates an instance of CA and saves the signing key pair in
-// baseDir/name
-func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode string) (*CA, error) {
+// baseDir/name. The key is generated using keyAlg (csp.ECDSA if empty)
+func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode, keyAlg string) (*CA, error) {
 
 	var response error
 	var ca *CA
 
 	err := os.MkdirAll(baseDir, 0755)
 	if err == nil {
-		priv, signer, err := csp.GeneratePrivateKey(baseDir)
+		if keyAlg == "" {
+			keyAlg = csp.ECDSA
+		}
+		priv, signer, err := csp.GeneratePrivateKeyWithAlgorithm(baseDir, keyAlg)
 		response = err
 		if err == nil {
 			// get public signing certificate
-			ecPubKey, err := csp.GetECPublicKey(priv)
+			pubKey, err := csp.GetPublicKey(priv)
 			response = err
 			if err == nil {
 				template := x509Template()
@@ -72,8 +78,8 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 				template.Subject = subject
 				template.SubjectKeyId = priv.SKI()
 
-				x509Cert, err := genCertificateECDSA(baseDir, name, &template, &template,
-					ecPubKey, signer)
+				x509Cert, err := genCertificate(baseDir, name, &template, &template,
+					pubKey, signer)
 				response = err
 				if err == nil {
 					ca = &CA{
@@ -86,6 +92,7 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 						OrganizationalUnit: orgUnit,
 						StreetAddress:      streetAddress,
 						PostalCode:         postalCode,
+						KeyAlgorithm:       keyAlg,
 					}
 				}
 			}
@@ -96,7 +103,7 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 
 // SignCertificate creates a signed certificate based on a built-in template
 // and saves it in baseDir/name
-func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecdsa.PublicKey,
+func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub crypto.PublicKey,
 	ku x509.KeyUsage, eku []x509.ExtKeyUsage) (*x509.Certificate, error) {
 
 	template := x509Template()
@@ -120,7 +127,7 @@ func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecd
 		}
 	}
 
-	cert, err := genCertificateECDSA(baseDir, name, &template, ca.SignCert,
+	cert, err := genCertificate(baseDir, name, &template, ca.SignCert,
 		pub, ca.Signer)
 
 	if err != nil {
@@ -130,6 +137,37 @@ func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecd
 	return cert, nil
 }
 
+// CrossSign issues a certificate for the key and subject of other, signed by
+// this CA, and saves it in baseDir/other.Name. The resulting intermediate
+// certificate lets identities issued by other chain up to this CA
+func (ca *CA) CrossSign(baseDir string, other *CA) (*x509.Certificate, error) {
+	template := x509Template()
+	template.IsCA = true
+	template.KeyUsage = other.SignCert.KeyUsage
+	template.ExtKeyUsage = other.SignCert.ExtKeyUsage
+	template.Subject = other.SignCert.Subject
+	template.Subject.Names = nil
+	template.SubjectKeyId = other.SignCert.SubjectKeyId
+
+	return genCertificate(baseDir, other.Name, &template, ca.SignCert,
+		other.SignCert.PublicKey, ca.Signer)
+}
+
+// ReissueCertificate creates a certificate with the subject, public key,
+// key usages and subject alternative names of cert, signed by this CA,
+// and saves it at path
+func (ca *CA) ReissueCertificate(path string, cert *x509.Certificate) (*x509.Certificate, error) {
+	template := x509Template()
+	template.KeyUsage = cert.KeyUsage
+	template.ExtKeyUsage = cert.ExtKeyUsage
+	template.Subject = cert.Subject
+	template.Subject.Names = nil
+	template.DNSNames = cert.DNSNames
+	template.IPAddresses = cert.IPAddresses
+
+	return createCertificate(path, &template, ca.SignCert, cert.PublicKey, ca.Signer)
+}
+
 // default template for X509 subject
 func subjectTemplate() pkix.Name {
 	return pkix.Name{
@@ -187,8 +225,15 @@ func x509Template() x509.Certificate {
 
 }
 
-// generate a signed X509 certificate using ECDSA
-func genCertificateECDSA(baseDir, name string, template, parent *x509.Certificate, pub *ecdsa.PublicKey,
+// generate a signed X509 certificate for an ECDSA or Ed25519 public key
+func genCertificate(baseDir, name string, template, parent *x509.Certificate, pub crypto.PublicKey,
+	priv interface{}) (*x509.Certificate, error) {
+
+	return createCertificate(filepath.Join(baseDir, name+"-cert.pem"), template, parent, pub, priv)
+}
+
+// create a signed X509 certificate and save it at fileName
+func createCertificate(fileName string, template, parent *x509.Certificate, pub crypto.PublicKey,
 	priv interface{}) (*x509.Certificate, error) {
 
 	//create the x509 public cert
@@ -198,7 +243,6 @@ func genCertificateECDSA(baseDir, name string, template, parent *x509.Certificat
 	}
 
 	//write cert out to file
-	fileName := filepath.Join(baseDir, name+"-cert.pem")
 	certFile, err := os.Create(fileName)
 	if err != nil {
 		return nil, err
@@ -217,6 +261,19 @@ func genCertificateECDSA(baseDir, name string, template, parent *x509.Certificat
 	return x509Cert, nil
 }
 
+// LoadCertificate loads a PEM encoded certificate from the file at path
+func LoadCertificate(path string) (*x509.Certificate, error) {
+	rawCert, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(rawCert)
+	if block == nil {
+		return nil, errors.Errorf("%s: wrong PEM encoding", path)
+	}
+	return utils.DERToX509Certificate(block.Bytes)
+}
+
 // LoadCertificateECDSA load a ecdsa cert from a file in cert path
 func LoadCertificateECDSA(certPath string) (*x509.Certificate, error) {
 	var cert *x509.Certificate
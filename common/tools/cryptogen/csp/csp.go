@@ -8,6 +8,7 @@ package csp
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
@@ -21,6 +22,13 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// ECDSA selects ECDSA keys on the P-256 curve
+	ECDSA = "ECDSA"
+	// ED25519 selects Ed25519 keys
+	ED25519 = "ED25519"
+)
+
 // LoadPrivateKey loads a private key from file in keystorePath
 func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 	var err error
@@ -55,7 +63,7 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 			if block == nil {
 				return errors.Errorf("%s: wrong PEM encoding", path)
 			}
-			priv, err = csp.KeyImport(block.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+			priv, err = csp.KeyImport(block.Bytes, keyImportOpts(block.Bytes))
 			if err != nil {
 				return err
 			}
@@ -78,9 +86,27 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 	return priv, s, err
 }
 
-// GeneratePrivateKey creates a private key and stores it in keystorePath
+// keyImportOpts returns the import options matching the type of
+// the PKCS#8 encoded private key in der
+func keyImportOpts(der []byte) bccsp.KeyImportOpts {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		if _, ok := key.(ed25519.PrivateKey); ok {
+			return &bccsp.ED25519PrivateKeyImportOpts{Temporary: true}
+		}
+	}
+	return &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true}
+}
+
+// GeneratePrivateKey creates an ECDSA private key and stores it in keystorePath
 func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	crypto.Signer, error) {
+	return GeneratePrivateKeyWithAlgorithm(keystorePath, ECDSA)
+}
+
+// GeneratePrivateKeyWithAlgorithm creates a private key of the given
+// algorithm (ECDSA or ED25519) and stores it in keystorePath
+func GeneratePrivateKeyWithAlgorithm(keystorePath, keyAlg string) (bccsp.Key,
+	crypto.Signer, error) {
 
 	var err error
 	var priv bccsp.Key
@@ -100,7 +126,7 @@ func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	csp, err := factory.GetBCCSPFromOpts(opts)
 	if err == nil {
 		// generate a key
-		priv, err = csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+		priv, err = generateKey(csp, keyAlg)
 		if err == nil {
 			// create a crypto.Signer
 			s, err = signer.New(csp, priv)
@@ -109,7 +135,33 @@ func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	return priv, s, err
 }
 
+func generateKey(csp bccsp.BCCSP, keyAlg string) (bccsp.Key, error) {
+	switch keyAlg {
+	case "", ECDSA:
+		return csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+	case ED25519:
+		return csp.KeyGen(&bccsp.ED25519KeyGenOpts{Temporary: false})
+	default:
+		return nil, errors.Errorf("unsupported key algorithm: %s", keyAlg)
+	}
+}
+
+// GetECPublicKey returns the ECDSA public key of priv
 func GetECPublicKey(priv bccsp.Key) (*ecdsa.PublicKey, error) {
+	pubKey, err := GetPublicKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	ecPubKey, ok := pubKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an ECDSA public key")
+	}
+	return ecPubKey, nil
+}
+
+// GetPublicKey returns the public key of priv as a standard library
+// public key (*ecdsa.PublicKey or ed25519.PublicKey)
+func GetPublicKey(priv bccsp.Key) (crypto.PublicKey, error) {
 
 	// get the public key
 	pubKey, err := priv.PublicKey()
@@ -122,9 +174,5 @@ func GetECPublicKey(priv bccsp.Key) (*ecdsa.PublicKey, error) {
 		return nil, err
 	}
 	// unmarshal using pkix
-	ecPubKey, err := x509.ParsePKIXPublicKey(pubKeyBytes)
-	if err != nil {
-		return nil, err
-	}
-	return ecPubKey.(*ecdsa.PublicKey), nil
+	return x509.ParsePKIXPublicKey(pubKeyBytes)
 }
@@ -7,6 +7,7 @@ package csp_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"encoding/hex"
 	"errors"
 	"os"
@@ -94,6 +95,28 @@ func TestGeneratePrivateKey(t *testing.T) {
 
 }
 
+func TestGeneratePrivateKeyWithAlgorithm(t *testing.T) {
+	defer cleanup(testDir)
+
+	priv, signer, err := csp.GeneratePrivateKeyWithAlgorithm(testDir, csp.ED25519)
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.Equal(t, true, priv.Private(), "Failed to return private key")
+	assert.IsType(t, ed25519.PublicKey{}, signer.Public())
+
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key from private key")
+	assert.IsType(t, ed25519.PublicKey{}, pubKey)
+	_, err = csp.GetECPublicKey(priv)
+	assert.EqualError(t, err, "public key is not an ECDSA public key")
+
+	loadedPriv, _, err := csp.LoadPrivateKey(testDir)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+
+	_, _, err = csp.GeneratePrivateKeyWithAlgorithm(testDir, "DSA")
+	assert.EqualError(t, err, "unsupported key algorithm: DSA")
+}
+
 func TestGetECPublicKey(t *testing.T) {
 
 	priv, _, err := csp.GeneratePrivateKey(testDir)
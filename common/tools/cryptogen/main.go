@@ -7,6 +7,7 @@ package main
 
 import (
 	"bytes"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -67,8 +68,10 @@ type UsersSpec struct {
 
 type OrgSpec struct {
 	Name          string       `yaml:"Name"`
+	MSPID         string       `yaml:"MSPID"`
 	Domain        string       `yaml:"Domain"`
 	EnableNodeOUs bool         `yaml:"EnableNodeOUs"`
+	KeyAlgorithm  string       `yaml:"KeyAlgorithm"`
 	CA            NodeSpec     `yaml:"CA"`
 	Template      NodeTemplate `yaml:"Template"`
 	Specs         []NodeSpec   `yaml:"Specs"`
@@ -107,8 +110,35 @@ PeerOrgs:
   # ---------------------------------------------------------------------------
   - Name: Org1
     Domain: org1.example.com
+
+    # ---------------------------------------------------------------------------
+    # "EnableNodeOUs"
+    # ---------------------------------------------------------------------------
+    # When set to true, each identity is classified by an organizational unit
+    # in its certificate: peers get the "peer" OU, orderers the "orderer" OU,
+    # users the "client" OU and the Admin user the "admin" OU. The generated
+    # MSPs carry a config.yaml declaring these OUs, and the admincerts folders
+    # are left empty: administrators are recognized by their OU alone, which
+    # requires the V1_4_3 channel capability.
+    # ---------------------------------------------------------------------------
     EnableNodeOUs: false
 
+    # ---------------------------------------------------------------------------
+    # "MSPID"
+    # ---------------------------------------------------------------------------
+    # The ID of the MSP of this organization in channel configurations, used
+    # when emitting the MSP config updates of a CA rotation. Defaults to Name.
+    # ---------------------------------------------------------------------------
+    # MSPID: Org1MSP
+
+    # ---------------------------------------------------------------------------
+    # "KeyAlgorithm"
+    # ---------------------------------------------------------------------------
+    # The algorithm of the keys generated for the CAs and all the identities of
+    # this organization. Supported values are ECDSA (P-256) and ED25519.
+    # ---------------------------------------------------------------------------
+    # KeyAlgorithm: ECDSA # default
+
     # ---------------------------------------------------------------------------
     # "CA"
     # ---------------------------------------------------------------------------
@@ -212,6 +242,17 @@ var (
 	ext           = app.Command("extend", "Extend existing network")
 	inputDir      = ext.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
 	extConfigFile = ext.Flag("config", "The configuration template to use").File()
+
+	rot           = app.Command("rotate", "Roll the signing CA of existing organizations and re-issue their certificates")
+	rotInputDir   = rot.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
+	rotConfigFile = rot.Flag("config", "The configuration template to use").File()
+	rotComplete   = rot.Flag("complete", "Complete a rotation, once the channels trust the new CA").Bool()
+	rotUpdatesDir = rot.Flag("updates", "The output directory in which to place the MSP config updates").Default("msp-updates").String()
+
+	reiss               = app.Command("reissue", "Re-issue the certificates of existing organizations, preserving their keys")
+	reissInputDir       = reiss.Flag("input", "The input directory in which existing network place").Default("crypto-config").String()
+	reissConfigFile     = reiss.Flag("config", "The configuration template to use").File()
+	reissExpiringWithin = reiss.Flag("expiring-within", "Only re-issue the certificates expiring within this duration").Duration()
 )
 
 func main() {
@@ -225,6 +266,12 @@ func main() {
 	case ext.FullCommand():
 		extend()
 
+	case rot.FullCommand():
+		rotate()
+
+	case reiss.FullCommand():
+		reissue()
+
 		// "showtemplate" command
 	case showtemplate.FullCommand():
 		fmt.Print(defaultConfig)
@@ -240,22 +287,18 @@ func main() {
 func getConfig() (*Config, error) {
 	var configData string
 
-	if *genConfigFile != nil {
-		data, err := ioutil.ReadAll(*genConfigFile)
-		if err != nil {
-			return nil, fmt.Errorf("Error reading configuration: %s", err)
+	configData = defaultConfig
+	for _, configFile := range []*os.File{*genConfigFile, *extConfigFile, *rotConfigFile, *reissConfigFile} {
+		if configFile == nil {
+			continue
 		}
-
-		configData = string(data)
-	} else if *extConfigFile != nil {
-		data, err := ioutil.ReadAll(*extConfigFile)
+		data, err := ioutil.ReadAll(configFile)
 		if err != nil {
 			return nil, fmt.Errorf("Error reading configuration: %s", err)
 		}
 
 		configData = string(data)
-	} else {
-		configData = defaultConfig
+		break
 	}
 
 	config := &Config{}
@@ -466,6 +509,19 @@ func renderNodeSpec(domain string, spec *NodeSpec) error {
 }
 
 func renderOrgSpec(orgSpec *OrgSpec, prefix string) error {
+	switch orgSpec.KeyAlgorithm {
+	case "":
+		orgSpec.KeyAlgorithm = csp.ECDSA
+	case csp.ECDSA, csp.ED25519:
+	default:
+		return fmt.Errorf("unsupported KeyAlgorithm %s for org %s: must be %s or %s",
+			orgSpec.KeyAlgorithm, orgSpec.Name, csp.ECDSA, csp.ED25519)
+	}
+
+	if orgSpec.MSPID == "" {
+		orgSpec.MSPID = orgSpec.Name
+	}
+
 	// First process all of our templated nodes
 	for i := 0; i < orgSpec.Template.Count; i++ {
 		data := HostnameData{
@@ -522,13 +578,13 @@ func generatePeerOrg(baseDir string, orgSpec OrgSpec) {
 	usersDir := filepath.Join(orgDir, "users")
 	adminCertsDir := filepath.Join(mspDir, "admincerts")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm)
 	if err != nil {
 		fmt.Printf("Error generating signCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm)
 	if err != nil {
 		fmt.Printf("Error generating tlsCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
@@ -640,13 +696,13 @@ func generateOrdererOrg(baseDir string, orgSpec OrgSpec) {
 	usersDir := filepath.Join(orgDir, "users")
 	adminCertsDir := filepath.Join(mspDir, "admincerts")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	signCA, err := ca.NewCA(caDir, orgName, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm)
 	if err != nil {
 		fmt.Printf("Error generating signCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
 	}
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, orgName, "tls"+orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, orgSpec.KeyAlgorithm)
 	if err != nil {
 		fmt.Printf("Error generating tlsCA for org %s:\n%v\n", orgName, err)
 		os.Exit(1)
@@ -661,6 +717,7 @@ func generateOrdererOrg(baseDir string, orgSpec OrgSpec) {
 	generateNodes(orderersDir, orgSpec.Specs, signCA, tlsCA, msp.ORDERER, orgSpec.EnableNodeOUs)
 
 	adminUser := NodeSpec{
+		isAdmin:    true,
 		CommonName: fmt.Sprintf("%s@%s", adminBaseName, orgName),
 	}
 
@@ -733,5 +790,14 @@ func getCA(caDir string, spec OrgSpec, name string) *ca.CA {
 		OrganizationalUnit: spec.CA.OrganizationalUnit,
 		StreetAddress:      spec.CA.StreetAddress,
 		PostalCode:         spec.CA.PostalCode,
+		KeyAlgorithm:       keyAlgorithm(cert),
+	}
+}
+
+// keyAlgorithm returns the key algorithm of an existing CA certificate
+func keyAlgorithm(cert *x509.Certificate) string {
+	if cert != nil && cert.PublicKeyAlgorithm == x509.Ed25519 {
+		return csp.ED25519
 	}
+	return csp.ECDSA
 }
@@ -65,13 +65,13 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	keystore := filepath.Join(mspDir, "keystore")
 
 	// generate private key
-	priv, _, err := csp.GeneratePrivateKey(keystore)
+	priv, _, err := csp.GeneratePrivateKeyWithAlgorithm(keystore, signCA.KeyAlgorithm)
 	if err != nil {
 		return err
 	}
 
 	// get public key
-	ecPubKey, err := csp.GetECPublicKey(priv)
+	pubKey, err := csp.GetPublicKey(priv)
 	if err != nil {
 		return err
 	}
@@ -81,7 +81,7 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 		ous = []string{nodeOUMap[nodeType]}
 	}
 	cert, err := signCA.SignCertificate(filepath.Join(mspDir, "signcerts"),
-		name, ous, nil, ecPubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+		name, ous, nil, pubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
 	if err != nil {
 		return err
 	}
@@ -124,12 +124,12 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	*/
 
 	// generate private key
-	tlsPrivKey, _, err := csp.GeneratePrivateKey(tlsDir)
+	tlsPrivKey, _, err := csp.GeneratePrivateKeyWithAlgorithm(tlsDir, tlsCA.KeyAlgorithm)
 	if err != nil {
 		return err
 	}
 	// get public key
-	tlsPubKey, err := csp.GetECPublicKey(tlsPrivKey)
+	tlsPubKey, err := csp.GetPublicKey(tlsPrivKey)
 	if err != nil {
 		return err
 	}
@@ -197,13 +197,20 @@ func GenerateVerifyingMSP(baseDir string, signCA *ca.CA, tlsCA *ca.CA, nodeOUs b
 
 	factory.InitFactories(nil)
 	bcsp := factory.GetDefault()
-	priv, err := bcsp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: true})
-	ecPubKey, err := csp.GetECPublicKey(priv)
+	var keyGenOpts bccsp.KeyGenOpts = &bccsp.ECDSAP256KeyGenOpts{Temporary: true}
+	if signCA.KeyAlgorithm == csp.ED25519 {
+		keyGenOpts = &bccsp.ED25519KeyGenOpts{Temporary: true}
+	}
+	priv, err := bcsp.KeyGen(keyGenOpts)
+	if err != nil {
+		return err
+	}
+	pubKey, err := csp.GetPublicKey(priv)
 	if err != nil {
 		return err
 	}
 	_, err = signCA.SignCertificate(filepath.Join(baseDir, "admincerts"), signCA.Name,
-		nil, nil, ecPubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+		nil, nil, pubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
 	if err != nil {
 		return err
 	}
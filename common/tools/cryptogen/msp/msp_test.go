@@ -6,14 +6,20 @@ SPDX-License-Identifier: Apache-2.0
 package msp_test
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
 	fabricmsp "github.com/hyperledger/fabric/msp"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v2"
 )
@@ -44,10 +50,10 @@ func testGenerateLocalMSP(t *testing.T, nodeOUs bool) {
 	tlsDir := filepath.Join(testDir, "tls")
 
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 
 	assert.NotEmpty(t, signCA.SignCert.Subject.Country, "country cannot be empty.")
@@ -135,15 +141,108 @@ func TestGenerateLocalMSPWithoutNodeOU(t *testing.T) {
 	testGenerateLocalMSP(t, false)
 }
 
+func TestGenerateLocalMSPED25519(t *testing.T) {
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	caDir := filepath.Join(testDir, "ca")
+	tlsCADir := filepath.Join(testDir, "tlsca")
+	mspDir := filepath.Join(testDir, "msp")
+
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ED25519)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ED25519)
+	assert.NoError(t, err, "Error generating CA")
+
+	err = msp.GenerateLocalMSP(testDir, testName, nil, signCA, tlsCA, msp.PEER, false)
+	assert.NoError(t, err, "Failed to generate local MSP")
+
+	testMSPConfig, err := fabricmsp.GetLocalMspConfig(mspDir, nil, testName)
+	assert.NoError(t, err, "Error parsing local MSP config")
+	testMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_0}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = testMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up local MSP")
+
+	id, err := testMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err, "Error getting signing identity")
+	msg := []byte("hello world")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err, "Error signing message")
+	assert.NoError(t, id.Verify(msg, sig), "Error verifying signature")
+	assert.NoError(t, testMSP.Validate(id.GetPublicVersion()))
+}
+
+func TestGenerateLocalMSPNodeOURoles(t *testing.T) {
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	signCA, err := ca.NewCA(filepath.Join(testDir, "ca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(filepath.Join(testDir, "tlsca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+
+	roles := map[int]mspprotos.MSPRole_MSPRoleType{
+		msp.CLIENT:  mspprotos.MSPRole_CLIENT,
+		msp.PEER:    mspprotos.MSPRole_PEER,
+		msp.ADMIN:   mspprotos.MSPRole_ADMIN,
+		msp.ORDERER: mspprotos.MSPRole_ORDERER,
+	}
+	for nodeType, role := range roles {
+		nodeDir := filepath.Join(testDir, nodeOUName(nodeType))
+		err = msp.GenerateLocalMSP(nodeDir, testName, nil, signCA, tlsCA, nodeType, true)
+		assert.NoError(t, err, "Failed to generate local MSP")
+
+		// admins are recognized by their OU alone
+		files, err := ioutil.ReadDir(filepath.Join(nodeDir, "msp", "admincerts"))
+		assert.NoError(t, err)
+		assert.Empty(t, files)
+
+		testMSPConfig, err := fabricmsp.GetVerifyingMspConfig(filepath.Join(nodeDir, "msp"), testName, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+		assert.NoError(t, err, "Error parsing verifying MSP config")
+		testMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4_3}})
+		assert.NoError(t, err, "Error creating new BCCSP MSP")
+		err = testMSP.Setup(testMSPConfig)
+		assert.NoError(t, err, "Error setting up verifying MSP")
+
+		certBytes, err := ioutil.ReadFile(filepath.Join(nodeDir, "msp", "signcerts", testName+"-cert.pem"))
+		assert.NoError(t, err)
+		serializedID, err := proto.Marshal(&mspprotos.SerializedIdentity{Mspid: testName, IdBytes: certBytes})
+		assert.NoError(t, err)
+		id, err := testMSP.DeserializeIdentity(serializedID)
+		assert.NoError(t, err, "Error deserializing identity")
+		principalBytes, err := proto.Marshal(&mspprotos.MSPRole{Role: role, MspIdentifier: testName})
+		assert.NoError(t, err)
+		err = id.SatisfiesPrincipal(&mspprotos.MSPPrincipal{
+			PrincipalClassification: mspprotos.MSPPrincipal_ROLE,
+			Principal:               principalBytes,
+		})
+		assert.NoError(t, err, "Identity should satisfy the %s role", role)
+	}
+}
+
+func nodeOUName(nodeType int) string {
+	switch nodeType {
+	case msp.CLIENT:
+		return msp.CLIENTOU
+	case msp.PEER:
+		return msp.PEEROU
+	case msp.ADMIN:
+		return msp.ADMINOU
+	default:
+		return msp.ORDEREROU
+	}
+}
+
 func testGenerateVerifyingMSP(t *testing.T, nodeOUs bool) {
 	caDir := filepath.Join(testDir, "ca")
 	tlsCADir := filepath.Join(testDir, "tlsca")
 	mspDir := filepath.Join(testDir, "msp")
 	// generate signing CA
-	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	signCA, err := ca.NewCA(caDir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 	// generate TLS CA
-	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	tlsCA, err := ca.NewCA(tlsCADir, testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
 	assert.NoError(t, err, "Error generating CA")
 
 	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, nodeOUs)
@@ -226,6 +325,151 @@ func TestExportConfig(t *testing.T) {
 	assert.Equal(t, msp.ORDEREROU, config.NodeOUs.OrdererOUIdentifier.OrganizationalUnitIdentifier)
 }
 
+func TestCARotation(t *testing.T) {
+	t.Run("WithNodeOU", func(t *testing.T) { testCARotation(t, true) })
+	t.Run("WithoutNodeOU", func(t *testing.T) { testCARotation(t, false) })
+}
+
+func testCARotation(t *testing.T, nodeOUs bool) {
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	mspDir := filepath.Join(testDir, "msp")
+	localMSPDir := filepath.Join(testDir, "user", "msp")
+
+	signCA, err := ca.NewCA(filepath.Join(testDir, "ca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(filepath.Join(testDir, "tlsca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	err = msp.GenerateVerifyingMSP(mspDir, signCA, tlsCA, nodeOUs)
+	assert.NoError(t, err, "Failed to generate verifying MSP")
+	err = msp.GenerateLocalMSP(filepath.Join(testDir, "user"), testName, nil, signCA, tlsCA, msp.CLIENT, nodeOUs)
+	assert.NoError(t, err, "Failed to generate local MSP")
+	if !nodeOUs {
+		// the user is the admin of the organization
+		adminCert, err := ioutil.ReadFile(filepath.Join(localMSPDir, "signcerts", testName+"-cert.pem"))
+		assert.NoError(t, err)
+		assert.NoError(t, os.RemoveAll(filepath.Join(mspDir, "admincerts")))
+		assert.NoError(t, os.MkdirAll(filepath.Join(mspDir, "admincerts"), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(mspDir, "admincerts", testName+"-cert.pem"), adminCert, 0644))
+	}
+
+	// roll the CA and re-issue the certificate of the user
+	newCA, err := ca.NewCA(filepath.Join(testDir, "newca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+
+	oldCert, newCert, err := msp.ReissueSignCert(localMSPDir, newCA, time.Time{})
+	assert.NoError(t, err, "Error re-issuing certificate")
+	assert.Equal(t, oldCert.PublicKey, newCert.PublicKey)
+	assert.Equal(t, oldCert.Subject.String(), newCert.Subject.String())
+	assert.NoError(t, newCert.CheckSignatureFrom(newCA.SignCert))
+
+	reissued := map[string]*x509.Certificate{string(oldCert.Raw): newCert}
+	for _, dir := range []string{mspDir, localMSPDir} {
+		assert.NoError(t, msp.BeginCARotation(dir, testCAName, newCA.SignCert))
+		assert.NoError(t, msp.ReplaceAdminCerts(dir, reissued, true))
+	}
+
+	// during the rotation, identities issued by either CA are valid
+	testMSP := setupVerifyingMSP(t, mspDir)
+	assert.NoError(t, validateCert(testMSP, oldCert, nodeOUs))
+	assert.NoError(t, validateCert(testMSP, newCert, nodeOUs))
+	setupVerifyingMSP(t, localMSPDir)
+
+	for _, dir := range []string{mspDir, localMSPDir} {
+		assert.NoError(t, msp.CompleteCARotation(dir, testCAName, newCA.SignCert))
+	}
+
+	// afterwards, only those issued by the new CA are
+	testMSP = setupVerifyingMSP(t, mspDir)
+	assert.Error(t, validateCert(testMSP, oldCert, nodeOUs))
+	assert.NoError(t, validateCert(testMSP, newCert, nodeOUs))
+	setupVerifyingMSP(t, localMSPDir)
+
+	files, err := ioutil.ReadDir(filepath.Join(mspDir, "cacerts"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	if !nodeOUs {
+		files, err := ioutil.ReadDir(filepath.Join(localMSPDir, "admincerts"))
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+	}
+}
+
+func TestReissueCertificateExpiring(t *testing.T) {
+	cleanup(testDir)
+	defer cleanup(testDir)
+
+	signCA, err := ca.NewCA(filepath.Join(testDir, "ca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	tlsCA, err := ca.NewCA(filepath.Join(testDir, "tlsca"), testCAOrg, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode, csp.ECDSA)
+	assert.NoError(t, err, "Error generating CA")
+	err = msp.GenerateLocalMSP(testDir, testName, []string{"peer0.example.com"}, signCA, tlsCA, msp.PEER, false)
+	assert.NoError(t, err, "Failed to generate local MSP")
+
+	// certificates are not re-issued before they are about to expire
+	oldCert, newCert, err := msp.ReissueSignCert(filepath.Join(testDir, "msp"), signCA, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Nil(t, oldCert)
+	assert.Nil(t, newCert)
+	tlsCert, err := msp.ReissueTLSCert(filepath.Join(testDir, "tls"), tlsCA, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Nil(t, tlsCert)
+
+	expiringBefore := time.Now().Add(20 * 365 * 24 * time.Hour)
+	oldCert, newCert, err = msp.ReissueSignCert(filepath.Join(testDir, "msp"), signCA, expiringBefore)
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldCert.SerialNumber, newCert.SerialNumber)
+	tlsCert, err = msp.ReissueTLSCert(filepath.Join(testDir, "tls"), tlsCA, expiringBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"peer0.example.com"}, tlsCert.DNSNames)
+
+	// the admin certificates follow
+	reissued := map[string]*x509.Certificate{string(oldCert.Raw): newCert}
+	assert.NoError(t, msp.ReplaceAdminCerts(filepath.Join(testDir, "msp"), reissued, false))
+	adminCert, err := ca.LoadCertificate(filepath.Join(testDir, "msp", "admincerts", testName+"-cert.pem"))
+	assert.NoError(t, err)
+	assert.Equal(t, newCert.Raw, adminCert.Raw)
+
+	_, err = msp.ReissueTLSCert(filepath.Join(testDir, "missing"), tlsCA, time.Time{})
+	assert.EqualError(t, err, "no TLS certificate found in "+filepath.Join(testDir, "missing"))
+}
+
+func setupVerifyingMSP(t *testing.T, mspDir string) fabricmsp.MSP {
+	testMSPConfig, err := fabricmsp.GetVerifyingMspConfig(mspDir, testName, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+	assert.NoError(t, err, "Error parsing verifying MSP config")
+	testMSP, err := fabricmsp.New(&fabricmsp.BCCSPNewOpts{NewBaseOpts: fabricmsp.NewBaseOpts{Version: fabricmsp.MSPv1_4_3}})
+	assert.NoError(t, err, "Error creating new BCCSP MSP")
+	err = testMSP.Setup(testMSPConfig)
+	assert.NoError(t, err, "Error setting up verifying MSP")
+	return testMSP
+}
+
+func validateCert(testMSP fabricmsp.MSP, cert *x509.Certificate, nodeOUs bool) error {
+	serializedID, err := proto.Marshal(&mspprotos.SerializedIdentity{
+		Mspid:   testName,
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+	})
+	if err != nil {
+		return err
+	}
+	id, err := testMSP.DeserializeIdentity(serializedID)
+	if err != nil {
+		return err
+	}
+	if err := id.Validate(); err != nil || !nodeOUs {
+		return err
+	}
+	principalBytes, err := proto.Marshal(&mspprotos.MSPRole{Role: mspprotos.MSPRole_CLIENT, MspIdentifier: testName})
+	if err != nil {
+		return err
+	}
+	return id.SatisfiesPrincipal(&mspprotos.MSPPrincipal{
+		PrincipalClassification: mspprotos.MSPPrincipal_ROLE,
+		Principal:               principalBytes,
+	})
+}
+
 func cleanup(dir string) {
 	os.RemoveAll(dir)
 }
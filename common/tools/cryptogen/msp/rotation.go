@@ -0,0 +1,169 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package msp
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/pkg/errors"
+)
+
+// ReissueSignCert re-issues the signing certificate of the local MSP in
+// mspDir from signCA, preserving its key. If expiringBefore is not zero,
+// the certificate is only re-issued if it expires before that time.
+// It returns the replaced and the new certificate, or nil if nothing
+// was re-issued
+func ReissueSignCert(mspDir string, signCA *ca.CA, expiringBefore time.Time) (*x509.Certificate, *x509.Certificate, error) {
+	files, err := filepath.Glob(filepath.Join(mspDir, "signcerts", "*.pem"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(files) != 1 {
+		return nil, nil, errors.Errorf("expected a single signing certificate in %s, found %d", mspDir, len(files))
+	}
+	return reissue(files[0], signCA, expiringBefore)
+}
+
+// ReissueTLSCert re-issues the TLS certificate in tlsDir from tlsCA,
+// preserving its key. If expiringBefore is not zero, the certificate is
+// only re-issued if it expires before that time. It returns the new
+// certificate, or nil if nothing was re-issued
+func ReissueTLSCert(tlsDir string, tlsCA *ca.CA, expiringBefore time.Time) (*x509.Certificate, error) {
+	for _, prefix := range []string{"server", "client"} {
+		path := filepath.Join(tlsDir, prefix+".crt")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		_, cert, err := reissue(path, tlsCA, expiringBefore)
+		return cert, err
+	}
+	return nil, errors.Errorf("no TLS certificate found in %s", tlsDir)
+}
+
+func reissue(path string, signCA *ca.CA, expiringBefore time.Time) (*x509.Certificate, *x509.Certificate, error) {
+	cert, err := ca.LoadCertificate(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !expiringBefore.IsZero() && cert.NotAfter.After(expiringBefore) {
+		return nil, nil, nil
+	}
+	newCert, err := signCA.ReissueCertificate(path, cert)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed re-issuing %s", path)
+	}
+	return cert, newCert, nil
+}
+
+// ReplaceAdminCerts replaces the admin certificates of the MSP in mspDir
+// which have been re-issued. reissued maps the raw bytes of the replaced
+// certificates to the new ones. If keepPrevious is true, the replaced
+// certificates are kept next to the new ones
+func ReplaceAdminCerts(mspDir string, reissued map[string]*x509.Certificate, keepPrevious bool) error {
+	adminCertsDir := filepath.Join(mspDir, "admincerts")
+	files, err := ioutil.ReadDir(adminCertsDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		path := filepath.Join(adminCertsDir, f.Name())
+		cert, err := ca.LoadCertificate(path)
+		if err != nil {
+			return err
+		}
+		newCert, ok := reissued[string(cert.Raw)]
+		if !ok {
+			continue
+		}
+		if keepPrevious {
+			previous := strings.TrimSuffix(path, "-cert.pem") + "-previous-cert.pem"
+			if err := x509Export(previous, cert); err != nil {
+				return err
+			}
+		}
+		if err := x509Export(path, newCert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeginCARotation prepares the MSP in mspDir for the rotation of the
+// signing CA called name. The certificate of the new CA is added to the
+// CA certificates, next to the one of the previous CA, so that identities
+// issued by either CA are valid, and the NodeOU identifiers, if any, are
+// no longer bound to the certificate of the previous CA
+func BeginCARotation(mspDir, name string, rootCert *x509.Certificate) error {
+	if err := x509Export(filepath.Join(mspDir, "cacerts", rotatingX509Filename(name)), rootCert); err != nil {
+		return err
+	}
+	return updateNodeOUConfig(mspDir, "")
+}
+
+// CompleteCARotation completes the rotation of the signing CA called name
+// in the MSP in mspDir: rootCert, the certificate of the new CA, replaces
+// the previous one along with the admin certificates not issued by the new
+// CA, and the NodeOU identifiers, if any, are bound to the new CA
+func CompleteCARotation(mspDir, name string, rootCert *x509.Certificate) error {
+	caFile := filepath.Join("cacerts", x509Filename(name))
+	if err := x509Export(filepath.Join(mspDir, caFile), rootCert); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(mspDir, "cacerts", rotatingX509Filename(name)))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	adminCertsDir := filepath.Join(mspDir, "admincerts")
+	files, err := ioutil.ReadDir(adminCertsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		path := filepath.Join(adminCertsDir, f.Name())
+		cert, err := ca.LoadCertificate(path)
+		if err != nil {
+			return err
+		}
+		if cert.CheckSignatureFrom(rootCert) != nil {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return updateNodeOUConfig(mspDir, caFile)
+}
+
+func rotatingX509Filename(name string) string {
+	return name + "-rotating-cert.pem"
+}
+
+// updateNodeOUConfig rewrites the NodeOU configuration of the MSP in
+// mspDir, if it has one, binding its identifiers to the CA certificate
+// in caFile or, if caFile is empty, to none of them
+func updateNodeOUConfig(mspDir, caFile string) error {
+	if _, err := os.Stat(filepath.Join(mspDir, "config.yaml")); os.IsNotExist(err) {
+		return nil
+	}
+	return exportConfig(mspDir, caFile, true)
+}
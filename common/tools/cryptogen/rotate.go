@@ -0,0 +1,388 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/ca"
+	"github.com/hyperledger/fabric/common/tools/cryptogen/msp"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	fabricmsp "github.com/hyperledger/fabric/msp"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// previousCADir is the directory, next to the ca directory of an
+// organization, holding the signing CA being rotated out
+const previousCADir = "ca-previous"
+
+// crossCertDir is the directory, next to the ca directory of an
+// organization, holding the certificate of the new signing CA
+// cross-signed by the previous one while a rotation is in progress
+const crossCertDir = "ca-cross"
+
+// MSPUpdate describes the change of the MSP of an organization
+// which has to be applied to the channels it is a member of
+type MSPUpdate struct {
+	MSPID   string       `json:"msp_id"`
+	Added   CertsChanges `json:"added"`
+	Removed CertsChanges `json:"removed"`
+	// CrossSignedCert is the PEM encoded certificate of the new signing
+	// CA cross-signed by the previous one, set when a rotation begins.
+	// It is meant for the relying parties outside of the channels which
+	// only trust the previous CA, and is not part of the MSP config: an
+	// MSP only validates identities issued by the leaves of its tree of
+	// CA certificates, through a single chain, so it can't hold both the
+	// previous CA certificate and a certificate of the new CA issued by it.
+	CrossSignedCert string `json:"cross_signed_cert,omitempty"`
+	// Config is the updated MSP config, in the JSON format of
+	// configtxlator, to be set as the MSP value of the organization
+	Config json.RawMessage `json:"config"`
+}
+
+// CertsChanges lists the PEM encoded certificates added to or
+// removed from an MSP config
+type CertsChanges struct {
+	RootCerts         []string `json:"root_certs,omitempty"`
+	IntermediateCerts []string `json:"intermediate_certs,omitempty"`
+	Admins            []string `json:"admins,omitempty"`
+}
+
+func rotate() {
+	config, err := getConfig()
+	if err != nil {
+		fmt.Printf("Error reading config: %s", err)
+		os.Exit(-1)
+	}
+
+	for _, orgSpec := range config.PeerOrgs {
+		err = renderOrgSpec(&orgSpec, "peer")
+		if err != nil {
+			fmt.Printf("Error processing peer configuration: %s", err)
+			os.Exit(-1)
+		}
+		rotateOrg(filepath.Join(*rotInputDir, "peerOrganizations", orgSpec.Domain), orgSpec)
+	}
+
+	for _, orgSpec := range config.OrdererOrgs {
+		err = renderOrgSpec(&orgSpec, "orderer")
+		if err != nil {
+			fmt.Printf("Error processing orderer configuration: %s", err)
+			os.Exit(-1)
+		}
+		rotateOrg(filepath.Join(*rotInputDir, "ordererOrganizations", orgSpec.Domain), orgSpec)
+	}
+}
+
+func rotateOrg(orgDir string, orgSpec OrgSpec) {
+	orgName := orgSpec.Domain
+	mspDir := filepath.Join(orgDir, "msp")
+
+	before, err := fabricmsp.GetVerifyingMspConfig(mspDir, orgSpec.MSPID, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+	if err != nil {
+		fmt.Printf("Error loading MSP for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	var crossCert *x509.Certificate
+	if *rotComplete {
+		err = completeCARotation(orgDir, orgSpec)
+	} else {
+		crossCert, err = beginCARotation(orgDir, orgSpec)
+	}
+	if err != nil {
+		fmt.Printf("Error rotating the CA of org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	after, err := fabricmsp.GetVerifyingMspConfig(mspDir, orgSpec.MSPID, fabricmsp.ProviderTypeToString(fabricmsp.FABRIC))
+	if err != nil {
+		fmt.Printf("Error loading MSP for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	err = writeMSPUpdate(filepath.Join(*rotUpdatesDir, orgName+".json"), orgSpec.MSPID, before, after, crossCert)
+	if err != nil {
+		fmt.Printf("Error writing MSP config update for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+}
+
+// beginCARotation replaces the signing CA of the organization in orgDir with
+// a new one, cross-signed by the previous CA, and re-issues the certificates
+// of all its nodes and users from the new CA. Until the rotation is completed,
+// the MSPs of the organization trust the identities issued by either CA, so
+// that nodes can switch to their new certificates one at a time. It returns
+// the cross-signed certificate of the new CA.
+func beginCARotation(orgDir string, orgSpec OrgSpec) (*x509.Certificate, error) {
+	caDir := filepath.Join(orgDir, "ca")
+	previousDir := filepath.Join(orgDir, previousCADir)
+	if _, err := os.Stat(previousDir); err == nil {
+		return nil, errors.New("a rotation of the signing CA is already in progress")
+	}
+
+	oldCA := getCA(caDir, orgSpec, orgSpec.CA.CommonName)
+	if oldCA.Signer == nil || oldCA.SignCert == nil {
+		return nil, errors.Errorf("failed loading the signing CA from %s", caDir)
+	}
+
+	// the new CA is generated aside, so that the previous one
+	// is left in place if anything goes wrong
+	newCADir, err := ioutil.TempDir(orgDir, "ca-new")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(newCADir)
+	newCA, err := ca.NewCA(newCADir, orgSpec.Domain, orgSpec.CA.CommonName, orgSpec.CA.Country, orgSpec.CA.Province, orgSpec.CA.Locality, orgSpec.CA.OrganizationalUnit, orgSpec.CA.StreetAddress, orgSpec.CA.PostalCode, oldCA.KeyAlgorithm)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed generating the new signing CA")
+	}
+
+	// relying parties which only trust the previous CA can verify the
+	// identities issued by the new one through the cross-signed certificate
+	if err := os.MkdirAll(filepath.Join(orgDir, crossCertDir), 0755); err != nil {
+		return nil, err
+	}
+	crossCert, err := oldCA.CrossSign(filepath.Join(orgDir, crossCertDir), newCA)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed cross-signing the new signing CA")
+	}
+
+	if err := os.Rename(caDir, previousDir); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(newCADir, caDir); err != nil {
+		if restoreErr := os.Rename(previousDir, caDir); restoreErr != nil {
+			return nil, errors.Errorf("failed moving the new signing CA into place: %s, and restoring the previous one: %s", err, restoreErr)
+		}
+		return nil, err
+	}
+
+	localMSPDirs, err := getLocalMSPDirs(orgDir)
+	if err != nil {
+		return nil, err
+	}
+	reissued := map[string]*x509.Certificate{}
+	for _, dir := range localMSPDirs {
+		oldCert, newCert, err := msp.ReissueSignCert(dir, newCA, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		reissued[string(oldCert.Raw)] = newCert
+	}
+
+	for _, dir := range append(localMSPDirs, filepath.Join(orgDir, "msp")) {
+		if err := msp.BeginCARotation(dir, newCA.Name, newCA.SignCert); err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed updating MSP %s", dir))
+		}
+		if err := msp.ReplaceAdminCerts(dir, reissued, true); err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed updating the admin certificates of MSP %s", dir))
+		}
+	}
+	return crossCert, nil
+}
+
+// completeCARotation makes the new signing CA of the organization in orgDir
+// the only one trusted by its MSPs, and discards the previous CA
+func completeCARotation(orgDir string, orgSpec OrgSpec) error {
+	previousDir := filepath.Join(orgDir, previousCADir)
+	if _, err := os.Stat(previousDir); os.IsNotExist(err) {
+		return errors.New("no rotation of the signing CA is in progress")
+	}
+
+	caDir := filepath.Join(orgDir, "ca")
+	rootCert, err := ca.LoadCertificateECDSA(caDir)
+	if err != nil || rootCert == nil {
+		return errors.Errorf("failed loading the signing CA certificate from %s", caDir)
+	}
+
+	localMSPDirs, err := getLocalMSPDirs(orgDir)
+	if err != nil {
+		return err
+	}
+	for _, dir := range append(localMSPDirs, filepath.Join(orgDir, "msp")) {
+		if err := msp.CompleteCARotation(dir, orgSpec.CA.CommonName, rootCert); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed updating MSP %s", dir))
+		}
+	}
+
+	if err := os.RemoveAll(filepath.Join(orgDir, crossCertDir)); err != nil {
+		return err
+	}
+	return os.RemoveAll(previousDir)
+}
+
+func reissue() {
+	config, err := getConfig()
+	if err != nil {
+		fmt.Printf("Error reading config: %s", err)
+		os.Exit(-1)
+	}
+
+	var expiringBefore time.Time
+	if *reissExpiringWithin > 0 {
+		expiringBefore = time.Now().Add(*reissExpiringWithin)
+	}
+
+	for _, orgSpec := range config.PeerOrgs {
+		err = renderOrgSpec(&orgSpec, "peer")
+		if err != nil {
+			fmt.Printf("Error processing peer configuration: %s", err)
+			os.Exit(-1)
+		}
+		reissueOrg(filepath.Join(*reissInputDir, "peerOrganizations", orgSpec.Domain), orgSpec, expiringBefore)
+	}
+
+	for _, orgSpec := range config.OrdererOrgs {
+		err = renderOrgSpec(&orgSpec, "orderer")
+		if err != nil {
+			fmt.Printf("Error processing orderer configuration: %s", err)
+			os.Exit(-1)
+		}
+		reissueOrg(filepath.Join(*reissInputDir, "ordererOrganizations", orgSpec.Domain), orgSpec, expiringBefore)
+	}
+}
+
+// reissueOrg re-issues the signing and TLS certificates of the nodes and
+// users of the organization in orgDir from its current CAs, preserving
+// their keys, and updates the admin certificates of its MSPs
+func reissueOrg(orgDir string, orgSpec OrgSpec, expiringBefore time.Time) {
+	orgName := orgSpec.Domain
+
+	signCA := getCA(filepath.Join(orgDir, "ca"), orgSpec, orgSpec.CA.CommonName)
+	tlsCA := getCA(filepath.Join(orgDir, "tlsca"), orgSpec, "tls"+orgSpec.CA.CommonName)
+	if signCA.Signer == nil || signCA.SignCert == nil || tlsCA.Signer == nil || tlsCA.SignCert == nil {
+		fmt.Printf("Error loading CAs for org %s\n", orgName)
+		os.Exit(1)
+	}
+
+	localMSPDirs, err := getLocalMSPDirs(orgDir)
+	if err != nil {
+		fmt.Printf("Error listing MSPs for org %s:\n%v\n", orgName, err)
+		os.Exit(1)
+	}
+
+	reissued := map[string]*x509.Certificate{}
+	for _, dir := range localMSPDirs {
+		oldCert, newCert, err := msp.ReissueSignCert(dir, signCA, expiringBefore)
+		if err != nil {
+			fmt.Printf("Error re-issuing certificate for org %s:\n%v\n", orgName, err)
+			os.Exit(1)
+		}
+		if newCert != nil {
+			reissued[string(oldCert.Raw)] = newCert
+		}
+
+		_, err = msp.ReissueTLSCert(filepath.Join(filepath.Dir(dir), "tls"), tlsCA, expiringBefore)
+		if err != nil {
+			fmt.Printf("Error re-issuing TLS certificate for org %s:\n%v\n", orgName, err)
+			os.Exit(1)
+		}
+	}
+
+	for _, dir := range append(localMSPDirs, filepath.Join(orgDir, "msp")) {
+		err := msp.ReplaceAdminCerts(dir, reissued, false)
+		if err != nil {
+			fmt.Printf("Error updating admin certificates for org %s:\n%v\n", orgName, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// getLocalMSPDirs returns the local MSP directories of the nodes and users
+// of the organization in orgDir
+func getLocalMSPDirs(orgDir string) ([]string, error) {
+	var dirs []string
+	for _, kind := range []string{"peers", "orderers", "users"} {
+		matches, err := filepath.Glob(filepath.Join(orgDir, kind, "*", "msp"))
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, matches...)
+	}
+	return dirs, nil
+}
+
+// writeMSPUpdate writes to path the update turning the MSP config before
+// into the MSP config after, along with the cross-signed certificate of the
+// new signing CA, if any
+func writeMSPUpdate(path, mspID string, before, after *mspprotos.MSPConfig, crossCert *x509.Certificate) error {
+	beforeConf := &mspprotos.FabricMSPConfig{}
+	if err := proto.Unmarshal(before.Config, beforeConf); err != nil {
+		return err
+	}
+	afterConf := &mspprotos.FabricMSPConfig{}
+	if err := proto.Unmarshal(after.Config, afterConf); err != nil {
+		return err
+	}
+
+	update := &MSPUpdate{MSPID: mspID}
+	update.Added.RootCerts, update.Removed.RootCerts = diffCerts(beforeConf.RootCerts, afterConf.RootCerts)
+	update.Added.IntermediateCerts, update.Removed.IntermediateCerts = diffCerts(beforeConf.IntermediateCerts, afterConf.IntermediateCerts)
+	update.Added.Admins, update.Removed.Admins = diffCerts(beforeConf.Admins, afterConf.Admins)
+	if crossCert != nil {
+		update.CrossSignedCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: crossCert.Raw}))
+	}
+
+	buf := &bytes.Buffer{}
+	if err := protolator.DeepMarshalJSON(buf, after); err != nil {
+		return err
+	}
+	update.Config = buf.Bytes()
+
+	data, err := json.MarshalIndent(update, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// diffCerts returns the PEM encoded certificates of after missing from
+// before, and those of before missing from after
+func diffCerts(before, after [][]byte) (added []string, removed []string) {
+	beforeCerts := pemCerts(before)
+	afterCerts := pemCerts(after)
+	for cert := range afterCerts {
+		if !beforeCerts[cert] {
+			added = append(added, cert)
+		}
+	}
+	for cert := range beforeCerts {
+		if !afterCerts[cert] {
+			removed = append(removed, cert)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// pemCerts normalizes PEM encoded certificates so that they can be compared
+func pemCerts(certs [][]byte) map[string]bool {
+	normalized := map[string]bool{}
+	for _, cert := range certs {
+		block, _ := pem.Decode(cert)
+		if block == nil {
+			continue
+		}
+		normalized[string(pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: block.Bytes}))] = true
+	}
+	return normalized
+}
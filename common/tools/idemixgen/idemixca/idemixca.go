@@ -42,7 +42,8 @@ func GenerateIssuerKey() ([]byte, []byte, error) {
 // GenerateSignerConfig creates a new signer config.
 // It generates a fresh user secret and issues a credential
 // with four attributes (described above) using the CA's key pair.
-func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey *ecdsa.PrivateKey) ([]byte, error) {
+// The signer discloses the given attributes, or its OU and role if none is given.
+func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey *ecdsa.PrivateKey, disclosedAttributes ...string) ([]byte, error) {
 	attrs := make([]*FP256BN.BIG, 4)
 
 	if ouString == "" {
@@ -92,6 +93,7 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 		Role:                            int32(roleMask),
 		EnrollmentId:                    enrollmentId,
 		CredentialRevocationInformation: criBytes,
+		DisclosedAttributes:             disclosedAttributes,
 	}
 
 	return proto.Marshal(signer)
@@ -47,6 +47,8 @@ var (
 	genCredIsAdmin          = genSignerConfig.Flag("admin", "Make the default signer admin").Short('a').Bool()
 	genCredEnrollmentId     = genSignerConfig.Flag("enrollmentId", "The enrollment id of the default signer").Short('e').String()
 	genCredRevocationHandle = genSignerConfig.Flag("revocationHandle", "The handle used to revoke this signer").Short('r').Int()
+	genSignerName           = genSignerConfig.Flag("name", "Generate an additional signer with this name instead of the default signer").Short('n').String()
+	genSignerDisclose       = genSignerConfig.Flag("disclose", "An attribute (OU, Role or EnrollmentID) disclosed by the signer, by default OU and Role").Short('d').Strings()
 
 	version = app.Command("version", "Show version information")
 )
@@ -93,9 +95,19 @@ func main() {
 		} else {
 			roleMask = msp.GetRoleMaskFromIdemixRole(msp.MEMBER)
 		}
-		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(), readRevocationKey())
+		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(), readRevocationKey(), *genSignerDisclose...)
 		handleError(err)
 
+		if *genSignerName != "" {
+			// Additional signers are written to the signers directory
+			path := filepath.Join(*outputDir, msp.IdemixConfigDirUser, msp.IdemixConfigDirSigners, *genSignerName)
+			checkDirectoryNotExists(path, fmt.Sprintf("This MSP config already contains a signer \"%s\"", *genSignerName))
+
+			handleError(os.MkdirAll(filepath.Dir(path), 0770))
+			writeFile(path, config)
+			break
+		}
+
 		path := filepath.Join(*outputDir, msp.IdemixConfigDirUser)
 		checkDirectoryNotExists(path, fmt.Sprintf("This MSP config already contains a directory \"%s\"", path))
 
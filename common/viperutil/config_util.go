@@ -357,6 +357,7 @@ func EnhancedExactUnmarshalKey(baseKey string, output interface{}) error {
 		Metadata:         nil,
 		Result:           output,
 		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
 	}
 
 	decoder, err := mapstructure.NewDecoder(config)
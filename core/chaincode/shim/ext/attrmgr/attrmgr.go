@@ -154,30 +154,48 @@ func (mgr *Mgr) GetAttributesFromIdemix(creator []byte) (*Attributes, error) {
 		Attrs: make(map[string]string),
 	}
 
-	ou := &msp.OrganizationUnit{}
-	err = proto.Unmarshal(idemixID.Ou, ou)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal transaction invoker's ou")
+	// Disclosure tells which of the OU, role and enrollment ID attributes
+	// are disclosed by the identity; if empty, the OU and role are disclosed
+	disclosure := idemixID.Disclosure
+	if len(disclosure) == 0 {
+		disclosure = []byte{1, 1, 0, 0}
+	}
+	if len(disclosure) < 3 {
+		return nil, errors.New("invalid disclosure of transaction invoker's idemix identity")
 	}
-	attrs.Attrs["ou"] = ou.OrganizationalUnitIdentifier
 
-	role := &msp.MSPRole{}
-	err = proto.Unmarshal(idemixID.Role, role)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal transaction invoker's role")
+	if disclosure[0] == 1 {
+		ou := &msp.OrganizationUnit{}
+		err = proto.Unmarshal(idemixID.Ou, ou)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal transaction invoker's ou")
+		}
+		attrs.Attrs["ou"] = ou.OrganizationalUnitIdentifier
 	}
-	var roleStr string
-	switch role.Role {
-	case 0:
-		roleStr = "member"
-	case 1:
-		roleStr = "admin"
-	case 2:
-		roleStr = "client"
-	case 3:
-		roleStr = "peer"
+
+	if disclosure[1] == 1 {
+		role := &msp.MSPRole{}
+		err = proto.Unmarshal(idemixID.Role, role)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal transaction invoker's role")
+		}
+		var roleStr string
+		switch role.Role {
+		case 0:
+			roleStr = "member"
+		case 1:
+			roleStr = "admin"
+		case 2:
+			roleStr = "client"
+		case 3:
+			roleStr = "peer"
+		}
+		attrs.Attrs["role"] = roleStr
+	}
+
+	if disclosure[2] == 1 {
+		attrs.Attrs["enrollmentId"] = string(idemixID.EnrollmentId)
 	}
-	attrs.Attrs["role"] = roleStr
 
 	return attrs, nil
 }
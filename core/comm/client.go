@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,6 +22,9 @@ import (
 type GRPCClient struct {
 	// TLS configuration used by the grpc.ClientConn
 	tlsConfig *tls.Config
+	// Certificate presented by the client for mutual TLS
+	// stored as an atomic reference
+	certificate atomic.Value
 	// Options for setting up new connections
 	dialOpts []grpc.DialOption
 	// Duration for which to block while established a new connection
@@ -101,6 +105,11 @@ func (client *GRPCClient) parseSecureOptions(opts *SecureOptions) error {
 			}
 			client.tlsConfig.Certificates = append(
 				client.tlsConfig.Certificates, cert)
+			client.certificate.Store(cert)
+			client.tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				cert := client.certificate.Load().(tls.Certificate)
+				return &cert, nil
+			}
 		} else {
 			return errors.New("both Key and Certificate " +
 				"are required when using mutual TLS")
@@ -119,13 +128,22 @@ func (client *GRPCClient) parseSecureOptions(opts *SecureOptions) error {
 // Certificate returns the tls.Certificate used to make TLS connections
 // when client certificates are required by the server
 func (client *GRPCClient) Certificate() tls.Certificate {
-	cert := tls.Certificate{}
-	if client.tlsConfig != nil && len(client.tlsConfig.Certificates) > 0 {
-		cert = client.tlsConfig.Certificates[0]
+	cert, ok := client.certificate.Load().(tls.Certificate)
+	if !ok {
+		return tls.Certificate{}
 	}
 	return cert
 }
 
+// SetCertificate replaces the tls.Certificate used to make TLS connections
+// when client certificates are required by the server, e.g. after it has
+// been renewed. It takes effect for new connections.
+func (client *GRPCClient) SetCertificate(cert tls.Certificate) {
+	if client.MutualTLSRequired() {
+		client.certificate.Store(cert)
+	}
+}
+
 // TLSEnabled is a flag indicating whether to use TLS for client
 // connections
 func (client *GRPCClient) TLSEnabled() bool {
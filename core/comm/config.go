@@ -30,7 +30,11 @@ var (
 		ServerTimeout:     time.Duration(20) * time.Second, // 20 sec - gRPC default
 		ServerMinInterval: time.Duration(1) * time.Minute,  // match ClientInterval
 	}
-	// strong TLS cipher suites
+	// strong TLS cipher suites; the ECDHE_ECDSA suites are also
+	// negotiated for Ed25519 certificates, which crypto/tls supports
+	// as of Go 1.13. Ed25519 TLS keys must be loaded from PEM files,
+	// and the TLS clients and servers connecting to nodes which use
+	// them must support the Ed25519 signature scheme of RFC 8422.
 	DefaultTLSCipherSuites = []uint16{
 		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
 		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
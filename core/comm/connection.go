@@ -88,11 +88,15 @@ func GetCredentialSupport() *CredentialSupport {
 // SetClientCertificate sets the tls.Certificate to use for gRPC client
 // connections
 func (cs *CredentialSupport) SetClientCertificate(cert tls.Certificate) {
+	cs.Lock()
+	defer cs.Unlock()
 	cs.clientCert = cert
 }
 
 // GetClientCertificate returns the client certificate of the CredentialSupport
 func (cs *CredentialSupport) GetClientCertificate() tls.Certificate {
+	cs.RLock()
+	defer cs.RUnlock()
 	return cs.clientCert
 }
 
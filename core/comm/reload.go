@@ -0,0 +1,35 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/pkg/errors"
+)
+
+// NewKeyPairWatcher returns a FileWatcher that loads the TLS key pair in
+// certFile and keyFile whenever they change, and passes it to each of the
+// onReload functions, such as GRPCServer.SetServerCertificate or
+// GRPCClient.SetCertificate. A key pair that fails to load, e.g. a renewed
+// certificate whose key has not been replaced yet, is loaded again at the
+// next poll, so that certificates and keys are always swapped together.
+func NewKeyPairWatcher(certFile, keyFile string, interval time.Duration, onReload ...func(tls.Certificate)) *crypto.FileWatcher {
+	return crypto.NewFileWatcher(interval, func() error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return errors.Wrap(err, "failed loading TLS key pair")
+		}
+		for _, f := range onReload {
+			f(cert)
+		}
+		commLogger.Infof("Reloaded TLS certificate %s", certFile)
+		return nil
+	}, certFile, keyFile)
+}
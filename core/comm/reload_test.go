@@ -0,0 +1,151 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm_test
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/core/comm"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyPairWatcherReloadsServerCertificate(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keypairwatcher")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	serverKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+	renewedKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	assert.NoError(t, ioutil.WriteFile(certFile, serverKeyPair.Cert, 0644))
+	assert.NoError(t, ioutil.WriteFile(keyFile, serverKeyPair.Key, 0600))
+
+	gRPCServer, err := comm.NewGRPCServer("127.0.0.1:", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			Key:         serverKeyPair.Key,
+			Certificate: serverKeyPair.Cert,
+			UseTLS:      true,
+		},
+	})
+	assert.NoError(t, err)
+	go gRPCServer.Start()
+	defer gRPCServer.Stop()
+
+	probeTLS := func() []byte {
+		tlsCfg := &tls.Config{RootCAs: x509.NewCertPool()}
+		tlsCfg.RootCAs.AppendCertsFromPEM(ca.CertBytes())
+		conn, err := tls.Dial("tcp", gRPCServer.Address(), tlsCfg)
+		if err != nil {
+			return nil
+		}
+		defer conn.Close()
+		return conn.ConnectionState().PeerCertificates[0].Raw
+	}
+	assert.Equal(t, serverKeyPair.TLSCert.Raw, probeTLS())
+
+	var reloads int
+	var lock sync.Mutex
+	w := comm.NewKeyPairWatcher(certFile, keyFile, 10*time.Millisecond, gRPCServer.SetServerCertificate, func(tls.Certificate) {
+		lock.Lock()
+		defer lock.Unlock()
+		reloads++
+	})
+	w.Start()
+	defer w.Stop()
+	getReloads := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return reloads
+	}
+
+	// The renewed certificate does not match the current key yet
+	assert.NoError(t, ioutil.WriteFile(certFile, renewedKeyPair.Cert, 0644))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, getReloads())
+	assert.Equal(t, serverKeyPair.TLSCert.Raw, probeTLS())
+
+	assert.NoError(t, ioutil.WriteFile(keyFile, renewedKeyPair.Key, 0600))
+	gt := NewGomegaWithT(t)
+	gt.Eventually(probeTLS).Should(Equal(renewedKeyPair.TLSCert.Raw))
+	assert.Equal(t, 1, getReloads())
+}
+
+func TestGRPCClientSetCertificate(t *testing.T) {
+	t.Parallel()
+
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	serverKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	assert.NoError(t, err)
+	clientKeyPair, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+	renewedKeyPair, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+
+	presented := make(chan []byte, 10)
+	gRPCServer, err := comm.NewGRPCServer("127.0.0.1:", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			Key:               serverKeyPair.Key,
+			Certificate:       serverKeyPair.Cert,
+			UseTLS:            true,
+			RequireClientCert: true,
+			ClientRootCAs:     [][]byte{ca.CertBytes()},
+			VerifyCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				presented <- rawCerts[0]
+				return nil
+			},
+		},
+	})
+	assert.NoError(t, err)
+	go gRPCServer.Start()
+	defer gRPCServer.Stop()
+
+	client, err := comm.NewGRPCClient(comm.ClientConfig{
+		Timeout: time.Second,
+		SecOpts: &comm.SecureOptions{
+			UseTLS:            true,
+			RequireClientCert: true,
+			Key:               clientKeyPair.Key,
+			Certificate:       clientKeyPair.Cert,
+			ServerRootCAs:     [][]byte{ca.CertBytes()},
+		},
+	})
+	assert.NoError(t, err)
+
+	conn, err := client.NewConnection(gRPCServer.Address(), "")
+	assert.NoError(t, err)
+	conn.Close()
+	assert.True(t, bytes.Equal(clientKeyPair.TLSCert.Raw, <-presented))
+
+	renewedCert, err := tls.X509KeyPair(renewedKeyPair.Cert, renewedKeyPair.Key)
+	assert.NoError(t, err)
+	client.SetCertificate(renewedCert)
+	assert.Equal(t, renewedCert, client.Certificate())
+
+	conn, err = client.NewConnection(gRPCServer.Address(), "")
+	assert.NoError(t, err)
+	conn.Close()
+	assert.True(t, bytes.Equal(renewedKeyPair.TLSCert.Raw, <-presented))
+}
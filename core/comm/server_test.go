@@ -9,13 +9,18 @@ package comm_test
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math/big"
 	"net"
 	"path/filepath"
 	"sync"
@@ -810,6 +815,83 @@ func TestVerifyCertificateCallback(t *testing.T) {
 
 }
 
+// newEd25519CertKeyPair creates a PEM encoded Ed25519 certificate and private key,
+// signed by parent and parentKey, or self-signed if parent is nil
+func newEd25519CertKeyPair(t *testing.T, isCA bool, parent *x509.Certificate, parentKey ed25519.PrivateKey) (*x509.Certificate, ed25519.PrivateKey, []byte, []byte) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "ed25519"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	if parent == nil {
+		parent, parentKey = template, privKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pubKey, parentKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	rawKey, err := x509.MarshalPKCS8PrivateKey(privKey)
+	assert.NoError(t, err)
+
+	return cert, privKey,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rawKey})
+}
+
+func TestEd25519MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	caCert, caKey, caPEM, _ := newEd25519CertKeyPair(t, true, nil, nil)
+	_, _, serverCert, serverKey := newEd25519CertKeyPair(t, false, caCert, caKey)
+	_, _, clientCert, clientKey := newEd25519CertKeyPair(t, false, caCert, caKey)
+
+	srv, err := comm.NewGRPCServer("127.0.0.1:", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:            true,
+			Certificate:       serverCert,
+			Key:               serverKey,
+			RequireClientCert: true,
+			ClientRootCAs:     [][]byte{caPEM},
+		},
+	})
+	assert.NoError(t, err)
+	testpb.RegisterEmptyServiceServer(srv.Server(), &emptyServiceServer{})
+	go srv.Start()
+	defer srv.Stop()
+
+	client, err := comm.NewGRPCClient(comm.ClientConfig{
+		Timeout: testTimeout,
+		SecOpts: &comm.SecureOptions{
+			UseTLS:            true,
+			ServerRootCAs:     [][]byte{caPEM},
+			RequireClientCert: true,
+			Certificate:       clientCert,
+			Key:               clientKey,
+		},
+	})
+	assert.NoError(t, err)
+
+	conn, err := client.NewConnection(srv.Address(), "")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = testpb.NewEmptyServiceClient(conn).EmptyCall(context.Background(), &testpb.Empty{})
+	assert.NoError(t, err)
+}
+
 // prior tests used self-signed certficates loaded by the GRPCServer and the test client
 // here we'll use certificates signed by certificate authorities
 func TestWithSignedRootCertificates(t *testing.T) {
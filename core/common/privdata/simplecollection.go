@@ -113,6 +113,13 @@ func (sc *SimpleCollection) Setup(collectionConfig *common.StaticCollectionConfi
 				return errors.Wrap(err, "Could not unmarshal OrganizationUnit from principal")
 			}
 			sc.memberOrgs = append(sc.memberOrgs, OU.MspIdentifier)
+		case m.MSPPrincipal_ATTRIBUTE:
+			attr := &m.MSPAttribute{}
+			err := proto.Unmarshal(principal.Principal, attr)
+			if err != nil {
+				return errors.Wrap(err, "Could not unmarshal MSPAttribute from principal")
+			}
+			sc.memberOrgs = append(sc.memberOrgs, attr.MspIdentifier)
 		default:
 			return errors.New(fmt.Sprintf("Invalid principal type %d", int32(principal.PrincipalClassification)))
 		}
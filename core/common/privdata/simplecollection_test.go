@@ -137,6 +137,22 @@ func TestSetupGoodConfigCollection(t *testing.T) {
 	assert.True(t, sc.RequiredPeerCount() == 1)
 }
 
+func TestSetupAttributeMemberCollection(t *testing.T) {
+	policyEnvelope, err := cauthdsl.FromString("OR('Org1.attr.OU=sales', 'Org2.member')")
+	assert.NoError(t, err)
+
+	collectionConfig := &pb.StaticCollectionConfig{
+		Name:              "test collection",
+		RequiredPeerCount: 1,
+		MemberOrgsPolicy:  createCollectionPolicyConfig(policyEnvelope),
+	}
+
+	var sc SimpleCollection
+	err = sc.Setup(collectionConfig, &mockDeserializer{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org1", "Org2"}, sc.MemberOrgs())
+}
+
 func TestSimpleCollectionFilter(t *testing.T) {
 	// create member access policy
 	var signers = [][]byte{[]byte("signer0"), []byte("signer1")}
@@ -98,6 +98,10 @@ type Config struct {
 	// Gossip enables to enumerate peers in the channel, send a message to peers,
 	// and add a block to the gossip state transfer layer
 	Gossip blocksprovider.GossipServiceAdapter
+	// ReconnectTotalTimeThreshold is the total time the delivery service keeps
+	// trying to reconnect to the ordering service before giving up and calling the
+	// finalizer of the channel. If zero, peer.deliveryclient.reconnectTotalTimeThreshold is used.
+	ReconnectTotalTimeThreshold time.Duration
 }
 
 // ConnectionCriteria defines how to connect to ordering service nodes.
@@ -265,7 +269,10 @@ func (d *deliverServiceImpl) Stop() {
 
 func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocksprovider.LedgerInfo) *broadcastClient {
 	reconnectBackoffThreshold := getReConnectBackoffThreshold()
-	reconnectTotalTimeThreshold := getReConnectTotalTimeThreshold()
+	reconnectTotalTimeThreshold := d.conf.ReconnectTotalTimeThreshold
+	if reconnectTotalTimeThreshold == 0 {
+		reconnectTotalTimeThreshold = getReConnectTotalTimeThreshold()
+	}
 	requester := &blocksRequester{
 		tls:     viper.GetBool("peer.tls.enabled"),
 		chainID: chainID,
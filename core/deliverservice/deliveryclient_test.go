@@ -718,6 +718,25 @@ func TestRetryPolicyOverflow(t *testing.T) {
 	}
 }
 
+func TestRetryPolicyReconnectTotalTimeThreshold(t *testing.T) {
+	connFactory := func(channelID string) func(comm.EndpointCriteria) (*grpc.ClientConn, error) {
+		return func(_ comm.EndpointCriteria) (*grpc.ClientConn, error) {
+			return nil, errors.New("")
+		}
+	}
+	conf := &Config{ConnFactory: connFactory, ReconnectTotalTimeThreshold: time.Minute}
+	client := (&deliverServiceImpl{conf: conf}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)})
+	_, retry := client.shouldRetry(1, time.Second*59)
+	assert.True(t, retry)
+	_, retry = client.shouldRetry(1, time.Minute)
+	assert.False(t, retry)
+
+	// Without a threshold in the config, the one from the peer configuration is used
+	client = (&deliverServiceImpl{conf: &Config{ConnFactory: connFactory}}).newClient("TEST", &mocks.MockLedgerInfo{Height: uint64(100)})
+	_, retry = client.shouldRetry(1, time.Minute)
+	assert.True(t, retry)
+}
+
 func assertBlockDissemination(expectedSeq uint64, ch chan uint64, t *testing.T) {
 	select {
 	case seq := <-ch:
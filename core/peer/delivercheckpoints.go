@@ -0,0 +1,357 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/deliver"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/config"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// CheckpointStore stores the checkpoints acknowledged by the named consumers
+// of the deliver service. The consumers are named by the identity creating
+// the requests, given as a serialized identity, and their consumer ID.
+type CheckpointStore interface {
+	// Checkpoint returns the checkpoint of the consumer of the identity on the
+	// channel, or nil if the consumer has none
+	Checkpoint(channelID string, creator []byte, consumerID string) (*peer.DeliverCheckpoint, error)
+
+	// Acknowledge stores the checkpoint of its consumer of the identity on the
+	// channel, unless the consumer already acknowledged a later transaction
+	Acknowledge(channelID string, creator []byte, checkpoint *peer.DeliverCheckpoint) error
+}
+
+// GetCheckpointStorePath returns the filesystem path of the checkpoints of the
+// deliver service consumers
+func GetCheckpointStorePath() string {
+	sysPath := config.GetPath("peer.fileSystemPath")
+	return filepath.Join(sysPath, "deliverCheckpoints")
+}
+
+// NewCheckpointStore opens the LevelDB checkpoint store found at the path,
+// creating it if missing. Each identity can acknowledge the checkpoints of
+// up to maxConsumers consumers on each channel.
+func NewCheckpointStore(dbPath string, maxConsumers int) CheckpointStore {
+	db := leveldbhelper.CreateDB(&leveldbhelper.Conf{DBPath: dbPath})
+	db.Open()
+	return &checkpointStore{db: db, maxConsumers: maxConsumers}
+}
+
+// checkpointStore keeps the checkpoints under keys bound to the identity of
+// their consumer, so that each identity has its own namespace of consumer IDs
+// on each channel.
+type checkpointStore struct {
+	mutex        sync.Mutex
+	db           *leveldbhelper.DB
+	maxConsumers int
+}
+
+// checkpointOwner returns the owner of the checkpoints acknowledged by the
+// serialized identity: its MSP ID and the hash of its certificate
+func checkpointOwner(creator []byte) (string, error) {
+	sid := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(creator, sid); err != nil {
+		return "", errors.Wrap(err, "error unmarshaling creator")
+	}
+	if sid.Mspid == "" || len(sid.IdBytes) == 0 {
+		return "", errors.New("creator is not a serialized identity")
+	}
+	hash := sha256.Sum256(sid.IdBytes)
+	return sid.Mspid + ":" + hex.EncodeToString(hash[:]), nil
+}
+
+func checkpointKeyPrefix(channelID, owner string) []byte {
+	return []byte("c" + channelID + "\x00" + owner + "\x00")
+}
+
+func checkpointKey(channelID, owner, consumerID string) []byte {
+	return append(checkpointKeyPrefix(channelID, owner), consumerID...)
+}
+
+// Checkpoint returns the checkpoint of the consumer of the identity on the
+// channel
+func (cs *checkpointStore) Checkpoint(channelID string, creator []byte, consumerID string) (*peer.DeliverCheckpoint, error) {
+	owner, err := checkpointOwner(creator)
+	if err != nil {
+		return nil, err
+	}
+	return cs.checkpoint(channelID, owner, consumerID)
+}
+
+func (cs *checkpointStore) checkpoint(channelID, owner, consumerID string) (*peer.DeliverCheckpoint, error) {
+	value, err := cs.db.Get(checkpointKey(channelID, owner, consumerID))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	checkpoint := &peer.DeliverCheckpoint{}
+	if err := proto.Unmarshal(value, checkpoint); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling checkpoint of consumer %s", consumerID)
+	}
+	return checkpoint, nil
+}
+
+// consumers returns the number of consumers of the owner on the channel
+func (cs *checkpointStore) consumers(channelID, owner string) int {
+	prefix := checkpointKeyPrefix(channelID, owner)
+	end := append([]byte{}, prefix...)
+	end[len(end)-1]++
+	it := cs.db.GetIterator(prefix, end)
+	defer it.Release()
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	return count
+}
+
+// consumerLimitError is returned when an identity acknowledges a checkpoint
+// for a new consumer while it already has the maximum number of consumers
+type consumerLimitError struct {
+	channelID    string
+	maxConsumers int
+}
+
+func (e *consumerLimitError) Error() string {
+	return fmt.Sprintf("maximum number of consumers (%d) reached on channel %s", e.maxConsumers, e.channelID)
+}
+
+// Acknowledge stores the checkpoint, acknowledgements of earlier transactions
+// being ignored so that they can be sent again by the consumers
+func (cs *checkpointStore) Acknowledge(channelID string, creator []byte, checkpoint *peer.DeliverCheckpoint) error {
+	owner, err := checkpointOwner(creator)
+	if err != nil {
+		return err
+	}
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	current, err := cs.checkpoint(channelID, owner, checkpoint.ConsumerId)
+	if err != nil {
+		return err
+	}
+	if current == nil && cs.consumers(channelID, owner) >= cs.maxConsumers {
+		return &consumerLimitError{channelID: channelID, maxConsumers: cs.maxConsumers}
+	}
+	if current != nil && (current.BlockNumber > checkpoint.BlockNumber ||
+		current.BlockNumber == checkpoint.BlockNumber && current.TxIndex >= checkpoint.TxIndex) {
+		return nil
+	}
+
+	value, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrapf(err, "error marshaling checkpoint of consumer %s", checkpoint.ConsumerId)
+	}
+	return cs.db.Put(checkpointKey(channelID, owner, checkpoint.ConsumerId), value, true)
+}
+
+// streamCheckpoints resumes the delivery of the consumers of a stream from
+// their checkpoint. It is embedded by the response senders, which implement
+// deliver.Checkpointer through it.
+type streamCheckpoints struct {
+	store CheckpointStore
+	send  func(*peer.DeliverResponse) error
+	// resumed is the checkpoint the delivery last resumed from, until the
+	// checkpointed block is sent
+	resumed *peer.DeliverCheckpoint
+}
+
+// ResumeFrom sends the checkpoint of the consumer of the creator, and returns
+// the number of the checkpointed block, which the delivery resumes from
+func (sc *streamCheckpoints) ResumeFrom(channelID, consumerID string, creator []byte) (uint64, bool, error) {
+	if sc.store == nil {
+		return 0, false, errors.New("deliver checkpoints are not enabled")
+	}
+	checkpoint, err := sc.store.Checkpoint(channelID, creator, consumerID)
+	if err != nil || checkpoint == nil {
+		return 0, false, err
+	}
+
+	response := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_Checkpoint{Checkpoint: checkpoint},
+	}
+	if err := sc.send(response); err != nil {
+		return 0, false, err
+	}
+	sc.resumed = checkpoint
+	return checkpoint.BlockNumber, true, nil
+}
+
+// unacknowledged returns the block with the transactions acknowledged by the
+// consumer the delivery resumed from removed, so that they are left out of
+// filtered blocks. Consumers of full blocks skip them using the checkpoint
+// sent when resuming.
+func (sc *streamCheckpoints) unacknowledged(block *common.Block) *common.Block {
+	resumed := sc.resumed
+	sc.resumed = nil
+	if resumed == nil || block.GetHeader().GetNumber() != resumed.BlockNumber || block.Data == nil {
+		return block
+	}
+
+	data := make([][]byte, len(block.Data.Data))
+	for i := range data {
+		if uint64(i) > resumed.TxIndex {
+			data[i] = block.Data.Data[i]
+		}
+	}
+	trimmed := *block
+	trimmed.Data = &common.BlockData{Data: data}
+	return &trimmed
+}
+
+// reset forgets the checkpoint the delivery resumed from, once the request
+// is completed
+func (sc *streamCheckpoints) reset() {
+	sc.resumed = nil
+}
+
+type receivedEnvelope struct {
+	envelope *common.Envelope
+	err      error
+}
+
+// checkpointReceiver receives the seek requests of a stream and, meanwhile
+// the requested blocks are delivered, stores the checkpoints acknowledged by
+// the consumers of the stream. The first acknowledgement rejected ends the
+// stream, by canceling its context.
+type checkpointReceiver struct {
+	deliver.Receiver
+	ctx           context.Context
+	cancel        context.CancelFunc
+	store         CheckpointStore
+	policyChecker deliver.PolicyChecker
+	chainManager  deliver.ChainManager
+
+	once      sync.Once
+	envelopes chan receivedEnvelope
+
+	mutex    sync.Mutex
+	rejected common.Status
+}
+
+func newCheckpointReceiver(ctx context.Context, cancel context.CancelFunc, receiver deliver.Receiver, store CheckpointStore, policyChecker deliver.PolicyChecker, chainManager deliver.ChainManager) *checkpointReceiver {
+	return &checkpointReceiver{
+		Receiver:      receiver,
+		ctx:           ctx,
+		cancel:        cancel,
+		store:         store,
+		policyChecker: policyChecker,
+		chainManager:  chainManager,
+		envelopes:     make(chan receivedEnvelope),
+	}
+}
+
+// rejection returns the status of the acknowledgement that ended the stream,
+// if any
+func (cr *checkpointReceiver) rejection() (common.Status, bool) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	return cr.rejected, cr.rejected != common.Status_UNKNOWN
+}
+
+// Recv returns the next envelope received which is not an acknowledgement
+func (cr *checkpointReceiver) Recv() (*common.Envelope, error) {
+	cr.once.Do(func() { go cr.receive() })
+	select {
+	case received := <-cr.envelopes:
+		return received.envelope, received.err
+	case <-cr.ctx.Done():
+		return nil, cr.ctx.Err()
+	}
+}
+
+func (cr *checkpointReceiver) receive() {
+	for {
+		envelope, err := cr.Receiver.Recv()
+		if err == nil && cr.acknowledged(envelope) {
+			if _, rejected := cr.rejection(); rejected {
+				return
+			}
+			continue
+		}
+		select {
+		case cr.envelopes <- receivedEnvelope{envelope: envelope, err: err}:
+		case <-cr.ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// acknowledged stores the checkpoint carried by the envelope, and returns
+// false if the envelope is not an acknowledgement. If the acknowledgement is
+// rejected, the stream is ended with the status of the rejection.
+func (cr *checkpointReceiver) acknowledged(envelope *common.Envelope) bool {
+	payload, err := utils.UnmarshalPayload(envelope.Payload)
+	if err != nil || payload.Header == nil {
+		return false
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil || common.HeaderType(chdr.Type) != common.HeaderType_DELIVER_ACK {
+		return false
+	}
+
+	if status, err := cr.acknowledge(envelope, chdr.ChannelId, payload); err != nil {
+		logger.Warningf("[channel: %s] Rejecting deliver acknowledgement: %s", chdr.ChannelId, err)
+		cr.mutex.Lock()
+		cr.rejected = status
+		cr.mutex.Unlock()
+		cr.cancel()
+	}
+	return true
+}
+
+func (cr *checkpointReceiver) acknowledge(envelope *common.Envelope, channelID string, payload *common.Payload) (common.Status, error) {
+	shdr, err := utils.GetSignatureHeader(payload.Header.SignatureHeader)
+	if err != nil {
+		return common.Status_BAD_REQUEST, err
+	}
+	checkpoint := &peer.DeliverCheckpoint{}
+	if err := proto.Unmarshal(payload.Data, checkpoint); err != nil {
+		return common.Status_BAD_REQUEST, errors.Wrap(err, "error unmarshaling checkpoint")
+	}
+	if checkpoint.ConsumerId == "" {
+		return common.Status_BAD_REQUEST, errors.New("missing consumer ID")
+	}
+
+	chain := cr.chainManager.GetChain(channelID)
+	if chain == nil {
+		return common.Status_NOT_FOUND, errors.Errorf("channel %s not found", channelID)
+	}
+	if err := cr.policyChecker.CheckPolicy(envelope, channelID); err != nil {
+		return common.Status_FORBIDDEN, errors.WithMessage(err, "access denied")
+	}
+	if height := chain.Reader().Height(); checkpoint.BlockNumber >= height {
+		return common.Status_BAD_REQUEST, errors.Errorf("block %d of consumer %s is not committed, height is %d", checkpoint.BlockNumber, checkpoint.ConsumerId, height)
+	}
+
+	err = cr.store.Acknowledge(channelID, shdr.Creator, checkpoint)
+	switch err.(type) {
+	case nil:
+		return common.Status_SUCCESS, nil
+	case *consumerLimitError:
+		return common.Status_CONFLICT, err
+	default:
+		return common.Status_INTERNAL_SERVER_ERROR, err
+	}
+}
@@ -0,0 +1,331 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/deliver"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCreator  = utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte("cert1")})
+	otherCreator = utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte("cert2")})
+)
+
+// newTestChainManager returns a chain manager of the testChainID channel,
+// whose ledger holds the block only
+func newTestChainManager(block *common.Block) deliver.ChainManager {
+	iter := &mockIterator{}
+	iter.On("Next").Return(block, common.Status_SUCCESS)
+	reader := &mockReader{}
+	reader.On("Iterator", mock.Anything).Return(iter, uint64(0))
+	reader.On("Height").Return(uint64(1))
+	chain := &mockChainSupport{}
+	chain.On("Sequence").Return(uint64(0))
+	chain.On("Reader").Return(reader)
+	chainManager := &mockChainManager{}
+	chainManager.On("GetChain", "testChainID").Return(chain, true)
+	return chainManager
+}
+
+func createSeekRequest(creator []byte, start *orderer.SeekPosition) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					Type:      int32(common.HeaderType_DELIVER_SEEK_INFO),
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: utils.MarshalOrPanic(&common.SignatureHeader{Creator: creator}),
+			},
+			Data: utils.MarshalOrPanic(&orderer.SeekInfo{
+				Start:    start,
+				Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+			}),
+		}),
+	}
+}
+
+func createAck(creator []byte, checkpoint *peer.DeliverCheckpoint) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					Type:      int32(common.HeaderType_DELIVER_ACK),
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: utils.MarshalOrPanic(&common.SignatureHeader{Creator: creator}),
+			},
+			Data: utils.MarshalOrPanic(checkpoint),
+		}),
+	}
+}
+
+func seekCheckpoint(consumerID string) *orderer.SeekPosition {
+	return &orderer.SeekPosition{
+		Type: &orderer.SeekPosition_Checkpoint{
+			Checkpoint: &orderer.SeekCheckpoint{ConsumerId: consumerID},
+		},
+	}
+}
+
+func newTestCheckpointStore(t *testing.T) (CheckpointStore, func()) {
+	dir, err := ioutil.TempDir("", "deliverCheckpoints")
+	require.NoError(t, err)
+	store := NewCheckpointStore(dir, 2)
+	return store, func() {
+		store.(*checkpointStore).db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestCheckpointStore(t *testing.T) {
+	store, cleanup := newTestCheckpointStore(t)
+	defer cleanup()
+
+	checkpoint, err := store.Checkpoint("testChainID", testCreator, "consumer")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 5, TxIndex: 2}))
+	checkpoint, err = store.Checkpoint("testChainID", testCreator, "consumer")
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 5, TxIndex: 2}, checkpoint)
+
+	checkpoint, err = store.Checkpoint("otherChainID", testCreator, "consumer")
+	assert.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	// acknowledgements of earlier transactions are ignored
+	require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 5, TxIndex: 1}))
+	require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 4, TxIndex: 9}))
+	checkpoint, err = store.Checkpoint("testChainID", testCreator, "consumer")
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 5, TxIndex: 2}, checkpoint)
+
+	require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 6}))
+	checkpoint, err = store.Checkpoint("testChainID", testCreator, "consumer")
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 6}, checkpoint)
+
+	t.Run("Other Identity", func(t *testing.T) {
+		checkpoint, err := store.Checkpoint("testChainID", otherCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Nil(t, checkpoint)
+
+		// each identity has its own consumers
+		require.NoError(t, store.Acknowledge("testChainID", otherCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 1}))
+		checkpoint, err = store.Checkpoint("testChainID", otherCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 1}, checkpoint)
+		checkpoint, err = store.Checkpoint("testChainID", testCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 6}, checkpoint)
+	})
+
+	t.Run("Maximum Consumers", func(t *testing.T) {
+		require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer2"}))
+		err := store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer3"})
+		assert.EqualError(t, err, "maximum number of consumers (2) reached on channel testChainID")
+
+		// the consumers of other identities and channels are counted apart
+		require.NoError(t, store.Acknowledge("testChainID", otherCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer3"}))
+		err = store.Acknowledge("testChainID", otherCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer4"})
+		assert.EqualError(t, err, "maximum number of consumers (2) reached on channel testChainID")
+		require.NoError(t, store.Acknowledge("otherChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer3"}))
+		// existing consumers can still acknowledge
+		require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer2", BlockNumber: 1}))
+	})
+
+	t.Run("Invalid Creator", func(t *testing.T) {
+		_, err := store.Checkpoint("testChainID", []byte("garbage"), "consumer")
+		assert.Error(t, err)
+		err = store.Acknowledge("testChainID", utils.MarshalOrPanic(&msp.SerializedIdentity{}), &peer.DeliverCheckpoint{ConsumerId: "consumer"})
+		assert.EqualError(t, err, "creator is not a serialized identity")
+	})
+}
+
+func TestEventsServer_DeliverCheckpoint(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1s")
+	block := createFilterTestBlock(t, []filterTestTx{
+		{txID: "tx0", chaincode: "mycc", event: "transfer", valid: true},
+		{txID: "tx1", chaincode: "mycc", event: "transfer", valid: true},
+	})
+	store, cleanup := newTestCheckpointStore(t)
+	defer cleanup()
+	server := NewDeliverEventsServerWithCheckpoints(false, defaultPolicyCheckerProvider, newTestChainManager(block), &disabled.Provider{}, store)
+
+	t.Run("Acknowledge", func(t *testing.T) {
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(testCreator, &orderer.SeekPosition{Type: &orderer.SeekPosition_Oldest{Oldest: &orderer.SeekOldest{}}}),
+			createAck(testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}),
+		}}
+
+		err := server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 2)
+		assert.NotNil(t, srv.responses[0].GetBlock())
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[1].GetStatus())
+
+		checkpoint, err := store.Checkpoint("testChainID", testCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, checkpoint)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		for _, test := range []struct {
+			name       string
+			checkpoint *peer.DeliverCheckpoint
+			status     common.Status
+		}{
+			{name: "Uncommitted Block", checkpoint: &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 1}, status: common.Status_BAD_REQUEST},
+			{name: "Missing Consumer ID", checkpoint: &peer.DeliverCheckpoint{BlockNumber: 0, TxIndex: 1}, status: common.Status_BAD_REQUEST},
+			{name: "Maximum Consumers", checkpoint: &peer.DeliverCheckpoint{ConsumerId: "consumer3"}, status: common.Status_CONFLICT},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				require.NoError(t, store.Acknowledge("testChainID", testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer2"}))
+				srv := &recordingDeliverServer{requests: []*common.Envelope{
+					createAck(testCreator, test.checkpoint),
+					createAck(testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 1}),
+				}}
+
+				// the stream ends with the status of the rejection
+				err := server.Deliver(srv)
+				assert.NoError(t, err)
+				require.Len(t, srv.responses, 1)
+				assert.Equal(t, test.status, srv.responses[0].GetStatus())
+
+				checkpoint, err := store.Checkpoint("testChainID", testCreator, "consumer")
+				assert.NoError(t, err)
+				assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, checkpoint)
+			})
+		}
+	})
+
+	t.Run("Resume Filtered", func(t *testing.T) {
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(testCreator, seekCheckpoint("consumer")),
+		}}
+
+		err := server.DeliverFiltered(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 3)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, srv.responses[0].GetCheckpoint())
+		filteredTransactions := srv.responses[1].GetFilteredBlock().FilteredTransactions
+		require.Len(t, filteredTransactions, 2)
+		assert.Equal(t, "tx1", filteredTransactions[0].Txid)
+		assert.Equal(t, common.HeaderType_CONFIG, filteredTransactions[1].Type)
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[2].GetStatus())
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(testCreator, seekCheckpoint("consumer")),
+		}}
+
+		err := server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 3)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, srv.responses[0].GetCheckpoint())
+		assert.Equal(t, block, srv.responses[1].GetBlock())
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[2].GetStatus())
+	})
+
+	t.Run("Unknown Consumer", func(t *testing.T) {
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(testCreator, seekCheckpoint("unknown")),
+		}}
+
+		err := server.DeliverWithFilter(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_NOT_FOUND, srv.responses[0].GetStatus())
+	})
+
+	t.Run("Other Identity", func(t *testing.T) {
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(otherCreator, seekCheckpoint("consumer")),
+		}}
+
+		err := server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_NOT_FOUND, srv.responses[0].GetStatus())
+
+		// the consumer ID of another identity can be used without affecting it
+		srv = &recordingDeliverServer{requests: []*common.Envelope{
+			createAck(otherCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 1}),
+			createSeekRequest(otherCreator, seekCheckpoint("consumer")),
+		}}
+
+		err = server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 3)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 1}, srv.responses[0].GetCheckpoint())
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[2].GetStatus())
+
+		checkpoint, err := store.Checkpoint("testChainID", testCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, checkpoint)
+	})
+
+	t.Run("Access Denied", func(t *testing.T) {
+		policyCheckerProvider := func(_ string) deliver.PolicyCheckerFunc {
+			return func(envelope *common.Envelope, _ string) error {
+				chdr, err := utils.ChannelHeader(envelope)
+				require.NoError(t, err)
+				if common.HeaderType(chdr.Type) == common.HeaderType_DELIVER_ACK {
+					return errors.New("access denied")
+				}
+				return nil
+			}
+		}
+		server := NewDeliverEventsServerWithCheckpoints(false, policyCheckerProvider, newTestChainManager(block), &disabled.Provider{}, store)
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createAck(testCreator, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 1}),
+		}}
+
+		err := server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_FORBIDDEN, srv.responses[0].GetStatus())
+
+		checkpoint, err := store.Checkpoint("testChainID", testCreator, "consumer")
+		assert.NoError(t, err)
+		assert.Equal(t, &peer.DeliverCheckpoint{ConsumerId: "consumer", BlockNumber: 0, TxIndex: 0}, checkpoint)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		server := NewDeliverEventsServer(false, defaultPolicyCheckerProvider, newTestChainManager(block), &disabled.Provider{})
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createSeekRequest(testCreator, seekCheckpoint("consumer")),
+		}}
+
+		err := server.Deliver(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_INTERNAL_SERVER_ERROR, srv.responses[0].GetStatus())
+	})
+}
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package peer
 
 import (
+	"context"
+	"io"
 	"runtime/debug"
 	"time"
 
@@ -33,15 +35,18 @@ type PolicyCheckerProvider func(resourceName string) deliver.PolicyCheckerFunc
 type server struct {
 	dh                    *deliver.Handler
 	policyCheckerProvider PolicyCheckerProvider
+	checkpoints           CheckpointStore
 }
 
 // blockResponseSender structure used to send block responses
 type blockResponseSender struct {
 	peer.Deliver_DeliverServer
+	*streamCheckpoints
 }
 
 // SendStatusResponse generates status reply proto message
 func (brs *blockResponseSender) SendStatusResponse(status common.Status) error {
+	brs.reset()
 	reply := &peer.DeliverResponse{
 		Type: &peer.DeliverResponse_Status{Status: status},
 	}
@@ -59,10 +64,12 @@ func (brs *blockResponseSender) SendBlockResponse(block *common.Block) error {
 // filteredBlockResponseSender structure used to send filtered block responses
 type filteredBlockResponseSender struct {
 	peer.Deliver_DeliverFilteredServer
+	*streamCheckpoints
 }
 
 // SendStatusResponse generates status reply proto message
 func (fbrs *filteredBlockResponseSender) SendStatusResponse(status common.Status) error {
+	fbrs.reset()
 	response := &peer.DeliverResponse{
 		Type: &peer.DeliverResponse_Status{Status: status},
 	}
@@ -78,7 +85,7 @@ func (fbrs *filteredBlockResponseSender) IsFiltered() bool {
 // SendBlockResponse generates deliver response with block message
 func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block) error {
 	// Generates filtered block response
-	b := blockEvent(*block)
+	b := blockEvent(*fbrs.unacknowledged(block))
 	filteredBlock, err := b.toFilteredBlock()
 	if err != nil {
 		logger.Warningf("Failed to generate filtered block due to: %s", err)
@@ -90,6 +97,72 @@ func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block)
 	return fbrs.Send(response)
 }
 
+// selectedBlockResponseSender structure used to send filtered block responses
+// holding the transactions and chaincode events selected by a deliver filter
+type selectedBlockResponseSender struct {
+	peer.Deliver_DeliverWithFilterServer
+	*streamCheckpoints
+	filter *deliverFilter
+}
+
+// SendStatusResponse generates status reply proto message
+func (sbrs *selectedBlockResponseSender) SendStatusResponse(status common.Status) error {
+	sbrs.reset()
+	response := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_Status{Status: status},
+	}
+	return sbrs.Send(response)
+}
+
+// IsFiltered is a marker method which indicates that this response sender
+// sends filtered blocks.
+func (sbrs *selectedBlockResponseSender) IsFiltered() bool {
+	return true
+}
+
+// SendBlockResponse generates deliver response with the selected transactions
+// of the block, blocks without any being sent empty so that clients can
+// follow the progress of the delivery
+func (sbrs *selectedBlockResponseSender) SendBlockResponse(block *common.Block) error {
+	b := blockEvent(*sbrs.unacknowledged(block))
+	filteredBlock, err := b.toSelectedBlock(sbrs.filter)
+	if err != nil {
+		logger.Warningf("Failed to generate filtered block due to: %s", err)
+		return sbrs.SendStatusResponse(common.Status_BAD_REQUEST)
+	}
+	response := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_FilteredBlock{FilteredBlock: filteredBlock},
+	}
+	return sbrs.Send(response)
+}
+
+// filterReceiver reads the deliver filter of each seek info envelope received
+// and sets it on the response sender
+type filterReceiver struct {
+	deliver.Receiver
+	sender *selectedBlockResponseSender
+}
+
+// Recv receives the next seek info envelope, and ends the stream after a
+// BAD_REQUEST status if its deliver filter is invalid
+func (fr *filterReceiver) Recv() (*common.Envelope, error) {
+	envelope, err := fr.Receiver.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := newDeliverFilter(envelope)
+	if err != nil {
+		logger.Warningf("Rejecting deliver request with an invalid filter: %s", err)
+		if err := fr.sender.SendStatusResponse(common.Status_BAD_REQUEST); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	fr.sender.filter = filter
+	return envelope, nil
+}
+
 // transactionActions aliasing for peer.TransactionAction pointers slice
 type transactionActions []*peer.TransactionAction
 
@@ -102,14 +175,17 @@ func (s *server) DeliverFiltered(srv peer.Deliver_DeliverFilteredServer) error {
 	logger.Debugf("Starting new DeliverFiltered handler")
 	defer dumpStacktraceOnPanic()
 	// getting policy checker based on resources.Event_FilteredBlock resource name
-	deliverServer := &deliver.Server{
-		Receiver:      srv,
-		PolicyChecker: s.policyCheckerProvider(resources.Event_FilteredBlock),
-		ResponseSender: &filteredBlockResponseSender{
-			Deliver_DeliverFilteredServer: srv,
-		},
-	}
-	return s.dh.Handle(srv.Context(), deliverServer)
+	policyChecker := s.policyCheckerProvider(resources.Event_FilteredBlock)
+	return s.handle(srv.Context(), srv, policyChecker, func(receiver deliver.Receiver) *deliver.Server {
+		return &deliver.Server{
+			Receiver:      receiver,
+			PolicyChecker: policyChecker,
+			ResponseSender: &filteredBlockResponseSender{
+				Deliver_DeliverFilteredServer: srv,
+				streamCheckpoints:             s.streamCheckpoints(srv.Send),
+			},
+		}
+	})
 }
 
 // Deliver sends a stream of blocks to a client after commitment
@@ -117,19 +193,85 @@ func (s *server) Deliver(srv peer.Deliver_DeliverServer) (err error) {
 	logger.Debugf("Starting new Deliver handler")
 	defer dumpStacktraceOnPanic()
 	// getting policy checker based on resources.Event_Block resource name
-	deliverServer := &deliver.Server{
-		PolicyChecker: s.policyCheckerProvider(resources.Event_Block),
-		Receiver:      srv,
-		ResponseSender: &blockResponseSender{
-			Deliver_DeliverServer: srv,
-		},
+	policyChecker := s.policyCheckerProvider(resources.Event_Block)
+	return s.handle(srv.Context(), srv, policyChecker, func(receiver deliver.Receiver) *deliver.Server {
+		return &deliver.Server{
+			PolicyChecker: policyChecker,
+			Receiver:      receiver,
+			ResponseSender: &blockResponseSender{
+				Deliver_DeliverServer: srv,
+				streamCheckpoints:     s.streamCheckpoints(srv.Send),
+			},
+		}
+	})
+}
+
+// DeliverWithFilter sends a stream of blocks holding the transactions and
+// chaincode events selected by the deliver filter of the request to a client
+// after commitment
+func (s *server) DeliverWithFilter(srv peer.Deliver_DeliverWithFilterServer) error {
+	logger.Debugf("Starting new DeliverWithFilter handler")
+	defer dumpStacktraceOnPanic()
+	// getting policy checker based on resources.Event_Block resource name,
+	// since the chaincode event payloads are sent
+	policyChecker := s.policyCheckerProvider(resources.Event_Block)
+	sender := &selectedBlockResponseSender{
+		Deliver_DeliverWithFilterServer: srv,
+		streamCheckpoints:               s.streamCheckpoints(srv.Send),
+	}
+	return s.handle(srv.Context(), srv, policyChecker, func(receiver deliver.Receiver) *deliver.Server {
+		return &deliver.Server{
+			PolicyChecker: policyChecker,
+			Receiver: &filterReceiver{
+				Receiver: receiver,
+				sender:   sender,
+			},
+			ResponseSender: sender,
+		}
+	})
+}
+
+// handle serves the requests received by the receiver with the deliver
+// server returned by newServer. When checkpoints are enabled, the receiver
+// also stores the checkpoints acknowledged on the stream, and the first
+// acknowledgement rejected ends the stream with a status response.
+func (s *server) handle(ctx context.Context, receiver deliver.Receiver, policyChecker deliver.PolicyChecker, newServer func(deliver.Receiver) *deliver.Server) error {
+	if s.checkpoints == nil {
+		return s.dh.Handle(ctx, newServer(receiver))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	checkpointReceiver := newCheckpointReceiver(ctx, cancel, receiver, s.checkpoints, policyChecker, s.dh.ChainManager)
+	deliverServer := newServer(checkpointReceiver)
+	err := s.dh.Handle(ctx, deliverServer)
+	if status, rejected := checkpointReceiver.rejection(); rejected {
+		return deliverServer.SendStatusResponse(status)
+	}
+	return err
+}
+
+func (s *server) streamCheckpoints(send func(*peer.DeliverResponse) error) *streamCheckpoints {
+	return &streamCheckpoints{
+		store: s.checkpoints,
+		send:  send,
 	}
-	return s.dh.Handle(srv.Context(), deliverServer)
 }
 
 // NewDeliverEventsServer creates a peer.Deliver server to deliver block and
 // filtered block events
 func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider) peer.DeliverServer {
+	return newDeliverEventsServer(mutualTLS, policyCheckerProvider, chainManager, metricsProvider, nil)
+}
+
+// NewDeliverEventsServerWithCheckpoints creates a peer.Deliver server which,
+// in addition, resumes the delivery of named consumers from the checkpoints
+// they acknowledge, stored in checkpoints
+func NewDeliverEventsServerWithCheckpoints(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider, checkpoints CheckpointStore) peer.DeliverServer {
+	return newDeliverEventsServer(mutualTLS, policyCheckerProvider, chainManager, metricsProvider, checkpoints)
+}
+
+func newDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider, checkpoints CheckpointStore) *server {
 	timeWindow := viper.GetDuration("peer.authentication.timewindow")
 	if timeWindow == 0 {
 		defaultTimeWindow := 15 * time.Minute
@@ -140,6 +282,7 @@ func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerP
 	return &server{
 		dh:                    deliver.NewHandler(chainManager, timeWindow, mutualTLS, metrics, false),
 		policyCheckerProvider: policyCheckerProvider,
+		checkpoints:           checkpoints,
 	}
 }
 
@@ -160,7 +303,7 @@ func (block *blockEvent) toFilteredBlock() (*peer.FilteredBlock, error) {
 	}
 
 	txsFltr := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
-	for txIndex, ebytes := range block.Data.Data {
+	for txIndex, ebytes := range block.Data.GetData() {
 		var env *common.Envelope
 		var err error
 
@@ -231,19 +374,9 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 			logger.Debugf("chaincode action, the payload action is nil, skipping")
 			continue
 		}
-		propRespPayload, err := utils.GetProposalResponsePayload(chaincodeActionPayload.Action.ProposalResponsePayload)
+		_, ccEvent, err := chaincodeAction(chaincodeActionPayload.Action)
 		if err != nil {
-			return nil, errors.WithMessage(err, "error unmarshal proposal response payload for block event")
-		}
-
-		caPayload, err := utils.GetChaincodeAction(propRespPayload.Extension)
-		if err != nil {
-			return nil, errors.WithMessage(err, "error unmarshal chaincode action for block event")
-		}
-
-		ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
-		if err != nil {
-			return nil, errors.WithMessage(err, "error unmarshal chaincode event for block event")
+			return nil, err
 		}
 
 		if ccEvent.GetChaincodeId() != "" {
@@ -262,6 +395,26 @@ func (ta transactionActions) toFilteredActions() (*peer.FilteredTransaction_Tran
 	}, nil
 }
 
+// chaincodeAction extracts the chaincode action and its chaincode event from
+// an endorsed action
+func chaincodeAction(action *peer.ChaincodeEndorsedAction) (*peer.ChaincodeAction, *peer.ChaincodeEvent, error) {
+	propRespPayload, err := utils.GetProposalResponsePayload(action.ProposalResponsePayload)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "error unmarshal proposal response payload for block event")
+	}
+
+	caPayload, err := utils.GetChaincodeAction(propRespPayload.Extension)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "error unmarshal chaincode action for block event")
+	}
+
+	ccEvent, err := utils.GetChaincodeEvents(caPayload.Events)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "error unmarshal chaincode event for block event")
+	}
+	return caPayload, ccEvent, nil
+}
+
 func dumpStacktraceOnPanic() {
 	func() {
 		if r := recover(); r != nil {
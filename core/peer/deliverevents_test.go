@@ -523,3 +523,19 @@ func createTestBlock(data []*common.Envelope) (*common.Block, error) {
 	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(data))
 	return block, nil
 }
+
+func TestToFilteredBlockHeaderOnly(t *testing.T) {
+	// blocks delivered header only have no data
+	b := blockEvent(common.Block{
+		Header:   &common.BlockHeader{Number: 5},
+		Metadata: &common.BlockMetadata{Metadata: make([][]byte, 4)},
+	})
+
+	filteredBlock, err := b.toFilteredBlock()
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.FilteredBlock{Number: 5}, filteredBlock)
+
+	filteredBlock, err = b.toSelectedBlock(&deliverFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, &peer.FilteredBlock{Number: 5}, filteredBlock)
+}
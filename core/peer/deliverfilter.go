@@ -0,0 +1,183 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"regexp"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// deliverFilter selects the transactions and chaincode events of the blocks
+// sent by DeliverWithFilter
+type deliverFilter struct {
+	chaincodeNames map[string]struct{}
+	eventName      *regexp.Regexp
+	onlyValid      bool
+}
+
+// newDeliverFilter reads the deliver filter carried by the channel header
+// extension of a seek info envelope. Envelopes without a channel header are
+// rejected by the deliver handler, so they get a filter selecting every
+// transaction.
+func newDeliverFilter(envelope *common.Envelope) (*deliverFilter, error) {
+	filter := &deliverFilter{}
+	chdr, err := utils.ChannelHeader(envelope)
+	if err != nil {
+		return filter, nil
+	}
+
+	spec := &peer.DeliverFilter{}
+	if err := proto.Unmarshal(chdr.Extension, spec); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling deliver filter")
+	}
+
+	if len(spec.ChaincodeNames) > 0 {
+		filter.chaincodeNames = map[string]struct{}{}
+		for _, name := range spec.ChaincodeNames {
+			filter.chaincodeNames[name] = struct{}{}
+		}
+	}
+	if spec.EventNamePattern != "" {
+		filter.eventName, err = regexp.Compile("^(?:" + spec.EventNamePattern + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid event name pattern %s", spec.EventNamePattern)
+		}
+	}
+	filter.onlyValid = spec.OnlyValid
+	return filter, nil
+}
+
+// selectsAll returns whether transactions are selected whatever their
+// chaincode actions
+func (f *deliverFilter) selectsAll() bool {
+	return f.chaincodeNames == nil && f.eventName == nil
+}
+
+// toSelectedBlock returns a filtered block holding the transactions of the
+// block selected by the filter, with their chaincode events and payloads
+func (block *blockEvent) toSelectedBlock(filter *deliverFilter) (*peer.FilteredBlock, error) {
+	filteredBlock := &peer.FilteredBlock{
+		Number: block.Header.Number,
+	}
+
+	txsFltr := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	for txIndex, ebytes := range block.Data.GetData() {
+		if ebytes == nil {
+			logger.Debugf("got nil data bytes for tx index %d, "+
+				"block num %d", txIndex, block.Header.Number)
+			continue
+		}
+
+		env, err := utils.GetEnvelopeFromBlock(ebytes)
+		if err != nil {
+			logger.Errorf("error getting tx from block, %s", err)
+			continue
+		}
+
+		payload, err := utils.GetPayload(env)
+		if err != nil {
+			return nil, errors.WithMessage(err, "could not extract payload from envelope")
+		}
+
+		if payload.Header == nil {
+			logger.Debugf("transaction payload header is nil, %d, block num %d",
+				txIndex, block.Header.Number)
+			continue
+		}
+		chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		filteredBlock.ChannelId = chdr.ChannelId
+
+		if filter.onlyValid && txsFltr.IsInvalid(txIndex) {
+			continue
+		}
+
+		filteredTransaction := &peer.FilteredTransaction{
+			Txid:             chdr.TxId,
+			Type:             common.HeaderType(chdr.Type),
+			TxValidationCode: txsFltr.Flag(txIndex),
+		}
+
+		if filteredTransaction.Type == common.HeaderType_ENDORSER_TRANSACTION {
+			tx, err := utils.GetTransaction(payload.Data)
+			if err != nil {
+				return nil, errors.WithMessage(err, "error unmarshal transaction payload for block event")
+			}
+
+			actions, selected, err := transactionActions(tx.Actions).toSelectedActions(filter)
+			if err != nil {
+				logger.Errorf(err.Error())
+				return nil, err
+			}
+			if !selected {
+				continue
+			}
+			filteredTransaction.Data = actions
+		} else if !filter.selectsAll() {
+			continue
+		}
+
+		filteredBlock.FilteredTransactions = append(filteredBlock.FilteredTransactions, filteredTransaction)
+	}
+
+	return filteredBlock, nil
+}
+
+// toSelectedActions returns the chaincode events of the actions selected by
+// the filter, and whether the transaction holding the actions is selected
+func (ta transactionActions) toSelectedActions(filter *deliverFilter) (*peer.FilteredTransaction_TransactionActions, bool, error) {
+	transactionActions := &peer.FilteredTransactionActions{}
+	selected := filter.chaincodeNames == nil
+	for _, action := range ta {
+		chaincodeActionPayload, err := utils.GetChaincodeActionPayload(action.Payload)
+		if err != nil {
+			return nil, false, errors.WithMessage(err, "error unmarshal transaction action payload for block event")
+		}
+
+		if chaincodeActionPayload.Action == nil {
+			logger.Debugf("chaincode action, the payload action is nil, skipping")
+			continue
+		}
+		caPayload, ccEvent, err := chaincodeAction(chaincodeActionPayload.Action)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if filter.chaincodeNames != nil {
+			if _, ok := filter.chaincodeNames[caPayload.GetChaincodeId().GetName()]; !ok {
+				continue
+			}
+			selected = true
+		}
+
+		if ccEvent.GetChaincodeId() == "" {
+			continue
+		}
+		if filter.eventName != nil && !filter.eventName.MatchString(ccEvent.EventName) {
+			continue
+		}
+		transactionActions.ChaincodeActions = append(transactionActions.ChaincodeActions, &peer.FilteredChaincodeAction{
+			ChaincodeEvent: ccEvent,
+		})
+	}
+
+	if filter.eventName != nil && len(transactionActions.ChaincodeActions) == 0 {
+		selected = false
+	}
+	return &peer.FilteredTransaction_TransactionActions{
+		TransactionActions: transactionActions,
+	}, selected, nil
+}
@@ -0,0 +1,270 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/deliver"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	peer2 "google.golang.org/grpc/peer"
+)
+
+// recordingDeliverServer replays a list of requests and records the
+// responses sent
+type recordingDeliverServer struct {
+	mockDeliverServer
+	requests  []*common.Envelope
+	responses []*peer.DeliverResponse
+}
+
+func (s *recordingDeliverServer) Context() context.Context {
+	return peer2.NewContext(context.TODO(), &peer2.Peer{})
+}
+
+func (s *recordingDeliverServer) Recv() (*common.Envelope, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *recordingDeliverServer) Send(response *peer.DeliverResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+type filterTestTx struct {
+	txID      string
+	chaincode string
+	event     string
+	valid     bool
+}
+
+func createFilterTestBlock(t *testing.T, txs []filterTestTx) *common.Block {
+	var envs []*common.Envelope
+	for _, tx := range txs {
+		eventBytes := utils.MarshalOrPanic(&peer.ChaincodeEvent{
+			ChaincodeId: tx.chaincode,
+			EventName:   tx.event,
+			TxId:        tx.txID,
+			Payload:     []byte("payload of " + tx.txID),
+		})
+		actionBytes := utils.MarshalOrPanic(&peer.ChaincodeAction{
+			ChaincodeId: &peer.ChaincodeID{Name: tx.chaincode},
+			Events:      eventBytes,
+		})
+		chaincodeActionPayload := &peer.ChaincodeActionPayload{
+			Action: &peer.ChaincodeEndorsedAction{
+				ProposalResponsePayload: utils.MarshalOrPanic(&peer.ProposalResponsePayload{Extension: actionBytes}),
+			},
+		}
+		payload, err := createEndorsement("testChainID", tx.txID, chaincodeActionPayload)
+		require.NoError(t, err)
+		envs = append(envs, &common.Envelope{Payload: utils.MarshalOrPanic(payload)})
+	}
+	envs = append(envs, &common.Envelope{Payload: utils.MarshalOrPanic(&common.Payload{
+		Header: &common.Header{
+			ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+				ChannelId: "testChainID",
+				Type:      int32(common.HeaderType_CONFIG),
+			}),
+		},
+	})})
+
+	block, err := createTestBlock(envs)
+	require.NoError(t, err)
+	for i, tx := range txs {
+		if !tx.valid {
+			block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER][i] = uint8(peer.TxValidationCode_MVCC_READ_CONFLICT)
+		}
+	}
+	return block
+}
+
+func createFilterRequest(filter *peer.DeliverFilter) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+					Extension: utils.MarshalOrPanic(filter),
+				}),
+				SignatureHeader: utils.MarshalOrPanic(&common.SignatureHeader{}),
+			},
+			Data: utils.MarshalOrPanic(&orderer.SeekInfo{
+				Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+			}),
+		}),
+	}
+}
+
+func TestSelectedBlockResponseSenderIsFiltered(t *testing.T) {
+	var sbrs interface{} = &selectedBlockResponseSender{}
+	filtered, ok := sbrs.(deliver.Filtered)
+	assert.True(t, ok, "should be filtered")
+	assert.True(t, filtered.IsFiltered(), "should return true from IsFiltered")
+}
+
+func TestToSelectedBlock(t *testing.T) {
+	block := createFilterTestBlock(t, []filterTestTx{
+		{txID: "tx0", chaincode: "mycc", event: "transfer", valid: true},
+		{txID: "tx1", chaincode: "mycc", event: "transferFailed", valid: false},
+		{txID: "tx2", chaincode: "othercc", event: "transfer", valid: true},
+		{txID: "tx3", chaincode: "othercc", event: "mint", valid: true},
+	})
+
+	tests := []struct {
+		name   string
+		filter *peer.DeliverFilter
+		txIDs  []string
+	}{
+		{name: "Empty", filter: &peer.DeliverFilter{}, txIDs: []string{"tx0", "tx1", "tx2", "tx3", ""}},
+		{name: "Chaincode Names", filter: &peer.DeliverFilter{ChaincodeNames: []string{"mycc"}}, txIDs: []string{"tx0", "tx1"}},
+		{name: "Event Name Pattern", filter: &peer.DeliverFilter{EventNamePattern: "transfer"}, txIDs: []string{"tx0", "tx2"}},
+		{name: "Event Name Prefix", filter: &peer.DeliverFilter{EventNamePattern: "transfer.*"}, txIDs: []string{"tx0", "tx1", "tx2"}},
+		{name: "Only Valid", filter: &peer.DeliverFilter{OnlyValid: true}, txIDs: []string{"tx0", "tx2", "tx3", ""}},
+		{
+			name:   "Combined",
+			filter: &peer.DeliverFilter{ChaincodeNames: []string{"mycc", "othercc"}, EventNamePattern: "transfer|mint", OnlyValid: true},
+			txIDs:  []string{"tx0", "tx2", "tx3"},
+		},
+		{name: "No Match", filter: &peer.DeliverFilter{ChaincodeNames: []string{"unknowncc"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := newDeliverFilter(createFilterRequest(test.filter))
+			require.NoError(t, err)
+
+			b := blockEvent(*block)
+			filteredBlock, err := b.toSelectedBlock(filter)
+			require.NoError(t, err)
+			assert.Equal(t, "testChainID", filteredBlock.ChannelId)
+
+			var txIDs []string
+			for _, tx := range filteredBlock.FilteredTransactions {
+				txIDs = append(txIDs, tx.Txid)
+				if tx.Type != common.HeaderType_ENDORSER_TRANSACTION {
+					continue
+				}
+				chaincodeActions := tx.GetTransactionActions().ChaincodeActions
+				require.Len(t, chaincodeActions, 1)
+				assert.Equal(t, tx.Txid, chaincodeActions[0].ChaincodeEvent.TxId)
+				assert.Equal(t, []byte("payload of "+tx.Txid), chaincodeActions[0].ChaincodeEvent.Payload)
+			}
+			assert.Equal(t, test.txIDs, txIDs)
+		})
+	}
+}
+
+func TestNewDeliverFilter(t *testing.T) {
+	_, err := newDeliverFilter(createFilterRequest(&peer.DeliverFilter{EventNamePattern: "transfer("}))
+	assert.EqualError(t, err, "invalid event name pattern transfer(: error parsing regexp: missing closing ): `^(?:transfer()$`")
+
+	request := createFilterRequest(&peer.DeliverFilter{})
+	payload, err := utils.UnmarshalPayload(request.Payload)
+	require.NoError(t, err)
+	payload.Header.ChannelHeader = utils.MarshalOrPanic(&common.ChannelHeader{Extension: []byte("garbage")})
+	_, err = newDeliverFilter(&common.Envelope{Payload: utils.MarshalOrPanic(payload)})
+	assert.Contains(t, err.Error(), "error unmarshaling deliver filter")
+
+	filter, err := newDeliverFilter(&common.Envelope{Payload: []byte("garbage")})
+	assert.NoError(t, err)
+	assert.True(t, filter.selectsAll())
+}
+
+func TestEventsServer_DeliverWithFilter(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1s")
+	block := createFilterTestBlock(t, []filterTestTx{
+		{txID: "tx0", chaincode: "mycc", event: "transfer", valid: true},
+		{txID: "tx1", chaincode: "othercc", event: "transfer", valid: true},
+	})
+
+	newChainManager := func() deliver.ChainManager {
+		iter := &mockIterator{}
+		iter.On("Next").Return(block, common.Status_SUCCESS)
+		reader := &mockReader{}
+		reader.On("Iterator", mock.Anything).Return(iter, uint64(0))
+		reader.On("Height").Return(uint64(1))
+		chain := &mockChainSupport{}
+		chain.On("Sequence").Return(uint64(0))
+		chain.On("Reader").Return(reader)
+		chainManager := &mockChainManager{}
+		chainManager.On("GetChain", "testChainID").Return(chain, true)
+		return chainManager
+	}
+
+	t.Run("Selected Transactions", func(t *testing.T) {
+		var resourceNames []string
+		policyCheckerProvider := func(resourceName string) deliver.PolicyCheckerFunc {
+			resourceNames = append(resourceNames, resourceName)
+			return defaultPolicyCheckerProvider(resourceName)
+		}
+		server := NewDeliverEventsServer(false, policyCheckerProvider, newChainManager(), &disabled.Provider{})
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createFilterRequest(&peer.DeliverFilter{ChaincodeNames: []string{"mycc"}}),
+			createFilterRequest(&peer.DeliverFilter{ChaincodeNames: []string{"othercc"}}),
+		}}
+
+		err := server.DeliverWithFilter(srv)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{resources.Event_Block}, resourceNames)
+		require.Len(t, srv.responses, 4)
+		assert.Equal(t, "tx0", srv.responses[0].GetFilteredBlock().FilteredTransactions[0].Txid)
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[1].GetStatus())
+		assert.Equal(t, "tx1", srv.responses[2].GetFilteredBlock().FilteredTransactions[0].Txid)
+		assert.Equal(t, common.Status_SUCCESS, srv.responses[3].GetStatus())
+	})
+
+	t.Run("Invalid Filter", func(t *testing.T) {
+		server := NewDeliverEventsServer(false, defaultPolicyCheckerProvider, newChainManager(), &disabled.Provider{})
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createFilterRequest(&peer.DeliverFilter{EventNamePattern: "("}),
+			createFilterRequest(&peer.DeliverFilter{}),
+		}}
+
+		err := server.DeliverWithFilter(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_BAD_REQUEST, srv.responses[0].GetStatus())
+	})
+
+	t.Run("Access Denied", func(t *testing.T) {
+		policyCheckerProvider := func(_ string) deliver.PolicyCheckerFunc {
+			return func(_ *common.Envelope, _ string) error {
+				return errors.New("access denied")
+			}
+		}
+		server := NewDeliverEventsServer(false, policyCheckerProvider, newChainManager(), &disabled.Provider{})
+		srv := &recordingDeliverServer{requests: []*common.Envelope{
+			createFilterRequest(&peer.DeliverFilter{}),
+		}}
+
+		err := server.DeliverWithFilter(srv)
+		assert.NoError(t, err)
+		require.Len(t, srv.responses, 1)
+		assert.Equal(t, common.Status_FORBIDDEN, srv.responses[0].GetStatus())
+	})
+}
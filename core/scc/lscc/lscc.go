@@ -283,6 +283,21 @@ func checkCollectionMemberPolicy(collectionConfig *common.CollectionConfig, mspm
 				}
 			}
 
+		case mb.MSPPrincipal_ATTRIBUTE:
+			mspattr := &mb.MSPAttribute{}
+			err := proto.Unmarshal(principal.Principal, mspattr)
+			if err != nil {
+				return errors.Wrapf(err, "collection-name: %s -- cannot unmarshal identities", coll.GetName())
+			}
+			orgID = mspattr.MspIdentifier
+			// the msp map is indexed using msp IDs - this behavior is implementation specific, making the following check a bit of a hack
+			for mspid := range msps {
+				if mspid == orgID {
+					found = true
+					break
+				}
+			}
+
 		case mb.MSPPrincipal_IDENTITY:
 			orgID = "identity principal"
 			for _, msp := range msps {
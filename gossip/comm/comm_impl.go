@@ -77,11 +77,15 @@ func NewCommInstance(s *grpc.Server, certs *common.TLSCertificates, idStore iden
 		connTimeout:    config.ConnTimeout,
 		recvBuffSize:   config.RecvBuffSize,
 		sendBuffSize:   config.SendBuffSize,
+		compression:    config.CompressionAlgorithms,
+		threshold:      config.CompressionThreshold,
 	}
 
 	connConfig := ConnConfig{
-		RecvBuffSize: config.RecvBuffSize,
-		SendBuffSize: config.SendBuffSize,
+		RecvBuffSize:          config.RecvBuffSize,
+		SendBuffSize:          config.SendBuffSize,
+		CompressionAlgorithms: config.CompressionAlgorithms,
+		CompressionThreshold:  config.CompressionThreshold,
 	}
 
 	commInst.connStore = newConnStore(commInst, commInst.logger, connConfig)
@@ -97,6 +101,12 @@ type CommConfig struct {
 	ConnTimeout  time.Duration // Connection timeout
 	RecvBuffSize int           // Buffer size of received messages
 	SendBuffSize int           // Buffer size of sending messages
+	// CompressionAlgorithms are the algorithms used to compress messages that carry blocks
+	// and private data, in order of preference. Only algorithms that the remote peer
+	// supports are used, and messages aren't compressed if there are none.
+	CompressionAlgorithms []proto.CompressionAlgorithm
+	// CompressionThreshold is the minimum payload size of a message to be compressed
+	CompressionThreshold int
 }
 
 type commImpl struct {
@@ -122,6 +132,8 @@ type commImpl struct {
 	connTimeout    time.Duration
 	recvBuffSize   int
 	sendBuffSize   int
+	compression    []proto.CompressionAlgorithm
+	threshold      int
 }
 
 func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidType) (*connection, error) {
@@ -178,8 +190,10 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 				}
 			}
 			connConfig := ConnConfig{
-				RecvBuffSize: c.recvBuffSize,
-				SendBuffSize: c.sendBuffSize,
+				RecvBuffSize:          c.recvBuffSize,
+				SendBuffSize:          c.sendBuffSize,
+				CompressionAlgorithms: c.compression,
+				CompressionThreshold:  c.threshold,
 			}
 			conn := newConnection(cl, cc, stream, nil, c.metrics, connConfig)
 			conn.pkiID = pkiID
@@ -459,6 +473,7 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 			Signature:  m.Signature,
 			SignedData: m.Payload,
 		},
+		Compression: negotiateCompression(c.compression, receivedMsg.CompressionAlgorithms),
 	}
 
 	// if TLS is enabled and detected, verify remote peer
@@ -629,9 +644,10 @@ func (c *commImpl) createConnectionMsg(pkiID common.PKIidType, certHash []byte,
 		Nonce: 0,
 		Content: &proto.GossipMessage_Conn{
 			Conn: &proto.ConnEstablish{
-				TlsCertHash: certHash,
-				Identity:    cert,
-				PkiId:       pkiID,
+				TlsCertHash:           certHash,
+				Identity:              cert,
+				PkiId:                 pkiID,
+				CompressionAlgorithms: c.compression,
 			},
 		},
 	}
@@ -438,6 +438,33 @@ func TestHandshake(t *testing.T) {
 	tempL.Close()
 }
 
+func TestHandshakeAfterCertificateReload(t *testing.T) {
+	t.Parallel()
+	port1, gRPCServer1, certs1, secureDialOpts1, dialOpts1 := util.CreateGRPCLayer()
+	comm1 := newCommInstanceOnly(t, naiveSec, gRPCServer1, certs1, secureDialOpts1, dialOpts1...)
+	defer comm1.Stop()
+	comm2, _ := newCommInstance(t, naiveSec)
+	defer comm2.Stop()
+
+	// Obtain a renewed key pair issued by the same CA
+	_, renewedServer, renewedCerts, _, _ := util.CreateGRPCLayer()
+	renewedServer.Listener().Close()
+	renewedCert := renewedCerts.TLSServerCert.Load().(*tls.Certificate)
+
+	// Reload the key pair the way the peer does: in the gRPC server, and in
+	// the certificates gossip presents in its handshakes
+	gRPCServer1.SetServerCertificate(*renewedCert)
+	certs1.TLSServerCert.Store(renewedCert)
+
+	inc1 := comm1.Accept(acceptAll)
+	comm2.Send(createGossipMsg(), remotePeer(port1))
+	select {
+	case <-inc1:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "Didn't receive a message, seems like handshake failed")
+	}
+}
+
 func TestBasic(t *testing.T) {
 	t.Parallel()
 	comm1, port1 := newCommInstance(t, naiveSec)
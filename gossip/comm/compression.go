@@ -0,0 +1,158 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+	corecomm "github.com/hyperledger/fabric/core/comm"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefCompressionThreshold is the default minimum size of a message payload that is compressed
+	DefCompressionThreshold = 64 * 1024
+)
+
+// compressor compresses and decompresses the payloads of envelopes
+type compressor interface {
+	compress(payload []byte) ([]byte, error)
+	// decompress decompresses the given payload,
+	// and fails if the decompressed payload is larger than maxSize
+	decompress(payload []byte, maxSize int) ([]byte, error)
+}
+
+var compressors = map[proto.CompressionAlgorithm]compressor{
+	proto.CompressionAlgorithm_GZIP:   gzipCompressor{},
+	proto.CompressionAlgorithm_SNAPPY: snappyCompressor{},
+}
+
+// ParseCompressionAlgorithms converts the given names of compression
+// algorithms (e.g "gzip", "snappy") to CompressionAlgorithms
+func ParseCompressionAlgorithms(names []string) ([]proto.CompressionAlgorithm, error) {
+	var algorithms []proto.CompressionAlgorithm
+	for _, name := range names {
+		algorithm, exists := proto.CompressionAlgorithm_value[strings.ToUpper(name)]
+		if !exists || algorithm == int32(proto.CompressionAlgorithm_UNCOMPRESSED) {
+			return nil, errors.Errorf("unsupported compression algorithm: %s", name)
+		}
+		algorithms = append(algorithms, proto.CompressionAlgorithm(algorithm))
+	}
+	return algorithms, nil
+}
+
+// negotiateCompression returns the most preferred algorithm out of the local algorithms
+// that the remote peer supports, or UNCOMPRESSED if there is none
+func negotiateCompression(local []proto.CompressionAlgorithm, remote []proto.CompressionAlgorithm) proto.CompressionAlgorithm {
+	for _, algorithm := range local {
+		for _, remoteAlgorithm := range remote {
+			if algorithm == remoteAlgorithm {
+				return algorithm
+			}
+		}
+	}
+	return proto.CompressionAlgorithm_UNCOMPRESSED
+}
+
+// isCompressible returns whether the given message carries
+// blocks or private data, and is worth compressing
+func isCompressible(msg *proto.SignedGossipMessage) bool {
+	return msg.IsDataMsg() || msg.GetStateResponse() != nil ||
+		msg.GetPrivateData() != nil || msg.GetPrivateRes() != nil
+}
+
+// compressEnvelope returns an envelope with the payload of the given envelope
+// compressed with the given algorithm
+func compressEnvelope(envelope *proto.Envelope, algorithm proto.CompressionAlgorithm) (*proto.Envelope, error) {
+	c, exists := compressors[algorithm]
+	if !exists {
+		return nil, errors.Errorf("unsupported compression algorithm: %s", algorithm)
+	}
+	payload, err := c.compress(envelope.Payload)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &proto.Envelope{
+		Payload:        payload,
+		Signature:      envelope.Signature,
+		SecretEnvelope: envelope.SecretEnvelope,
+		Compression:    algorithm,
+	}, nil
+}
+
+// decompressEnvelope returns the given envelope with its payload decompressed,
+// or the envelope itself if it isn't compressed
+func decompressEnvelope(envelope *proto.Envelope, supported []proto.CompressionAlgorithm) (*proto.Envelope, error) {
+	if envelope.Compression == proto.CompressionAlgorithm_UNCOMPRESSED {
+		return envelope, nil
+	}
+	if negotiateCompression([]proto.CompressionAlgorithm{envelope.Compression}, supported) != envelope.Compression {
+		return nil, errors.Errorf("envelope is compressed with %s, which wasn't negotiated", envelope.Compression)
+	}
+	payload, err := compressors[envelope.Compression].decompress(envelope.Payload, corecomm.MaxRecvMsgSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed decompressing %s envelope", envelope.Compression)
+	}
+	return &proto.Envelope{
+		Payload:        payload,
+		Signature:      envelope.Signature,
+		SecretEnvelope: envelope.SecretEnvelope,
+	}, nil
+}
+
+type gzipCompressor struct{}
+
+func (gzipCompressor) compress(payload []byte) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	w := gzip.NewWriter(buff)
+	if _, err := w.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+func (gzipCompressor) decompress(payload []byte, maxSize int) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	decompressed, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxSize {
+		return nil, errors.Errorf("decompressed payload exceeds %d bytes", maxSize)
+	}
+	return decompressed, nil
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) compress(payload []byte) ([]byte, error) {
+	return snappy.Encode(nil, payload), nil
+}
+
+func (snappyCompressor) decompress(payload []byte, maxSize int) ([]byte, error) {
+	size, err := snappy.DecodedLen(payload)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxSize {
+		return nil, errors.Errorf("decompressed payload exceeds %d bytes", maxSize)
+	}
+	return snappy.Decode(nil, payload)
+}
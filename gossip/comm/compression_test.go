@@ -0,0 +1,217 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/golang/protobuf/proto"
+	corecomm "github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/gossip/identity"
+	"github.com/hyperledger/fabric/gossip/metrics"
+	"github.com/hyperledger/fabric/gossip/metrics/mocks"
+	"github.com/hyperledger/fabric/gossip/util"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	gzipAlgorithm   = proto.CompressionAlgorithm_GZIP
+	snappyAlgorithm = proto.CompressionAlgorithm_SNAPPY
+)
+
+func TestParseCompressionAlgorithms(t *testing.T) {
+	algorithms, err := ParseCompressionAlgorithms(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, algorithms)
+
+	algorithms, err = ParseCompressionAlgorithms([]string{"snappy", "GZIP"})
+	assert.NoError(t, err)
+	assert.Equal(t, []proto.CompressionAlgorithm{snappyAlgorithm, gzipAlgorithm}, algorithms)
+
+	_, err = ParseCompressionAlgorithms([]string{"gzip", "zstd"})
+	assert.EqualError(t, err, "unsupported compression algorithm: zstd")
+
+	_, err = ParseCompressionAlgorithms([]string{"uncompressed"})
+	assert.EqualError(t, err, "unsupported compression algorithm: uncompressed")
+}
+
+func TestNegotiateCompression(t *testing.T) {
+	for _, testCase := range []struct {
+		name     string
+		local    []proto.CompressionAlgorithm
+		remote   []proto.CompressionAlgorithm
+		expected proto.CompressionAlgorithm
+	}{
+		{
+			name:     "local preference wins",
+			local:    []proto.CompressionAlgorithm{gzipAlgorithm, snappyAlgorithm},
+			remote:   []proto.CompressionAlgorithm{snappyAlgorithm, gzipAlgorithm},
+			expected: gzipAlgorithm,
+		},
+		{
+			name:     "only common algorithm",
+			local:    []proto.CompressionAlgorithm{gzipAlgorithm, snappyAlgorithm},
+			remote:   []proto.CompressionAlgorithm{snappyAlgorithm},
+			expected: snappyAlgorithm,
+		},
+		{
+			name:     "remote peer doesn't support compression",
+			local:    []proto.CompressionAlgorithm{gzipAlgorithm},
+			expected: proto.CompressionAlgorithm_UNCOMPRESSED,
+		},
+		{
+			name:     "compression disabled",
+			remote:   []proto.CompressionAlgorithm{gzipAlgorithm},
+			expected: proto.CompressionAlgorithm_UNCOMPRESSED,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, negotiateCompression(testCase.local, testCase.remote))
+		})
+	}
+}
+
+func TestCompressEnvelope(t *testing.T) {
+	envelope := &proto.Envelope{
+		Payload:   bytes.Repeat([]byte{1, 2, 3, 4}, 1024),
+		Signature: []byte{5, 6, 7},
+		SecretEnvelope: &proto.SecretEnvelope{
+			Payload: []byte{8, 9},
+		},
+	}
+
+	for _, algorithm := range []proto.CompressionAlgorithm{gzipAlgorithm, snappyAlgorithm} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			compressed, err := compressEnvelope(envelope, algorithm)
+			assert.NoError(t, err)
+			assert.Equal(t, algorithm, compressed.Compression)
+			assert.True(t, len(compressed.Payload) < len(envelope.Payload))
+			assert.Equal(t, envelope.Signature, compressed.Signature)
+			assert.Equal(t, envelope.SecretEnvelope, compressed.SecretEnvelope)
+
+			decompressed, err := decompressEnvelope(compressed, []proto.CompressionAlgorithm{gzipAlgorithm, snappyAlgorithm})
+			assert.NoError(t, err)
+			assert.Equal(t, envelope, decompressed)
+
+			_, err = decompressEnvelope(compressed, nil)
+			assert.EqualError(t, err, fmt.Sprintf("envelope is compressed with %s, which wasn't negotiated", algorithm))
+
+			compressed.Payload = []byte{0xff, 0xff, 0xff}
+			_, err = decompressEnvelope(compressed, []proto.CompressionAlgorithm{algorithm})
+			assert.Contains(t, err.Error(), fmt.Sprintf("failed decompressing %s envelope", algorithm))
+		})
+	}
+
+	_, err := compressEnvelope(envelope, proto.CompressionAlgorithm_UNCOMPRESSED)
+	assert.EqualError(t, err, "unsupported compression algorithm: UNCOMPRESSED")
+
+	uncompressed, err := decompressEnvelope(envelope, nil)
+	assert.NoError(t, err)
+	assert.True(t, envelope == uncompressed)
+}
+
+func TestDecompressionSizeLimit(t *testing.T) {
+	payload := make([]byte, corecomm.MaxRecvMsgSize+1)
+	for _, c := range []compressor{gzipCompressor{}, snappyCompressor{}} {
+		compressed, err := c.compress(payload)
+		assert.NoError(t, err)
+		_, err = c.decompress(compressed, corecomm.MaxRecvMsgSize)
+		assert.EqualError(t, err, fmt.Sprintf("decompressed payload exceeds %d bytes", corecomm.MaxRecvMsgSize))
+	}
+}
+
+func newCommInstanceWithConfig(t *testing.T, commMetrics *metrics.CommMetrics, config CommConfig) (Comm, int) {
+	port, gRPCServer, certs, secureDialOpts, dialOpts := util.CreateGRPCLayer()
+	_, portString, err := net.SplitHostPort(gRPCServer.Address())
+	assert.NoError(t, err)
+
+	id := []byte(fmt.Sprintf("127.0.0.1:%s", portString))
+	identityMapper := identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec)
+	commInst, err := NewCommInstance(gRPCServer.Server(), certs, identityMapper, id, secureDialOpts,
+		naiveSec, commMetrics, config, dialOpts...)
+	assert.NoError(t, err)
+
+	go func() {
+		err := gRPCServer.Start()
+		assert.NoError(t, err)
+	}()
+
+	return &commGRPC{commInst.(*commImpl), gRPCServer}, port
+}
+
+func TestCompressedCommunication(t *testing.T) {
+	t.Parallel()
+
+	testMetricProvider := mocks.TestUtilConstructMetricProvider()
+	senderMetrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).CommMetrics
+
+	config := testCommConfig
+	config.CompressionAlgorithms = []proto.CompressionAlgorithm{gzipAlgorithm, snappyAlgorithm}
+	config.CompressionThreshold = 1024
+	sender, _ := newCommInstanceWithConfig(t, senderMetrics, config)
+	defer sender.Stop()
+
+	config.CompressionAlgorithms = []proto.CompressionAlgorithm{snappyAlgorithm}
+	snappyPeer, snappyPort := newCommInstanceWithConfig(t, disabledMetrics, config)
+	defer snappyPeer.Stop()
+
+	// A peer with no compression configured behaves like a peer that predates compression
+	legacyPeer, legacyPort := newCommInstanceWithConfig(t, disabledMetrics, testCommConfig)
+	defer legacyPeer.Stop()
+
+	largeMsg := func() *proto.SignedGossipMessage {
+		msg, _ := (&proto.GossipMessage{
+			Tag:   proto.GossipMessage_CHAN_AND_ORG,
+			Nonce: 1,
+			Content: &proto.GossipMessage_DataMsg{
+				DataMsg: &proto.DataMessage{
+					Payload: &proto.Payload{
+						SeqNum: 1,
+						Data:   bytes.Repeat([]byte("block"), 1024),
+					},
+				},
+			},
+		}).NoopSign()
+		return msg
+	}
+
+	receive := func(ch <-chan proto.ReceivedMessage) *proto.SignedGossipMessage {
+		select {
+		case msg := <-ch:
+			return msg.GetGossipMessage()
+		case <-time.After(10 * time.Second):
+			t.Fatal("Didn't receive message")
+		}
+		return nil
+	}
+
+	sent := largeMsg()
+	fromSender := snappyPeer.Accept(acceptAll)
+	sender.Send(sent, remotePeer(snappyPort))
+	received := receive(fromSender)
+	assert.True(t, pb.Equal(sent.GossipMessage, received.GossipMessage))
+	assert.Equal(t, sent.Envelope.Payload, received.Envelope.Payload)
+	assert.Equal(t, proto.CompressionAlgorithm_UNCOMPRESSED, received.Envelope.Compression)
+	assert.Equal(t, 1, testMetricProvider.FakeCompressedMessages.AddCallCount())
+	assert.True(t, testMetricProvider.FakeCompressionSavedBytes.AddArgsForCall(0) > 0)
+
+	// Messages smaller than the threshold aren't compressed
+	sender.Send(createGossipMsg(), remotePeer(snappyPort))
+	receive(fromSender)
+	assert.Equal(t, 1, testMetricProvider.FakeCompressedMessages.AddCallCount())
+
+	fromSender = legacyPeer.Accept(acceptAll)
+	sender.Send(largeMsg(), remotePeer(legacyPort))
+	received = receive(fromSender)
+	assert.True(t, pb.Equal(sent.GossipMessage, received.GossipMessage))
+	assert.Equal(t, 1, testMetricProvider.FakeCompressedMessages.AddCallCount())
+}
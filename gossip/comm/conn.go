@@ -206,18 +206,24 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 		stopFlag:     int32(0),
 		stopChan:     make(chan struct{}, 1),
 		recvBuffSize: config.RecvBuffSize,
+		compression:  config.CompressionAlgorithms,
+		threshold:    config.CompressionThreshold,
 	}
 	return connection
 }
 
 // ConnConfig is the configuration required to initialize a new conn
 type ConnConfig struct {
-	RecvBuffSize int
-	SendBuffSize int
+	RecvBuffSize          int
+	SendBuffSize          int
+	CompressionAlgorithms []proto.CompressionAlgorithm
+	CompressionThreshold  int
 }
 
 type connection struct {
 	recvBuffSize int
+	compression  []proto.CompressionAlgorithm // compression algorithms this peer supports
+	threshold    int                          // minimum payload size of compressed messages
 	metrics      *metrics.CommMetrics
 	cancel       context.CancelFunc
 	info         *proto.ConnectionInfo
@@ -273,7 +279,7 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 	}
 
 	m := &msgSending{
-		envelope: msg.Envelope,
+		envelope: conn.compress(msg),
 		onErr:    onErr,
 	}
 
@@ -290,6 +296,26 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 	}
 }
 
+// compress returns the envelope of the given message, compressed with the algorithm
+// negotiated with the remote peer if the message is large enough to be worth it
+func (conn *connection) compress(msg *proto.SignedGossipMessage) *proto.Envelope {
+	algorithm := conn.info.Compression
+	if algorithm == proto.CompressionAlgorithm_UNCOMPRESSED || len(msg.Envelope.Payload) < conn.threshold || !isCompressible(msg) {
+		return msg.Envelope
+	}
+	envelope, err := compressEnvelope(msg.Envelope, algorithm)
+	if err != nil {
+		conn.logger.Warningf("Failed compressing message to %s, sending it uncompressed: %+v", conn.info.Endpoint, err)
+		return msg.Envelope
+	}
+	if len(envelope.Payload) >= len(msg.Envelope.Payload) {
+		return msg.Envelope
+	}
+	conn.metrics.CompressedMessages.Add(1)
+	conn.metrics.CompressionSavedBytes.Add(float64(len(msg.Envelope.Payload) - len(envelope.Payload)))
+	return envelope
+}
+
 func (conn *connection) serviceConnection() error {
 	errChan := make(chan error, 1)
 	msgChan := make(chan *proto.SignedGossipMessage, conn.recvBuffSize)
@@ -372,6 +398,12 @@ func (conn *connection) readFromStream(errChan chan error, quit chan struct{}, m
 			return
 		}
 		conn.metrics.ReceivedMessages.Add(1)
+		envelope, err = decompressEnvelope(envelope, conn.compression)
+		if err != nil {
+			errChan <- err
+			conn.logger.Warningf("Got error, aborting: %v", err)
+			return
+		}
 		msg, err := envelope.ToGossipMessage()
 		if err != nil {
 			errChan <- err
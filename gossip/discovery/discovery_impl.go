@@ -77,6 +77,13 @@ type gossipDiscoveryImpl struct {
 	reconnectInterval            time.Duration
 
 	bootstrapPeers []string
+
+	staticBootstrapPeers     []string
+	bootstrapPeerSources     []PeerSource
+	resolvedBootstrapPeers   [][]string
+	pendingBootstrapPeers    map[string]struct{}
+	bootstrapRefreshInterval time.Duration
+	identifyBootstrapPeer    func(endpoint string) (*PeerIdentification, error)
 }
 
 type DiscoveryConfig struct {
@@ -85,6 +92,13 @@ type DiscoveryConfig struct {
 	AliveExpirationCheckInterval time.Duration
 	ReconnectInterval            time.Duration
 	BootstrapPeers               []string
+	// BootstrapPeerSources are sources of additional bootstrap peers,
+	// which are resolved every BootstrapRefreshInterval
+	BootstrapPeerSources     []PeerSource
+	BootstrapRefreshInterval time.Duration
+	// IdentifyBootstrapPeer identifies the bootstrap peer at the given endpoint,
+	// and is used to connect to the peers that BootstrapPeerSources resolve
+	IdentifyBootstrapPeer func(endpoint string) (*PeerIdentification, error)
 }
 
 // NewDiscoveryService returns a new discovery service with the comm module passed and the crypto service passed
@@ -114,6 +128,13 @@ func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoServi
 		reconnectInterval:            config.ReconnectInterval,
 
 		bootstrapPeers: config.BootstrapPeers,
+
+		staticBootstrapPeers:     config.BootstrapPeers,
+		bootstrapPeerSources:     config.BootstrapPeerSources,
+		resolvedBootstrapPeers:   make([][]string, len(config.BootstrapPeerSources)),
+		pendingBootstrapPeers:    make(map[string]struct{}),
+		bootstrapRefreshInterval: config.BootstrapRefreshInterval,
+		identifyBootstrapPeer:    config.IdentifyBootstrapPeer,
 	}
 
 	d.validateSelfConfig()
@@ -124,6 +145,9 @@ func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoServi
 	go d.handleMessages()
 	go d.periodicalReconnectToDead()
 	go d.handlePresumedDeadPeers()
+	if len(d.bootstrapPeerSources) > 0 && d.identifyBootstrapPeer != nil {
+		go d.periodicalRefreshBootstrapPeers()
+	}
 
 	return d
 }
@@ -139,9 +163,16 @@ func (d *gossipDiscoveryImpl) Lookup(PKIID common.PKIidType) *NetworkMember {
 }
 
 func (d *gossipDiscoveryImpl) Connect(member NetworkMember, id identifier) {
+	d.connect(member, id, func() {})
+}
+
+// connect connects to the given member asynchronously, and invokes done
+// once it is connected or gave up connecting to it
+func (d *gossipDiscoveryImpl) connect(member NetworkMember, id identifier, done func()) {
 	for _, endpoint := range []string{member.InternalEndpoint, member.Endpoint} {
 		if d.isMyOwnEndpoint(endpoint) {
 			d.logger.Debug("Skipping connecting to myself")
+			done()
 			return
 		}
 	}
@@ -149,6 +180,7 @@ func (d *gossipDiscoveryImpl) Connect(member NetworkMember, id identifier) {
 	d.logger.Debug("Entering", member)
 	defer d.logger.Debug("Exiting")
 	go func() {
+		defer done()
 		for i := 0; i < maxConnectionAttempts && !d.toDie(); i++ {
 			id, err := id()
 			if err != nil {
@@ -180,13 +212,86 @@ func (d *gossipDiscoveryImpl) Connect(member NetworkMember, id identifier) {
 				d.logger.Warningf("Failed adding NONCE to SignedGossipMessage %+v", errors.WithStack(err))
 				continue
 			}
-			go d.sendUntilAcked(peer, req)
+			d.sendUntilAcked(peer, req)
 			return
 		}
 
 	}()
 }
 
+func (d *gossipDiscoveryImpl) periodicalRefreshBootstrapPeers() {
+	defer d.logger.Debug("Stopped")
+
+	for !d.toDie() {
+		d.refreshBootstrapPeers()
+		d.logger.Debug("Sleeping", d.bootstrapRefreshInterval)
+		time.Sleep(d.bootstrapRefreshInterval)
+	}
+}
+
+// refreshBootstrapPeers resolves the bootstrap peer sources, and connects
+// to the resolved bootstrap peers that aren't alive members.
+// A source that fails resolving keeps its previously resolved peers.
+func (d *gossipDiscoveryImpl) refreshBootstrapPeers() {
+	for i, source := range d.bootstrapPeerSources {
+		endpoints, err := source.Endpoints()
+		if err != nil {
+			d.logger.Warningf("Failed resolving bootstrap peers: %+v", err)
+			continue
+		}
+		d.resolvedBootstrapPeers[i] = endpoints
+	}
+
+	bootstrapPeers := append([]string{}, d.staticBootstrapPeers...)
+	var endpoints2Connect []string
+	for _, endpoints := range d.resolvedBootstrapPeers {
+		for _, endpoint := range endpoints {
+			if util.Contains(endpoint, bootstrapPeers) {
+				continue
+			}
+			bootstrapPeers = append(bootstrapPeers, endpoint)
+			endpoints2Connect = append(endpoints2Connect, endpoint)
+		}
+	}
+
+	d.lock.Lock()
+	d.bootstrapPeers = bootstrapPeers
+	aliveEndpoints := make(map[string]struct{})
+	for _, m := range d.aliveMembership.ToSlice() {
+		pkiID := m.GetAliveMsg().Membership.PkiId
+		aliveEndpoints[m.GetAliveMsg().Membership.Endpoint] = struct{}{}
+		if member, exists := d.id2Member[string(pkiID)]; exists {
+			aliveEndpoints[member.InternalEndpoint] = struct{}{}
+		}
+	}
+	var newPeers []string
+	for _, endpoint := range endpoints2Connect {
+		if _, isAlive := aliveEndpoints[endpoint]; isAlive {
+			continue
+		}
+		if _, isPending := d.pendingBootstrapPeers[endpoint]; isPending {
+			continue
+		}
+		d.pendingBootstrapPeers[endpoint] = struct{}{}
+		newPeers = append(newPeers, endpoint)
+	}
+	d.lock.Unlock()
+
+	for _, endpoint := range newPeers {
+		endpoint := endpoint
+		d.logger.Info("Connecting to bootstrap peer", endpoint)
+		identifier := func() (*PeerIdentification, error) {
+			return d.identifyBootstrapPeer(endpoint)
+		}
+		done := func() {
+			d.lock.Lock()
+			delete(d.pendingBootstrapPeers, endpoint)
+			d.lock.Unlock()
+		}
+		d.connect(NetworkMember{InternalEndpoint: endpoint, Endpoint: endpoint}, identifier, done)
+	}
+}
+
 func (d *gossipDiscoveryImpl) isMyOwnEndpoint(endpoint string) bool {
 	return endpoint == fmt.Sprintf("127.0.0.1:%d", d.port) || endpoint == fmt.Sprintf("localhost:%d", d.port) ||
 		endpoint == d.self.InternalEndpoint || endpoint == d.self.Endpoint
@@ -0,0 +1,119 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefBootstrapRefreshInterval is the default interval in which bootstrap peer sources are resolved
+const DefBootstrapRefreshInterval = 30 * time.Second
+
+// PeerSource is a source of bootstrap peer endpoints that may change over time
+type PeerSource interface {
+	// Endpoints returns the current endpoints of the bootstrap peers
+	Endpoints() ([]string, error)
+}
+
+// NewSRVPeerSource returns a PeerSource that resolves the DNS SRV records of the given name
+// (e.g _gossip._tcp.org1.example.com) to bootstrap peer endpoints, using the given resolver.
+// If the resolver is nil, the default resolver is used.
+func NewSRVPeerSource(name string, resolver *net.Resolver) PeerSource {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &srvPeerSource{
+		name:     name,
+		resolver: resolver,
+	}
+}
+
+type srvPeerSource struct {
+	name     string
+	resolver *net.Resolver
+}
+
+// Endpoints returns the endpoints of the SRV records, in order of priority
+func (s *srvPeerSource) Endpoints() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, records, err := s.resolver.LookupSRV(ctx, "", "", s.name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed resolving SRV records of %s", s.name)
+	}
+	var endpoints []string
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		endpoints = append(endpoints, net.JoinHostPort(host, fmt.Sprintf("%d", record.Port)))
+	}
+	return endpoints, nil
+}
+
+// NewFilePeerSource returns a PeerSource that reads bootstrap peer endpoints from a peer
+// directory file, which lists an endpoint per line. Empty lines and lines that start
+// with '#' are ignored. The file is read again whenever it changes.
+func NewFilePeerSource(path string) PeerSource {
+	return &filePeerSource{path: path}
+}
+
+type filePeerSource struct {
+	sync.Mutex
+	path      string
+	modTime   time.Time
+	size      int64
+	endpoints []string
+}
+
+// Endpoints returns the endpoints listed in the peer directory file
+func (f *filePeerSource) Endpoints() ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading peer directory %s", f.path)
+	}
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.endpoints, nil
+	}
+
+	rawDirectory, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed reading peer directory %s", f.path)
+	}
+	var endpoints []string
+	scanner := bufio.NewScanner(bytes.NewReader(rawDirectory))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(line); err != nil {
+			return nil, errors.Wrapf(err, "invalid endpoint in line %d of peer directory %s", lineNum, f.path)
+		}
+		endpoints = append(endpoints, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed reading peer directory %s", f.path)
+	}
+
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	f.endpoints = endpoints
+	return endpoints, nil
+}
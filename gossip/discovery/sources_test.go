@@ -0,0 +1,240 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package discovery
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type srvRecord struct {
+	target string
+	port   uint16
+}
+
+// dnsStub is a DNS server that answers SRV queries of names it knows
+type dnsStub struct {
+	sync.Mutex
+	conn    net.PacketConn
+	records map[string][]srvRecord
+}
+
+func newDNSStub(t *testing.T) *dnsStub {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	stub := &dnsStub{
+		conn:    conn,
+		records: make(map[string][]srvRecord),
+	}
+	go stub.serve()
+	return stub
+}
+
+func (s *dnsStub) setRecords(name string, records ...srvRecord) {
+	s.Lock()
+	defer s.Unlock()
+	s.records[name] = records
+}
+
+func (s *dnsStub) resolver() *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, "udp", s.conn.LocalAddr().String())
+		},
+	}
+}
+
+func (s *dnsStub) stop() {
+	s.conn.Close()
+}
+
+func (s *dnsStub) serve() {
+	buff := make([]byte, 512)
+	for {
+		n, addr, err := s.conn.ReadFrom(buff)
+		if err != nil {
+			return
+		}
+		if response := s.respond(buff[:n]); response != nil {
+			s.conn.WriteTo(response, addr)
+		}
+	}
+}
+
+func (s *dnsStub) respond(query []byte) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+	// Parse the name in the question, which follows the 12 bytes header
+	var labels []string
+	i := 12
+	for i < len(query) && query[i] != 0 {
+		length := int(query[i])
+		if i+1+length > len(query) {
+			return nil
+		}
+		labels = append(labels, string(query[i+1:i+1+length]))
+		i += 1 + length
+	}
+	questionEnd := i + 5
+	if questionEnd > len(query) {
+		return nil
+	}
+	name := strings.ToLower(strings.Join(labels, "."))
+	qType := binary.BigEndian.Uint16(query[i+1 : i+3])
+
+	s.Lock()
+	records, exists := s.records[name]
+	s.Unlock()
+	if qType != 33 {
+		records = nil
+	}
+
+	response := append([]byte{}, query[:2]...)
+	flags := uint16(0x8180)
+	if !exists {
+		flags |= 3 // NXDOMAIN
+	}
+	response = appendUint16(response, flags, 1, uint16(len(records)), 0, 0)
+	response = append(response, query[12:questionEnd]...)
+	for _, record := range records {
+		target := encodeName(record.target)
+		// A pointer to the name in the question, type SRV, class IN and TTL
+		response = appendUint16(response, 0xc00c, 33, 1, 0, 60)
+		response = appendUint16(response, uint16(6+len(target)), 0, 0, record.port)
+		response = append(response, target...)
+	}
+	return response
+}
+
+func appendUint16(b []byte, values ...uint16) []byte {
+	for _, v := range values {
+		b = append(b, byte(v>>8), byte(v))
+	}
+	return b
+}
+
+func encodeName(name string) []byte {
+	var encoded []byte
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		encoded = append(encoded, byte(len(label)))
+		encoded = append(encoded, label...)
+	}
+	return append(encoded, 0)
+}
+
+func TestSRVPeerSource(t *testing.T) {
+	stub := newDNSStub(t)
+	defer stub.stop()
+
+	stub.setRecords("_gossip._tcp.org1.example.com",
+		srvRecord{target: "peer0.org1.example.com.", port: 7051},
+		srvRecord{target: "peer1.org1.example.com.", port: 8051})
+
+	source := NewSRVPeerSource("_gossip._tcp.org1.example.com", stub.resolver())
+	endpoints, err := source.Endpoints()
+	assert.NoError(t, err)
+	assert.Len(t, endpoints, 2)
+	assert.Contains(t, endpoints, "peer0.org1.example.com:7051")
+	assert.Contains(t, endpoints, "peer1.org1.example.com:8051")
+
+	// The records are resolved again on every call
+	stub.setRecords("_gossip._tcp.org1.example.com",
+		srvRecord{target: "peer2.org1.example.com.", port: 9051})
+	endpoints, err = source.Endpoints()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"peer2.org1.example.com:9051"}, endpoints)
+
+	source = NewSRVPeerSource("_gossip._tcp.org2.example.com", stub.resolver())
+	_, err = source.Endpoints()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed resolving SRV records of _gossip._tcp.org2.example.com")
+}
+
+func TestFilePeerSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerdirectory")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "peers")
+
+	source := NewFilePeerSource(path)
+	_, err = source.Endpoints()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("failed reading peer directory %s", path))
+
+	err = ioutil.WriteFile(path, []byte("# Peers of org1\npeer0.org1.example.com:7051\n\n  peer1.org1.example.com:8051  \n"), 0644)
+	assert.NoError(t, err)
+	endpoints, err := source.Endpoints()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"peer0.org1.example.com:7051", "peer1.org1.example.com:8051"}, endpoints)
+
+	err = ioutil.WriteFile(path, []byte("peer0.org1.example.com\n"), 0644)
+	assert.NoError(t, err)
+	_, err = source.Endpoints()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid endpoint in line 1 of peer directory")
+
+	err = ioutil.WriteFile(path, []byte("peer2.org1.example.com:9051\n"), 0644)
+	assert.NoError(t, err)
+	endpoints, err = source.Endpoints()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"peer2.org1.example.com:9051"}, endpoints)
+}
+
+func TestBootstrapPeerSources(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "peerdirectory")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "peers")
+	err = ioutil.WriteFile(path, nil, 0644)
+	assert.NoError(t, err)
+
+	config := defaultTestConfig
+	config.BootstrapPeerSources = []PeerSource{NewFilePeerSource(path)}
+	config.BootstrapRefreshInterval = aliveTimeInterval
+	config.IdentifyBootstrapPeer = func(endpoint string) (*PeerIdentification, error) {
+		return &PeerIdentification{SelfOrg: true, ID: common.PKIidType(endpoint)}, nil
+	}
+
+	inst1 := createDiscoveryInstance(14611, "d1", []string{})
+	inst2 := createDiscoveryInstanceCustomConfig(14612, "d2", []string{}, config)
+	inst3 := createDiscoveryInstance(14613, "d3", []string{})
+	instances := []*gossipInstance{inst1, inst2, inst3}
+	defer stopInstances(t, instances)
+
+	time.Sleep(3 * aliveTimeInterval)
+	assert.Empty(t, inst2.GetMembership())
+
+	// Peers that are added to the peer directory are connected to without a restart
+	err = ioutil.WriteFile(path, []byte(fmt.Sprintf("%s\n", bootPeer(14611))), 0644)
+	assert.NoError(t, err)
+	assertMembership(t, []*gossipInstance{inst1, inst2}, 1)
+
+	err = ioutil.WriteFile(path, []byte(fmt.Sprintf("%s\n%s\n", bootPeer(14611), bootPeer(14613))), 0644)
+	assert.NoError(t, err)
+	assertMembership(t, instances, 2)
+	d := inst2.discoveryImpl()
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	assert.Contains(t, d.bootstrapPeers, bootPeer(14613))
+}
@@ -34,6 +34,10 @@ func (mi *msgImpl) IsDeclaration() bool {
 	return mi.msg.GetLeadershipMsg().IsDeclaration
 }
 
+func (mi *msgImpl) Rank() uint64 {
+	return mi.msg.GetLeadershipMsg().Rank
+}
+
 type peerImpl struct {
 	member discovery.NetworkMember
 }
@@ -123,13 +127,14 @@ func (ai *adapterImpl) Accept() <-chan Msg {
 	return msgCh
 }
 
-func (ai *adapterImpl) CreateMessage(isDeclaration bool) Msg {
+func (ai *adapterImpl) CreateMessage(isDeclaration bool, rank uint64) Msg {
 	ai.seqNum++
 	seqNum := ai.seqNum
 
 	leadershipMsg := &proto.LeadershipMessage{
 		PkiId:         ai.selfPKIid,
 		IsDeclaration: isDeclaration,
+		Rank:          rank,
 		Timestamp: &proto.PeerTime{
 			IncNum: ai.incTime,
 			SeqNum: seqNum,
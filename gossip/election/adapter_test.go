@@ -53,7 +53,7 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 
 	adapter := NewAdapter(mockGossip, selfNetworkMember.PKIid, []byte("channel0"),
 		metrics.NewGossipMetrics(&disabled.Provider{}).ElectionMetrics)
-	msg := adapter.CreateMessage(true)
+	msg := adapter.CreateMessage(true, 7)
 
 	if !msg.(*msgImpl).msg.IsLeadershipMsg() {
 		t.Error("Newly created message should be LeadershipMsg")
@@ -63,7 +63,11 @@ func TestAdapterImpl_CreateMessage(t *testing.T) {
 		t.Error("Newly created msg should be Declaration msg")
 	}
 
-	msg = adapter.CreateMessage(false)
+	if msg.Rank() != 7 {
+		t.Error("Newly created msg should carry the given rank")
+	}
+
+	msg = adapter.CreateMessage(false, 0)
 
 	if !msg.(*msgImpl).msg.IsLeadershipMsg() {
 		t.Error("Newly created message should be LeadershipMsg")
@@ -123,7 +127,7 @@ func TestAdapterImpl_Gossip(t *testing.T) {
 
 	sender := adapters[fmt.Sprintf("Peer%d", 0)]
 
-	sender.Gossip(sender.CreateMessage(true))
+	sender.Gossip(sender.CreateMessage(true, 0))
 
 	totalMsg := 0
 
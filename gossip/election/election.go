@@ -18,7 +18,7 @@ import (
 
 // Gossip leader election module
 // Algorithm properties:
-// - Peers break symmetry by comparing IDs
+// - Peers break symmetry by comparing ranks, and then IDs
 // - Each peer is either a leader or a follower,
 //   and the aim is to have exactly 1 leader if the membership view
 //   is the same for all peers
@@ -50,7 +50,8 @@ import (
 //		If you are the leader:
 //			Broadcast leadership declaration
 //			If a leadership declaration was received from
-// 			a peer with a lower ID,
+// 			a better candidate (a peer with a higher rank,
+//			or with an equal rank and a lower ID),
 //			become a follower
 //		Else, you're a follower:
 //			If haven't received a leadership declaration within
@@ -63,7 +64,7 @@ import (
 //	If received a leadership declaration:
 //		return
 //	Iterate over all proposal messages collected.
-// 	If a proposal message from a peer with an ID lower
+// 	If a proposal message from a better candidate
 // 	than yourself was received, return.
 //	Else, declare yourself a leader
 
@@ -76,8 +77,9 @@ type LeaderElectionAdapter interface {
 	// Accept returns a channel that emits messages
 	Accept() <-chan Msg
 
-	// CreateProposalMessage
-	CreateMessage(isDeclaration bool) Msg
+	// CreateMessage creates a leadership proposal or declaration
+	// that carries the given rank of this peer
+	CreateMessage(isDeclaration bool, rank uint64) Msg
 
 	// Peers returns a list of peers considered alive
 	Peers() []Peer
@@ -124,6 +126,22 @@ type Msg interface {
 	IsProposal() bool
 	// IsDeclaration returns whether this message is a leadership declaration
 	IsDeclaration() bool
+	// Rank returns the rank of the peer sent the message
+	Rank() uint64
+}
+
+// Ranker ranks this peer as a candidate for leadership.
+// Peers with a higher rank are preferred as leaders,
+// and peers with equal ranks are ordered by their IDs.
+type Ranker interface {
+	// Rank returns the current rank of this peer
+	Rank() uint64
+}
+
+// candidate is a peer that proposed itself as a leader
+type candidate struct {
+	id   string
+	rank uint64
 }
 
 func noopCallback(_ bool) {
@@ -141,6 +159,10 @@ type ElectionConfig struct {
 	MembershipSampleInterval time.Duration
 	LeaderAliveThreshold     time.Duration
 	LeaderElectionDuration   time.Duration
+	// Ranker ranks this peer as a candidate for leadership.
+	// If nil, all peers have the same rank and the peer
+	// with the lowest ID is elected.
+	Ranker Ranker
 }
 
 // NewLeaderElectionService returns a new LeaderElectionService
@@ -157,11 +179,15 @@ func NewLeaderElectionService(adapter LeaderElectionAdapter, id string, callback
 		logger:        util.GetLogger(util.ElectionLogger, ""),
 		callback:      noopCallback,
 		config:        config,
+		ranker:        config.Ranker,
 	}
 
 	if callback != nil {
 		le.callback = callback
 	}
+	if le.ranker == nil {
+		le.ranker = StaticRanker(0)
+	}
 
 	go le.start()
 	return le
@@ -185,6 +211,8 @@ type leaderElectionSvcImpl struct {
 	callback      leadershipCallback
 	yieldTimer    *time.Timer
 	config        ElectionConfig
+	ranker        Ranker
+	rank          uint64
 }
 
 func (le *leaderElectionSvcImpl) start() {
@@ -224,13 +252,13 @@ func (le *leaderElectionSvcImpl) handleMessage(msg Msg) {
 	defer le.Unlock()
 
 	if msg.IsProposal() {
-		le.proposals.Add(string(msg.SenderID()))
+		le.proposals.Add(candidate{id: string(msg.SenderID()), rank: msg.Rank()})
 	} else if msg.IsDeclaration() {
 		atomic.StoreInt32(&le.leaderExists, int32(1))
 		if le.sleeping && len(le.interruptChan) == 0 {
 			le.interruptChan <- struct{}{}
 		}
-		if bytes.Compare(msg.SenderID(), le.id) < 0 && le.IsLeader() {
+		if le.isBetterCandidate(msg.SenderID(), msg.Rank()) && le.IsLeader() {
 			le.stopBeingLeader()
 		}
 	} else {
@@ -312,8 +340,8 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 	// Leader doesn't exist, let's see if there is a better candidate than us
 	// for being a leader
 	for _, o := range le.proposals.ToArray() {
-		id := o.(string)
-		if bytes.Compare(peerID(id), le.id) < 0 {
+		c := o.(candidate)
+		if le.isBetterCandidate(peerID(c.id), c.rank) {
 			return
 		}
 	}
@@ -327,10 +355,27 @@ func (le *leaderElectionSvcImpl) leaderElection() {
 func (le *leaderElectionSvcImpl) propose() {
 	le.logger.Debug(le.id, ": Entering")
 	le.logger.Debug(le.id, ": Exiting")
-	leadershipProposal := le.adapter.CreateMessage(false)
+	leadershipProposal := le.adapter.CreateMessage(false, le.updateRank())
 	le.adapter.Gossip(leadershipProposal)
 }
 
+// updateRank re-computes the rank of this peer, and returns it
+func (le *leaderElectionSvcImpl) updateRank() uint64 {
+	rank := le.ranker.Rank()
+	atomic.StoreUint64(&le.rank, rank)
+	return rank
+}
+
+// isBetterCandidate returns whether the peer with the given ID and rank
+// is a better candidate than this peer for being a leader
+func (le *leaderElectionSvcImpl) isBetterCandidate(id peerID, rank uint64) bool {
+	myRank := atomic.LoadUint64(&le.rank)
+	if rank != myRank {
+		return rank > myRank
+	}
+	return bytes.Compare(id, le.id) < 0
+}
+
 func (le *leaderElectionSvcImpl) follower() {
 	le.logger.Debug(le.id, ": Entering")
 	defer le.logger.Debug(le.id, ": Exiting")
@@ -346,7 +391,7 @@ func (le *leaderElectionSvcImpl) follower() {
 }
 
 func (le *leaderElectionSvcImpl) leader() {
-	leaderDeclaration := le.adapter.CreateMessage(true)
+	leaderDeclaration := le.adapter.CreateMessage(true, le.updateRank())
 	le.adapter.Gossip(leaderDeclaration)
 	le.adapter.ReportMetrics(true)
 	le.waitForInterrupt(le.config.LeaderAliveThreshold / 2)
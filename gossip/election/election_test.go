@@ -35,6 +35,7 @@ func init() {
 type msg struct {
 	sender   string
 	proposal bool
+	rank     uint64
 }
 
 func (m *msg) SenderID() peerID {
@@ -49,6 +50,10 @@ func (m *msg) IsDeclaration() bool {
 	return !m.proposal
 }
 
+func (m *msg) Rank() uint64 {
+	return m.rank
+}
+
 type peer struct {
 	mockedMethods map[string]struct{}
 	mock.Mock
@@ -101,8 +106,8 @@ func (p *peer) Accept() <-chan Msg {
 	return (<-chan Msg)(p.msgChan)
 }
 
-func (p *peer) CreateMessage(isDeclaration bool) Msg {
-	return &msg{proposal: !isDeclaration, sender: p.id}
+func (p *peer) CreateMessage(isDeclaration bool, rank uint64) Msg {
+	return &msg{proposal: !isDeclaration, sender: p.id, rank: rank}
 }
 
 func (p *peer) Peers() []Peer {
@@ -158,7 +163,21 @@ func createPeers(spawnInterval time.Duration, ids ...int) []*peer {
 	return peers
 }
 
+func createPeersWithRanks(ranks map[int]uint64, ids ...int) []*peer {
+	peers := make([]*peer, len(ids))
+	peerMap := make(map[string]*peer)
+	l := &sync.RWMutex{}
+	for i, id := range ids {
+		peers[i] = createPeerWithRanker(id, peerMap, l, func(mock.Arguments) {}, StaticRanker(ranks[id]))
+	}
+	return peers
+}
+
 func createPeerWithCostumeMetrics(id int, peerMap map[string]*peer, l *sync.RWMutex, f func(mock.Arguments)) *peer {
+	return createPeerWithRanker(id, peerMap, l, f, nil)
+}
+
+func createPeerWithRanker(id int, peerMap map[string]*peer, l *sync.RWMutex, f func(mock.Arguments), ranker Ranker) *peer {
 	idStr := fmt.Sprintf("p%d", id)
 	c := make(chan Msg, 100)
 	p := &peer{id: idStr, peers: peerMap, sharedLock: l, msgChan: c, mockedMethods: make(map[string]struct{}), leaderFromCallback: false, callbackInvoked: false}
@@ -168,6 +187,7 @@ func createPeerWithCostumeMetrics(id int, peerMap map[string]*peer, l *sync.RWMu
 		MembershipSampleInterval: testMembershipSampleInterval,
 		LeaderAliveThreshold:     testLeaderAliveThreshold,
 		LeaderElectionDuration:   testLeaderElectionDuration,
+		Ranker:                   ranker,
 	}
 	p.LeaderElectionService = NewLeaderElectionService(p, idStr, p.leaderCallback, config)
 	l.Lock()
@@ -255,6 +275,17 @@ func TestInitPeersAtSameTime(t *testing.T) {
 	waitForBoolFunc(t, peers[len(peers)-1].isLeaderFromCallback, true, "Leadership callback result is wrong for ", peers[len(peers)-1].id)
 }
 
+func TestInitPeersWithRanks(t *testing.T) {
+	t.Parallel()
+	// Scenario: Peers are spawned at the same time, and p2 and p3 have a higher rank than the rest
+	// expected outcome: the peer with the lowest ID among the peers with the highest rank is the leader
+	peers := createPeersWithRanks(map[int]uint64{2: 10, 3: 10}, 4, 3, 2, 1, 0)
+	time.Sleep(testStartupGracePeriod + testLeaderElectionDuration)
+	leaders := waitForLeaderElection(t, peers)
+	assert.Len(t, leaders, 1, "More than 1 leader elected")
+	assert.Equal(t, "p2", leaders[0])
+}
+
 func TestInitPeersStartAtIntervals(t *testing.T) {
 	t.Parallel()
 	// Scenario: Peers are spawned one by one in a slow rate
@@ -344,6 +375,38 @@ func TestConvergence(t *testing.T) {
 	}
 }
 
+func TestConvergenceWithRanks(t *testing.T) {
+	// Scenario: 2 peer groups converge their views, and the leader
+	// of the second group has a higher rank than the first group
+	// expected outcome: only the leader of the second group is left
+	t.Parallel()
+	peers := createPeersWithRanks(map[int]uint64{4: 1}, 3, 2, 1, 0, 4, 5)
+	peers1, peers2 := peers[:4], peers[4:]
+	for _, group := range [][]*peer{peers1, peers2} {
+		var groupPeers []Peer
+		for _, p := range group {
+			groupPeers = append(groupPeers, &peer{id: p.id})
+		}
+		for _, p := range group {
+			p.On("Peers").Return(groupPeers)
+		}
+	}
+	leaders1 := waitForLeaderElection(t, peers1)
+	leaders2 := waitForLeaderElection(t, peers2)
+	assert.Equal(t, []string{"p0"}, leaders1)
+	assert.Equal(t, []string{"p4"}, leaders2)
+
+	for _, p := range peers {
+		p.sharedLock.Lock()
+		delete(p.mockedMethods, "Peers")
+		p.sharedLock.Unlock()
+	}
+
+	time.Sleep(testLeaderAliveThreshold * 5)
+	finalLeaders := waitForLeaderElection(t, peers)
+	assert.Equal(t, []string{"p4"}, finalLeaders)
+}
+
 func TestLeadershipTakeover(t *testing.T) {
 	t.Parallel()
 	// Scenario: Peers spawn one by one in descending order.
@@ -0,0 +1,89 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package election
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// StaticRanker ranks the peer with a fixed, operator assigned priority
+type StaticRanker uint64
+
+// Rank returns the priority of the peer
+func (sr StaticRanker) Rank() uint64 {
+	return uint64(sr)
+}
+
+// RankerFunc is an adapter that allows the use of ordinary functions as Rankers
+type RankerFunc func() uint64
+
+// Rank returns f()
+func (f RankerFunc) Rank() uint64 {
+	return f()
+}
+
+// NewLedgerHeightRanker returns a Ranker that prefers peers with a higher ledger height.
+// A peer that fails obtaining its ledger height is ranked lowest.
+func NewLedgerHeightRanker(ledgerHeight func() (uint64, error)) Ranker {
+	return RankerFunc(func() uint64 {
+		height, err := ledgerHeight()
+		if err != nil {
+			return 0
+		}
+		return height
+	})
+}
+
+// NewLatencyRanker returns a Ranker that prefers peers with a lower latency to the ordering
+// service, at a millisecond granularity. The latency is measured with the given probe in the
+// background, at most once every refreshInterval, so that Rank never waits for the probe.
+// A peer whose latency hasn't been measured yet, or whose probe fails, is ranked lowest.
+func NewLatencyRanker(probe func() (time.Duration, error), refreshInterval time.Duration) Ranker {
+	lr := &latencyRanker{
+		probe:           probe,
+		refreshInterval: refreshInterval,
+		lastProbe:       time.Now(),
+		probing:         true,
+	}
+	go lr.refresh()
+	return lr
+}
+
+type latencyRanker struct {
+	sync.Mutex
+	probe           func() (time.Duration, error)
+	refreshInterval time.Duration
+	lastProbe       time.Time
+	probing         bool
+	rank            uint64
+}
+
+// Rank returns the rank of the peer according to its latest measured latency,
+// and starts measuring the latency again if the refresh interval has elapsed
+func (lr *latencyRanker) Rank() uint64 {
+	lr.Lock()
+	defer lr.Unlock()
+	if !lr.probing && time.Since(lr.lastProbe) >= lr.refreshInterval {
+		lr.lastProbe = time.Now()
+		lr.probing = true
+		go lr.refresh()
+	}
+	return lr.rank
+}
+
+func (lr *latencyRanker) refresh() {
+	var rank uint64
+	if latency, err := lr.probe(); err == nil {
+		rank = math.MaxUint64 - uint64(latency/time.Millisecond)
+	}
+	lr.Lock()
+	defer lr.Unlock()
+	lr.rank = rank
+	lr.probing = false
+}
@@ -0,0 +1,75 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package election
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticRanker(t *testing.T) {
+	assert.Equal(t, uint64(0), StaticRanker(0).Rank())
+	assert.Equal(t, uint64(42), StaticRanker(42).Rank())
+}
+
+func TestLedgerHeightRanker(t *testing.T) {
+	var err error
+	ranker := NewLedgerHeightRanker(func() (uint64, error) {
+		return 100, err
+	})
+	assert.Equal(t, uint64(100), ranker.Rank())
+
+	err = errors.New("ledger is closed")
+	assert.Equal(t, uint64(0), ranker.Rank())
+}
+
+func TestLatencyRanker(t *testing.T) {
+	type measurement struct {
+		latency time.Duration
+		err     error
+	}
+	probes := make(chan measurement)
+	probe := func() (time.Duration, error) {
+		m := <-probes
+		return m.latency, m.err
+	}
+
+	ranker := NewLatencyRanker(probe, time.Hour)
+	// Rank doesn't wait for the latency to be measured
+	assert.Equal(t, uint64(0), ranker.Rank())
+	probes <- measurement{latency: 20 * time.Millisecond}
+	waitForRank(t, ranker, math.MaxUint64-20)
+	// The latency isn't measured again until the refresh interval elapses
+	select {
+	case probes <- measurement{latency: 10 * time.Millisecond}:
+		assert.Fail(t, "latency was measured before the refresh interval elapsed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Equal(t, uint64(math.MaxUint64-20), ranker.Rank())
+
+	ranker = NewLatencyRanker(probe, 0)
+	probes <- measurement{latency: 10 * time.Millisecond}
+	waitForRank(t, ranker, math.MaxUint64-10)
+	probes <- measurement{latency: 30 * time.Millisecond}
+	waitForRank(t, ranker, math.MaxUint64-30)
+	probes <- measurement{err: errors.New("no orderer is reachable")}
+	waitForRank(t, ranker, 0)
+}
+
+func waitForRank(t *testing.T, ranker Ranker, expected uint64) {
+	deadline := time.Now().Add(5 * time.Second)
+	for ranker.Rank() != expected {
+		if time.Now().After(deadline) {
+			assert.FailNow(t, "rank wasn't refreshed", "expected rank %d, got %d", expected, ranker.Rank())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -115,6 +115,10 @@ type Config struct {
 	PropagateIterations int      // Number of times a message is pushed to remote peers
 	PropagatePeerNum    int      // Number of peers selected to push messages to
 
+	BootstrapSRVName         string        // DNS name whose SRV records resolve to additional bootstrap peers
+	BootstrapPeerDirectory   string        // File that lists additional bootstrap peers, an endpoint per line
+	BootstrapRefreshInterval time.Duration // Interval in which the additional bootstrap peers are resolved
+
 	MaxBlockCountToStore int // Maximum count of blocks we store in memory
 
 	MaxPropagationBurstSize    int           // Max number of messages stored until it triggers a push to remote peers
@@ -144,6 +148,9 @@ type Config struct {
 	RecvBuffSize int           // Buffer size of received messages
 	SendBuffSize int           // Buffer size of sending messages
 
+	CompressionAlgorithms []proto.CompressionAlgorithm // Algorithms used to compress large messages, in order of preference
+	CompressionThreshold  int                          // Minimum payload size of compressed messages
+
 	MsgExpirationTimeout time.Duration // Leadership message expiration timeout
 
 	AliveTimeInterval            time.Duration // Alive check interval
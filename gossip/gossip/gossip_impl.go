@@ -99,10 +99,12 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 	}, sa)
 
 	commConfig := comm.CommConfig{
-		DialTimeout:  conf.DialTimeout,
-		ConnTimeout:  conf.ConnTimeout,
-		RecvBuffSize: conf.RecvBuffSize,
-		SendBuffSize: conf.SendBuffSize,
+		DialTimeout:           conf.DialTimeout,
+		ConnTimeout:           conf.ConnTimeout,
+		RecvBuffSize:          conf.RecvBuffSize,
+		SendBuffSize:          conf.SendBuffSize,
+		CompressionAlgorithms: conf.CompressionAlgorithms,
+		CompressionThreshold:  conf.CompressionThreshold,
 	}
 	g.comm, err = comm.NewCommInstance(s, conf.TLSCerts, g.idMapper, selfIdentity, secureDialOpts, sa,
 		gossipMetrics.CommMetrics, commConfig)
@@ -126,6 +128,9 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 		AliveExpirationCheckInterval: conf.AliveExpirationCheckInterval,
 		ReconnectInterval:            conf.ReconnectInterval,
 		BootstrapPeers:               conf.BootstrapPeers,
+		BootstrapPeerSources:         bootstrapPeerSources(conf),
+		BootstrapRefreshInterval:     conf.BootstrapRefreshInterval,
+		IdentifyBootstrapPeer:        g.identifyBootstrapPeer,
 	}
 	g.disc = discovery.NewDiscoveryService(g.selfNetworkMember(), g.discAdapter, g.disSecAdap, g.disclosurePolicy,
 		discoveryConfig)
@@ -1157,19 +1162,7 @@ func (g *gossipServiceImpl) connect2BootstrapPeers() {
 	for _, endpoint := range g.conf.BootstrapPeers {
 		endpoint := endpoint
 		identifier := func() (*discovery.PeerIdentification, error) {
-			remotePeerIdentity, err := g.comm.Handshake(&comm.RemotePeer{Endpoint: endpoint})
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			sameOrg := bytes.Equal(g.selfOrg, g.secAdvisor.OrgByPeerIdentity(remotePeerIdentity))
-			if !sameOrg {
-				return nil, errors.Errorf("%s isn't in our organization, cannot be a bootstrap peer", endpoint)
-			}
-			pkiID := g.mcs.GetPKIidOfCert(remotePeerIdentity)
-			if len(pkiID) == 0 {
-				return nil, errors.Errorf("Wasn't able to extract PKI-ID of remote peer with identity of %v", remotePeerIdentity)
-			}
-			return &discovery.PeerIdentification{ID: pkiID, SelfOrg: sameOrg}, nil
+			return g.identifyBootstrapPeer(endpoint)
 		}
 		g.disc.Connect(discovery.NetworkMember{
 			InternalEndpoint: endpoint,
@@ -1179,6 +1172,36 @@ func (g *gossipServiceImpl) connect2BootstrapPeers() {
 
 }
 
+// identifyBootstrapPeer handshakes with the bootstrap peer at the given endpoint,
+// and makes sure it is in our organization
+func (g *gossipServiceImpl) identifyBootstrapPeer(endpoint string) (*discovery.PeerIdentification, error) {
+	remotePeerIdentity, err := g.comm.Handshake(&comm.RemotePeer{Endpoint: endpoint})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sameOrg := bytes.Equal(g.selfOrg, g.secAdvisor.OrgByPeerIdentity(remotePeerIdentity))
+	if !sameOrg {
+		return nil, errors.Errorf("%s isn't in our organization, cannot be a bootstrap peer", endpoint)
+	}
+	pkiID := g.mcs.GetPKIidOfCert(remotePeerIdentity)
+	if len(pkiID) == 0 {
+		return nil, errors.Errorf("Wasn't able to extract PKI-ID of remote peer with identity of %v", remotePeerIdentity)
+	}
+	return &discovery.PeerIdentification{ID: pkiID, SelfOrg: sameOrg}, nil
+}
+
+// bootstrapPeerSources returns the sources of additional bootstrap peers in the given config
+func bootstrapPeerSources(conf *Config) []discovery.PeerSource {
+	var sources []discovery.PeerSource
+	if conf.BootstrapSRVName != "" {
+		sources = append(sources, discovery.NewSRVPeerSource(conf.BootstrapSRVName, nil))
+	}
+	if conf.BootstrapPeerDirectory != "" {
+		sources = append(sources, discovery.NewFilePeerSource(conf.BootstrapPeerDirectory))
+	}
+	return sources
+}
+
 func (g *gossipServiceImpl) hasExternalEndpoint(PKIID common.PKIidType) bool {
 	if nm := g.disc.Lookup(PKIID); nm != nil {
 		return nm.Endpoint != ""
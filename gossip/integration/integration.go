@@ -42,6 +42,9 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 	conf := &gossip.Config{
 		BindPort:                   int(port),
 		BootstrapPeers:             bootPeers,
+		BootstrapSRVName:           viper.GetString("peer.gossip.bootstrapDiscovery.srvName"),
+		BootstrapPeerDirectory:     viper.GetString("peer.gossip.bootstrapDiscovery.peerDirectory"),
+		BootstrapRefreshInterval:   util.GetDurationOrDefault("peer.gossip.bootstrapDiscovery.refreshInterval", discovery.DefBootstrapRefreshInterval),
 		ID:                         selfEndpoint,
 		MaxBlockCountToStore:       util.GetIntOrDefault("peer.gossip.maxBlockCountToStore", 100),
 		MaxPropagationBurstLatency: util.GetDurationOrDefault("peer.gossip.maxPropagationBurstLatency", 10*time.Millisecond),
@@ -69,6 +72,12 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 		AliveTimeInterval:          util.GetDurationOrDefault("peer.gossip.aliveTimeInterval", discovery.DefAliveTimeInterval),
 	}
 
+	conf.CompressionAlgorithms, err = comm.ParseCompressionAlgorithms(viper.GetStringSlice("peer.gossip.compression.algorithms"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	conf.CompressionThreshold = util.GetIntOrDefault("peer.gossip.compression.threshold", comm.DefCompressionThreshold)
+
 	conf.AliveExpirationTimeout = util.GetDurationOrDefault("peer.gossip.aliveExpirationTimeout", 5*conf.AliveTimeInterval)
 	conf.AliveExpirationCheckInterval = conf.AliveExpirationTimeout / 10
 	conf.ReconnectInterval = util.GetDurationOrDefault("peer.gossip.reconnectInterval", conf.AliveExpirationTimeout)
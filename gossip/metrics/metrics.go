@@ -32,6 +32,9 @@ type StateMetrics struct {
 	Height            metrics.Gauge
 	CommitDuration    metrics.Histogram
 	PayloadBufferSize metrics.Gauge
+	StreamedBlocks    metrics.Counter
+	StreamedBytes     metrics.Counter
+	StreamDuration    metrics.Histogram
 }
 
 func newStateMetrics(p metrics.Provider) *StateMetrics {
@@ -39,6 +42,9 @@ func newStateMetrics(p metrics.Provider) *StateMetrics {
 		Height:            p.NewGauge(HeightOpts),
 		CommitDuration:    p.NewHistogram(CommitDurationOpts),
 		PayloadBufferSize: p.NewGauge(PayloadBufferSizeOpts),
+		StreamedBlocks:    p.NewCounter(StreamedBlocksOpts),
+		StreamedBytes:     p.NewCounter(StreamedBytesOpts),
+		StreamDuration:    p.NewHistogram(StreamDurationOpts),
 	}
 }
 
@@ -69,6 +75,33 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+
+	StreamedBlocksOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "state",
+		Name:         "streamed_blocks",
+		Help:         "Number of blocks received over the block stream",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	StreamedBytesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "state",
+		Name:         "streamed_bytes",
+		Help:         "Number of block bytes received over the block stream",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	StreamDurationOpts = metrics.HistogramOpts{
+		Namespace:    "gossip",
+		Subsystem:    "state",
+		Name:         "stream_duration",
+		Help:         "Time it takes to receive a segment of blocks over the block stream in seconds",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
 
 // ElectionMetrics encapsulates gossip leader election related metrics
@@ -95,16 +128,20 @@ var (
 
 // CommMetrics encapsulates gossip communication related metrics
 type CommMetrics struct {
-	SentMessages     metrics.Counter
-	BufferOverflow   metrics.Counter
-	ReceivedMessages metrics.Counter
+	SentMessages          metrics.Counter
+	BufferOverflow        metrics.Counter
+	ReceivedMessages      metrics.Counter
+	CompressedMessages    metrics.Counter
+	CompressionSavedBytes metrics.Counter
 }
 
 func newCommMetrics(p metrics.Provider) *CommMetrics {
 	return &CommMetrics{
-		SentMessages:     p.NewCounter(SentMessagesOpts),
-		BufferOverflow:   p.NewCounter(BufferOverflowOpts),
-		ReceivedMessages: p.NewCounter(ReceivedMessagesOpts),
+		SentMessages:          p.NewCounter(SentMessagesOpts),
+		BufferOverflow:        p.NewCounter(BufferOverflowOpts),
+		ReceivedMessages:      p.NewCounter(ReceivedMessagesOpts),
+		CompressedMessages:    p.NewCounter(CompressedMessagesOpts),
+		CompressionSavedBytes: p.NewCounter(CompressionSavedBytesOpts),
 	}
 }
 
@@ -132,6 +169,22 @@ var (
 		Help:         "Number of messages received",
 		StatsdFormat: "%{#fqname}",
 	}
+
+	CompressedMessagesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "messages_compressed",
+		Help:         "Number of messages sent compressed",
+		StatsdFormat: "%{#fqname}",
+	}
+
+	CompressionSavedBytesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "compression_saved_bytes",
+		Help:         "Number of bytes saved by compressing sent messages",
+		StatsdFormat: "%{#fqname}",
+	}
 )
 
 // MembershipMetrics encapsulates gossip channel membership related metrics
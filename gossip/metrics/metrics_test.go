@@ -34,6 +34,9 @@ func TestMetrics(t *testing.T) {
 	assert.NotNil(t, gossipMetrics.StateMetrics.Height)
 	assert.NotNil(t, gossipMetrics.StateMetrics.CommitDuration)
 	assert.NotNil(t, gossipMetrics.StateMetrics.PayloadBufferSize)
+	assert.NotNil(t, gossipMetrics.StateMetrics.StreamedBlocks)
+	assert.NotNil(t, gossipMetrics.StateMetrics.StreamedBytes)
+	assert.NotNil(t, gossipMetrics.StateMetrics.StreamDuration)
 
 	assert.NotNil(t, gossipMetrics.ElectionMetrics)
 	assert.NotNil(t, gossipMetrics.ElectionMetrics.Declaration)
@@ -42,6 +45,8 @@ func TestMetrics(t *testing.T) {
 	assert.NotNil(t, gossipMetrics.CommMetrics.SentMessages)
 	assert.NotNil(t, gossipMetrics.CommMetrics.ReceivedMessages)
 	assert.NotNil(t, gossipMetrics.CommMetrics.BufferOverflow)
+	assert.NotNil(t, gossipMetrics.CommMetrics.CompressedMessages)
+	assert.NotNil(t, gossipMetrics.CommMetrics.CompressionSavedBytes)
 
 	assert.NotNil(t, gossipMetrics.MembershipMetrics)
 	assert.NotNil(t, gossipMetrics.MembershipMetrics.Total)
@@ -25,6 +25,9 @@ type TestMetricProvider struct {
 	FakeBufferOverflow   *metricsfakes.Counter
 	FakeReceivedMessages *metricsfakes.Counter
 
+	FakeCompressedMessages    *metricsfakes.Counter
+	FakeCompressionSavedBytes *metricsfakes.Counter
+
 	FakeTotalGauge *metricsfakes.Gauge
 
 	FakeValidationDuration             *metricsfakes.Histogram
@@ -51,6 +54,9 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 	fakeBufferOverflow := testUtilConstructCounter()
 	fakeReceivedMessages := testUtilConstructCounter()
 
+	fakeCompressedMessages := testUtilConstructCounter()
+	fakeCompressionSavedBytes := testUtilConstructCounter()
+
 	fakeTotalGauge := testUtilConstructGauge()
 
 	fakeValidationDuration := testUtilConstructHist()
@@ -71,6 +77,10 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 			return fakeSentMessages
 		case gmetrics.ReceivedMessagesOpts.Name:
 			return fakeReceivedMessages
+		case gmetrics.CompressedMessagesOpts.Name:
+			return fakeCompressedMessages
+		case gmetrics.CompressionSavedBytesOpts.Name:
+			return fakeCompressionSavedBytes
 		}
 		return nil
 	}
@@ -122,6 +132,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 		fakeSentMessages,
 		fakeBufferOverflow,
 		fakeReceivedMessages,
+		fakeCompressedMessages,
+		fakeCompressionSavedBytes,
 		fakeTotalGauge,
 		fakeValidationDuration,
 		fakeListMissingPrivateDataDuration,
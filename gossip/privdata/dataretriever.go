@@ -95,11 +95,11 @@ func (dr *dataRetriever) CollectionRWSet(digests []*gossip2.PvtDataDigest, block
 		return results, false, nil
 	}
 	// Since ledger height is above block sequence number private data is might be available in the ledger
-	results, err := dr.fromLedger(digests, blockNum)
+	results, err := dr.fromLedger(digests, blockNum, height)
 	return results, true, err
 }
 
-func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum uint64) (Dig2PvtRWSetWithConfig, error) {
+func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum uint64, height uint64) (Dig2PvtRWSetWithConfig, error) {
 	filter := make(map[string]ledger.PvtCollFilter)
 	for _, dig := range digests {
 		if _, ok := filter[dig.Namespace]; !ok {
@@ -155,6 +155,10 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 				" namespace <%s> txID <%s>", dig.Collection, dig.Namespace, dig.TxId)
 		}
 		pvtRWSetWithConfig.CollectionConfig = configs
+		pvtRWSetWithConfig.NewMembersExcluded, err = dr.excludesNewMembers(confHistoryRetriever, dig, height)
+		if err != nil {
+			return nil, err
+		}
 		results[common.DigKey{
 			Namespace:  dig.Namespace,
 			Collection: dig.Collection,
@@ -167,6 +171,34 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 	return results, nil
 }
 
+// excludesNewMembers returns whether the most recent collection config forbids sharing
+// the private data denoted by the given digest with organizations that were added
+// to the collection after the data was committed
+func (dr *dataRetriever) excludesNewMembers(confHistoryRetriever ledger.ConfigHistoryRetriever, dig *gossip2.PvtDataDigest, height uint64) (bool, error) {
+	configInfo, err := confHistoryRetriever.MostRecentCollectionConfigBelow(height, dig.Namespace)
+	if err != nil {
+		return false, errors.Errorf("cannot find recent collection config update below ledger height = %d,"+
+			" collection name = <%s> for chaincode <%s>", height, dig.Collection, dig.Namespace)
+	}
+	if configInfo == nil {
+		return false, nil
+	}
+	latestConfig := extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
+	if latestConfig == nil {
+		// the collection was removed from the chaincode definition
+		return true, nil
+	}
+	staticConfig := latestConfig.GetStaticCollectionConfig()
+	if staticConfig.ExcludeHistoryFromNewMembers {
+		return true, nil
+	}
+	depth := staticConfig.NewMembersHistoryDepth
+	if depth == 0 {
+		return false, nil
+	}
+	return addWithOverflow(dig.BlockSeq, depth) < height, nil
+}
+
 func (dr *dataRetriever) fromTransientStore(dig *gossip2.PvtDataDigest, filter map[string]ledger.PvtCollFilter) (*util.PrivateRWSetWithConfig, error) {
 	results := &util.PrivateRWSetWithConfig{}
 	it, err := dr.store.GetTxPvtRWSetByTxid(dig.TxId, filter)
@@ -167,6 +167,96 @@ func TestNewDataRetriever_GetDataFromLedger(t *testing.T) {
 	assertion.Equal([]byte{1, 2, 3, 4}, mergedRWSet)
 }
 
+func TestNewDataRetriever_NewMembersExcluded(t *testing.T) {
+	t.Parallel()
+
+	namespace := "testChaincodeName1"
+	collectionName := "testCollectionName"
+
+	result := []*ledger.TxPvtData{{
+		WriteSet: &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				pvtReadWriteSet(namespace, collectionName, []byte{1, 2}),
+			},
+		},
+		SeqInBlock: 1,
+	}}
+
+	latestConfig := func(exclude bool, depth uint64) *ledger.CollectionConfigInfo {
+		conf := newCollectionConfig(collectionName)
+		staticConf := conf.CollectionConfig.Config[0].GetStaticCollectionConfig()
+		staticConf.ExcludeHistoryFromNewMembers = exclude
+		staticConf.NewMembersHistoryDepth = depth
+		return conf
+	}
+
+	for _, testCase := range []struct {
+		description      string
+		latestConfig     *ledger.CollectionConfigInfo
+		expectedExcluded bool
+	}{
+		{
+			description:      "history shared with new members",
+			latestConfig:     latestConfig(false, 0),
+			expectedExcluded: false,
+		},
+		{
+			description:      "history excluded from new members",
+			latestConfig:     latestConfig(true, 0),
+			expectedExcluded: true,
+		},
+		{
+			description:      "block within history depth",
+			latestConfig:     latestConfig(false, 5),
+			expectedExcluded: false,
+		},
+		{
+			description:      "block beyond history depth",
+			latestConfig:     latestConfig(false, 4),
+			expectedExcluded: true,
+		},
+		{
+			description:      "collection missing from latest config",
+			latestConfig:     newCollectionConfig("otherCollectionName"),
+			expectedExcluded: true,
+		},
+	} {
+		testCase := testCase
+		t.Run(testCase.description, func(t *testing.T) {
+			dataStore := &mocks.DataStore{}
+			dataStore.On("LedgerHeight").Return(uint64(10), nil)
+			dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).Return(result, nil)
+
+			historyRetreiver := &mocks.ConfigHistoryRetriever{}
+			historyRetreiver.On("MostRecentCollectionConfigBelow", uint64(5), namespace).Return(newCollectionConfig(collectionName), nil)
+			historyRetreiver.On("MostRecentCollectionConfigBelow", uint64(10), namespace).Return(testCase.latestConfig, nil)
+			dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
+
+			retriever := NewDataRetriever(dataStore)
+			rwSets, wasFetchedFromLedger, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
+				Namespace:  namespace,
+				Collection: collectionName,
+				BlockSeq:   uint64(5),
+				TxId:       "testTxID",
+				SeqInBlock: 1,
+			}}, uint64(5))
+
+			assert.NoError(t, err)
+			assert.True(t, wasFetchedFromLedger)
+			pvtRWSet := rwSets[privdatacommon.DigKey{
+				Namespace:  namespace,
+				Collection: collectionName,
+				BlockSeq:   5,
+				TxId:       "testTxID",
+				SeqInBlock: 1,
+			}]
+			assert.NotNil(t, pvtRWSet)
+			assert.Equal(t, testCase.expectedExcluded, pvtRWSet.NewMembersExcluded)
+		})
+	}
+}
+
 func TestNewDataRetriever_FailGetPvtDataFromLedger(t *testing.T) {
 	t.Parallel()
 	dataStore := &mocks.DataStore{}
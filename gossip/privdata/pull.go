@@ -613,7 +613,10 @@ func (p *puller) filterNotEligible(dig2rwSets Dig2PvtRWSetWithConfig, shouldChec
 			continue
 		}
 
-		eligibleForCollection := shouldCheckLatestConfig && p.isEligibleByLatestConfig(p.channel, d.Collection, d.Namespace, signedData)
+		// Organizations that joined the collection after the data was committed are
+		// eligible only by the latest config, hence skip it if the collection excludes them
+		eligibleForCollection := shouldCheckLatestConfig && !rwSets.NewMembersExcluded &&
+			p.isEligibleByLatestConfig(p.channel, d.Collection, d.Namespace, signedData)
 
 		if !eligibleForCollection {
 			colAP, err := p.AccessPolicy(rwSets.CollectionConfig, p.channel)
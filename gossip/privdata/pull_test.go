@@ -1033,6 +1033,67 @@ func TestPullerAvoidPullingPurgedData(t *testing.T) {
 
 }
 
+func TestPullerNewMembersExcluded(t *testing.T) {
+	t.Parallel()
+	// Scenario: p1 was added to col1 and col2 after the private data was committed,
+	// hence it is eligible only by the latest collection config.
+	// col1 excludes history from new members while col2 doesn't,
+	// so p1 should receive only the private data of col2 from p2
+	gn := &gossipNetwork{}
+	factoryMock := &collectionAccessFactoryMock{}
+	accessPolicyMock2 := &collectionAccessPolicyMock{}
+	accessPolicyMock2.Setup(1, 2, func(data fcommon.SignedData) bool {
+		return bytes.Equal(data.Identity, []byte("p2"))
+	}, []string{"org2"}, false)
+	factoryMock.On("AccessPolicy", mock.Anything, mock.Anything).Return(accessPolicyMock2, nil)
+
+	policyStore := newCollectionStore().withPolicy("col1", uint64(100)).thatMapsTo("p1", "p2").
+		withPolicy("col2", uint64(100)).thatMapsTo("p1", "p2")
+
+	p1 := gn.newPuller("p1", policyStore, factoryMock, membership(peerData{"p2", uint64(1)})...)
+	p2 := gn.newPuller("p2", policyStore, factoryMock)
+
+	dig1 := &proto.PvtDataDigest{
+		TxId:       "txID1",
+		Collection: "col1",
+		Namespace:  "ns1",
+	}
+
+	dig2 := &proto.PvtDataDigest{
+		TxId:       "txID1",
+		Collection: "col2",
+		Namespace:  "ns1",
+	}
+
+	store := Dig2PvtRWSetWithConfig{
+		privdatacommon.DigKey{
+			TxId:       "txID1",
+			Collection: "col1",
+			Namespace:  "ns1",
+		}: &util.PrivateRWSetWithConfig{
+			RWSet:              newPRWSet(),
+			NewMembersExcluded: true,
+		},
+		privdatacommon.DigKey{
+			TxId:       "txID1",
+			Collection: "col2",
+			Namespace:  "ns1",
+		}: &util.PrivateRWSetWithConfig{
+			RWSet: newPRWSet(),
+		},
+	}
+
+	p2.PrivateDataRetriever.(*dataRetrieverMock).On("CollectionRWSet", mock.Anything, uint64(0)).Return(store, true, nil)
+
+	dasf := &digestsAndSourceFactory{}
+	d2s := dasf.mapDigest(toDigKey(dig1)).toSources("p2").mapDigest(toDigKey(dig2)).toSources("p2").create()
+	fetchedMessages, err := p1.fetch(d2s)
+	assert.NoError(t, err)
+	assert.Len(t, fetchedMessages.AvailableElements, 1)
+	assert.True(t, pb.Equal(dig2, fetchedMessages.AvailableElements[0].Digest))
+	assert.Equal(t, store[*toDigKey(dig2)].RWSet[0], util.PrivateRWSet(fetchedMessages.AvailableElements[0].Payload[0]))
+}
+
 type counterDataRetreiver struct {
 	numberOfCalls int
 	PrivateDataRetriever
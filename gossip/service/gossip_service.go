@@ -7,7 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package service
 
 import (
+	"net"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/committer"
@@ -16,6 +18,7 @@ import (
 	deliverclient "github.com/hyperledger/fabric/core/deliverservice"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/gossip/api"
+	gossipComm "github.com/hyperledger/fabric/gossip/comm"
 	gossipCommon "github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/election"
 	"github.com/hyperledger/fabric/gossip/gossip"
@@ -66,10 +69,11 @@ type deliveryFactoryImpl struct {
 // Returns an instance of delivery client
 func (*deliveryFactoryImpl) Service(g GossipService, ec OrdererAddressConfig, mcs api.MessageCryptoService) (deliverclient.DeliverService, error) {
 	return deliverclient.NewDeliverService(&deliverclient.Config{
-		CryptoSvc:   mcs,
-		Gossip:      g,
-		ConnFactory: deliverclient.DefaultConnectionFactory,
-		ABCFactory:  deliverclient.DefaultABCFactory,
+		CryptoSvc:                   mcs,
+		Gossip:                      g,
+		ConnFactory:                 deliverclient.DefaultConnectionFactory,
+		ABCFactory:                  deliverclient.DefaultABCFactory,
+		ReconnectTotalTimeThreshold: getLeaderYieldThreshold(),
 	}, deliverclient.ConnectionCriteria{
 		OrdererEndpointsByOrg: ec.AddressesByOrg,
 		Organizations:         ec.Organizations,
@@ -98,16 +102,18 @@ func (p privateHandler) close() {
 
 type gossipServiceImpl struct {
 	gossipSvc
-	privateHandlers map[string]privateHandler
-	chains          map[string]state.GossipStateProvider
-	leaderElection  map[string]election.LeaderElectionService
-	deliveryService map[string]deliverclient.DeliverService
-	deliveryFactory DeliveryServiceFactory
-	lock            sync.RWMutex
-	mcs             api.MessageCryptoService
-	peerIdentity    []byte
-	secAdv          api.SecurityAdvisor
-	metrics         *gossipMetrics.GossipMetrics
+	privateHandlers   map[string]privateHandler
+	chains            map[string]state.GossipStateProvider
+	leaderElection    map[string]election.LeaderElectionService
+	deliveryService   map[string]deliverclient.DeliverService
+	deliveryFactory   DeliveryServiceFactory
+	lock              sync.RWMutex
+	mcs               api.MessageCryptoService
+	peerIdentity      []byte
+	secAdv            api.SecurityAdvisor
+	metrics           *gossipMetrics.GossipMetrics
+	blockStreamServer *state.BlockStreamServer
+	blockStreamer     state.BlockStreamer
 }
 
 // This is an implementation of api.JoinChannelMessage.
@@ -168,17 +174,25 @@ func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, metricsProvider
 
 		gossip, err = integration.NewGossipComponent(peerIdentity, endpoint, s, secAdv,
 			mcs, secureDialOpts, certs, gossipMetrics, bootPeers...)
+
+		blockStreamServer := state.NewBlockStreamServer(mcs, certs != nil, getBlockStreamMaxSegmentSize())
+		gproto.RegisterBlockStreamServer(s, blockStreamServer)
+		blockStreamer := state.NewBlockStreamer(peerIdentity, mcs.Sign, secureDialOpts, certs,
+			util.GetDurationOrDefault("peer.gossip.dialTimeout", gossipComm.DefDialTimeout))
+
 		gossipServiceInstance = &gossipServiceImpl{
-			mcs:             mcs,
-			gossipSvc:       gossip,
-			privateHandlers: make(map[string]privateHandler),
-			chains:          make(map[string]state.GossipStateProvider),
-			leaderElection:  make(map[string]election.LeaderElectionService),
-			deliveryService: make(map[string]deliverclient.DeliverService),
-			deliveryFactory: factory,
-			peerIdentity:    peerIdentity,
-			secAdv:          secAdv,
-			metrics:         gossipMetrics,
+			mcs:               mcs,
+			gossipSvc:         gossip,
+			privateHandlers:   make(map[string]privateHandler),
+			chains:            make(map[string]state.GossipStateProvider),
+			leaderElection:    make(map[string]election.LeaderElectionService),
+			deliveryService:   make(map[string]deliverclient.DeliverService),
+			deliveryFactory:   factory,
+			peerIdentity:      peerIdentity,
+			secAdv:            secAdv,
+			metrics:           gossipMetrics,
+			blockStreamServer: blockStreamServer,
+			blockStreamer:     blockStreamer,
 		}
 	})
 	return errors.WithStack(err)
@@ -240,7 +254,7 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, oac OrdererAddress
 	defer g.lock.Unlock()
 	// Initialize new state provider for given committer
 	logger.Debug("Creating state provider for chainID", chainID)
-	servicesAdapter := &state.ServicesMediator{GossipAdapter: g, MCSAdapter: g.mcs}
+	servicesAdapter := &state.ServicesMediator{GossipAdapter: g, MCSAdapter: g.mcs, BlockStreamer: g.blockStreamer}
 
 	// Embed transient store and committer APIs to fulfill
 	// DataStore interface to capture ability of retrieving
@@ -292,6 +306,9 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, oac OrdererAddress
 
 	g.chains[chainID] = state.NewGossipStateProvider(chainID, servicesAdapter, coordinator,
 		g.metrics.StateMetrics, getStateConfiguration())
+	if g.blockStreamServer != nil {
+		g.blockStreamServer.RegisterChannel(chainID, coordinator)
+	}
 	if g.deliveryService[chainID] == nil {
 		var err error
 		g.deliveryService[chainID], err = g.deliveryFactory.Service(g, oac, g.mcs)
@@ -319,7 +336,7 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, oac OrdererAddress
 		if leaderElection {
 			logger.Debug("Delivery uses dynamic leader election mechanism, channel", chainID)
 			g.leaderElection[chainID] = g.newLeaderElectionComponent(chainID, g.onStatusChangeFactory(chainID,
-				support.Committer), g.metrics.ElectionMetrics)
+				support.Committer), g.metrics.ElectionMetrics, getLeaderElectionRanker(support.Committer, oac))
 		} else if isStaticOrgLeader {
 			logger.Debug("This peer is configured to connect to ordering service for blocks delivery, channel", chainID)
 			g.deliveryService[chainID].StartDeliverForChannel(chainID, support.Committer, func() {})
@@ -400,6 +417,9 @@ func (g *gossipServiceImpl) Stop() {
 			logger.Infof("Stopping leader election for %s", chainID)
 			le.Stop()
 		}
+		if g.blockStreamServer != nil {
+			g.blockStreamServer.UnregisterChannel(chainID)
+		}
 		g.chains[chainID].Stop()
 		g.privateHandlers[chainID].close()
 
@@ -411,7 +431,7 @@ func (g *gossipServiceImpl) Stop() {
 }
 
 func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback func(bool),
-	electionMetrics *gossipMetrics.ElectionMetrics) election.LeaderElectionService {
+	electionMetrics *gossipMetrics.ElectionMetrics, ranker election.Ranker) election.LeaderElectionService {
 	PKIid := g.mcs.GetPKIidOfCert(g.peerIdentity)
 	adapter := election.NewAdapter(g, PKIid, gossipCommon.ChainID(chainID), electionMetrics)
 	config := election.ElectionConfig{
@@ -419,10 +439,98 @@ func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback
 		MembershipSampleInterval: util.GetDurationOrDefault("peer.gossip.election.membershipSampleInterval", election.DefMembershipSampleInterval),
 		LeaderAliveThreshold:     util.GetDurationOrDefault("peer.gossip.election.leaderAliveThreshold", election.DefLeaderAliveThreshold),
 		LeaderElectionDuration:   util.GetDurationOrDefault("peer.gossip.election.leaderElectionDuration", election.DefLeaderElectionDuration),
+		Ranker:                   ranker,
 	}
 	return election.NewLeaderElectionService(adapter, string(PKIid), callback, config)
 }
 
+// ValidateLeaderElectionRanking returns an error if the leader election
+// ranking strategy configured in peer.gossip.election.ranking is unknown
+func ValidateLeaderElectionRanking() error {
+	switch ranking := viper.GetString("peer.gossip.election.ranking"); ranking {
+	case "", "ledgerHeight", "ordererLatency", "priority":
+		return nil
+	default:
+		return errors.Errorf("unknown leader election ranking strategy %q in peer.gossip.election.ranking, "+
+			"expected ledgerHeight, ordererLatency or priority", ranking)
+	}
+}
+
+// getLeaderElectionRanker returns the Ranker of the leader election strategy
+// configured in peer.gossip.election.ranking, which is expected to have been
+// validated with ValidateLeaderElectionRanking when the peer started
+func getLeaderElectionRanker(ledgerInfo blocksprovider.LedgerInfo, oac OrdererAddressConfig) election.Ranker {
+	switch ranking := viper.GetString("peer.gossip.election.ranking"); ranking {
+	case "":
+		return nil
+	case "ledgerHeight":
+		return election.NewLedgerHeightRanker(ledgerInfo.LedgerHeight)
+	case "ordererLatency":
+		endpoints := oac.Addresses
+		for _, org := range oac.Organizations {
+			endpoints = append(endpoints, oac.AddressesByOrg[org]...)
+		}
+		refreshInterval := util.GetDurationOrDefault("peer.gossip.election.leaderAliveThreshold", election.DefLeaderAliveThreshold)
+		dialTimeout := util.GetDurationOrDefault("peer.deliveryclient.connTimeout", gossipComm.DefDialTimeout)
+		return election.NewLatencyRanker(ordererLatencyProbe(endpoints, dialTimeout), refreshInterval)
+	case "priority":
+		return election.StaticRanker(uint64(viper.GetInt("peer.gossip.election.priority")))
+	default:
+		logger.Panicf("Unknown leader election ranking strategy: %s", ranking)
+		return nil
+	}
+}
+
+// ordererLatencyProbe returns a probe that measures the time it takes to connect
+// to the closest of the given ordering service endpoints. The endpoints are dialed
+// in parallel, and the probe fails if none of them is reached within dialTimeout.
+func ordererLatencyProbe(endpoints []string, dialTimeout time.Duration) func() (time.Duration, error) {
+	type dialResult struct {
+		latency time.Duration
+		err     error
+	}
+	return func() (time.Duration, error) {
+		if len(endpoints) == 0 {
+			return 0, errors.New("no ordering service endpoints")
+		}
+		dialer := &net.Dialer{Deadline: time.Now().Add(dialTimeout)}
+		results := make(chan dialResult, len(endpoints))
+		for _, endpoint := range endpoints {
+			go func(endpoint string) {
+				start := time.Now()
+				conn, err := dialer.Dial("tcp", endpoint)
+				if err != nil {
+					results <- dialResult{err: err}
+					return
+				}
+				latency := time.Since(start)
+				conn.Close()
+				results <- dialResult{latency: latency}
+			}(endpoint)
+		}
+		// all endpoints are dialed at the same time,
+		// so the first connection is the closest one
+		var err error
+		for range endpoints {
+			result := <-results
+			if result.err == nil {
+				return result.latency, nil
+			}
+			err = result.err
+		}
+		return 0, err
+	}
+}
+
+// getLeaderYieldThreshold returns the time the delivery service keeps failing to
+// receive blocks before it gives up, which makes a dynamically elected leader yield
+func getLeaderYieldThreshold() time.Duration {
+	if !viper.GetBool("peer.gossip.useLeaderElection") {
+		return 0
+	}
+	return viper.GetDuration("peer.gossip.election.leaderYieldThreshold")
+}
+
 func (g *gossipServiceImpl) amIinChannel(myOrg string, config Config) bool {
 	for _, orgName := range orgListFromConfig(config) {
 		if orgName == myOrg {
@@ -474,6 +582,11 @@ func getStateConfiguration() *state.Configuration {
 		ChannelBufferSize:               state.DefChannelBufferSize,
 		EnableStateTransfer:             true,
 		BlockingMode:                    state.Blocking,
+		BlockStreamEnabled:              true,
+		BlockStreamThreshold:            state.DefBlockStreamThreshold,
+		BlockStreamSegmentSize:          state.DefBlockStreamSegmentSize,
+		BlockStreamMaxPeers:             state.DefBlockStreamMaxPeers,
+		BlockStreamSegmentTimeout:       state.DefBlockStreamSegmentTimeout,
 	}
 
 	if viper.IsSet("peer.gossip.state.checkInterval") {
@@ -508,5 +621,43 @@ func getStateConfiguration() *state.Configuration {
 		config.BlockingMode = state.NonBlocking
 	}
 
+	if viper.IsSet("peer.gossip.state.blockStream.enabled") {
+		config.BlockStreamEnabled = viper.GetBool("peer.gossip.state.blockStream.enabled")
+	}
+
+	if viper.IsSet("peer.gossip.state.blockStream.threshold") {
+		config.BlockStreamThreshold = uint64(viper.GetInt("peer.gossip.state.blockStream.threshold"))
+	}
+
+	if viper.IsSet("peer.gossip.state.blockStream.segmentSize") {
+		config.BlockStreamSegmentSize = uint64(getPositiveIntOrDefault("peer.gossip.state.blockStream.segmentSize", state.DefBlockStreamSegmentSize))
+	}
+
+	if viper.IsSet("peer.gossip.state.blockStream.maxPeers") {
+		config.BlockStreamMaxPeers = getPositiveIntOrDefault("peer.gossip.state.blockStream.maxPeers", state.DefBlockStreamMaxPeers)
+	}
+
+	if viper.IsSet("peer.gossip.state.blockStream.segmentTimeout") {
+		config.BlockStreamSegmentTimeout = viper.GetDuration("peer.gossip.state.blockStream.segmentTimeout")
+	}
+
 	return config
 }
+
+func getBlockStreamMaxSegmentSize() uint64 {
+	if viper.IsSet("peer.gossip.state.blockStream.maxSegmentSize") {
+		return uint64(getPositiveIntOrDefault("peer.gossip.state.blockStream.maxSegmentSize", state.DefBlockStreamMaxSegmentSize))
+	}
+	return state.DefBlockStreamMaxSegmentSize
+}
+
+// getPositiveIntOrDefault returns the int value of key, or defVal
+// if the value is lower than 1
+func getPositiveIntOrDefault(key string, defVal int) int {
+	val := viper.GetInt(key)
+	if val < 1 {
+		logger.Warningf("%s must be at least 1 but is %d, using the default value %d instead", key, val, defVal)
+		return defVal
+	}
+	return val
+}
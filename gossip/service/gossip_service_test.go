@@ -426,7 +426,7 @@ func TestLeaderElectionWithRealGossip(t *testing.T) {
 	for i := 0; i < n; i++ {
 		services[i] = &electionService{nil, false, 0}
 		services[i].LeaderElectionService = gossips[i].(*gossipGRPC).gossipServiceImpl.newLeaderElectionComponent(channelName,
-			services[i].callback, electionMetrics)
+			services[i].callback, electionMetrics, nil)
 	}
 
 	logger.Warning("Waiting for leader election")
@@ -454,7 +454,7 @@ func TestLeaderElectionWithRealGossip(t *testing.T) {
 		secondChannelServices[idx] = &electionService{nil, false, 0}
 		secondChannelServices[idx].LeaderElectionService =
 			gossips[i].(*gossipGRPC).gossipServiceImpl.newLeaderElectionComponent(secondChannelName,
-				secondChannelServices[idx].callback, electionMetrics)
+				secondChannelServices[idx].callback, electionMetrics, nil)
 	}
 
 	assert.True(t, waitForLeaderElection(t, secondChannelServices, time.Second*30, time.Second*2), "One leader should be selected for chanB")
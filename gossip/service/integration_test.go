@@ -16,6 +16,8 @@ import (
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/transientstore"
 	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/election"
+	"github.com/hyperledger/fabric/gossip/state"
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	transientstore2 "github.com/hyperledger/fabric/protos/transientstore"
@@ -108,8 +110,8 @@ func TestLeaderYield(t *testing.T) {
 	// Test case has only two instance + making assertions only after membership view
 	// is stable, hence election duration could be shorter
 	viper.Set("peer.gossip.election.leaderElectionDuration", time.Millisecond*500)
-	// It's enough to make single re-try
-	viper.Set("peer.deliveryclient.reconnectTotalTimeThreshold", time.Second*1)
+	// It's enough to make single re-try before yielding
+	viper.Set("peer.gossip.election.leaderYieldThreshold", time.Second*1)
 	// Since we ensuring gossip has stable membership, there is no need for
 	// leader election to wait for stabilization
 	viper.Set("peer.gossip.election.membershipSampleInterval", time.Millisecond*100)
@@ -189,3 +191,109 @@ func TestLeaderYield(t *testing.T) {
 	p0.deliveryService[channelName].Stop()
 	p1.deliveryService[channelName].Stop()
 }
+
+func restoreViperKeys(keys ...string) func() {
+	values := make(map[string]interface{})
+	for _, key := range keys {
+		values[key] = viper.Get(key)
+	}
+	return func() {
+		for key, value := range values {
+			viper.Set(key, value)
+		}
+	}
+}
+
+func TestGetLeaderYieldThreshold(t *testing.T) {
+	defer restoreViperKeys("peer.gossip.useLeaderElection", "peer.gossip.election.leaderYieldThreshold")()
+	viper.Set("peer.gossip.election.leaderYieldThreshold", time.Minute)
+
+	viper.Set("peer.gossip.useLeaderElection", false)
+	assert.Equal(t, time.Duration(0), getLeaderYieldThreshold())
+
+	viper.Set("peer.gossip.useLeaderElection", true)
+	assert.Equal(t, time.Minute, getLeaderYieldThreshold())
+}
+
+func TestGetStateConfigurationBlockStream(t *testing.T) {
+	defer restoreViperKeys(
+		"peer.gossip.state.blockStream.segmentSize",
+		"peer.gossip.state.blockStream.maxPeers",
+		"peer.gossip.state.blockStream.maxSegmentSize",
+	)()
+
+	viper.Set("peer.gossip.state.blockStream.segmentSize", 50)
+	viper.Set("peer.gossip.state.blockStream.maxPeers", 5)
+	viper.Set("peer.gossip.state.blockStream.maxSegmentSize", 500)
+	config := getStateConfiguration()
+	assert.Equal(t, uint64(50), config.BlockStreamSegmentSize)
+	assert.Equal(t, 5, config.BlockStreamMaxPeers)
+	assert.Equal(t, uint64(500), getBlockStreamMaxSegmentSize())
+
+	// Values lower than 1 are replaced with the defaults
+	viper.Set("peer.gossip.state.blockStream.segmentSize", 0)
+	viper.Set("peer.gossip.state.blockStream.maxPeers", 0)
+	viper.Set("peer.gossip.state.blockStream.maxSegmentSize", -1)
+	config = getStateConfiguration()
+	assert.Equal(t, uint64(state.DefBlockStreamSegmentSize), config.BlockStreamSegmentSize)
+	assert.Equal(t, state.DefBlockStreamMaxPeers, config.BlockStreamMaxPeers)
+	assert.Equal(t, uint64(state.DefBlockStreamMaxSegmentSize), getBlockStreamMaxSegmentSize())
+}
+
+func TestGetLeaderElectionRanker(t *testing.T) {
+	defer restoreViperKeys("peer.gossip.election.ranking", "peer.gossip.election.priority")()
+	ledgerInfo := &mockLedgerInfo{100}
+
+	viper.Set("peer.gossip.election.ranking", "")
+	assert.Nil(t, getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{}))
+
+	viper.Set("peer.gossip.election.ranking", "ledgerHeight")
+	assert.Equal(t, uint64(100), getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{}).Rank())
+
+	viper.Set("peer.gossip.election.ranking", "priority")
+	viper.Set("peer.gossip.election.priority", 5)
+	assert.Equal(t, uint64(5), getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{}).Rank())
+
+	endpoint, socket := getAvailablePort(t)
+	defer socket.Close()
+	closedEndpoint, closedSocket := getAvailablePort(t)
+	closedSocket.Close()
+	viper.Set("peer.gossip.election.ranking", "ordererLatency")
+	reachable := getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{
+		Addresses:      []string{closedEndpoint},
+		Organizations:  []string{"org"},
+		AddressesByOrg: map[string][]string{"org": {endpoint}},
+	})
+	waitForNonZeroRank(t, reachable)
+	unreachable := getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{Addresses: []string{closedEndpoint}})
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, unreachable.Rank())
+
+	viper.Set("peer.gossip.election.ranking", "coinToss")
+	assert.Panics(t, func() {
+		getLeaderElectionRanker(ledgerInfo, OrdererAddressConfig{})
+	})
+}
+
+func TestValidateLeaderElectionRanking(t *testing.T) {
+	defer restoreViperKeys("peer.gossip.election.ranking")()
+
+	for _, ranking := range []string{"", "ledgerHeight", "ordererLatency", "priority"} {
+		viper.Set("peer.gossip.election.ranking", ranking)
+		assert.NoError(t, ValidateLeaderElectionRanking())
+	}
+
+	viper.Set("peer.gossip.election.ranking", "coinToss")
+	assert.EqualError(t, ValidateLeaderElectionRanking(), `unknown leader election ranking strategy "coinToss" in `+
+		"peer.gossip.election.ranking, expected ledgerHeight, ordererLatency or priority")
+}
+
+func waitForNonZeroRank(t *testing.T, ranker election.Ranker) {
+	deadline := time.Now().Add(5 * time.Second)
+	for ranker.Rank() == 0 {
+		if time.Now().After(deadline) {
+			assert.FailNow(t, "rank wasn't measured")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/util"
+	corecomm "github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/comm"
+	common2 "github.com/hyperledger/fabric/gossip/common"
+	gossiputil "github.com/hyperledger/fabric/gossip/util"
+	"github.com/hyperledger/fabric/protos/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const (
+	DefBlockStreamThreshold      = 100
+	DefBlockStreamSegmentSize    = 100
+	DefBlockStreamMaxPeers       = 3
+	DefBlockStreamSegmentTimeout = 60 * time.Second
+	DefBlockStreamMaxSegmentSize = 1000
+
+	// blockStreamRequestTimeWindow is the maximum difference between the timestamp
+	// of a BlockStreamRequest and the local time of the peer that serves it
+	blockStreamRequestTimeWindow = 15 * time.Minute
+)
+
+// BlockSource provides the blocks that are served over the block stream
+type BlockSource interface {
+	// GetPvtDataAndBlockByNum get block by number and returns also all related private data
+	// the order of private data in slice of PvtDataCollections doesn't imply the order of
+	// transactions in the block related to these private data, to get the correct placement
+	// need to read TxPvtData.SeqInBlock field
+	GetPvtDataAndBlockByNum(seqNum uint64, peerAuthInfo common.SignedData) (*common.Block, gossiputil.PvtDataCollections, error)
+
+	// Get recent block sequence number
+	LedgerHeight() (uint64, error)
+}
+
+// BlockStreamServer serves ranges of blocks of the channels
+// registered with it to lagging peers over the BlockStream service
+type BlockStreamServer struct {
+	mcs            MCSAdapter
+	tlsEnabled     bool
+	maxSegmentSize uint64
+	lock           sync.RWMutex
+	channels       map[string]BlockSource
+}
+
+// NewBlockStreamServer creates a BlockStreamServer that verifies requests with the given MCSAdapter,
+// and serves at most maxSegmentSize blocks per request
+func NewBlockStreamServer(mcs MCSAdapter, tlsEnabled bool, maxSegmentSize uint64) *BlockStreamServer {
+	return &BlockStreamServer{
+		mcs:            mcs,
+		tlsEnabled:     tlsEnabled,
+		maxSegmentSize: maxSegmentSize,
+		channels:       make(map[string]BlockSource),
+	}
+}
+
+// RegisterChannel makes the blocks of the given channel available over the block stream
+func (s *BlockStreamServer) RegisterChannel(chainID string, source BlockSource) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.channels[chainID] = source
+}
+
+// UnregisterChannel stops serving the blocks of the given channel
+func (s *BlockStreamServer) UnregisterChannel(chainID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.channels, chainID)
+}
+
+// StreamBlocks streams the blocks requested by the BlockStreamRequest
+// that is marshalled in the given envelope
+func (s *BlockStreamServer) StreamBlocks(envelope *proto.Envelope, stream proto.BlockStream_StreamBlocksServer) error {
+	request, err := s.validateRequest(stream.Context(), envelope)
+	if err != nil {
+		logger.Warningf("Rejecting block stream request: %s", err)
+		return err
+	}
+
+	chainID := string(request.Channel)
+	s.lock.RLock()
+	source, exists := s.channels[chainID]
+	s.lock.RUnlock()
+	if !exists {
+		return errors.Errorf("channel %s isn't served over the block stream", chainID)
+	}
+
+	height, err := source.LedgerHeight()
+	if err != nil {
+		return errors.WithMessage(err, "failed obtaining ledger height")
+	}
+	if request.StartSeqNum >= height {
+		return errors.Errorf("requested blocks [%d...%d] are above ledger height %d", request.StartSeqNum, request.EndSeqNum, height)
+	}
+	endSeqNum := min(height-1, request.EndSeqNum)
+
+	peerAuthInfo := common.SignedData{
+		Data:      envelope.Payload,
+		Signature: envelope.Signature,
+		Identity:  request.Identity,
+	}
+
+	logger.Debugf("[%s] Streaming blocks [%d...%d]", chainID, request.StartSeqNum, endSeqNum)
+	for seqNum := request.StartSeqNum; seqNum <= endSeqNum; seqNum++ {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+
+		block, pvtData, err := source.GetPvtDataAndBlockByNum(seqNum, peerAuthInfo)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed reading block %d from ledger", seqNum))
+		}
+
+		blockBytes, err := pb.Marshal(block)
+		if err != nil {
+			return errors.Wrapf(err, "failed marshaling block %d", seqNum)
+		}
+
+		var pvtBytes [][]byte
+		if pvtData != nil {
+			pvtBytes, err = pvtData.Marshal()
+			if err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("failed marshaling private rwset for block %d", seqNum))
+			}
+		}
+
+		err = stream.Send(&proto.BlockStreamResponse{
+			Payload: &proto.Payload{
+				SeqNum:      seqNum,
+				Data:        blockBytes,
+				PrivateData: pvtBytes,
+			},
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func (s *BlockStreamServer) validateRequest(ctx context.Context, envelope *proto.Envelope) (*proto.BlockStreamRequest, error) {
+	if envelope == nil {
+		return nil, errors.New("nil envelope")
+	}
+	request := &proto.BlockStreamRequest{}
+	if err := pb.Unmarshal(envelope.Payload, request); err != nil {
+		return nil, errors.Wrap(err, "malformed block stream request")
+	}
+
+	if request.StartSeqNum > request.EndSeqNum {
+		return nil, errors.Errorf("invalid sequence interval [%d...%d]", request.StartSeqNum, request.EndSeqNum)
+	}
+	if request.EndSeqNum-request.StartSeqNum >= s.maxSegmentSize {
+		return nil, errors.Errorf("requested blocks range [%d...%d] is greater than the allowed segment size (%d)",
+			request.StartSeqNum, request.EndSeqNum, s.maxSegmentSize)
+	}
+
+	requestTime := time.Unix(0, int64(request.Timestamp))
+	if requestTime.Before(time.Now().Add(-blockStreamRequestTimeWindow)) || requestTime.After(time.Now().Add(blockStreamRequestTimeWindow)) {
+		return nil, errors.Errorf("request timestamp %s is more than %s apart from the current time", requestTime, blockStreamRequestTimeWindow)
+	}
+
+	if s.tlsEnabled {
+		if !bytes.Equal(corecomm.ExtractCertificateHashFromContext(ctx), request.TlsCertHash) {
+			return nil, errors.New("TLS certificate hash of the request doesn't match the TLS certificate of the connection")
+		}
+	}
+
+	err := s.mcs.VerifyByChannel(common2.ChainID(request.Channel), request.Identity, envelope.Signature, envelope.Payload)
+	if err != nil {
+		return nil, errors.WithMessage(err, "request isn't authorized")
+	}
+	return request, nil
+}
+
+// BlockReceiver receives the blocks of a block stream one by one
+type BlockReceiver interface {
+	// Recv returns the next block of the stream, or io.EOF
+	// once all blocks of the stream have been received
+	Recv() (*proto.BlockStreamResponse, error)
+}
+
+// BlockStreamer opens block streams to remote peers
+type BlockStreamer interface {
+	// StreamBlocks requests the blocks in the range [start...end] of the given channel
+	// from the given peer. The stream is torn down once the given context is done.
+	StreamBlocks(ctx context.Context, peer *comm.RemotePeer, chainID string, start uint64, end uint64) (BlockReceiver, error)
+}
+
+type blockStreamer struct {
+	identity       api.PeerIdentityType
+	signer         proto.Signer
+	secureDialOpts api.PeerSecureDialOpts
+	certs          *common2.TLSCertificates
+	dialTimeout    time.Duration
+}
+
+// NewBlockStreamer creates a BlockStreamer that signs its requests with the given signer,
+// and dials remote peers with the given secure dial options
+func NewBlockStreamer(identity api.PeerIdentityType, signer proto.Signer, secureDialOpts api.PeerSecureDialOpts,
+	certs *common2.TLSCertificates, dialTimeout time.Duration) BlockStreamer {
+	return &blockStreamer{
+		identity:       identity,
+		signer:         signer,
+		secureDialOpts: secureDialOpts,
+		certs:          certs,
+		dialTimeout:    dialTimeout,
+	}
+}
+
+// StreamBlocks requests the blocks in the range [start...end] of the given channel
+// from the given peer. The stream is torn down once the given context is done.
+func (bs *blockStreamer) StreamBlocks(ctx context.Context, peer *comm.RemotePeer, chainID string, start uint64, end uint64) (BlockReceiver, error) {
+	request, err := pb.Marshal(&proto.BlockStreamRequest{
+		Channel:     []byte(chainID),
+		StartSeqNum: start,
+		EndSeqNum:   end,
+		Identity:    bs.identity,
+		Timestamp:   uint64(time.Now().UnixNano()),
+		TlsCertHash: bs.tlsCertHash(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed marshaling block stream request")
+	}
+	signature, err := bs.signer(request)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed signing block stream request")
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, bs.dialTimeout)
+	defer cancel()
+	cc, err := grpc.DialContext(dialCtx, peer.Endpoint, append(bs.secureDialOpts(), grpc.WithBlock())...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed connecting to %s", peer.Endpoint)
+	}
+	go func() {
+		<-ctx.Done()
+		cc.Close()
+	}()
+
+	stream, err := proto.NewBlockStreamClient(cc).StreamBlocks(ctx, &proto.Envelope{
+		Payload:   request,
+		Signature: signature,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed opening block stream to %s", peer.Endpoint)
+	}
+	return stream, nil
+}
+
+func (bs *blockStreamer) tlsCertHash() []byte {
+	if bs.certs == nil {
+		return nil
+	}
+	cert, isCert := bs.certs.TLSClientCert.Load().(*tls.Certificate)
+	if !isCert || cert == nil || len(cert.Certificate) == 0 {
+		return nil
+	}
+	return util.ComputeSHA256(cert.Certificate[0])
+}
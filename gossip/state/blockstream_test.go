@@ -0,0 +1,425 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/comm"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/metrics"
+	"github.com/hyperledger/fabric/gossip/state/mocks"
+	gossiputil "github.com/hyperledger/fabric/gossip/util"
+	pcomm "github.com/hyperledger/fabric/protos/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+type blockStreamServerMock struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*proto.BlockStreamResponse
+}
+
+func (s *blockStreamServerMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockStreamServerMock) Send(response *proto.BlockStreamResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+type blockReceiverMock struct {
+	payloads []*proto.Payload
+	err      error
+}
+
+func (r *blockReceiverMock) Recv() (*proto.BlockStreamResponse, error) {
+	if len(r.payloads) == 0 {
+		if r.err != nil {
+			return nil, r.err
+		}
+		return nil, io.EOF
+	}
+	payload := r.payloads[0]
+	r.payloads = r.payloads[1:]
+	return &proto.BlockStreamResponse{Payload: payload}, nil
+}
+
+// blockStreamerMock streams the blocks of the given chain,
+// and fails streaming from the peers marked as faulty
+type blockStreamerMock struct {
+	sync.Mutex
+	chain    []*proto.Payload
+	faulty   map[string]error
+	requests map[uint64]string
+}
+
+func (bs *blockStreamerMock) StreamBlocks(ctx context.Context, peer *comm.RemotePeer, chainID string, start uint64, end uint64) (BlockReceiver, error) {
+	bs.Lock()
+	defer bs.Unlock()
+	if bs.requests == nil {
+		bs.requests = make(map[uint64]string)
+	}
+	bs.requests[start] = peer.Endpoint
+	if err, isFaulty := bs.faulty[peer.Endpoint]; isFaulty {
+		return nil, err
+	}
+	return &blockReceiverMock{payloads: bs.chain[start : end+1]}, nil
+}
+
+// createBlockChain creates payloads of blocks [0...n) that are linked by their previous hash
+func createBlockChain(t *testing.T, n uint64) []*proto.Payload {
+	var payloads []*proto.Payload
+	var previousHash []byte
+	for seqNum := uint64(0); seqNum < n; seqNum++ {
+		block := pcomm.NewBlock(seqNum, previousHash)
+		block.Header.DataHash = []byte{byte(seqNum)}
+		previousHash = block.Header.Hash()
+		blockBytes, err := pb.Marshal(block)
+		assert.NoError(t, err)
+		payloads = append(payloads, &proto.Payload{SeqNum: seqNum, Data: blockBytes})
+	}
+	return payloads
+}
+
+func newStreamingStateProvider(height uint64, streamer BlockStreamer, members ...discovery.NetworkMember) *GossipStateProviderImpl {
+	g := &mocks.GossipMock{}
+	g.On("PeersOfChannel", mock.Anything).Return(members)
+	return &GossipStateProviderImpl{
+		chainID: "testchainid",
+		mediator: &ServicesMediator{
+			GossipAdapter: g,
+			MCSAdapter:    &cryptoServiceMock{acceptor: noopPeerIdentityAcceptor},
+			BlockStreamer: streamer,
+		},
+		payloads: NewPayloadsBuffer(height),
+		stopCh:   make(chan struct{}, 1),
+		config: &Configuration{
+			MaxBlockDistance:          DefMaxBlockDistance,
+			BlockStreamEnabled:        true,
+			BlockStreamThreshold:      10,
+			BlockStreamSegmentSize:    10,
+			BlockStreamMaxPeers:       2,
+			BlockStreamSegmentTimeout: time.Second,
+		},
+		stateMetrics: metrics.NewGossipMetrics(&disabled.Provider{}).StateMetrics,
+	}
+}
+
+func networkMember(endpoint string, height uint64) discovery.NetworkMember {
+	return discovery.NetworkMember{
+		PKIid:      common.PKIidType(endpoint),
+		Endpoint:   endpoint,
+		Properties: &proto.Properties{LedgerHeight: height},
+	}
+}
+
+func popPayloads(s *GossipStateProviderImpl) []uint64 {
+	var seqNums []uint64
+	for payload := s.payloads.Pop(); payload != nil; payload = s.payloads.Pop() {
+		seqNums = append(seqNums, payload.SeqNum)
+	}
+	return seqNums
+}
+
+func seqNumsInRange(start uint64, end uint64) []uint64 {
+	var seqNums []uint64
+	for seqNum := start; seqNum <= end; seqNum++ {
+		seqNums = append(seqNums, seqNum)
+	}
+	return seqNums
+}
+
+func TestShouldStreamBlocks(t *testing.T) {
+	s := newStreamingStateProvider(1, &blockStreamerMock{})
+	assert.True(t, s.shouldStreamBlocks(1, 10))
+	assert.False(t, s.shouldStreamBlocks(1, 9))
+
+	s.config.BlockStreamEnabled = false
+	assert.False(t, s.shouldStreamBlocks(1, 10))
+
+	s = newStreamingStateProvider(1, nil)
+	assert.False(t, s.shouldStreamBlocks(1, 10))
+}
+
+func TestStreamBlocksInRange(t *testing.T) {
+	streamer := &blockStreamerMock{chain: createBlockChain(t, 60)}
+	s := newStreamingStateProvider(1, streamer, networkMember("p1", 60), networkMember("p2", 60), networkMember("p3", 30))
+
+	next := s.streamBlocksInRange(1, 54)
+	assert.Equal(t, uint64(55), next)
+	assert.Equal(t, seqNumsInRange(1, 54), popPayloads(s))
+	// Segments beyond the height of p3 are only streamed from p1 and p2
+	for start, endpoint := range streamer.requests {
+		if start > 30 {
+			assert.NotEqual(t, "p3", endpoint)
+		}
+	}
+}
+
+func TestStreamBlocksInRangeNoPeers(t *testing.T) {
+	streamer := &blockStreamerMock{chain: createBlockChain(t, 60)}
+	s := newStreamingStateProvider(1, streamer, networkMember("p1", 20))
+
+	assert.Equal(t, uint64(1), s.streamBlocksInRange(1, 54))
+	assert.Empty(t, popPayloads(s))
+}
+
+func TestStreamBlocksInRangeZeroWindow(t *testing.T) {
+	streamer := &blockStreamerMock{chain: createBlockChain(t, 60)}
+	s := newStreamingStateProvider(1, streamer, networkMember("p1", 60))
+
+	s.config.BlockStreamSegmentSize = 0
+	assert.Equal(t, uint64(1), s.streamBlocksInRange(1, 54))
+	assert.Empty(t, popPayloads(s))
+
+	s.config.BlockStreamSegmentSize = 10
+	s.config.BlockStreamMaxPeers = 0
+	assert.Equal(t, uint64(1), s.streamBlocksInRange(1, 54))
+	assert.Empty(t, popPayloads(s))
+	assert.Empty(t, streamer.requests)
+}
+
+func TestStreamBlocksInRangeStreamFailure(t *testing.T) {
+	streamer := &blockStreamerMock{
+		chain:  createBlockChain(t, 60),
+		faulty: map[string]error{"p1": errors.New("connection refused")},
+	}
+	s := newStreamingStateProvider(1, streamer, networkMember("p1", 60))
+
+	// The first segment can't be streamed, so nothing is acquired
+	assert.Equal(t, uint64(1), s.streamBlocksInRange(1, 54))
+	assert.Empty(t, popPayloads(s))
+}
+
+func TestStreamBlocksInRangePartialSegment(t *testing.T) {
+	chain := createBlockChain(t, 60)
+	s := newStreamingStateProvider(1, &partialBlockStreamer{chain: chain, cutoff: 15}, networkMember("p1", 60))
+
+	// The stream of the second segment ends at block 15,
+	// so blocks up to 14 are acquired and the rest are left to state requests
+	assert.Equal(t, uint64(15), s.streamBlocksInRange(1, 54))
+	assert.Equal(t, seqNumsInRange(1, 14), popPayloads(s))
+}
+
+func TestStreamBlocksInRangeBrokenHashChain(t *testing.T) {
+	chain := createBlockChain(t, 60)
+	// Replace block 11, which is the first block of the second segment,
+	// with a block that doesn't point to block 10
+	forged := pcomm.NewBlock(11, []byte("forged"))
+	forgedBytes, _ := pb.Marshal(forged)
+	chain[11] = &proto.Payload{SeqNum: 11, Data: forgedBytes}
+
+	s := newStreamingStateProvider(1, &blockStreamerMock{chain: chain}, networkMember("p1", 60))
+	assert.Equal(t, uint64(11), s.streamBlocksInRange(1, 54))
+	assert.Equal(t, seqNumsInRange(1, 10), popPayloads(s))
+}
+
+func TestStreamSegmentVerification(t *testing.T) {
+	chain := createBlockChain(t, 20)
+	peer := &comm.RemotePeer{Endpoint: "p1"}
+
+	t.Run("out of order", func(t *testing.T) {
+		s := newStreamingStateProvider(1, &fixedBlockStreamer{payloads: []*proto.Payload{chain[1], chain[3]}})
+		segment := s.streamSegment(context.Background(), peer, 1, 5)
+		assert.Equal(t, uint64(2), segment.next)
+		assert.Len(t, segment.payloads, 1)
+	})
+
+	t.Run("broken hash chain", func(t *testing.T) {
+		forged := pcomm.NewBlock(2, []byte("forged"))
+		forgedBytes, _ := pb.Marshal(forged)
+		s := newStreamingStateProvider(1, &fixedBlockStreamer{payloads: []*proto.Payload{chain[1], {SeqNum: 2, Data: forgedBytes}}})
+		segment := s.streamSegment(context.Background(), peer, 1, 5)
+		assert.Equal(t, uint64(2), segment.next)
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		noHeaderBytes, _ := pb.Marshal(&pcomm.Block{})
+		s := newStreamingStateProvider(1, &fixedBlockStreamer{payloads: []*proto.Payload{{SeqNum: 1, Data: noHeaderBytes}}})
+		segment := s.streamSegment(context.Background(), peer, 1, 5)
+		assert.Equal(t, uint64(1), segment.next)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		s := newStreamingStateProvider(1, &fixedBlockStreamer{payloads: chain[1:6]})
+		segment := s.streamSegment(context.Background(), peer, 1, 5)
+		assert.Equal(t, uint64(6), segment.next)
+		assert.Len(t, segment.payloads, 5)
+
+		block1 := &pcomm.Block{}
+		pb.Unmarshal(chain[1].Data, block1)
+		block5 := &pcomm.Block{}
+		pb.Unmarshal(chain[5].Data, block5)
+		assert.Equal(t, block1.Header.PreviousHash, segment.previousHash)
+		assert.Equal(t, block5.Header.Hash(), segment.lastHash)
+	})
+}
+
+type partialBlockStreamer struct {
+	chain  []*proto.Payload
+	cutoff uint64
+}
+
+func (bs *partialBlockStreamer) StreamBlocks(ctx context.Context, peer *comm.RemotePeer, chainID string, start uint64, end uint64) (BlockReceiver, error) {
+	if start > bs.cutoff {
+		return &blockReceiverMock{}, nil
+	}
+	return &blockReceiverMock{payloads: bs.chain[start : min(end, bs.cutoff-1)+1], err: errors.New("stream reset")}, nil
+}
+
+type fixedBlockStreamer struct {
+	payloads []*proto.Payload
+}
+
+func (bs *fixedBlockStreamer) StreamBlocks(ctx context.Context, peer *comm.RemotePeer, chainID string, start uint64, end uint64) (BlockReceiver, error) {
+	return &blockReceiverMock{payloads: bs.payloads}, nil
+}
+
+type blockSourceMock struct {
+	chain  []*pcomm.Block
+	height uint64
+}
+
+func (bs *blockSourceMock) GetPvtDataAndBlockByNum(seqNum uint64, _ pcomm.SignedData) (*pcomm.Block, gossiputil.PvtDataCollections, error) {
+	return bs.chain[seqNum], nil, nil
+}
+
+func (bs *blockSourceMock) LedgerHeight() (uint64, error) {
+	return bs.height, nil
+}
+
+type unauthorizedMCS struct {
+	cryptoServiceMock
+}
+
+func (*unauthorizedMCS) VerifyByChannel(chainID common.ChainID, peerIdentity api.PeerIdentityType, signature, message []byte) error {
+	return errors.New("not a member of the channel")
+}
+
+func blockStreamEnvelope(t *testing.T, request *proto.BlockStreamRequest) *proto.Envelope {
+	payload, err := pb.Marshal(request)
+	assert.NoError(t, err)
+	return &proto.Envelope{Payload: payload}
+}
+
+func TestBlockStreamServer(t *testing.T) {
+	var chain []*pcomm.Block
+	for seqNum := uint64(0); seqNum < 10; seqNum++ {
+		chain = append(chain, pcomm.NewBlock(seqNum, nil))
+	}
+	now := uint64(time.Now().UnixNano())
+
+	for _, testCase := range []struct {
+		name          string
+		mcs           MCSAdapter
+		request       *proto.BlockStreamRequest
+		expectedErr   string
+		expectedSeqNo []uint64
+	}{
+		{
+			name:          "valid request",
+			request:       &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 2, EndSeqNum: 5, Timestamp: now},
+			expectedSeqNo: []uint64{2, 3, 4, 5},
+		},
+		{
+			name:          "range above ledger height is truncated",
+			request:       &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 8, EndSeqNum: 12, Timestamp: now},
+			expectedSeqNo: []uint64{8, 9},
+		},
+		{
+			name:        "start above ledger height",
+			request:     &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 10, EndSeqNum: 12, Timestamp: now},
+			expectedErr: "requested blocks [10...12] are above ledger height 10",
+		},
+		{
+			name:        "invalid range",
+			request:     &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 5, EndSeqNum: 2, Timestamp: now},
+			expectedErr: "invalid sequence interval [5...2]",
+		},
+		{
+			name:        "segment too large",
+			request:     &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 0, EndSeqNum: 100, Timestamp: now},
+			expectedErr: "requested blocks range [0...100] is greater than the allowed segment size (50)",
+		},
+		{
+			name:        "stale request",
+			request:     &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 0, EndSeqNum: 1, Timestamp: uint64(time.Now().Add(-time.Hour).UnixNano())},
+			expectedErr: "is more than 15m0s apart from the current time",
+		},
+		{
+			name:        "unknown channel",
+			request:     &proto.BlockStreamRequest{Channel: []byte("B"), StartSeqNum: 0, EndSeqNum: 1, Timestamp: now},
+			expectedErr: "channel B isn't served over the block stream",
+		},
+		{
+			name:        "unauthorized",
+			mcs:         &unauthorizedMCS{},
+			request:     &proto.BlockStreamRequest{Channel: []byte("A"), StartSeqNum: 0, EndSeqNum: 1, Timestamp: now},
+			expectedErr: "request isn't authorized: not a member of the channel",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			mcs := testCase.mcs
+			if mcs == nil {
+				mcs = &cryptoServiceMock{acceptor: noopPeerIdentityAcceptor}
+			}
+			server := NewBlockStreamServer(mcs, false, 50)
+			server.RegisterChannel("A", &blockSourceMock{chain: chain, height: 10})
+
+			stream := &blockStreamServerMock{ctx: context.Background()}
+			err := server.StreamBlocks(blockStreamEnvelope(t, testCase.request), stream)
+			if testCase.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.expectedErr)
+				assert.Empty(t, stream.responses)
+				return
+			}
+			assert.NoError(t, err)
+			var seqNums []uint64
+			for _, response := range stream.responses {
+				block := &pcomm.Block{}
+				assert.NoError(t, pb.Unmarshal(response.Payload.Data, block))
+				assert.Equal(t, response.Payload.SeqNum, block.Header.Number)
+				seqNums = append(seqNums, response.Payload.SeqNum)
+			}
+			assert.Equal(t, testCase.expectedSeqNo, seqNums)
+		})
+	}
+}
+
+func TestBlockStreamServerUnregisterChannel(t *testing.T) {
+	server := NewBlockStreamServer(&cryptoServiceMock{acceptor: noopPeerIdentityAcceptor}, false, 50)
+	server.RegisterChannel("A", &blockSourceMock{chain: []*pcomm.Block{pcomm.NewBlock(0, nil)}, height: 1})
+	server.UnregisterChannel("A")
+
+	request := &proto.BlockStreamRequest{Channel: []byte("A"), Timestamp: uint64(time.Now().UnixNano())}
+	err := server.StreamBlocks(blockStreamEnvelope(t, request), &blockStreamServerMock{ctx: context.Background()})
+	assert.EqualError(t, err, "channel A isn't served over the block stream")
+}
+
+func TestBlockStreamServerTLSBinding(t *testing.T) {
+	server := NewBlockStreamServer(&cryptoServiceMock{acceptor: noopPeerIdentityAcceptor}, true, 50)
+	server.RegisterChannel("A", &blockSourceMock{chain: []*pcomm.Block{pcomm.NewBlock(0, nil)}, height: 1})
+
+	request := &proto.BlockStreamRequest{Channel: []byte("A"), Timestamp: uint64(time.Now().UnixNano()), TlsCertHash: []byte{1, 2, 3}}
+	err := server.StreamBlocks(blockStreamEnvelope(t, request), &blockStreamServerMock{ctx: context.Background()})
+	assert.EqualError(t, err, "TLS certificate hash of the request doesn't match the TLS certificate of the connection")
+}
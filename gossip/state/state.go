@@ -8,6 +8,7 @@ package state
 
 import (
 	"bytes"
+	"context"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -63,6 +64,11 @@ type Configuration struct {
 	ChannelBufferSize               int
 	EnableStateTransfer             bool
 	BlockingMode                    bool
+	BlockStreamEnabled              bool
+	BlockStreamThreshold            uint64
+	BlockStreamSegmentSize          uint64
+	BlockStreamMaxPeers             int
+	BlockStreamSegmentTimeout       time.Duration
 }
 
 // GossipAdapter defines gossip/communication required interface for state provider
@@ -127,6 +133,10 @@ type ledgerResources interface {
 type ServicesMediator struct {
 	GossipAdapter
 	MCSAdapter
+
+	// BlockStreamer is used to transfer large ranges of blocks,
+	// if nil the blocks are transferred only by state requests
+	BlockStreamer BlockStreamer
 }
 
 // GossipStateProviderImpl the implementation of the GossipStateProvider interface
@@ -664,6 +674,12 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 	atomic.StoreInt32(&s.stateTransferActive, 1)
 	defer atomic.StoreInt32(&s.stateTransferActive, 0)
 
+	if s.shouldStreamBlocks(start, end) {
+		// Fall back to state requests for the blocks
+		// that weren't acquired over the block stream
+		start = s.streamBlocksInRange(start, end)
+	}
+
 	for prev := start; prev <= end; {
 		next := min(end, prev+s.config.AntiEntropyBatchSize)
 
@@ -716,6 +732,175 @@ func (s *GossipStateProviderImpl) requestBlocksInRange(start uint64, end uint64)
 	}
 }
 
+// shouldStreamBlocks returns whether the blocks in the range [start...end]
+// should be acquired over the block stream
+func (s *GossipStateProviderImpl) shouldStreamBlocks(start uint64, end uint64) bool {
+	return s.config.BlockStreamEnabled && s.mediator.BlockStreamer != nil &&
+		end-start+1 >= s.config.BlockStreamThreshold
+}
+
+// streamedSegment holds the blocks of a segment received over the block stream
+type streamedSegment struct {
+	payloads []*proto.Payload
+	// previousHash is the previous hash referred by the first block of the segment
+	previousHash []byte
+	// lastHash is the header hash of the last block of the segment
+	lastHash []byte
+	// next is the sequence number of the first block of the segment that wasn't received
+	next uint64
+}
+
+// streamBlocksInRange acquires the blocks in the range [start...end] over the block stream.
+// The range is split into windows of segments that are streamed in parallel from different peers,
+// and each segment is linked to the hash chain of its preceding segment before its blocks are
+// added to the payloads buffer. Returns the sequence number of the first block that wasn't acquired.
+func (s *GossipStateProviderImpl) streamBlocksInRange(start uint64, end uint64) uint64 {
+	segmentSize := s.config.BlockStreamSegmentSize
+	windowSize := segmentSize * uint64(s.config.BlockStreamMaxPeers)
+	if windowSize == 0 {
+		logger.Warningf("[%s] Block stream segment size and max peers must be at least 1, not streaming blocks", s.chainID)
+		return start
+	}
+	var lastHash []byte
+
+	for start <= end {
+		if !s.waitForPayloadsBuffer() {
+			return start
+		}
+
+		windowEnd := min(end, start+windowSize-1)
+		peers := s.filterPeers(s.hasRequiredHeight(windowEnd + 1))
+		if len(peers) == 0 {
+			logger.Debugf("[%s] There are no peers to stream blocks [%d...%d] from", s.chainID, start, windowEnd)
+			return start
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), s.config.BlockStreamSegmentTimeout)
+		var results []chan *streamedSegment
+		offset := util.RandomInt(len(peers))
+		for segmentStart := start; segmentStart <= windowEnd; segmentStart += segmentSize {
+			result := make(chan *streamedSegment, 1)
+			results = append(results, result)
+			peer := peers[(offset+len(results))%len(peers)]
+			segmentEnd := min(windowEnd, segmentStart+segmentSize-1)
+			go func(segmentStart uint64) {
+				result <- s.streamSegment(ctx, peer, segmentStart, segmentEnd)
+			}(segmentStart)
+		}
+
+		for _, result := range results {
+			segment := <-result
+			if len(segment.payloads) > 0 && lastHash != nil && !bytes.Equal(segment.previousHash, lastHash) {
+				logger.Warningf("[%s] Block %d received over the block stream doesn't extend the hash chain, discarding its segment",
+					s.chainID, segment.payloads[0].SeqNum)
+				cancel()
+				return segment.payloads[0].SeqNum
+			}
+			for _, payload := range segment.payloads {
+				s.payloads.Push(payload)
+			}
+			if len(segment.payloads) > 0 {
+				lastHash = segment.lastHash
+			}
+			if segment.next <= min(windowEnd, start+segmentSize-1) {
+				cancel()
+				return segment.next
+			}
+			start = segment.next
+		}
+		cancel()
+	}
+	return start
+}
+
+// streamSegment receives the blocks in the range [start...end] from the given peer over the block stream,
+// and verifies them and their hash chain. Blocks received after a verification failure are discarded.
+func (s *GossipStateProviderImpl) streamSegment(ctx context.Context, peer *comm.RemotePeer, start uint64, end uint64) *streamedSegment {
+	segment := &streamedSegment{next: start}
+	t1 := time.Now()
+	defer func() {
+		s.stateMetrics.StreamDuration.With("channel", s.chainID).Observe(time.Since(t1).Seconds())
+	}()
+
+	logger.Debugf("[%s] Streaming blocks [%d...%d] from %s", s.chainID, start, end, peer.Endpoint)
+	receiver, err := s.mediator.BlockStreamer.StreamBlocks(ctx, peer, s.chainID, start, end)
+	if err != nil {
+		logger.Warningf("[%s] Failed streaming blocks [%d...%d] from %s: %+v", s.chainID, start, end, peer.Endpoint, err)
+		return segment
+	}
+
+	for segment.next <= end {
+		response, err := receiver.Recv()
+		if err != nil {
+			logger.Warningf("[%s] Block stream from %s ended at block %d out of [%d...%d]: %+v",
+				s.chainID, peer.Endpoint, segment.next, start, end, err)
+			return segment
+		}
+		if err := s.verifyStreamedPayload(response.Payload, segment); err != nil {
+			logger.Warningf("[%s] Failed verifying block %d streamed from %s: %+v", s.chainID, segment.next, peer.Endpoint, err)
+			return segment
+		}
+
+		segment.payloads = append(segment.payloads, response.Payload)
+		segment.next++
+
+		size := len(response.Payload.Data)
+		for _, pvtData := range response.Payload.PrivateData {
+			size += len(pvtData)
+		}
+		s.stateMetrics.StreamedBlocks.With("channel", s.chainID).Add(1)
+		s.stateMetrics.StreamedBytes.With("channel", s.chainID).Add(float64(size))
+	}
+	return segment
+}
+
+// verifyStreamedPayload verifies that the given payload is the next block of the given segment
+// and that it extends the segment's hash chain
+func (s *GossipStateProviderImpl) verifyStreamedPayload(payload *proto.Payload, segment *streamedSegment) error {
+	if payload == nil {
+		return errors.New("received nil payload")
+	}
+	if payload.SeqNum != segment.next {
+		return errors.Errorf("expected block %d but got block %d", segment.next, payload.SeqNum)
+	}
+	if err := s.mediator.VerifyBlock(common2.ChainID(s.chainID), payload.SeqNum, payload.Data); err != nil {
+		return errors.WithStack(err)
+	}
+
+	block := &common.Block{}
+	if err := pb.Unmarshal(payload.Data, block); err != nil {
+		return errors.WithStack(err)
+	}
+	if block.Header == nil {
+		return errors.New("block has no header")
+	}
+	if len(segment.payloads) == 0 {
+		segment.previousHash = block.Header.PreviousHash
+	} else if !bytes.Equal(block.Header.PreviousHash, segment.lastHash) {
+		return errors.Errorf("previous hash %x doesn't match the hash %x of block %d",
+			block.Header.PreviousHash, segment.lastHash, payload.SeqNum-1)
+	}
+	segment.lastHash = block.Header.Hash()
+	return nil
+}
+
+// waitForPayloadsBuffer waits until the payloads buffer has room for another window of
+// streamed blocks. Returns false if the state provider was stopped while waiting.
+func (s *GossipStateProviderImpl) waitForPayloadsBuffer() bool {
+	for {
+		select {
+		case <-s.stopCh:
+			s.stopCh <- struct{}{}
+			return false
+		default:
+		}
+		if s.payloads.Size() <= s.config.MaxBlockDistance {
+			return true
+		}
+		time.Sleep(enqueueRetryInterval)
+	}
+}
+
 // stateRequestMessage generates state request message for given blocks in range [beginSeq...endSeq]
 func (s *GossipStateProviderImpl) stateRequestMessage(beginSeq uint64, endSeq uint64) *proto.GossipMessage {
 	return &proto.GossipMessage{
@@ -93,4 +93,7 @@ func (rws PrivateRWSet) Digest() string {
 type PrivateRWSetWithConfig struct {
 	RWSet            []PrivateRWSet
 	CollectionConfig *common.CollectionConfig
+	// NewMembersExcluded denotes whether the private data may be shared
+	// only with organizations that were collection members when it was committed
+	NewMembersExcluded bool
 }
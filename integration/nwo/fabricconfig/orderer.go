@@ -51,6 +51,13 @@ type OrdererSASLPlain struct {
 	Password string `yaml:"Password,omitempty"`
 }
 
+type OrdererSASLSCRAM struct {
+	Enabled   bool   `yaml:"Enabled"`
+	Mechanism string `yaml:"Mechanism,omitempty"`
+	User      string `yaml:"User,omitempty"`
+	Password  string `yaml:"Password,omitempty"`
+}
+
 type OrdererKeepalive struct {
 	ServerMinInterval time.Duration `yaml:"ServerMinInterval,omitempty"`
 	ServerInterval    time.Duration `yaml:"ServerInterval,omitempty"`
@@ -68,6 +75,13 @@ type OrdererAuthentication struct {
 
 type OrdererTopic struct {
 	ReplicationFactor int16
+	NumPartitions     int32                          `yaml:"NumPartitions,omitempty"`
+	Channels          map[string]OrdererChannelTopic `yaml:"Channels,omitempty"`
+}
+
+type OrdererChannelTopic struct {
+	ReplicationFactor int16 `yaml:"ReplicationFactor,omitempty"`
+	NumPartitions     int32 `yaml:"NumPartitions,omitempty"`
 }
 
 type FileLedger struct {
@@ -84,6 +98,7 @@ type Kafka struct {
 	Verbose   bool              `yaml:"Verbose"`
 	TLS       *OrdererTLS       `yaml:"TLS,omitempty"`
 	SASLPlain *OrdererSASLPlain `yaml:"SASLPlain,omitempty"`
+	SASLSCRAM *OrdererSASLSCRAM `yaml:"SASLSCRAM,omitempty"`
 	Topic     *OrdererTopic     `yaml:"Topic,omitempty"`
 }
 
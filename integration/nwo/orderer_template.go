@@ -86,6 +86,11 @@ Kafka:
     Enabled: false
     User:
     Password:
+  SASLSCRAM:
+    Enabled: false
+    Mechanism: SCRAM-SHA-512
+    User:
+    Password:
   Version:{{ end }}
 Debug:
   BroadcastTraceDir:
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package cache
 
 import (
+	"time"
+
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/msp"
 	pmsp "github.com/hyperledger/fabric/protos/msp"
@@ -92,15 +94,25 @@ func (c *cachedMSP) Validate(id msp.Identity) error {
 	identifier := id.GetIdentifier()
 	key := string(identifier.Mspid + ":" + identifier.Id)
 
-	_, ok := c.validateIdentityCache.get(key)
+	v, ok := c.validateIdentityCache.get(key)
 	if ok {
-		// cache only stores if the identity is valid.
-		return nil
+		// cache only stores if the identity is valid,
+		// possibly until a given time.
+		expiry, expires := v.(time.Time)
+		if !expires || time.Now().Before(expiry) {
+			return nil
+		}
 	}
 
 	err := c.MSP.Validate(id)
 	if err == nil {
-		c.validateIdentityCache.add(key, true)
+		var v interface{} = true
+		if ev, ok := c.MSP.(msp.ExpiringValidator); ok {
+			if expiry := ev.ValidationExpiry(id); !expiry.IsZero() {
+				v = expiry
+			}
+		}
+		c.validateIdentityCache.add(key, v)
 	}
 
 	return err
@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric/msp"
+	"golang.org/x/crypto/ocsp"
+)
+
+const ocspResponseCacheSize = 1000
+
+// NewOCSPResponseCache returns an msp.OCSPResponseCache that keeps
+// OCSP responses until their NextUpdate time. Responses without a
+// NextUpdate time are not cached, as newer revocation information
+// is always available for them.
+func NewOCSPResponseCache() msp.OCSPResponseCache {
+	return &ocspResponseCache{
+		cache: newSecondChanceCache(ocspResponseCacheSize),
+	}
+}
+
+type ocspResponseCache struct {
+	cache *secondChanceCache
+}
+
+func (c *ocspResponseCache) Get(key string) (*ocsp.Response, bool) {
+	v, ok := c.cache.get(key)
+	if !ok {
+		return nil, false
+	}
+
+	resp := v.(*ocsp.Response)
+	if !time.Now().Before(resp.NextUpdate) {
+		return nil, false
+	}
+
+	return resp, true
+}
+
+func (c *ocspResponseCache) Put(key string, resp *ocsp.Response) {
+	if resp.NextUpdate.IsZero() {
+		return
+	}
+
+	c.cache.add(key, resp)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cache
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestOCSPResponseCache(t *testing.T) {
+	c := NewOCSPResponseCache()
+
+	fresh := &ocsp.Response{SerialNumber: big.NewInt(1), NextUpdate: time.Now().Add(time.Hour)}
+	c.Put("fresh", fresh)
+	resp, ok := c.Get("fresh")
+	assert.True(t, ok)
+	assert.Equal(t, fresh, resp)
+
+	c.Put("stale", &ocsp.Response{SerialNumber: big.NewInt(2), NextUpdate: time.Now().Add(-time.Second)})
+	_, ok = c.Get("stale")
+	assert.False(t, ok)
+
+	// responses without NextUpdate are not cached
+	c.Put("nonextupdate", &ocsp.Response{SerialNumber: big.NewInt(3)})
+	_, ok = c.Get("nonextupdate")
+	assert.False(t, ok)
+
+	_, ok = c.Get("missing")
+	assert.False(t, ok)
+}
+
+type expiringMockMSP struct {
+	*mocks.MockMSP
+	expiry time.Time
+}
+
+func (m *expiringMockMSP) ValidationExpiry(id msp.Identity) time.Time {
+	return m.expiry
+}
+
+func TestValidateExpiring(t *testing.T) {
+	mockMSP := &expiringMockMSP{MockMSP: &mocks.MockMSP{}, expiry: time.Now().Add(time.Hour)}
+	i, err := New(mockMSP)
+	assert.NoError(t, err)
+
+	mockIdentity := &mocks.MockIdentity{ID: "Alice"}
+	mockIdentity.On("GetIdentifier").Return(&msp.IdentityIdentifier{Mspid: "MSP", Id: "Alice"})
+	mockMSP.On("Validate", mockIdentity).Return(nil)
+
+	// The validation is cached until its expiry
+	assert.NoError(t, i.Validate(mockIdentity))
+	assert.NoError(t, i.Validate(mockIdentity))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 1)
+	v, ok := i.(*cachedMSP).validateIdentityCache.get("MSP:Alice")
+	assert.True(t, ok)
+	assert.Equal(t, mockMSP.expiry, v)
+
+	// Once expired, the identity is validated again
+	i.(*cachedMSP).validateIdentityCache.add("MSP:Alice", time.Now().Add(-time.Second))
+	assert.NoError(t, i.Validate(mockIdentity))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 2)
+
+	// The zero time means that the validation does not expire
+	mockMSP.expiry = time.Time{}
+	i.(*cachedMSP).validateIdentityCache.add("MSP:Alice", time.Now().Add(-time.Second))
+	assert.NoError(t, i.Validate(mockIdentity))
+	mockMSP.AssertNumberOfCalls(t, "Validate", 3)
+	v, ok = i.(*cachedMSP).validateIdentityCache.get("MSP:Alice")
+	assert.True(t, ok)
+	assert.Equal(t, true, v)
+}
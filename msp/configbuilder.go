@@ -16,6 +16,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/remote"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -143,14 +144,29 @@ func SetupBCCSPKeystoreConfig(bccspConfig *factory.FactoryOpts, keystoreDir stri
 			bccspConfig.SwOpts = factory.GetDefaultOpts().SwOpts
 		}
 
-		// Only override the KeyStorePath if it was left empty
-		if bccspConfig.SwOpts.FileKeystore == nil ||
+		// Only override the KeyStorePath if it was left empty. The key store
+		// of a secret backend indexes the keys in the keystore directory.
+		if bccspConfig.SwOpts.SecretKeystore != nil {
+			bccspConfig.SwOpts.Ephemeral = false
+			if bccspConfig.SwOpts.SecretKeystore.KeyStorePath == "" {
+				bccspConfig.SwOpts.SecretKeystore.KeyStorePath = keystoreDir
+			}
+		} else if bccspConfig.SwOpts.FileKeystore == nil ||
 			bccspConfig.SwOpts.FileKeystore.KeyStorePath == "" {
 			bccspConfig.SwOpts.Ephemeral = false
 			bccspConfig.SwOpts.FileKeystore = &factory.FileKeystoreOpts{KeyStorePath: keystoreDir}
 		}
 	}
 
+	// The key store of the remote signer BCCSP holds the references to the remote keys
+	if bccspConfig.ProviderName == "REMOTE" && bccspConfig.RemoteOpts != nil {
+		if bccspConfig.RemoteOpts.FileKeystore == nil ||
+			bccspConfig.RemoteOpts.FileKeystore.KeyStorePath == "" {
+			bccspConfig.RemoteOpts.Ephemeral = false
+			bccspConfig.RemoteOpts.FileKeystore = &remote.FileKeystoreOpts{KeyStorePath: keystoreDir}
+		}
+	}
+
 	return bccspConfig
 }
 
@@ -364,6 +380,11 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 }
 
 func loadCertificateAt(dir, certificatePath string, ouType string) []byte {
+	if certificatePath == "" {
+		// the certifier of the OU is not enforced
+		return nil
+	}
+
 	f := filepath.Join(dir, certificatePath)
 	raw, err := readFile(f)
 	if err != nil {
@@ -381,6 +402,7 @@ const (
 	IdemixConfigFileIssuerPublicKey     = "IssuerPublicKey"
 	IdemixConfigFileRevocationPublicKey = "RevocationPublicKey"
 	IdemixConfigFileSigner              = "SignerConfig"
+	IdemixConfigDirSigners              = "signers"
 )
 
 // GetIdemixMspConfig returns the configuration for the Idemix MSP
@@ -411,6 +433,31 @@ func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
 		idemixConfig.Signer = signerConfig
 	}
 
+	// Additional signers are in the signers directory, in files named after them
+	signersDir := filepath.Join(dir, IdemixConfigDirUser, IdemixConfigDirSigners)
+	signerFiles, err := ioutil.ReadDir(signersDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to read signers directory")
+	}
+	for _, f := range signerFiles {
+		if f.IsDir() {
+			continue
+		}
+		signerBytes, err := readFile(filepath.Join(signersDir, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read signer config file")
+		}
+		signerConfig := &msp.IdemixMSPSignerConfig{}
+		err = proto.Unmarshal(signerBytes, signerConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal signer config %s", f.Name())
+		}
+		if idemixConfig.Signers == nil {
+			idemixConfig.Signers = make(map[string]*msp.IdemixMSPSignerConfig)
+		}
+		idemixConfig.Signers[f.Name()] = signerConfig
+	}
+
 	confBytes, err := proto.Marshal(idemixConfig)
 	if err != nil {
 		return nil, err
@@ -37,6 +37,11 @@ func (o *NewBaseOpts) GetVersion() MSPVersion {
 // BCCSPNewOpts contains the options to instantiate a new BCCSP-based (X509) MSP
 type BCCSPNewOpts struct {
 	NewBaseOpts
+
+	// OCSP configures OCSP checking of the identities validated by the MSP.
+	// It is only meant for the local MSP, since the validations of channel
+	// MSPs must be deterministic. If nil, OCSP is not consulted.
+	OCSP *OCSPOptions
 }
 
 // IdemixNewOpts contains the options to instantiate a new Idemix-based MSP
@@ -48,22 +53,31 @@ type IdemixNewOpts struct {
 func New(opts NewOpts) (MSP, error) {
 	switch opts.(type) {
 	case *BCCSPNewOpts:
+		var theMsp MSP
+		var err error
 		switch opts.GetVersion() {
 		case MSPv1_0:
-			return newBccspMsp(MSPv1_0)
+			theMsp, err = newBccspMsp(MSPv1_0)
 		case MSPv1_1:
-			return newBccspMsp(MSPv1_1)
+			theMsp, err = newBccspMsp(MSPv1_1)
 		case MSPv1_3:
-			return newBccspMsp(MSPv1_3)
+			theMsp, err = newBccspMsp(MSPv1_3)
 		case MSPv1_4_3:
-			return newBccspMsp(MSPv1_4_3)
+			theMsp, err = newBccspMsp(MSPv1_4_3)
 		default:
 			return nil, errors.Errorf("Invalid *BCCSPNewOpts. Version not recognized [%v]", opts.GetVersion())
 		}
+		if err != nil {
+			return nil, err
+		}
+
+		theMsp.(*bccspmsp).ocsp = newOCSPChecker(opts.(*BCCSPNewOpts).OCSP)
+
+		return theMsp, nil
 	case *IdemixNewOpts:
 		switch opts.GetVersion() {
 		case MSPv1_4_3:
-			fallthrough
+			return newIdemixMsp(MSPv1_4_3)
 		case MSPv1_3:
 			return newIdemixMsp(MSPv1_3)
 		case MSPv1_1:
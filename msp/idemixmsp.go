@@ -62,6 +62,7 @@ type idemixmsp struct {
 	version      MSPVersion
 	ipk          bccsp.Key
 	signer       *idemixSigningIdentity
+	signers      map[string]*idemixSigningIdentity
 	name         string
 	revocationPK bccsp.Key
 	epoch        int
@@ -145,6 +146,15 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	}
 	msp.revocationPK = RevocationPublicKey
 
+	msp.signers = make(map[string]*idemixSigningIdentity)
+	for name, signerConf := range conf.Signers {
+		signer, err := msp.setupSigner(signerConf)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed setting up signer %s", name))
+		}
+		msp.signers[name] = signer
+	}
+
 	if conf.Signer == nil {
 		// No credential in config, so we don't setup a default signer
 		mspLogger.Debug("idemix msp setup as verification only msp (no key material found)")
@@ -152,48 +162,58 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	}
 
 	// A credential is present in the config, so we setup a default signer
+	msp.signer, err = msp.setupSigner(conf.Signer)
+	return err
+}
+
+// setupSigner sets up a signing identity from the given signer config
+func (msp *idemixmsp) setupSigner(conf *m.IdemixMSPSignerConfig) (*idemixSigningIdentity, error) {
+	disclosure, err := getDisclosure(conf.DisclosedAttributes)
+	if err != nil {
+		return nil, err
+	}
 
 	// Import User secret key
-	UserKey, err := msp.csp.KeyImport(conf.Signer.Sk, &bccsp.IdemixUserSecretKeyImportOpts{Temporary: true})
+	UserKey, err := msp.csp.KeyImport(conf.Sk, &bccsp.IdemixUserSecretKeyImportOpts{Temporary: true})
 	if err != nil {
-		return errors.WithMessage(err, "failed importing signer secret key")
+		return nil, errors.WithMessage(err, "failed importing signer secret key")
 	}
 
 	// Derive NymPublicKey
-	NymKey, err := msp.csp.KeyDeriv(UserKey, &bccsp.IdemixNymKeyDerivationOpts{Temporary: true, IssuerPK: IssuerPublicKey})
+	NymKey, err := msp.csp.KeyDeriv(UserKey, &bccsp.IdemixNymKeyDerivationOpts{Temporary: true, IssuerPK: msp.ipk})
 	if err != nil {
-		return errors.WithMessage(err, "failed deriving nym")
+		return nil, errors.WithMessage(err, "failed deriving nym")
 	}
 	NymPublicKey, err := NymKey.PublicKey()
 	if err != nil {
-		return errors.Wrapf(err, "failed getting public nym key")
+		return nil, errors.Wrapf(err, "failed getting public nym key")
 	}
 
 	role := &m.MSPRole{
 		MspIdentifier: msp.name,
 		Role:          m.MSPRole_MEMBER,
 	}
-	if checkRole(int(conf.Signer.Role), ADMIN) {
+	if checkRole(int(conf.Role), ADMIN) {
 		role.Role = m.MSPRole_ADMIN
 	}
 
 	ou := &m.OrganizationUnit{
 		MspIdentifier:                msp.name,
-		OrganizationalUnitIdentifier: conf.Signer.OrganizationalUnitIdentifier,
-		CertifiersIdentifier:         IssuerPublicKey.SKI(),
+		OrganizationalUnitIdentifier: conf.OrganizationalUnitIdentifier,
+		CertifiersIdentifier:         msp.ipk.SKI(),
 	}
 
-	enrollmentId := conf.Signer.EnrollmentId
+	enrollmentId := conf.EnrollmentId
 
 	// Verify credential
 	valid, err := msp.csp.Verify(
 		UserKey,
-		conf.Signer.Cred,
+		conf.Cred,
 		nil,
 		&bccsp.IdemixCredentialSignerOpts{
-			IssuerPK: IssuerPublicKey,
+			IssuerPK: msp.ipk,
 			Attributes: []bccsp.IdemixAttribute{
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(conf.Signer.OrganizationalUnitIdentifier)},
+				{Type: bccsp.IdemixBytesAttribute, Value: []byte(conf.OrganizationalUnitIdentifier)},
 				{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
 				{Type: bccsp.IdemixBytesAttribute, Value: []byte(enrollmentId)},
 				{Type: bccsp.IdemixHiddenAttribute},
@@ -201,7 +221,7 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		},
 	)
 	if err != nil || !valid {
-		return errors.WithMessage(err, "Credential is not cryptographically valid")
+		return nil, errors.WithMessage(err, "Credential is not cryptographically valid")
 	}
 
 	// Create the cryptographic evidence that this identity is valid
@@ -209,32 +229,75 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		UserKey,
 		nil,
 		&bccsp.IdemixSignerOpts{
-			Credential: conf.Signer.Cred,
+			Credential: conf.Cred,
 			Nym:        NymKey,
-			IssuerPK:   IssuerPublicKey,
-			Attributes: []bccsp.IdemixAttribute{
-				{Type: bccsp.IdemixBytesAttribute},
-				{Type: bccsp.IdemixIntAttribute},
-				{Type: bccsp.IdemixHiddenAttribute},
-				{Type: bccsp.IdemixHiddenAttribute},
-			},
-			RhIndex: rhIndex,
-			CRI:     conf.Signer.CredentialRevocationInformation,
+			IssuerPK:   msp.ipk,
+			Attributes: idemixAttributes(disclosure, ou, role, enrollmentId),
+			RhIndex:    rhIndex,
+			CRI:        conf.CredentialRevocationInformation,
 		},
 	)
 	if err != nil {
-		return errors.WithMessage(err, "Failed to setup cryptographic proof of identity")
+		return nil, errors.WithMessage(err, "Failed to setup cryptographic proof of identity")
+	}
+
+	id := newIdemixIdentity(msp, NymPublicKey, role, ou, proof)
+	id.disclosure = disclosure
+	if disclosed(disclosure, AttributeIndexEnrollmentId) {
+		id.enrollmentId = enrollmentId
 	}
 
-	// Set up default signer
-	msp.signer = &idemixSigningIdentity{
-		idemixidentity: newIdemixIdentity(msp, NymPublicKey, role, ou, proof),
-		Cred:           conf.Signer.Cred,
+	return &idemixSigningIdentity{
+		idemixidentity: id,
+		Cred:           conf.Cred,
 		UserKey:        UserKey,
 		NymKey:         NymKey,
-		enrollmentId:   enrollmentId}
+		enrollmentId:   enrollmentId}, nil
+}
 
-	return nil
+// getDisclosure returns the disclosure flags of the credential attributes
+// with the given names, or the default flags if no name is given
+func getDisclosure(attributeNames []string) ([]byte, error) {
+	if len(attributeNames) == 0 {
+		return discloseFlags, nil
+	}
+
+	disclosure := make([]byte, len(discloseFlags))
+	for _, name := range attributeNames {
+		switch name {
+		case AttributeNameOU:
+			disclosure[AttributeIndexOU] = 1
+		case AttributeNameRole:
+			disclosure[AttributeIndexRole] = 1
+		case AttributeNameEnrollmentId:
+			disclosure[AttributeIndexEnrollmentId] = 1
+		default:
+			return nil, errors.Errorf("attribute %s cannot be disclosed", name)
+		}
+	}
+	return disclosure, nil
+}
+
+// disclosed returns true if the attribute at the given index is disclosed
+func disclosed(disclosure []byte, index int) bool {
+	return disclosure[index] == 1
+}
+
+// idemixAttributes returns the attributes of a credential with the given
+// values, hiding those that are not disclosed
+func idemixAttributes(disclosure []byte, ou *m.OrganizationUnit, role *m.MSPRole, enrollmentId string) []bccsp.IdemixAttribute {
+	attributes := []bccsp.IdemixAttribute{
+		{Type: bccsp.IdemixBytesAttribute, Value: []byte(ou.OrganizationalUnitIdentifier)},
+		{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
+		{Type: bccsp.IdemixBytesAttribute, Value: []byte(enrollmentId)},
+		{Type: bccsp.IdemixHiddenAttribute},
+	}
+	for i := range attributes {
+		if !disclosed(disclosure, i) {
+			attributes[i] = bccsp.IdemixAttribute{Type: bccsp.IdemixHiddenAttribute}
+		}
+	}
+	return attributes
 }
 
 // GetVersion returns the version of this MSP
@@ -250,8 +313,30 @@ func (msp *idemixmsp) GetIdentifier() (string, error) {
 	return msp.name, nil
 }
 
+// GetSigningIdentity returns the signing identity with the given identifier.
+// The identifier is either the name of one of the signers of the MSP, or
+// the identifier of one of its signing identities.
 func (msp *idemixmsp) GetSigningIdentity(identifier *IdentityIdentifier) (SigningIdentity, error) {
-	return nil, errors.Errorf("GetSigningIdentity not implemented")
+	if identifier == nil {
+		return nil, errors.New("nil identifier")
+	}
+	if identifier.Mspid != msp.name {
+		return nil, errors.Errorf("expected MSP ID %s, received %s", msp.name, identifier.Mspid)
+	}
+
+	if signer, ok := msp.signers[identifier.Id]; ok {
+		return signer, nil
+	}
+	if msp.signer != nil && msp.signer.GetIdentifier().Id == identifier.Id {
+		return msp.signer, nil
+	}
+	for _, signer := range msp.signers {
+		if signer.GetIdentifier().Id == identifier.Id {
+			return signer, nil
+		}
+	}
+
+	return nil, errors.Errorf("no signing identity %s found", identifier.Id)
 }
 
 func (msp *idemixmsp) GetDefaultSigningIdentity() (SigningIdentity, error) {
@@ -300,21 +385,62 @@ func (msp *idemixmsp) deserializeIdentityInternal(serializedID []byte) (Identity
 		return nil, errors.WithMessage(err, "failed to import nym public key")
 	}
 
+	disclosure := discloseFlags
+	if len(serialized.Disclosure) != 0 && !bytes.Equal(serialized.Disclosure, discloseFlags) {
+		if msp.version < MSPv1_4_3 {
+			return nil, errors.Errorf("partially disclosed idemix identities are unsupported in MSP versions prior to v1.4.3")
+		}
+		disclosure = serialized.Disclosure
+		if err := checkDisclosure(disclosure); err != nil {
+			return nil, err
+		}
+	}
+
 	// OU
 	ou := &m.OrganizationUnit{}
-	err = proto.Unmarshal(serialized.Ou, ou)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot deserialize the OU of the identity")
+	if disclosed(disclosure, AttributeIndexOU) {
+		err = proto.Unmarshal(serialized.Ou, ou)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot deserialize the OU of the identity")
+		}
+	} else {
+		ou = &m.OrganizationUnit{MspIdentifier: msp.name, CertifiersIdentifier: msp.ipk.SKI()}
 	}
 
 	// Role
 	role := &m.MSPRole{}
-	err = proto.Unmarshal(serialized.Role, role)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot deserialize the role of the identity")
+	if disclosed(disclosure, AttributeIndexRole) {
+		err = proto.Unmarshal(serialized.Role, role)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot deserialize the role of the identity")
+		}
+	} else {
+		role = &m.MSPRole{MspIdentifier: msp.name, Role: m.MSPRole_MEMBER}
 	}
 
-	return newIdemixIdentity(msp, NymPublicKey, role, ou, serialized.Proof), nil
+	id := newIdemixIdentity(msp, NymPublicKey, role, ou, serialized.Proof)
+	id.disclosure = disclosure
+	if disclosed(disclosure, AttributeIndexEnrollmentId) {
+		id.enrollmentId = string(serialized.EnrollmentId)
+	}
+	return id, nil
+}
+
+// checkDisclosure checks that the given disclosure flags are well formed,
+// and that they do not disclose the revocation handle
+func checkDisclosure(disclosure []byte) error {
+	if len(disclosure) != len(discloseFlags) {
+		return errors.Errorf("invalid disclosure, expected %d flags, got %d", len(discloseFlags), len(disclosure))
+	}
+	for _, flag := range disclosure {
+		if flag > 1 {
+			return errors.Errorf("invalid disclosure flag %d", flag)
+		}
+	}
+	if disclosed(disclosure, AttributeIndexRevocationHandle) {
+		return errors.New("invalid disclosure, the revocation handle cannot be disclosed")
+	}
+	return nil
 }
 
 func (msp *idemixmsp) Validate(id Identity) error {
@@ -343,14 +469,9 @@ func (id *idemixidentity) verifyProof() error {
 		nil,
 		&bccsp.IdemixSignerOpts{
 			RevocationPublicKey: id.msp.revocationPK,
-			Attributes: []bccsp.IdemixAttribute{
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(id.OU.OrganizationalUnitIdentifier)},
-				{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(id.Role)},
-				{Type: bccsp.IdemixHiddenAttribute},
-				{Type: bccsp.IdemixHiddenAttribute},
-			},
-			RhIndex: rhIndex,
-			Epoch:   id.msp.epoch,
+			Attributes:          idemixAttributes(id.disclosure, id.OU, id.Role, id.enrollmentId),
+			RhIndex:             rhIndex,
+			Epoch:               id.msp.epoch,
 		},
 	)
 	if err == nil && !valid {
@@ -398,6 +519,9 @@ func (msp *idemixmsp) satisfiesPrincipalValidated(id Identity, principal *m.MSPP
 			return nil
 		case m.MSPRole_ADMIN:
 			mspLogger.Debugf("Checking if identity satisfies ADMIN role for %s", msp.name)
+			if !disclosed(id.(*idemixidentity).disclosure, AttributeIndexRole) {
+				return errors.Errorf("user does not disclose its role")
+			}
 			if id.(*idemixidentity).Role.Role != m.MSPRole_ADMIN {
 				return errors.Errorf("user is not an admin")
 			}
@@ -445,6 +569,10 @@ func (msp *idemixmsp) satisfiesPrincipalValidated(id Identity, principal *m.MSPP
 			return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", ou.MspIdentifier, id.GetMSPIdentifier())
 		}
 
+		if !disclosed(id.(*idemixidentity).disclosure, AttributeIndexOU) {
+			return errors.Errorf("user does not disclose its organizational unit")
+		}
+
 		if ou.OrganizationalUnitIdentifier != id.(*idemixidentity).OU.OrganizationalUnitIdentifier {
 			return errors.Errorf("user is not part of the desired organizational unit")
 		}
@@ -493,6 +621,31 @@ func (msp *idemixmsp) satisfiesPrincipalValidated(id Identity, principal *m.MSPP
 		default:
 			return errors.Errorf("unknown principal anonymity type: %d", anon.AnonymityType)
 		}
+	case m.MSPPrincipal_ATTRIBUTE:
+		if msp.version < MSPv1_4_3 {
+			return errors.Errorf("Attribute MSP Principals are unsupported in MSP versions prior to v1.4.3")
+		}
+
+		attr := &m.MSPAttribute{}
+		err := proto.Unmarshal(principal.Principal, attr)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal MSPAttribute from principal")
+		}
+
+		mspLogger.Debugf("Checking if identity discloses attribute %s with value \"%s\" of mspid \"%s\"", attr.Name, attr.Value, attr.MspIdentifier)
+
+		if attr.MspIdentifier != msp.name {
+			return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", attr.MspIdentifier, id.GetMSPIdentifier())
+		}
+
+		value, err := id.(*idemixidentity).attributeValue(attr.Name)
+		if err != nil {
+			return err
+		}
+		if value != attr.Value {
+			return errors.Errorf("attribute %s of the user does not have the desired value", attr.Name)
+		}
+		return nil
 	default:
 		return errors.Errorf("invalid principal type %d", int32(principal.PrincipalClassification))
 	}
@@ -526,6 +679,11 @@ type idemixidentity struct {
 	id           *IdentityIdentifier
 	Role         *m.MSPRole
 	OU           *m.OrganizationUnit
+	// disclosure tells, for each attribute of the credential,
+	// whether the identity discloses it
+	disclosure []byte
+	// enrollmentId is the enrollment id of the identity, if disclosed
+	enrollmentId string
 	// associationProof contains cryptographic proof that this identity
 	// belongs to the MSP id.msp, i.e., it proves that the pseudonym
 	// is constructed from a secret key on which the CA issued a credential.
@@ -543,6 +701,7 @@ func newIdemixIdentity(msp *idemixmsp, NymPublicKey bccsp.Key, role *m.MSPRole,
 	id.Role = role
 	id.OU = ou
 	id.associationProof = proof
+	id.disclosure = discloseFlags
 
 	raw, err := NymPublicKey.Bytes()
 	if err != nil {
@@ -556,6 +715,27 @@ func newIdemixIdentity(msp *idemixmsp, NymPublicKey bccsp.Key, role *m.MSPRole,
 	return id
 }
 
+// attributeValue returns the value of the disclosed attribute with the given name
+func (id *idemixidentity) attributeValue(name string) (string, error) {
+	var index int
+	var value string
+	switch name {
+	case AttributeNameOU:
+		index, value = AttributeIndexOU, id.OU.OrganizationalUnitIdentifier
+	case AttributeNameRole:
+		index, value = AttributeIndexRole, id.Role.Role.String()
+	case AttributeNameEnrollmentId:
+		index, value = AttributeIndexEnrollmentId, id.enrollmentId
+	default:
+		return "", errors.Errorf("unknown attribute %s", name)
+	}
+
+	if !disclosed(id.disclosure, index) {
+		return "", errors.Errorf("user does not disclose attribute %s", name)
+	}
+	return value, nil
+}
+
 func (id *idemixidentity) ExpiresAt() time.Time {
 	// Idemix MSP currently does not use expiration dates or revocation,
 	// so we return the zero time to indicate this.
@@ -572,6 +752,10 @@ func (id *idemixidentity) GetMSPIdentifier() string {
 }
 
 func (id *idemixidentity) GetOrganizationalUnits() []*OUIdentifier {
+	if !disclosed(id.disclosure, AttributeIndexOU) {
+		return nil
+	}
+
 	// we use the (serialized) public key of this MSP as the CertifiersIdentifier
 	certifiersIdentifier, err := id.msp.ipk.Bytes()
 	if err != nil {
@@ -618,19 +802,31 @@ func (id *idemixidentity) Serialize() ([]byte, error) {
 	// TODO: change this in future version
 	serialized.NymX = raw[:len(raw)/2]
 	serialized.NymY = raw[len(raw)/2:]
-	ouBytes, err := proto.Marshal(id.OU)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not marshal OU of identity %s", id.id)
+	// the attributes that are not disclosed must not leak into the
+	// serialized identity
+	if disclosed(id.disclosure, AttributeIndexOU) {
+		ouBytes, err := proto.Marshal(id.OU)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal OU of identity %s", id.id)
+		}
+		serialized.Ou = ouBytes
 	}
 
-	roleBytes, err := proto.Marshal(id.Role)
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not marshal role of identity %s", id.id)
+	if disclosed(id.disclosure, AttributeIndexRole) {
+		roleBytes, err := proto.Marshal(id.Role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal role of identity %s", id.id)
+		}
+		serialized.Role = roleBytes
 	}
 
-	serialized.Ou = ouBytes
-	serialized.Role = roleBytes
 	serialized.Proof = id.associationProof
+	if !bytes.Equal(id.disclosure, discloseFlags) {
+		serialized.Disclosure = id.disclosure
+		if disclosed(id.disclosure, AttributeIndexEnrollmentId) {
+			serialized.EnrollmentId = []byte(id.enrollmentId)
+		}
+	}
 
 	idemixIDBytes, err := proto.Marshal(serialized)
 	if err != nil {
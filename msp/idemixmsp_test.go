@@ -743,3 +743,214 @@ func TestRolePeerV11(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid MSP role type")
 }
+
+func TestGetSigningIdentity(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	defaultSigner, err := getDefaultSigner(msp1)
+	assert.NoError(t, err)
+	id, err := msp1.GetSigningIdentity(defaultSigner.GetIdentifier())
+	assert.NoError(t, err)
+	assert.Equal(t, defaultSigner, id)
+
+	for _, name := range []string{"enrollment", "admin"} {
+		id, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: name})
+		assert.NoError(t, err)
+		assert.NotEqual(t, defaultSigner.GetIdentifier(), id.GetIdentifier())
+		assert.NoError(t, id.Validate())
+
+		sig, err := id.Sign([]byte("testdata"))
+		assert.NoError(t, err)
+		assert.NoError(t, id.Verify([]byte("testdata"), sig))
+
+		sameID, err := msp1.GetSigningIdentity(id.GetIdentifier())
+		assert.NoError(t, err)
+		assert.Equal(t, id, sameID)
+	}
+
+	_, err = msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "unknown"})
+	assert.EqualError(t, err, "no signing identity unknown found")
+	_, err = msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP2", Id: "admin"})
+	assert.EqualError(t, err, "expected MSP ID MSP1Signers, received MSP2")
+	_, err = msp1.GetSigningIdentity(nil)
+	assert.EqualError(t, err, "nil identifier")
+}
+
+func TestSigningIdentityDisclosure(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	signer, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "enrollment"})
+	assert.NoError(t, err)
+	serialized, err := signer.Serialize()
+	assert.NoError(t, err)
+	id, err := msp1.DeserializeIdentity(serialized)
+	assert.NoError(t, err)
+	assert.NoError(t, id.Validate())
+	assert.Equal(t, "OU1", id.GetOrganizationalUnits()[0].OrganizationalUnitIdentifier)
+
+	adminRole := &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               marshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_ADMIN, MspIdentifier: "MSP1Signers"})}
+	err = id.SatisfiesPrincipal(adminRole)
+	assert.EqualError(t, err, "user does not disclose its role")
+
+	signer, err = msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "admin"})
+	assert.NoError(t, err)
+	serialized, err = signer.Serialize()
+	assert.NoError(t, err)
+	id, err = msp1.DeserializeIdentity(serialized)
+	assert.NoError(t, err)
+	assert.NoError(t, id.Validate())
+	assert.Nil(t, id.GetOrganizationalUnits())
+	assert.NoError(t, id.SatisfiesPrincipal(adminRole))
+
+	ou := &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ORGANIZATION_UNIT,
+		Principal:               marshalOrPanic(&msp.OrganizationUnit{OrganizationalUnitIdentifier: "OU2", MspIdentifier: "MSP1Signers"})}
+	err = id.SatisfiesPrincipal(ou)
+	assert.EqualError(t, err, "user does not disclose its organizational unit")
+}
+
+func TestSerializePartialDisclosure(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	serialize := func(id Identity) *msp.SerializedIdemixIdentity {
+		serialized, err := id.Serialize()
+		assert.NoError(t, err)
+		sID := &msp.SerializedIdentity{}
+		assert.NoError(t, proto.Unmarshal(serialized, sID))
+		idemixID := &msp.SerializedIdemixIdentity{}
+		assert.NoError(t, proto.Unmarshal(sID.IdBytes, idemixID))
+
+		// the identity can be deserialized and validated without its hidden attributes
+		deserialized, err := msp1.DeserializeIdentity(serialized)
+		assert.NoError(t, err)
+		assert.NoError(t, deserialized.Validate())
+		return idemixID
+	}
+
+	// the enrollment signer hides its role
+	signer, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "enrollment"})
+	assert.NoError(t, err)
+	idemixID := serialize(signer)
+	assert.Empty(t, idemixID.Role)
+	assert.NotEmpty(t, idemixID.Ou)
+	assert.Equal(t, []byte("alice"), idemixID.EnrollmentId)
+
+	// the admin signer hides its OU and enrollment ID
+	signer, err = msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "admin"})
+	assert.NoError(t, err)
+	idemixID = serialize(signer)
+	assert.Empty(t, idemixID.Ou)
+	assert.Empty(t, idemixID.EnrollmentId)
+	assert.NotEmpty(t, idemixID.Role)
+}
+
+func TestSigningIdentityDisclosureBad(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	signer, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "admin"})
+	assert.NoError(t, err)
+	serialized, err := signer.Serialize()
+	assert.NoError(t, err)
+
+	sID := &msp.SerializedIdentity{}
+	assert.NoError(t, proto.Unmarshal(serialized, sID))
+	idemixID := &msp.SerializedIdemixIdentity{}
+	assert.NoError(t, proto.Unmarshal(sID.IdBytes, idemixID))
+
+	// claiming to disclose the hidden OU invalidates the proof
+	idemixID.Disclosure = []byte{1, 1, 0, 0}
+	sID.IdBytes = marshalOrPanic(idemixID)
+	id, err := msp1.DeserializeIdentity(marshalOrPanic(sID))
+	assert.NoError(t, err)
+	assert.Error(t, id.Validate())
+
+	idemixID.Disclosure = []byte{0, 1, 0, 1}
+	sID.IdBytes = marshalOrPanic(idemixID)
+	_, err = msp1.DeserializeIdentity(marshalOrPanic(sID))
+	assert.EqualError(t, err, "invalid disclosure, the revocation handle cannot be disclosed")
+
+	idemixID.Disclosure = []byte{0, 1}
+	sID.IdBytes = marshalOrPanic(idemixID)
+	_, err = msp1.DeserializeIdentity(marshalOrPanic(sID))
+	assert.EqualError(t, err, "invalid disclosure, expected 4 flags, got 2")
+}
+
+func TestSigningIdentityDisclosureV13(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+	msp1v13, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_3)
+	assert.NoError(t, err)
+
+	// an identity that hides some of its attributes is rejected
+	signer, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "admin"})
+	assert.NoError(t, err)
+	serialized, err := signer.Serialize()
+	assert.NoError(t, err)
+	_, err = msp1v13.DeserializeIdentity(serialized)
+	assert.EqualError(t, err, "partially disclosed idemix identities are unsupported in MSP versions prior to v1.4.3")
+
+	// an identity with the default disclosure is accepted
+	signer, err = msp1v13.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	serialized, err = signer.Serialize()
+	assert.NoError(t, err)
+	id, err := msp1v13.DeserializeIdentity(serialized)
+	assert.NoError(t, err)
+	assert.NoError(t, id.Validate())
+}
+
+func TestPrincipalAttribute(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	attribute := func(mspID, name, value string) *msp.MSPPrincipal {
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+			Principal:               marshalOrPanic(&msp.MSPAttribute{MspIdentifier: mspID, Name: name, Value: value})}
+	}
+
+	enrollment, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "enrollment"})
+	assert.NoError(t, err)
+	assert.NoError(t, enrollment.SatisfiesPrincipal(attribute("MSP1Signers", "EnrollmentID", "alice")))
+	assert.NoError(t, enrollment.SatisfiesPrincipal(attribute("MSP1Signers", "OU", "OU1")))
+	err = enrollment.SatisfiesPrincipal(attribute("MSP1Signers", "EnrollmentID", "bob"))
+	assert.EqualError(t, err, "attribute EnrollmentID of the user does not have the desired value")
+	err = enrollment.SatisfiesPrincipal(attribute("MSP1Signers", "Role", "MEMBER"))
+	assert.EqualError(t, err, "user does not disclose attribute Role")
+	err = enrollment.SatisfiesPrincipal(attribute("MSP1Signers", "RevocationHandle", "11"))
+	assert.EqualError(t, err, "unknown attribute RevocationHandle")
+	err = enrollment.SatisfiesPrincipal(attribute("MSP2", "OU", "OU1"))
+	assert.EqualError(t, err, "the identity is a member of a different MSP (expected MSP2, got MSP1Signers)")
+
+	admin, err := msp1.GetSigningIdentity(&IdentityIdentifier{Mspid: "MSP1Signers", Id: "admin"})
+	assert.NoError(t, err)
+	assert.NoError(t, admin.SatisfiesPrincipal(attribute("MSP1Signers", "Role", "ADMIN")))
+	err = admin.SatisfiesPrincipal(attribute("MSP1Signers", "EnrollmentID", "bob"))
+	assert.EqualError(t, err, "user does not disclose attribute EnrollmentID")
+
+	defaultSigner, err := getDefaultSigner(msp1)
+	assert.NoError(t, err)
+	assert.NoError(t, defaultSigner.SatisfiesPrincipal(attribute("MSP1Signers", "OU", "OU1")))
+	assert.NoError(t, defaultSigner.SatisfiesPrincipal(attribute("MSP1Signers", "Role", "MEMBER")))
+
+	msp2, err := setupWithVersion("testdata/idemix/MSP1Signers", "MSP1Signers", MSPv1_3)
+	assert.NoError(t, err)
+	defaultSigner, err = getDefaultSigner(msp2)
+	assert.NoError(t, err)
+	err = defaultSigner.SatisfiesPrincipal(attribute("MSP1Signers", "OU", "OU1"))
+	assert.EqualError(t, err, "Attribute MSP Principals are unsupported in MSP versions prior to v1.4.3")
+}
+
+func marshalOrPanic(pb proto.Message) []byte {
+	bytes, err := proto.Marshal(pb)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
@@ -8,6 +8,7 @@ package msp
 
 import (
 	"crypto"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/hex"
@@ -36,6 +37,9 @@ type identity struct {
 
 	// reference to the MSP that "owns" this identity
 	msp *bccspmsp
+
+	// ocspResponse is the DER encoded OCSP response stapled to this identity, if any
+	ocspResponse []byte
 }
 
 func newIdentity(cert *x509.Certificate, pk bccsp.Key, msp *bccspmsp) (Identity, error) {
@@ -145,14 +149,9 @@ func (id *identity) Verify(msg []byte, sig []byte) error {
 	// mspIdentityLogger.Infof("Verifying signature")
 
 	// Compute Hash
-	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	digest, err := id.digest(msg)
 	if err != nil {
-		return errors.WithMessage(err, "failed getting hash function options")
-	}
-
-	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
-	if err != nil {
-		return errors.WithMessage(err, "failed computing digest")
+		return err
 	}
 
 	if mspIdentityLogger.IsEnabledFor(zapcore.DebugLevel) {
@@ -190,6 +189,26 @@ func (id *identity) Serialize() ([]byte, error) {
 	return idBytes, nil
 }
 
+// digest returns the bytes to be signed or verified for msg. Ed25519
+// hashes the message as part of the signature scheme, therefore
+// the message is returned unchanged for Ed25519 identities.
+func (id *identity) digest(msg []byte) ([]byte, error) {
+	if _, isEd25519 := id.cert.PublicKey.(ed25519.PublicKey); isEd25519 {
+		return msg, nil
+	}
+
+	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed getting hash function options")
+	}
+
+	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed computing digest")
+	}
+	return digest, nil
+}
+
 func (id *identity) getHashOpt(hashFamily string) (bccsp.HashOpts, error) {
 	switch hashFamily {
 	case bccsp.SHA2:
@@ -222,14 +241,9 @@ func (id *signingidentity) Sign(msg []byte) ([]byte, error) {
 	//mspIdentityLogger.Infof("Signing message")
 
 	// Compute Hash
-	hashOpt, err := id.getHashOpt(id.msp.cryptoConfig.SignatureHashFamily)
+	digest, err := id.digest(msg)
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed getting hash function options")
-	}
-
-	digest, err := id.msp.bccsp.Hash(msg, hashOpt)
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed computing digest")
+		return nil, err
 	}
 
 	if len(msg) < 32 {
@@ -7,10 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package mgmt
 
 import (
+	"path/filepath"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/cache"
@@ -46,6 +49,47 @@ func LoadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) er
 	return GetLocalMSP().Setup(conf)
 }
 
+// ReloadLocalMsp sets up a new local MSP of the specified type from the
+// specified directory and, if successful, atomically replaces the current
+// local MSP with it. It allows to swap in renewed certificates and keys
+// without a restart: components that look up the local MSP or its signing
+// identity when they need it use the new ones from then on.
+func ReloadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string) error {
+	if mspID == "" {
+		return errors.New("the local MSP must have an ID")
+	}
+
+	conf, err := msp.GetLocalMspConfigWithType(dir, bccspConfig, mspID, mspType)
+	if err != nil {
+		return err
+	}
+	newMsp := loadLocaMSP(mspType, getLocalMspOCSPOptions())
+	if err := newMsp.Setup(conf); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	localMsp = newMsp
+	mspLogger.Infof("Reloaded local MSP %s from %s", mspID, dir)
+	return nil
+}
+
+// NewLocalMspWatcher returns a FileWatcher that reloads the local MSP
+// whenever its signing certificates or keys change, and then calls
+// onReload with the new local MSP
+func NewLocalMspWatcher(dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string, interval time.Duration, onReload func(msp.MSP)) *crypto.FileWatcher {
+	return crypto.NewFileWatcher(interval, func() error {
+		if err := ReloadLocalMsp(dir, bccspConfig, mspID, mspType); err != nil {
+			return err
+		}
+		if onReload != nil {
+			onReload(GetLocalMSP())
+		}
+		return nil
+	}, filepath.Join(dir, "signcerts"), filepath.Join(dir, "keystore"))
+}
+
 // FIXME: AS SOON AS THE CHAIN MANAGEMENT CODE IS COMPLETE,
 // THESE MAPS AND HELPSER FUNCTIONS SHOULD DISAPPEAR BECAUSE
 // OWNERSHIP OF PER-CHAIN MSP MANAGERS WILL BE HANDLED BY IT;
@@ -53,6 +97,7 @@ func LoadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) er
 
 var m sync.Mutex
 var localMsp msp.MSP
+var localMspOCSPOptions *msp.OCSPOptions
 var mspMap map[string]msp.MSPManager = make(map[string]msp.MSPManager)
 var mspLogger = flogging.MustGetLogger("msp")
 
@@ -138,20 +183,34 @@ func GetLocalMSP() msp.MSP {
 		return localMsp
 	}
 
-	localMsp = loadLocaMSP()
+	localMsp = loadLocaMSP(viper.GetString("peer.localMspType"), localMspOCSPOptions)
 
 	return localMsp
 }
 
-func loadLocaMSP() msp.MSP {
+// SetLocalMspOCSPOptions sets the OCSP options of the local MSPs created from
+// then on, e.g. by ReloadLocalMsp. OCSP is never consulted by channel MSPs,
+// as the revocation status it reports is not deterministic.
+func SetLocalMspOCSPOptions(opts *msp.OCSPOptions) {
+	m.Lock()
+	defer m.Unlock()
+	localMspOCSPOptions = opts
+}
+
+func getLocalMspOCSPOptions() *msp.OCSPOptions {
+	m.Lock()
+	defer m.Unlock()
+	return localMspOCSPOptions
+}
+
+func loadLocaMSP(mspType string, ocspOpts *msp.OCSPOptions) msp.MSP {
 	// determine the type of MSP (by default, we'll use bccspMSP)
-	mspType := viper.GetString("peer.localMspType")
 	if mspType == "" {
 		mspType = msp.ProviderTypeToString(msp.FABRIC)
 	}
 
 	var mspOpts = map[string]msp.NewOpts{
-		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_4_3}},
+		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_4_3}, OCSP: ocspOpts},
 		msp.ProviderTypeToString(msp.IDEMIX): &msp.IdemixNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_1}},
 	}
 	newOpts, found := mspOpts[mspType]
@@ -7,12 +7,18 @@ SPDX-License-Identifier: Apache-2.0
 package mgmt
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/msp"
+	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetManagerForChains(t *testing.T) {
@@ -112,6 +118,139 @@ func TestNewMSPMgmtMgr(t *testing.T) {
 	assert.NotNil(t, idBack, "deserialized identity should not have been nil")
 }
 
+func TestReloadLocalMsp(t *testing.T) {
+	oldMsp := GetLocalMSP()
+	defer func() {
+		m.Lock()
+		localMsp = oldMsp
+		m.Unlock()
+	}()
+
+	dir := copyDevMspDir(t)
+	defer os.RemoveAll(dir)
+
+	err := ReloadLocalMsp(dir, nil, "", "bccsp")
+	assert.EqualError(t, err, "the local MSP must have an ID")
+
+	err = ReloadLocalMsp(filepath.Join(dir, "nonexistent"), nil, "SampleOrg", "bccsp")
+	assert.Error(t, err)
+	assert.True(t, oldMsp == GetLocalMSP(), "the local MSP should not have been replaced")
+
+	err = ReloadLocalMsp(dir, nil, "SampleOrg", "bccsp")
+	require.NoError(t, err)
+	newMsp := GetLocalMSP()
+	assert.False(t, oldMsp == newMsp, "the local MSP should have been replaced")
+
+	id, err := newMsp.GetDefaultSigningIdentity()
+	require.NoError(t, err)
+	sig, err := id.Sign([]byte("message"))
+	require.NoError(t, err)
+	assert.NoError(t, id.Verify([]byte("message"), sig))
+}
+
+func TestReloadLocalMspWithType(t *testing.T) {
+	oldMsp := GetLocalMSP()
+	defer func() {
+		m.Lock()
+		localMsp = oldMsp
+		m.Unlock()
+	}()
+
+	// the type passed to ReloadLocalMsp prevails over peer.localMspType
+	err := ReloadLocalMsp("../testdata/idemix/MSP1OU1", nil, "MSP1OU1", "idemix")
+	require.NoError(t, err)
+	assert.Equal(t, msp.IDEMIX, GetLocalMSP().GetType())
+
+	err = ReloadLocalMsp("../testdata/idemix/MSP1OU1", nil, "MSP1OU1", "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, msp.IDEMIX, GetLocalMSP().GetType())
+}
+
+func TestSetLocalMspOCSPOptions(t *testing.T) {
+	oldMsp := GetLocalMSP()
+	defer func() {
+		m.Lock()
+		localMsp = oldMsp
+		m.Unlock()
+		SetLocalMspOCSPOptions(nil)
+	}()
+
+	dir := copyDevMspDir(t)
+	defer os.RemoveAll(dir)
+
+	// the certificates of the sample MSP do not specify any OCSP responder,
+	// so its admin cannot be validated in hard-fail mode
+	SetLocalMspOCSPOptions(&msp.OCSPOptions{Mode: msp.OCSPHardFail})
+	err := ReloadLocalMsp(dir, nil, "SampleOrg", "bccsp")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the certificate does not specify any OCSP responder")
+	assert.True(t, oldMsp == GetLocalMSP(), "the local MSP should not have been replaced")
+
+	SetLocalMspOCSPOptions(&msp.OCSPOptions{Mode: msp.OCSPSoftFail})
+	err = ReloadLocalMsp(dir, nil, "SampleOrg", "bccsp")
+	assert.NoError(t, err)
+	assert.False(t, oldMsp == GetLocalMSP(), "the local MSP should have been replaced")
+}
+
+func TestLocalMspWatcher(t *testing.T) {
+	gt := NewGomegaWithT(t)
+
+	oldMsp := GetLocalMSP()
+	defer func() {
+		m.Lock()
+		localMsp = oldMsp
+		m.Unlock()
+	}()
+
+	dir := copyDevMspDir(t)
+	defer os.RemoveAll(dir)
+
+	reloaded := make(chan msp.MSP, 10)
+	watcher := NewLocalMspWatcher(dir, nil, "SampleOrg", "bccsp", 10*time.Millisecond, func(newMsp msp.MSP) {
+		reloaded <- newMsp
+	})
+	watcher.Start()
+	defer watcher.Stop()
+
+	// rewriting the signing certificate with a renewed one triggers a reload
+	certFile := filepath.Join(dir, "signcerts", "peer.pem")
+	cert, err := ioutil.ReadFile(certFile)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(certFile, append(cert, '\n'), 0644))
+
+	var newMsp msp.MSP
+	gt.Eventually(reloaded).Should(Receive(&newMsp))
+	assert.True(t, newMsp == GetLocalMSP())
+	assert.False(t, oldMsp == newMsp, "the local MSP should have been replaced")
+}
+
+func copyDevMspDir(t *testing.T) string {
+	devDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "localmsp")
+	require.NoError(t, err)
+
+	err = filepath.Walk(devDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(devDir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, rel), 0755)
+		}
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, rel), raw, 0644)
+	})
+	require.NoError(t, err)
+	return dir
+}
+
 func LoadMSPSetupForTesting() error {
 	dir, err := configtest.GetDevMspDir()
 	if err != nil {
@@ -8,11 +8,13 @@ package msp
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
@@ -100,6 +102,9 @@ type bccspmsp struct {
 	// These are the OUIdentifiers of the clients, peers, admins and orderers.
 	// They are used to tell apart these entities
 	clientOU, peerOU, adminOU, ordererOU *OUIdentifier
+
+	// ocsp checks the revocation status of identities via OCSP, if enabled
+	ocsp *ocspChecker
 }
 
 // newBccspMsp returns an MSP instance backed up by a BCCSP
@@ -201,9 +206,16 @@ func (msp *bccspmsp) getSigningIdentityFromConf(sidInfo *m.SigningIdentityInfo)
 		}
 
 		pemKey, _ := pem.Decode(sidInfo.PrivateSigner.KeyMaterial)
-		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
-		if err != nil {
-			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+		if _, isEd25519 := idPub.(*identity).cert.PublicKey.(ed25519.PublicKey); isEd25519 {
+			privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ED25519PrivateKeyImportOpts{Temporary: true})
+			if err != nil {
+				return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import Ed25519 private key")
+			}
+		} else {
+			privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+			if err != nil {
+				return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+			}
 		}
 	}
 
@@ -302,6 +314,17 @@ func (msp *bccspmsp) Validate(id Identity) error {
 	}
 }
 
+// ValidationExpiry returns the time after which a successful validation of
+// the passed identity should be performed again. Validations only expire
+// when OCSP checking is enabled, as the revocation status of the identity
+// may change at any time.
+func (msp *bccspmsp) ValidationExpiry(id Identity) time.Time {
+	if msp.ocsp == nil {
+		return time.Time{}
+	}
+	return time.Now().Add(msp.ocsp.recheckInterval)
+}
+
 // hasOURole checks that the identity belongs to the organizational unit
 // associated to the specified MSPRole.
 // This function does not check the certifiers identifier.
@@ -378,7 +401,7 @@ func (msp *bccspmsp) DeserializeIdentity(serializedID []byte) (Identity, error)
 // deserializeIdentityInternal returns an identity given its byte-level representation
 func (msp *bccspmsp) deserializeIdentityInternal(serializedIdentity []byte) (Identity, error) {
 	// This MSP will always deserialize certs this way
-	bl, _ := pem.Decode(serializedIdentity)
+	bl, rest := pem.Decode(serializedIdentity)
 	if bl == nil {
 		return nil, errors.New("could not decode the PEM structure")
 	}
@@ -399,7 +422,14 @@ func (msp *bccspmsp) deserializeIdentityInternal(serializedIdentity []byte) (Ide
 		return nil, errors.WithMessage(err, "failed to import certificate's public key")
 	}
 
-	return newIdentity(cert, pub, msp)
+	id, err := newIdentity(cert, pub, msp)
+	if err != nil {
+		return nil, err
+	}
+	// An OCSP response may be stapled after the certificate
+	id.(*identity).ocspResponse = getStapledOCSPResponse(rest)
+
+	return id, nil
 }
 
 // SatisfiesPrincipal returns null if the identity matches the principal or an error otherwise
@@ -560,6 +590,8 @@ func (msp *bccspmsp) satisfiesPrincipalInternalV13(id Identity, principal *m.MSP
 	switch principal.PrincipalClassification {
 	case m.MSPPrincipal_COMBINED:
 		return errors.New("SatisfiesPrincipalInternal shall not be called with a CombinedPrincipal")
+	case m.MSPPrincipal_ATTRIBUTE:
+		return errors.New("Attribute principals are not supported by the X.509 MSP")
 	case m.MSPPrincipal_ANONYMITY:
 		anon := &m.MSPIdentityAnonymity{}
 		err := proto.Unmarshal(principal.Principal, anon)
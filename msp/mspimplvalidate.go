@@ -29,6 +29,13 @@ func (msp *bccspmsp) validateIdentity(id *identity) error {
 		return errors.WithMessage(err, "could not validate identity against certification chain")
 	}
 
+	if msp.ocsp != nil {
+		err = msp.ocsp.check(id.cert, validationChain[1], id.ocspResponse)
+		if err != nil {
+			return errors.WithMessage(err, "could not validate identity's revocation status")
+		}
+	}
+
 	err = msp.internalValidateIdentityOusFunc(id)
 	if err != nil {
 		return errors.WithMessage(err, "could not validate identity's OUs")
@@ -0,0 +1,338 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+// OCSPResponsePEMType is the PEM block type of an OCSP response stapled
+// to a serialized identity, right after its certificate
+const OCSPResponsePEMType = "OCSP RESPONSE"
+
+const (
+	defaultOCSPTimeout         = 5 * time.Second
+	defaultOCSPRecheckInterval = 5 * time.Minute
+	// maxOCSPResponseSize bounds the size of the responses read from an OCSP responder
+	maxOCSPResponseSize = 1 << 20
+	// ocspClockSkew is the clock skew tolerated when checking ThisUpdate and NextUpdate
+	ocspClockSkew = 5 * time.Minute
+)
+
+// OCSPMode determines how the revocation status of a certificate
+// obtained via OCSP affects the validation of an identity
+type OCSPMode int
+
+const (
+	// OCSPDisabled disables OCSP checking
+	OCSPDisabled OCSPMode = iota
+	// OCSPSoftFail rejects identities whose certificate is reported as revoked,
+	// and accepts identities whose revocation status cannot be determined
+	OCSPSoftFail
+	// OCSPHardFail rejects identities whose certificate is reported as revoked,
+	// and identities whose revocation status cannot be determined
+	OCSPHardFail
+)
+
+// OCSPResponseCache caches parsed OCSP responses by certificate.
+// Implementations are expected to drop responses once they are no longer fresh.
+type OCSPResponseCache interface {
+	// Get returns the cached response for the given key, if any
+	Get(key string) (*ocsp.Response, bool)
+	// Put caches the given response under the given key
+	Put(key string, resp *ocsp.Response)
+}
+
+// ExpiringValidator is implemented by MSPs whose identity validation
+// results change over time, e.g. because they depend on the revocation
+// status reported by an OCSP responder. Caching layers should not
+// reuse a successful validation of id past the returned time; the
+// zero time means that the validation does not expire.
+type ExpiringValidator interface {
+	ValidationExpiry(id Identity) time.Time
+}
+
+// OCSPOptions configures the OCSP checking performed when validating identities.
+// As the revocation status reported by OCSP responders changes over time and
+// may differ between peers, OCSP must only be used where validations need not
+// be deterministic, i.e. by the local MSP and when verifying TLS certificates,
+// and never by the channel MSPs.
+type OCSPOptions struct {
+	// Mode selects soft-fail or hard-fail checking, or disables it
+	Mode OCSPMode
+	// Timeout bounds each request to an OCSP responder
+	Timeout time.Duration
+	// RecheckInterval is the time after which a successful validation
+	// of an identity is no longer reused by caching layers
+	RecheckInterval time.Duration
+	// ResponseCache, if set, is used to cache OCSP responses
+	ResponseCache OCSPResponseCache
+	// MetricsProvider, if set, is used to report OCSP metrics
+	MetricsProvider metrics.Provider
+}
+
+var (
+	ocspChecksOpts = metrics.CounterOpts{
+		Namespace:    "msp",
+		Subsystem:    "ocsp",
+		Name:         "checks",
+		Help:         "The number of OCSP checks performed, by certificate status and origin of the response.",
+		LabelNames:   []string{"status", "source"},
+		StatsdFormat: "%{#fqname}.%{status}.%{source}",
+	}
+
+	ocspRequestDurationOpts = metrics.HistogramOpts{
+		Namespace: "msp",
+		Subsystem: "ocsp",
+		Name:      "request_duration",
+		Help:      "The time to obtain a response from an OCSP responder in seconds.",
+	}
+
+	ocspRequestFailuresOpts = metrics.CounterOpts{
+		Namespace: "msp",
+		Subsystem: "ocsp",
+		Name:      "request_failures",
+		Help:      "The number of failed requests to OCSP responders.",
+	}
+)
+
+type ocspMetrics struct {
+	Checks          metrics.Counter
+	RequestDuration metrics.Histogram
+	RequestFailures metrics.Counter
+}
+
+func newOCSPMetrics(p metrics.Provider) *ocspMetrics {
+	return &ocspMetrics{
+		Checks:          p.NewCounter(ocspChecksOpts),
+		RequestDuration: p.NewHistogram(ocspRequestDurationOpts),
+		RequestFailures: p.NewCounter(ocspRequestFailuresOpts),
+	}
+}
+
+const (
+	ocspSourceCache     = "cache"
+	ocspSourceStapled   = "stapled"
+	ocspSourceResponder = "responder"
+	ocspSourceNone      = "none"
+
+	ocspStatusGood        = "good"
+	ocspStatusRevoked     = "revoked"
+	ocspStatusUnknown     = "unknown"
+	ocspStatusUnavailable = "unavailable"
+)
+
+// ocspChecker checks the revocation status of certificates via OCSP
+type ocspChecker struct {
+	mode            OCSPMode
+	recheckInterval time.Duration
+	client          *http.Client
+	cache           OCSPResponseCache
+	metrics         *ocspMetrics
+}
+
+func newOCSPChecker(opts *OCSPOptions) *ocspChecker {
+	if opts == nil || opts.Mode == OCSPDisabled {
+		return nil
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultOCSPTimeout
+	}
+	recheckInterval := opts.RecheckInterval
+	if recheckInterval == 0 {
+		recheckInterval = defaultOCSPRecheckInterval
+	}
+	var p metrics.Provider = &disabled.Provider{}
+	if opts.MetricsProvider != nil {
+		p = opts.MetricsProvider
+	}
+
+	return &ocspChecker{
+		mode:            opts.Mode,
+		recheckInterval: recheckInterval,
+		client:          &http.Client{Timeout: timeout},
+		cache:           opts.ResponseCache,
+		metrics:         newOCSPMetrics(p),
+	}
+}
+
+// NewOCSPVerifier returns a function verifying that the leaf certificates of
+// the chains verified during a TLS handshake have not been revoked, according
+// to OCSP, or nil if OCSP checking is disabled. It can be used as the
+// VerifyPeerCertificate callback of a tls.Config which verifies certificates.
+func NewOCSPVerifier(opts *OCSPOptions) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	c := newOCSPChecker(opts)
+	if c == nil {
+		return nil
+	}
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, chain := range verifiedChains {
+			if len(chain) < 2 {
+				continue
+			}
+			if err := c.check(chain[0], chain[1], nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// check returns an error if cert, issued by issuer, has been revoked or,
+// in hard-fail mode, if its revocation status cannot be determined.
+// stapled is an optional OCSP response provided along with cert.
+func (c *ocspChecker) check(cert, issuer *x509.Certificate, stapled []byte) error {
+	resp, source, err := c.getResponse(cert, issuer, stapled)
+	if err != nil {
+		c.metrics.Checks.With("status", ocspStatusUnavailable, "source", source).Add(1)
+		return c.unavailable(cert, err)
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+		c.metrics.Checks.With("status", ocspStatusGood, "source", source).Add(1)
+		return nil
+	case ocsp.Revoked:
+		c.metrics.Checks.With("status", ocspStatusRevoked, "source", source).Add(1)
+		return errors.Errorf("The certificate has been revoked (OCSP, revoked at %s)", resp.RevokedAt)
+	default:
+		c.metrics.Checks.With("status", ocspStatusUnknown, "source", source).Add(1)
+		return c.unavailable(cert, errors.New("the OCSP responder does not know the certificate"))
+	}
+}
+
+func (c *ocspChecker) unavailable(cert *x509.Certificate, err error) error {
+	if c.mode == OCSPHardFail {
+		return errors.WithMessage(err, "could not determine the revocation status of the certificate")
+	}
+	mspLogger.Warningf("Could not determine the revocation status of certificate with serial number %s, accepting it: %s", cert.SerialNumber, err)
+	return nil
+}
+
+// getResponse returns a fresh OCSP response for cert, looking it up, in order,
+// in the cache, in the stapled response and at the OCSP responders of cert,
+// together with the source of the response
+func (c *ocspChecker) getResponse(cert, issuer *x509.Certificate, stapled []byte) (*ocsp.Response, string, error) {
+	key := ocspCacheKey(cert, issuer)
+	if c.cache != nil {
+		if resp, ok := c.cache.Get(key); ok {
+			return resp, ocspSourceCache, nil
+		}
+	}
+
+	if len(stapled) != 0 {
+		resp, err := parseOCSPResponse(stapled, cert, issuer)
+		if err == nil {
+			c.cacheResponse(key, resp)
+			return resp, ocspSourceStapled, nil
+		}
+		mspLogger.Debugf("Ignoring stapled OCSP response for certificate with serial number %s: %s", cert.SerialNumber, err)
+	}
+
+	if len(cert.OCSPServer) == 0 {
+		return nil, ocspSourceNone, errors.New("the certificate does not specify any OCSP responder")
+	}
+
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return nil, ocspSourceResponder, errors.Wrap(err, "failed creating OCSP request")
+	}
+
+	for _, server := range cert.OCSPServer {
+		resp, err := c.query(server, req, cert, issuer)
+		if err != nil {
+			c.metrics.RequestFailures.Add(1)
+			mspLogger.Debugf("OCSP request to %s failed: %s", server, err)
+			continue
+		}
+		c.cacheResponse(key, resp)
+		return resp, ocspSourceResponder, nil
+	}
+
+	return nil, ocspSourceResponder, errors.Errorf("no OCSP responder in %v returned a valid response", cert.OCSPServer)
+}
+
+func (c *ocspChecker) query(server string, req []byte, cert, issuer *x509.Certificate) (*ocsp.Response, error) {
+	startTime := time.Now()
+	httpResp, err := c.client.Post(server, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	c.metrics.RequestDuration.Observe(time.Since(startTime).Seconds())
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected HTTP status %s", httpResp.Status)
+	}
+	raw, err := ioutil.ReadAll(io.LimitReader(httpResp.Body, maxOCSPResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOCSPResponse(raw, cert, issuer)
+}
+
+func (c *ocspChecker) cacheResponse(key string, resp *ocsp.Response) {
+	if c.cache != nil {
+		c.cache.Put(key, resp)
+	}
+}
+
+// parseOCSPResponse parses and verifies the OCSP response for cert in raw,
+// and checks that it is fresh
+func parseOCSPResponse(raw []byte, cert, issuer *x509.Certificate) (*ocsp.Response, error) {
+	resp, err := ocsp.ParseResponseForCert(raw, cert, issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid OCSP response")
+	}
+
+	now := time.Now()
+	if resp.ThisUpdate.After(now.Add(ocspClockSkew)) {
+		return nil, errors.Errorf("OCSP response is not yet valid, ThisUpdate is %s", resp.ThisUpdate)
+	}
+	if !resp.NextUpdate.IsZero() && resp.NextUpdate.Before(now.Add(-ocspClockSkew)) {
+		return nil, errors.Errorf("OCSP response has expired, NextUpdate was %s", resp.NextUpdate)
+	}
+
+	return resp, nil
+}
+
+func ocspCacheKey(cert, issuer *x509.Certificate) string {
+	issuerHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(issuerHash[:]) + ":" + cert.SerialNumber.String()
+}
+
+// getStapledOCSPResponse returns the DER encoded OCSP response that
+// follows the certificate in the PEM encoded rest, if any
+func getStapledOCSPResponse(rest []byte) []byte {
+	for len(rest) != 0 {
+		var bl *pem.Block
+		bl, rest = pem.Decode(rest)
+		if bl == nil {
+			return nil
+		}
+		if bl.Type == OCSPResponsePEMType {
+			return bl.Bytes
+		}
+	}
+	return nil
+}
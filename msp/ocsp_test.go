@@ -0,0 +1,377 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	m "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
+)
+
+type ocspTestCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newOCSPTestCA(t *testing.T) *ocspTestCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	pubRaw, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	ski := sha256.Sum256(pubRaw)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ocsp-ca", Organization: []string{"OCSPOrg"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          ski[:],
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &ocspTestCA{cert: cert, key: key}
+}
+
+func (ca *ocspTestCA) issue(t *testing.T, serial int64, ocspServers ...string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(serial),
+		Subject:        pkix.Name{CommonName: "member", Organization: []string{"OCSPOrg"}},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		AuthorityKeyId: ca.cert.SubjectKeyId,
+		OCSPServer:     ocspServers,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return cert
+}
+
+func (ca *ocspTestCA) response(t *testing.T, serial *big.Int, status int) []byte {
+	template := ocsp.Response{
+		Status:       status,
+		SerialNumber: serial,
+		ThisUpdate:   time.Now().Add(-time.Minute),
+		NextUpdate:   time.Now().Add(time.Hour),
+	}
+	if status == ocsp.Revoked {
+		template.RevokedAt = time.Now().Add(-time.Minute)
+	}
+	resp, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+	assert.NoError(t, err)
+	return resp
+}
+
+// ocspResponder is a local OCSP responder serving the statuses set in it
+type ocspResponder struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	statuses map[string]int
+	requests int
+}
+
+func newOCSPResponder(t *testing.T, ca *ocspTestCA) *ocspResponder {
+	r := &ocspResponder{statuses: map[string]int{}}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		raw, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		ocspReq, err := ocsp.ParseRequest(raw)
+		assert.NoError(t, err)
+
+		r.lock.Lock()
+		r.requests++
+		status, ok := r.statuses[ocspReq.SerialNumber.String()]
+		r.lock.Unlock()
+		if !ok {
+			status = ocsp.Unknown
+		}
+
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(ca.response(t, ocspReq.SerialNumber, status))
+	}))
+	return r
+}
+
+func (r *ocspResponder) setStatus(serial *big.Int, status int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.statuses[serial.String()] = status
+}
+
+func (r *ocspResponder) requestCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.requests
+}
+
+type mapOCSPResponseCache struct {
+	lock      sync.Mutex
+	responses map[string]*ocsp.Response
+}
+
+func (c *mapOCSPResponseCache) Get(key string) (*ocsp.Response, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	resp, ok := c.responses[key]
+	return resp, ok
+}
+
+func (c *mapOCSPResponseCache) Put(key string, resp *ocsp.Response) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.responses[key] = resp
+}
+
+func newOCSPTestMSP(t *testing.T, ca *ocspTestCA, opts *OCSPOptions) MSP {
+	fabricConf := &m.FabricMSPConfig{
+		Name:      "OCSPOrg",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})},
+	}
+	raw, err := proto.Marshal(fabricConf)
+	assert.NoError(t, err)
+
+	thisMSP, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}, OCSP: opts})
+	assert.NoError(t, err)
+	err = thisMSP.Setup(&m.MSPConfig{Type: int32(FABRIC), Config: raw})
+	assert.NoError(t, err)
+
+	return thisMSP
+}
+
+func serializeForOCSPTest(t *testing.T, cert *x509.Certificate, stapled []byte) []byte {
+	idBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	if stapled != nil {
+		idBytes = append(idBytes, pem.EncodeToMemory(&pem.Block{Type: OCSPResponsePEMType, Bytes: stapled})...)
+	}
+	sid, err := proto.Marshal(&m.SerializedIdentity{Mspid: "OCSPOrg", IdBytes: idBytes})
+	assert.NoError(t, err)
+	return sid
+}
+
+func validateForOCSPTest(t *testing.T, thisMSP MSP, cert *x509.Certificate, stapled []byte) error {
+	id, err := thisMSP.DeserializeIdentity(serializeForOCSPTest(t, cert, stapled))
+	assert.NoError(t, err)
+	return thisMSP.Validate(id)
+}
+
+func TestOCSPResponder(t *testing.T) {
+	ca := newOCSPTestCA(t)
+	responder := newOCSPResponder(t, ca)
+	defer responder.Close()
+
+	good := ca.issue(t, 2, responder.URL)
+	revoked := ca.issue(t, 3, responder.URL)
+	unknown := ca.issue(t, 4, responder.URL)
+	responder.setStatus(good.SerialNumber, ocsp.Good)
+	responder.setStatus(revoked.SerialNumber, ocsp.Revoked)
+
+	for _, mode := range []OCSPMode{OCSPSoftFail, OCSPHardFail} {
+		thisMSP := newOCSPTestMSP(t, ca, &OCSPOptions{Mode: mode})
+
+		assert.NoError(t, validateForOCSPTest(t, thisMSP, good, nil))
+
+		err := validateForOCSPTest(t, thisMSP, revoked, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "The certificate has been revoked (OCSP")
+
+		err = validateForOCSPTest(t, thisMSP, unknown, nil)
+		if mode == OCSPHardFail {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "the OCSP responder does not know the certificate")
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	// Without OCSP, the revoked certificate is accepted
+	thisMSP := newOCSPTestMSP(t, ca, nil)
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, revoked, nil))
+	assert.True(t, thisMSP.(*bccspmsp).ValidationExpiry(nil).IsZero())
+}
+
+func TestOCSPResponderUnavailable(t *testing.T) {
+	ca := newOCSPTestCA(t)
+	responder := newOCSPResponder(t, ca)
+	responder.Close()
+
+	cert := ca.issue(t, 2, responder.URL)
+	noResponder := ca.issue(t, 3)
+
+	thisMSP := newOCSPTestMSP(t, ca, &OCSPOptions{Mode: OCSPSoftFail, Timeout: time.Second})
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, cert, nil))
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, noResponder, nil))
+
+	thisMSP = newOCSPTestMSP(t, ca, &OCSPOptions{Mode: OCSPHardFail, Timeout: time.Second})
+	err := validateForOCSPTest(t, thisMSP, cert, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no OCSP responder in")
+	err = validateForOCSPTest(t, thisMSP, noResponder, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the certificate does not specify any OCSP responder")
+}
+
+func TestOCSPStapledResponse(t *testing.T) {
+	ca := newOCSPTestCA(t)
+	thisMSP := newOCSPTestMSP(t, ca, &OCSPOptions{Mode: OCSPHardFail})
+
+	good := ca.issue(t, 2)
+	revoked := ca.issue(t, 3)
+
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, good, ca.response(t, good.SerialNumber, ocsp.Good)))
+
+	err := validateForOCSPTest(t, thisMSP, revoked, ca.response(t, revoked.SerialNumber, ocsp.Revoked))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The certificate has been revoked (OCSP")
+
+	// A response stapled for another certificate is ignored
+	err = validateForOCSPTest(t, thisMSP, revoked, ca.response(t, good.SerialNumber, ocsp.Good))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the certificate does not specify any OCSP responder")
+
+	// Stapling does not change the identifier of the identity
+	id1, err := thisMSP.DeserializeIdentity(serializeForOCSPTest(t, good, nil))
+	assert.NoError(t, err)
+	id2, err := thisMSP.DeserializeIdentity(serializeForOCSPTest(t, good, ca.response(t, good.SerialNumber, ocsp.Good)))
+	assert.NoError(t, err)
+	assert.Equal(t, id1.GetIdentifier(), id2.GetIdentifier())
+}
+
+func TestOCSPResponseCacheAndMetrics(t *testing.T) {
+	ca := newOCSPTestCA(t)
+	responder := newOCSPResponder(t, ca)
+	defer responder.Close()
+
+	cert := ca.issue(t, 2, responder.URL)
+	responder.setStatus(cert.SerialNumber, ocsp.Good)
+
+	fakeChecks := &metricsfakes.Counter{}
+	fakeChecks.WithReturns(fakeChecks)
+	provider := &metricsfakes.Provider{}
+	provider.NewCounterReturns(fakeChecks)
+	provider.NewHistogramReturns(&metricsfakes.Histogram{})
+
+	thisMSP := newOCSPTestMSP(t, ca, &OCSPOptions{
+		Mode:            OCSPHardFail,
+		RecheckInterval: time.Minute,
+		ResponseCache:   &mapOCSPResponseCache{responses: map[string]*ocsp.Response{}},
+		MetricsProvider: provider,
+	})
+
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, cert, nil))
+	assert.NoError(t, validateForOCSPTest(t, thisMSP, cert, nil))
+	assert.Equal(t, 1, responder.requestCount())
+
+	assert.Equal(t, 2, fakeChecks.WithCallCount())
+	assert.Equal(t, []string{"status", "good", "source", "responder"}, fakeChecks.WithArgsForCall(0))
+	assert.Equal(t, []string{"status", "good", "source", "cache"}, fakeChecks.WithArgsForCall(1))
+
+	expiry := thisMSP.(*bccspmsp).ValidationExpiry(nil)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expiry, time.Second)
+}
+
+func TestOCSPResponseFreshness(t *testing.T) {
+	ca := newOCSPTestCA(t)
+	cert := ca.issue(t, 2)
+
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   time.Now().Add(-2 * time.Hour),
+		NextUpdate:   time.Now().Add(-time.Hour),
+	}
+	raw, err := ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+	assert.NoError(t, err)
+	_, err = parseOCSPResponse(raw, cert, ca.cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "OCSP response has expired")
+
+	template.ThisUpdate = time.Now().Add(time.Hour)
+	template.NextUpdate = time.Now().Add(2 * time.Hour)
+	raw, err = ocsp.CreateResponse(ca.cert, ca.cert, template, ca.key)
+	assert.NoError(t, err)
+	_, err = parseOCSPResponse(raw, cert, ca.cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "OCSP response is not yet valid")
+
+	// a response signed by another CA is rejected
+	otherCA := newOCSPTestCA(t)
+	_, err = parseOCSPResponse(otherCA.response(t, cert.SerialNumber, ocsp.Good), cert, ca.cert)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid OCSP response")
+}
+
+func TestNewMSPOCSPOptions(t *testing.T) {
+	// MSPs created without OCSP options, such as the channel MSPs, never
+	// consult OCSP
+	thisMSP, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}})
+	assert.NoError(t, err)
+	assert.Nil(t, thisMSP.(*bccspmsp).ocsp)
+
+	thisMSP, err = New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}, OCSP: &OCSPOptions{Mode: OCSPDisabled}})
+	assert.NoError(t, err)
+	assert.Nil(t, thisMSP.(*bccspmsp).ocsp)
+
+	thisMSP, err = New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0}, OCSP: &OCSPOptions{Mode: OCSPHardFail}})
+	assert.NoError(t, err)
+	assert.NotNil(t, thisMSP.(*bccspmsp).ocsp)
+	assert.Equal(t, OCSPHardFail, thisMSP.(*bccspmsp).ocsp.mode)
+}
+
+func TestNewOCSPVerifier(t *testing.T) {
+	assert.Nil(t, NewOCSPVerifier(nil))
+	assert.Nil(t, NewOCSPVerifier(&OCSPOptions{Mode: OCSPDisabled}))
+
+	ca := newOCSPTestCA(t)
+	responder := newOCSPResponder(t, ca)
+	defer responder.Close()
+
+	good := ca.issue(t, 2, responder.URL)
+	responder.setStatus(good.SerialNumber, ocsp.Good)
+	revoked := ca.issue(t, 3, responder.URL)
+	responder.setStatus(revoked.SerialNumber, ocsp.Revoked)
+
+	verify := NewOCSPVerifier(&OCSPOptions{Mode: OCSPHardFail, Timeout: time.Second})
+	assert.NotNil(t, verify)
+
+	assert.NoError(t, verify(nil, [][]*x509.Certificate{{good, ca.cert}}))
+	err := verify(nil, [][]*x509.Certificate{{revoked, ca.cert}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "revoked")
+	// self-signed certificates have no issuer to query
+	assert.NoError(t, verify(nil, [][]*x509.Certificate{{ca.cert}}))
+}
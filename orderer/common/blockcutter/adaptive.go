@@ -0,0 +1,141 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockcutter
+
+import (
+	"time"
+
+	ab "github.com/hyperledger/fabric/protos/orderer"
+)
+
+// smoothing is the weight of the last observation in the moving averages
+// of the time between messages and of their size
+const smoothing = 0.2
+
+// adaptation tunes the batch timeout and the preferred max bytes of the
+// batches to the arrival rate of the messages, within the bounds of the
+// config. The preferred max bytes only depends on the way the previous
+// batches were cut, so that the batches remain the same across consenters
+// which cut the same batches.
+type adaptation struct {
+	config               *ab.AdaptiveBatching
+	minBatchTimeout      time.Duration
+	maxPreferredMaxBytes uint32
+
+	// batchSize is the batch size of the config, whose preferred max bytes
+	// is the lower bound of the tuned one
+	batchSize         *ab.BatchSize
+	preferredMaxBytes uint32
+
+	lastArrival time.Time
+	interval    float64 // moving average of the time between messages, in seconds
+	size        float64 // moving average of the size of messages, in bytes
+}
+
+// newAdaptation returns the adaptation to the given config, or nil if there
+// is none. Unless tuneSize is set, only the batch timeout is tuned.
+func newAdaptation(config *ab.AdaptiveBatching, tuneSize bool) *adaptation {
+	if config == nil {
+		return nil
+	}
+
+	// The config is validated, so the min batch timeout is well formed
+	minBatchTimeout, _ := time.ParseDuration(config.MinBatchTimeout)
+	a := &adaptation{
+		config:          config,
+		minBatchTimeout: minBatchTimeout,
+	}
+	if tuneSize {
+		a.maxPreferredMaxBytes = config.MaxPreferredMaxBytes
+	}
+	return a
+}
+
+// observe records the arrival of a message
+func (a *adaptation) observe(now time.Time, messageSizeBytes uint32) {
+	if !a.lastArrival.IsZero() {
+		a.interval = average(a.interval, now.Sub(a.lastArrival).Seconds())
+	}
+	a.size = average(a.size, float64(messageSizeBytes))
+	a.lastArrival = now
+}
+
+func average(avg, value float64) float64 {
+	if avg == 0 {
+		return value
+	}
+	return smoothing*value + (1-smoothing)*avg
+}
+
+// tune returns the given batch size of the config with the tuned preferred
+// max bytes
+func (a *adaptation) tune(batchSize *ab.BatchSize) *ab.BatchSize {
+	a.batchSize = batchSize
+	if a.preferredMaxBytes <= batchSize.PreferredMaxBytes {
+		return batchSize
+	}
+	return &ab.BatchSize{
+		MaxMessageCount:   batchSize.MaxMessageCount,
+		AbsoluteMaxBytes:  batchSize.AbsoluteMaxBytes,
+		PreferredMaxBytes: a.preferredMaxBytes,
+	}
+}
+
+// overflowed raises the preferred max bytes by a quarter, up to its upper
+// bound, once a batch is cut for exceeding it
+func (a *adaptation) overflowed() {
+	if a.maxPreferredMaxBytes == 0 {
+		return
+	}
+	preferredMaxBytes := a.currentPreferredMaxBytes()
+	preferredMaxBytes += preferredMaxBytes / 4
+	if preferredMaxBytes > a.maxPreferredMaxBytes {
+		preferredMaxBytes = a.maxPreferredMaxBytes
+	}
+	a.preferredMaxBytes = preferredMaxBytes
+}
+
+// underfilled lowers the preferred max bytes by a fifth, down to the one of
+// the config, once a batch is cut before filling up
+func (a *adaptation) underfilled() {
+	if a.maxPreferredMaxBytes == 0 {
+		return
+	}
+	preferredMaxBytes := a.currentPreferredMaxBytes()
+	a.preferredMaxBytes = preferredMaxBytes - preferredMaxBytes/5
+}
+
+func (a *adaptation) currentPreferredMaxBytes() uint32 {
+	if a.preferredMaxBytes < a.batchSize.PreferredMaxBytes {
+		return a.batchSize.PreferredMaxBytes
+	}
+	return a.preferredMaxBytes
+}
+
+// batchTimeout returns the given batch timeout of the config, shortened
+// down to the min batch timeout when too few messages are expected to
+// arrive within it to fill the batch of the given size
+func (a *adaptation) batchTimeout(batchTimeout time.Duration, batchSize *ab.BatchSize) time.Duration {
+	if a.interval == 0 {
+		return batchTimeout
+	}
+
+	expected := batchTimeout.Seconds() / a.interval
+	fill := expected / float64(batchSize.MaxMessageCount)
+	if bytesFill := expected * a.size / float64(batchSize.PreferredMaxBytes); bytesFill > fill {
+		fill = bytesFill
+	}
+	if fill >= 1 {
+		return batchTimeout
+	}
+
+	timeout := time.Duration(fill * float64(batchTimeout))
+	if timeout < a.minBatchTimeout {
+		return a.minBatchTimeout
+	}
+	return timeout
+}
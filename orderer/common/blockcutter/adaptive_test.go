@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockcutter
+
+import (
+	"time"
+
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Adaptation", func() {
+	var (
+		a         *adaptation
+		batchSize *ab.BatchSize
+		start     time.Time
+	)
+
+	BeforeEach(func() {
+		a = newAdaptation(&ab.AdaptiveBatching{
+			MinBatchTimeout:      "100ms",
+			MaxPreferredMaxBytes: 200,
+		}, true)
+		batchSize = &ab.BatchSize{
+			MaxMessageCount:   10,
+			AbsoluteMaxBytes:  1000,
+			PreferredMaxBytes: 100,
+		}
+		a.tune(batchSize)
+		start = time.Unix(0, 0)
+	})
+
+	It("is nil without config", func() {
+		Expect(newAdaptation(nil, true)).To(BeNil())
+	})
+
+	Describe("batchTimeout", func() {
+		It("returns the batch timeout of the config before the arrival rate is known", func() {
+			a.observe(start, 1)
+			Expect(a.batchTimeout(time.Second, batchSize)).To(Equal(time.Second))
+		})
+
+		It("returns the batch timeout of the config when the batch is expected to fill up", func() {
+			for i := 0; i < 5; i++ {
+				a.observe(start.Add(time.Duration(i)*50*time.Millisecond), 1)
+			}
+			Expect(a.batchTimeout(time.Second, batchSize)).To(Equal(time.Second))
+		})
+
+		It("shortens the batch timeout when the batch is not expected to fill up", func() {
+			for i := 0; i < 5; i++ {
+				a.observe(start.Add(time.Duration(i)*250*time.Millisecond), 1)
+			}
+			// 4 messages of 10 are expected within the batch timeout
+			Expect(a.batchTimeout(time.Second, batchSize)).To(Equal(400 * time.Millisecond))
+		})
+
+		It("accounts for the size of the messages", func() {
+			for i := 0; i < 5; i++ {
+				a.observe(start.Add(time.Duration(i)*250*time.Millisecond), 20)
+			}
+			// 80 bytes of 100 are expected within the batch timeout
+			Expect(a.batchTimeout(time.Second, batchSize)).To(Equal(800 * time.Millisecond))
+		})
+
+		It("does not shorten the batch timeout below the min batch timeout", func() {
+			for i := 0; i < 5; i++ {
+				a.observe(start.Add(time.Duration(i)*time.Minute), 1)
+			}
+			Expect(a.batchTimeout(time.Second, batchSize)).To(Equal(100 * time.Millisecond))
+		})
+	})
+
+	Describe("tune", func() {
+		It("raises the preferred max bytes up to the max preferred max bytes", func() {
+			for _, expected := range []uint32{125, 156, 195, 200, 200} {
+				a.overflowed()
+				Expect(a.tune(batchSize).PreferredMaxBytes).To(Equal(expected))
+			}
+			Expect(a.tune(batchSize).MaxMessageCount).To(Equal(uint32(10)))
+			Expect(a.tune(batchSize).AbsoluteMaxBytes).To(Equal(uint32(1000)))
+		})
+
+		It("lowers the preferred max bytes down to the one of the config", func() {
+			a.overflowed()
+			a.overflowed()
+			for _, expected := range []uint32{125, 100, 100} {
+				a.underfilled()
+				Expect(a.tune(batchSize).PreferredMaxBytes).To(Equal(expected))
+			}
+			Expect(a.tune(batchSize)).To(BeIdenticalTo(batchSize))
+		})
+
+		It("does not tune the batch size unless told to", func() {
+			a = newAdaptation(&ab.AdaptiveBatching{MinBatchTimeout: "100ms", MaxPreferredMaxBytes: 200}, false)
+			a.tune(batchSize)
+			a.overflowed()
+			Expect(a.tune(batchSize)).To(BeIdenticalTo(batchSize))
+		})
+	})
+})
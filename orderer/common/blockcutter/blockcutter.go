@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 )
 
 var logger = flogging.MustGetLogger("orderer.common.blockcutter")
@@ -27,8 +28,24 @@ type Receiver interface {
 	// `pending` indicates if there are still messages pending in the receiver.
 	Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, pending bool)
 
-	// Cut returns the current batch and starts a new one
+	// Cut returns the current batch and starts a new one.
+	// When batch priorities are set, messages may remain pending once the
+	// batch is cut, so Cut must be invoked until it returns an empty batch
+	// to cut all of them.
 	Cut() []*cb.Envelope
+
+	// CutOnTimeout returns the current batch and starts a new one, as Cut
+	// does, once the batch timeout of the pending messages expired. Unlike
+	// the batches cut for other reasons, the batches cut on timeout make
+	// adaptive batching lower the preferred max bytes, as they were not
+	// filled up in time.
+	CutOnTimeout() []*cb.Envelope
+
+	// BatchTimeout returns the batch timeout of the messages pending in the
+	// receiver, which is the one of the given orderer config unless adaptive
+	// batching is set, in which case it is tuned to the arrival rate of the
+	// messages.
+	BatchTimeout(ordererConfig channelconfig.Orderer) time.Duration
 }
 
 type receiver struct {
@@ -36,6 +53,14 @@ type receiver struct {
 	pendingBatch          []*cb.Envelope
 	pendingBatchSizeBytes uint32
 
+	// lanes holds the pending messages instead of pendingBatch when
+	// batch priorities are set
+	lanes *lanes
+	// adaptation tunes the batch timeout and the batch size when adaptive
+	// batching is set
+	adaptation *adaptation
+	batchSize  *ab.BatchSize
+
 	PendingBatchStartTime time.Time
 	ChannelID             string
 	Metrics               *Metrics
@@ -52,6 +77,8 @@ func NewReceiverImpl(channelID string, sharedConfigFetcher OrdererConfigFetcher,
 
 // Ordered should be invoked sequentially as messages are ordered
 //
+// Unless batch priorities are set along with a consensus type other than kafka:
+//
 // messageBatches length: 0, pending: false
 //   - impossible, as we have just received a message
 // messageBatches length: 0, pending: true
@@ -65,13 +92,20 @@ func NewReceiverImpl(channelID string, sharedConfigFetcher OrdererConfigFetcher,
 // messageBatches length: 2, pending: true
 //   - impossible
 //
-// Note that messageBatches can not be greater than 2.
+// Note that messageBatches can then not be greater than 2.
+//
+// When batch priorities are set, the messages of every batch are ordered by
+// priority. Unless the consensus type is kafka, the message is moreover
+// queued in the lane of its priority class, and batches shared among the
+// lanes are cut for as long as the pending messages exceed the pending batch
+// along with the batches which may be held back, so that messageBatches may
+// hold any number of batches, whether messages remain pending or not. With
+// kafka, no batch is held back, so the priorities only reorder the messages
+// within each batch.
+//
+// When adaptive batching is set, the preferred max bytes of the batch size
+// is the one tuned to the way the previous batches were cut.
 func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, pending bool) {
-	if len(r.pendingBatch) == 0 {
-		// We are beginning a new batch, mark the time
-		r.PendingBatchStartTime = time.Now()
-	}
-
 	ordererConfig, ok := r.sharedConfigFetcher.OrdererConfig()
 	if !ok {
 		logger.Panicf("Could not retrieve orderer config to query batch parameters, block cutting is not possible")
@@ -79,13 +113,39 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 
 	batchSize := ordererConfig.BatchSize()
 
+	if adaptiveBatching := ordererConfig.AdaptiveBatching(); r.adaptation == nil || r.adaptation.config != adaptiveBatching {
+		// Kafka resumes with a new receiver, which must cut the same batches
+		// as the ones of the other consenters, so the batch size is not tuned
+		r.adaptation = newAdaptation(adaptiveBatching, ordererConfig.ConsensusType() != "kafka")
+	}
+	if r.adaptation != nil {
+		r.adaptation.observe(time.Now(), messageSizeBytes(msg))
+		batchSize = r.adaptation.tune(batchSize)
+	}
+	r.batchSize = batchSize
+
+	if r.lanes.empty() && len(r.pendingBatch) == 0 {
+		// The priority classes only change once every pending message is cut.
+		// Kafka resumes from the offset of the last message of the last block,
+		// so no message may be held back beyond the following ones.
+		r.lanes = newLanes(ordererConfig.BatchPriorities(), ordererConfig.ConsensusType() != "kafka")
+	}
+	if r.lanes != nil && r.lanes.maxPendingBatches > 0 {
+		return r.orderedByPriority(msg, batchSize)
+	}
+
+	if len(r.pendingBatch) == 0 {
+		// We are beginning a new batch, mark the time
+		r.PendingBatchStartTime = time.Now()
+	}
+
 	messageSizeBytes := messageSizeBytes(msg)
 	if messageSizeBytes > batchSize.PreferredMaxBytes {
 		logger.Debugf("The current message, with %v bytes, is larger than the preferred batch size of %v bytes and will be isolated.", messageSizeBytes, batchSize.PreferredMaxBytes)
 
 		// cut pending batch, if it has any messages
 		if len(r.pendingBatch) > 0 {
-			messageBatch := r.Cut()
+			messageBatch := r.cut()
 			messageBatches = append(messageBatches, messageBatch)
 		}
 
@@ -103,9 +163,10 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 	if messageWillOverflowBatchSizeBytes {
 		logger.Debugf("The current message, with %v bytes, will overflow the pending batch of %v bytes.", messageSizeBytes, r.pendingBatchSizeBytes)
 		logger.Debugf("Pending batch would overflow if current message is added, cutting batch now.")
-		messageBatch := r.Cut()
+		messageBatch := r.cut()
 		r.PendingBatchStartTime = time.Now()
 		messageBatches = append(messageBatches, messageBatch)
+		r.overflowed()
 	}
 
 	logger.Debugf("Enqueuing message into batch")
@@ -115,7 +176,7 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 
 	if uint32(len(r.pendingBatch)) >= batchSize.MaxMessageCount {
 		logger.Debugf("Batch size met, cutting batch")
-		messageBatch := r.Cut()
+		messageBatch := r.cut()
 		messageBatches = append(messageBatches, messageBatch)
 		pending = false
 	}
@@ -123,16 +184,103 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 	return
 }
 
+func (r *receiver) orderedByPriority(msg *cb.Envelope, batchSize *ab.BatchSize) (messageBatches [][]*cb.Envelope, pending bool) {
+	if r.lanes.empty() {
+		r.PendingBatchStartTime = time.Now()
+	}
+
+	logger.Debugf("Enqueuing message into lane")
+	r.lanes.enqueue(msg)
+
+	for r.lanes.full(batchSize) {
+		logger.Debugf("Pending messages exceed %d batches, cutting batch", r.lanes.maxPendingBatches+1)
+		overflowing := r.lanes.overflowing(batchSize)
+		messageBatches = append(messageBatches, r.cutByPriority())
+		if overflowing {
+			r.overflowed()
+		}
+	}
+
+	return messageBatches, !r.lanes.empty()
+}
+
 // Cut returns the current batch and starts a new one
 func (r *receiver) Cut() []*cb.Envelope {
+	return r.cut()
+}
+
+// CutOnTimeout returns the current batch and starts a new one once the
+// batch timeout expired
+func (r *receiver) CutOnTimeout() []*cb.Envelope {
+	batch := r.cut()
+	if len(batch) != 0 && len(r.pendingBatch) == 0 && r.lanes.empty() && r.adaptation != nil {
+		// The pending messages were cut before filling up a batch
+		r.adaptation.underfilled()
+		r.reportPreferredMaxBytes()
+	}
+	return batch
+}
+
+func (r *receiver) cut() []*cb.Envelope {
+	if r.lanes != nil && r.lanes.maxPendingBatches > 0 {
+		return r.cutByPriority()
+	}
+	if len(r.pendingBatch) == 0 {
+		return nil
+	}
+
 	r.Metrics.BlockFillDuration.With("channel", r.ChannelID).Observe(time.Since(r.PendingBatchStartTime).Seconds())
 	r.PendingBatchStartTime = time.Time{}
 	batch := r.pendingBatch
+	if r.lanes != nil {
+		batch = r.lanes.prioritize(batch)
+	}
 	r.pendingBatch = nil
 	r.pendingBatchSizeBytes = 0
 	return batch
 }
 
+func (r *receiver) cutByPriority() []*cb.Envelope {
+	if r.lanes.empty() {
+		return nil
+	}
+
+	r.Metrics.BlockFillDuration.With("channel", r.ChannelID).Observe(time.Since(r.PendingBatchStartTime).Seconds())
+	batch := r.lanes.next(r.batchSize)
+	if r.lanes.empty() {
+		r.PendingBatchStartTime = time.Time{}
+	} else {
+		r.PendingBatchStartTime = time.Now()
+	}
+	return batch
+}
+
+// overflowed records that a batch is cut for exceeding the preferred max bytes
+func (r *receiver) overflowed() {
+	if r.adaptation != nil {
+		r.adaptation.overflowed()
+		r.reportPreferredMaxBytes()
+	}
+}
+
+func (r *receiver) reportPreferredMaxBytes() {
+	preferredMaxBytes := r.adaptation.tune(r.adaptation.batchSize).PreferredMaxBytes
+	r.Metrics.PreferredMaxBytes.With("channel", r.ChannelID).Set(float64(preferredMaxBytes))
+}
+
+// BatchTimeout returns the batch timeout of the messages pending in the
+// receiver
+func (r *receiver) BatchTimeout(ordererConfig channelconfig.Orderer) time.Duration {
+	batchTimeout := ordererConfig.BatchTimeout()
+	if r.adaptation == nil {
+		return batchTimeout
+	}
+
+	batchTimeout = r.adaptation.batchTimeout(batchTimeout, r.batchSize)
+	r.Metrics.BatchTimeout.With("channel", r.ChannelID).Set(batchTimeout.Seconds())
+	return batchTimeout
+}
+
 func messageSizeBytes(message *cb.Envelope) uint32 {
 	return uint32(len(message.Payload) + len(message.Signature))
 }
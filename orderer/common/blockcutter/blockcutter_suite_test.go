@@ -22,6 +22,11 @@ type metricsHistogram interface {
 	metrics.Histogram
 }
 
+//go:generate counterfeiter -o mock/metrics_gauge.go --fake-name MetricsGauge . metricsGauge
+type metricsGauge interface {
+	metrics.Gauge
+}
+
 //go:generate counterfeiter -o mock/metrics_provider.go --fake-name MetricsProvider . metricsProvider
 type metricsProvider interface {
 	metrics.Provider
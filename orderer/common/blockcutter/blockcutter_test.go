@@ -7,13 +7,18 @@ SPDX-License-Identifier: Apache-2.0
 package blockcutter_test
 
 import (
+	"fmt"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/hyperledger/fabric/orderer/common/blockcutter"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter/mock"
 	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
 )
 
 var _ = Describe("Blockcutter", func() {
@@ -24,6 +29,8 @@ var _ = Describe("Blockcutter", func() {
 
 		metrics               *blockcutter.Metrics
 		fakeBlockFillDuration *mock.MetricsHistogram
+		fakeBatchTimeout      *mock.MetricsGauge
+		fakePreferredMaxBytes *mock.MetricsGauge
 	)
 
 	BeforeEach(func() {
@@ -33,8 +40,14 @@ var _ = Describe("Blockcutter", func() {
 
 		fakeBlockFillDuration = &mock.MetricsHistogram{}
 		fakeBlockFillDuration.WithReturns(fakeBlockFillDuration)
+		fakeBatchTimeout = &mock.MetricsGauge{}
+		fakeBatchTimeout.WithReturns(fakeBatchTimeout)
+		fakePreferredMaxBytes = &mock.MetricsGauge{}
+		fakePreferredMaxBytes.WithReturns(fakePreferredMaxBytes)
 		metrics = &blockcutter.Metrics{
 			BlockFillDuration: fakeBlockFillDuration,
+			BatchTimeout:      fakeBatchTimeout,
+			PreferredMaxBytes: fakePreferredMaxBytes,
 		}
 
 		bc = blockcutter.NewReceiverImpl("mychannel", fakeConfigFetcher, metrics)
@@ -182,5 +195,230 @@ var _ = Describe("Blockcutter", func() {
 				Expect(func() { bc.Ordered(message) }).To(Panic())
 			})
 		})
+
+		Context("when batch priorities are set", func() {
+			var (
+				fast, bulk []*cb.Envelope
+			)
+
+			BeforeEach(func() {
+				fakeConfig.BatchSizeReturns(&ab.BatchSize{
+					MaxMessageCount:   4,
+					PreferredMaxBytes: 10000,
+				})
+				fakeConfig.BatchPrioritiesReturns(&ab.BatchPriorities{
+					Classes: []*ab.PriorityClass{
+						{Name: "fast", Weight: 3, MspIds: []string{"Org1MSP"}, HeaderTypes: []string{"ENDORSER_TRANSACTION"}},
+					},
+				})
+
+				fast, bulk = nil, nil
+				for i := 0; i < 4; i++ {
+					fast = append(fast, envelope("Org1MSP", cb.HeaderType_ENDORSER_TRANSACTION, fmt.Sprintf("fast%d", i)))
+				}
+				for i := 0; i < 6; i++ {
+					bulk = append(bulk, envelope("Org2MSP", cb.HeaderType_ENDORSER_TRANSACTION, fmt.Sprintf("bulk%d", i)))
+				}
+			})
+
+			It("holds back a batch to share the batches among the priority classes", func() {
+				for _, msg := range bulk {
+					batches, pending := bc.Ordered(msg)
+					Expect(batches).To(BeEmpty())
+					Expect(pending).To(BeTrue())
+				}
+				batches, pending := bc.Ordered(fast[0])
+				Expect(batches).To(BeEmpty())
+				Expect(pending).To(BeTrue())
+
+				batches, pending = bc.Ordered(fast[1])
+				Expect(batches).To(Equal([][]*cb.Envelope{{fast[0], fast[1], bulk[0], bulk[1]}}))
+				Expect(pending).To(BeTrue())
+				Expect(fakeBlockFillDuration.ObserveCallCount()).To(Equal(1))
+
+				Expect(bc.Cut()).To(Equal(bulk[2:]))
+				Expect(bc.Cut()).To(BeNil())
+				Expect(fakeBlockFillDuration.ObserveCallCount()).To(Equal(2))
+			})
+
+			It("shares the batches in proportion to the weights", func() {
+				var batches [][]*cb.Envelope
+				for _, msg := range append(bulk[:4:4], fast...) {
+					batches, _ = bc.Ordered(msg)
+				}
+				Expect(batches).To(Equal([][]*cb.Envelope{{fast[0], fast[1], bulk[0], fast[2]}}))
+				Expect(bc.Cut()).To(Equal([]*cb.Envelope{fast[3], bulk[1], bulk[2], bulk[3]}))
+			})
+
+			It("matches the classes by MSP ID and header type", func() {
+				config := envelope("Org1MSP", cb.HeaderType_CONFIG, "config")
+				for _, msg := range []*cb.Envelope{bulk[0], config, message, fast[0]} {
+					bc.Ordered(msg)
+				}
+				Expect(bc.Cut()).To(Equal([]*cb.Envelope{fast[0], bulk[0], config, message}))
+			})
+
+			It("holds back the given number of batches", func() {
+				fakeConfig.BatchPrioritiesReturns(&ab.BatchPriorities{
+					Classes:           []*ab.PriorityClass{{Name: "fast", Weight: 3, MspIds: []string{"Org1MSP"}}},
+					MaxPendingBatches: 2,
+				})
+				for _, msg := range append(bulk[:6:6], fast...) {
+					batches, pending := bc.Ordered(msg)
+					Expect(batches).To(BeEmpty())
+					Expect(pending).To(BeTrue())
+				}
+				Expect(bc.Cut()).To(Equal([]*cb.Envelope{fast[0], fast[1], bulk[0], fast[2]}))
+			})
+
+			Context("when the pending messages exceed the preferred max bytes", func() {
+				BeforeEach(func() {
+					fakeConfig.BatchSizeReturns(&ab.BatchSize{
+						MaxMessageCount:   4,
+						PreferredMaxBytes: uint32(2 * len(bulk[0].Payload)),
+					})
+				})
+
+				It("cuts batches within the preferred max bytes", func() {
+					for _, msg := range bulk[:4] {
+						batches, pending := bc.Ordered(msg)
+						Expect(batches).To(BeEmpty())
+						Expect(pending).To(BeTrue())
+					}
+					batches, pending := bc.Ordered(fast[0])
+					Expect(batches).To(Equal([][]*cb.Envelope{{fast[0], bulk[0]}}))
+					Expect(pending).To(BeTrue())
+				})
+			})
+
+			Context("when the consensus type is kafka", func() {
+				BeforeEach(func() {
+					fakeConfig.ConsensusTypeReturns("kafka")
+				})
+
+				It("cuts the batches in order, ordering their messages by priority", func() {
+					for _, msg := range bulk[:3] {
+						batches, pending := bc.Ordered(msg)
+						Expect(batches).To(BeEmpty())
+						Expect(pending).To(BeTrue())
+					}
+					batches, pending := bc.Ordered(fast[0])
+					Expect(batches).To(Equal([][]*cb.Envelope{{fast[0], bulk[0], bulk[1], bulk[2]}}))
+					Expect(pending).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	Describe("Cut", func() {
+		It("returns no batch when no message is pending", func() {
+			Expect(bc.Cut()).To(BeNil())
+			Expect(fakeBlockFillDuration.ObserveCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when adaptive batching is set", func() {
+		var message *cb.Envelope
+
+		BeforeEach(func() {
+			fakeConfig.BatchSizeReturns(&ab.BatchSize{
+				MaxMessageCount:   10,
+				AbsoluteMaxBytes:  1000,
+				PreferredMaxBytes: 100,
+			})
+			fakeConfig.BatchTimeoutReturns(time.Second)
+			fakeConfig.AdaptiveBatchingReturns(&ab.AdaptiveBatching{
+				MinBatchTimeout:      "100ms",
+				MaxPreferredMaxBytes: 200,
+			})
+
+			message = &cb.Envelope{Payload: []byte("Twenty Bytes of Data"), Signature: []byte("Twenty Bytes of Data")}
+		})
+
+		It("raises the preferred max bytes once a batch overflows", func() {
+			for i := 0; i < 2; i++ {
+				batches, pending := bc.Ordered(message)
+				Expect(batches).To(BeEmpty())
+				Expect(pending).To(BeTrue())
+			}
+			batches, pending := bc.Ordered(message)
+			Expect(batches).To(Equal([][]*cb.Envelope{{message, message}}))
+			Expect(pending).To(BeTrue())
+
+			Expect(fakePreferredMaxBytes.WithCallCount()).To(Equal(1))
+			Expect(fakePreferredMaxBytes.WithArgsForCall(0)).To(Equal([]string{"channel", "mychannel"}))
+			Expect(fakePreferredMaxBytes.SetArgsForCall(0)).To(Equal(float64(125)))
+
+			for i := 0; i < 2; i++ {
+				batches, pending := bc.Ordered(message)
+				Expect(batches).To(BeEmpty())
+				Expect(pending).To(BeTrue())
+			}
+			batches, pending = bc.Ordered(message)
+			Expect(batches).To(Equal([][]*cb.Envelope{{message, message, message}}))
+			Expect(pending).To(BeTrue())
+		})
+
+		It("lowers the preferred max bytes once a batch times out before filling up", func() {
+			for i := 0; i < 3; i++ {
+				bc.Ordered(message)
+			}
+			Expect(fakePreferredMaxBytes.SetArgsForCall(0)).To(Equal(float64(125)))
+
+			Expect(bc.CutOnTimeout()).To(Equal([]*cb.Envelope{message}))
+			Expect(fakePreferredMaxBytes.SetCallCount()).To(Equal(2))
+			Expect(fakePreferredMaxBytes.SetArgsForCall(1)).To(Equal(float64(100)))
+		})
+
+		It("does not lower the preferred max bytes when a batch is cut for other reasons", func() {
+			for i := 0; i < 3; i++ {
+				bc.Ordered(message)
+			}
+			Expect(bc.Cut()).To(Equal([]*cb.Envelope{message}))
+			Expect(fakePreferredMaxBytes.SetCallCount()).To(Equal(1))
+		})
+
+		It("does not tune the batch size when the consensus type is kafka", func() {
+			fakeConfig.ConsensusTypeReturns("kafka")
+			for i := 0; i < 3; i++ {
+				bc.Ordered(message)
+			}
+			Expect(bc.CutOnTimeout()).To(Equal([]*cb.Envelope{message}))
+			Expect(fakePreferredMaxBytes.SetCallCount()).To(Equal(2))
+			Expect(fakePreferredMaxBytes.SetArgsForCall(0)).To(Equal(float64(100)))
+			Expect(fakePreferredMaxBytes.SetArgsForCall(1)).To(Equal(float64(100)))
+		})
+
+		It("tunes the batch timeout", func() {
+			bc.Ordered(message)
+			Expect(bc.BatchTimeout(fakeConfig)).To(Equal(time.Second))
+
+			bc.Ordered(message)
+			Expect(bc.BatchTimeout(fakeConfig)).To(Equal(time.Second))
+			Expect(fakeBatchTimeout.WithCallCount()).To(Equal(2))
+			Expect(fakeBatchTimeout.WithArgsForCall(1)).To(Equal([]string{"channel", "mychannel"}))
+			Expect(fakeBatchTimeout.SetArgsForCall(1)).To(Equal(float64(1)))
+		})
+	})
+
+	Describe("BatchTimeout", func() {
+		It("returns the batch timeout of the config", func() {
+			fakeConfig.BatchTimeoutReturns(time.Second)
+			Expect(bc.BatchTimeout(fakeConfig)).To(Equal(time.Second))
+			Expect(fakeBatchTimeout.WithCallCount()).To(Equal(0))
+		})
 	})
 })
+
+func envelope(mspID string, headerType cb.HeaderType, txID string) *cb.Envelope {
+	return &cb.Envelope{
+		Payload: utils.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{
+				ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{Type: int32(headerType), TxId: txID}),
+				SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{
+					Creator: utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID}),
+				}),
+			},
+		}),
+	}
+}
@@ -16,14 +16,32 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+	batchTimeout = metrics.GaugeOpts{
+		Namespace:    "blockcutter",
+		Name:         "batch_timeout",
+		Help:         "The batch timeout in seconds, as tuned to the arrival rate of transactions.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	preferredMaxBytes = metrics.GaugeOpts{
+		Namespace:    "blockcutter",
+		Name:         "preferred_max_bytes",
+		Help:         "The preferred max bytes of a batch, as tuned to the arrival rate of transactions.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
 
 type Metrics struct {
 	BlockFillDuration metrics.Histogram
+	BatchTimeout      metrics.Gauge
+	PreferredMaxBytes metrics.Gauge
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
 	return &Metrics{
 		BlockFillDuration: p.NewHistogram(blockFillDuration),
+		BatchTimeout:      p.NewGauge(batchTimeout),
+		PreferredMaxBytes: p.NewGauge(preferredMaxBytes),
 	}
 }
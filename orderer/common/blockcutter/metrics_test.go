@@ -23,6 +23,7 @@ var _ = Describe("Metrics", func() {
 		BeforeEach(func() {
 			fakeProvider = &mock.MetricsProvider{}
 			fakeProvider.NewHistogramReturns(&mock.MetricsHistogram{})
+			fakeProvider.NewGaugeReturns(&mock.MetricsGauge{})
 		})
 
 		It("uses the provider to initialize its field", func() {
@@ -30,7 +31,11 @@ var _ = Describe("Metrics", func() {
 			Expect(metrics).NotTo(BeNil())
 			Expect(metrics.BlockFillDuration).To(Equal(&mock.MetricsHistogram{}))
 
+			Expect(metrics.BatchTimeout).To(Equal(&mock.MetricsGauge{}))
+			Expect(metrics.PreferredMaxBytes).To(Equal(&mock.MetricsGauge{}))
+
 			Expect(fakeProvider.NewHistogramCallCount()).To(Equal(1))
+			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(2))
 		})
 	})
 })
@@ -10,6 +10,26 @@ import (
 )
 
 type OrdererConfig struct {
+	AdaptiveBatchingStub        func() *orderer.AdaptiveBatching
+	adaptiveBatchingMutex       sync.RWMutex
+	adaptiveBatchingArgsForCall []struct {
+	}
+	adaptiveBatchingReturns struct {
+		result1 *orderer.AdaptiveBatching
+	}
+	adaptiveBatchingReturnsOnCall map[int]struct {
+		result1 *orderer.AdaptiveBatching
+	}
+	BatchPrioritiesStub        func() *orderer.BatchPriorities
+	batchPrioritiesMutex       sync.RWMutex
+	batchPrioritiesArgsForCall []struct {
+	}
+	batchPrioritiesReturns struct {
+		result1 *orderer.BatchPriorities
+	}
+	batchPrioritiesReturnsOnCall map[int]struct {
+		result1 *orderer.BatchPriorities
+	}
 	BatchSizeStub        func() *orderer.BatchSize
 	batchSizeMutex       sync.RWMutex
 	batchSizeArgsForCall []struct {
@@ -70,6 +90,16 @@ type OrdererConfig struct {
 	consensusTypeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	DuplicateTxIDWindowStub        func() uint32
+	duplicateTxIDWindowMutex       sync.RWMutex
+	duplicateTxIDWindowArgsForCall []struct {
+	}
+	duplicateTxIDWindowReturns struct {
+		result1 uint32
+	}
+	duplicateTxIDWindowReturnsOnCall map[int]struct {
+		result1 uint32
+	}
 	KafkaBrokersStub        func() []string
 	kafkaBrokersMutex       sync.RWMutex
 	kafkaBrokersArgsForCall []struct {
@@ -100,10 +130,124 @@ type OrdererConfig struct {
 	organizationsReturnsOnCall map[int]struct {
 		result1 map[string]channelconfig.OrdererOrg
 	}
+	RateLimitsStub        func() *orderer.RateLimits
+	rateLimitsMutex       sync.RWMutex
+	rateLimitsArgsForCall []struct {
+	}
+	rateLimitsReturns struct {
+		result1 *orderer.RateLimits
+	}
+	rateLimitsReturnsOnCall map[int]struct {
+		result1 *orderer.RateLimits
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *OrdererConfig) AdaptiveBatching() *orderer.AdaptiveBatching {
+	fake.adaptiveBatchingMutex.Lock()
+	ret, specificReturn := fake.adaptiveBatchingReturnsOnCall[len(fake.adaptiveBatchingArgsForCall)]
+	fake.adaptiveBatchingArgsForCall = append(fake.adaptiveBatchingArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AdaptiveBatching", []interface{}{})
+	fake.adaptiveBatchingMutex.Unlock()
+	if fake.AdaptiveBatchingStub != nil {
+		return fake.AdaptiveBatchingStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.adaptiveBatchingReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) AdaptiveBatchingCallCount() int {
+	fake.adaptiveBatchingMutex.RLock()
+	defer fake.adaptiveBatchingMutex.RUnlock()
+	return len(fake.adaptiveBatchingArgsForCall)
+}
+
+func (fake *OrdererConfig) AdaptiveBatchingCalls(stub func() *orderer.AdaptiveBatching) {
+	fake.adaptiveBatchingMutex.Lock()
+	defer fake.adaptiveBatchingMutex.Unlock()
+	fake.AdaptiveBatchingStub = stub
+}
+
+func (fake *OrdererConfig) AdaptiveBatchingReturns(result1 *orderer.AdaptiveBatching) {
+	fake.adaptiveBatchingMutex.Lock()
+	defer fake.adaptiveBatchingMutex.Unlock()
+	fake.AdaptiveBatchingStub = nil
+	fake.adaptiveBatchingReturns = struct {
+		result1 *orderer.AdaptiveBatching
+	}{result1}
+}
+
+func (fake *OrdererConfig) AdaptiveBatchingReturnsOnCall(i int, result1 *orderer.AdaptiveBatching) {
+	fake.adaptiveBatchingMutex.Lock()
+	defer fake.adaptiveBatchingMutex.Unlock()
+	fake.AdaptiveBatchingStub = nil
+	if fake.adaptiveBatchingReturnsOnCall == nil {
+		fake.adaptiveBatchingReturnsOnCall = make(map[int]struct {
+			result1 *orderer.AdaptiveBatching
+		})
+	}
+	fake.adaptiveBatchingReturnsOnCall[i] = struct {
+		result1 *orderer.AdaptiveBatching
+	}{result1}
+}
+
+func (fake *OrdererConfig) BatchPriorities() *orderer.BatchPriorities {
+	fake.batchPrioritiesMutex.Lock()
+	ret, specificReturn := fake.batchPrioritiesReturnsOnCall[len(fake.batchPrioritiesArgsForCall)]
+	fake.batchPrioritiesArgsForCall = append(fake.batchPrioritiesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BatchPriorities", []interface{}{})
+	fake.batchPrioritiesMutex.Unlock()
+	if fake.BatchPrioritiesStub != nil {
+		return fake.BatchPrioritiesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.batchPrioritiesReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) BatchPrioritiesCallCount() int {
+	fake.batchPrioritiesMutex.RLock()
+	defer fake.batchPrioritiesMutex.RUnlock()
+	return len(fake.batchPrioritiesArgsForCall)
+}
+
+func (fake *OrdererConfig) BatchPrioritiesCalls(stub func() *orderer.BatchPriorities) {
+	fake.batchPrioritiesMutex.Lock()
+	defer fake.batchPrioritiesMutex.Unlock()
+	fake.BatchPrioritiesStub = stub
+}
+
+func (fake *OrdererConfig) BatchPrioritiesReturns(result1 *orderer.BatchPriorities) {
+	fake.batchPrioritiesMutex.Lock()
+	defer fake.batchPrioritiesMutex.Unlock()
+	fake.BatchPrioritiesStub = nil
+	fake.batchPrioritiesReturns = struct {
+		result1 *orderer.BatchPriorities
+	}{result1}
+}
+
+func (fake *OrdererConfig) BatchPrioritiesReturnsOnCall(i int, result1 *orderer.BatchPriorities) {
+	fake.batchPrioritiesMutex.Lock()
+	defer fake.batchPrioritiesMutex.Unlock()
+	fake.BatchPrioritiesStub = nil
+	if fake.batchPrioritiesReturnsOnCall == nil {
+		fake.batchPrioritiesReturnsOnCall = make(map[int]struct {
+			result1 *orderer.BatchPriorities
+		})
+	}
+	fake.batchPrioritiesReturnsOnCall[i] = struct {
+		result1 *orderer.BatchPriorities
+	}{result1}
+}
+
 func (fake *OrdererConfig) BatchSize() *orderer.BatchSize {
 	fake.batchSizeMutex.Lock()
 	ret, specificReturn := fake.batchSizeReturnsOnCall[len(fake.batchSizeArgsForCall)]
@@ -416,6 +560,58 @@ func (fake *OrdererConfig) ConsensusTypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *OrdererConfig) DuplicateTxIDWindow() uint32 {
+	fake.duplicateTxIDWindowMutex.Lock()
+	ret, specificReturn := fake.duplicateTxIDWindowReturnsOnCall[len(fake.duplicateTxIDWindowArgsForCall)]
+	fake.duplicateTxIDWindowArgsForCall = append(fake.duplicateTxIDWindowArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DuplicateTxIDWindow", []interface{}{})
+	fake.duplicateTxIDWindowMutex.Unlock()
+	if fake.DuplicateTxIDWindowStub != nil {
+		return fake.DuplicateTxIDWindowStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.duplicateTxIDWindowReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) DuplicateTxIDWindowCallCount() int {
+	fake.duplicateTxIDWindowMutex.RLock()
+	defer fake.duplicateTxIDWindowMutex.RUnlock()
+	return len(fake.duplicateTxIDWindowArgsForCall)
+}
+
+func (fake *OrdererConfig) DuplicateTxIDWindowCalls(stub func() uint32) {
+	fake.duplicateTxIDWindowMutex.Lock()
+	defer fake.duplicateTxIDWindowMutex.Unlock()
+	fake.DuplicateTxIDWindowStub = stub
+}
+
+func (fake *OrdererConfig) DuplicateTxIDWindowReturns(result1 uint32) {
+	fake.duplicateTxIDWindowMutex.Lock()
+	defer fake.duplicateTxIDWindowMutex.Unlock()
+	fake.DuplicateTxIDWindowStub = nil
+	fake.duplicateTxIDWindowReturns = struct {
+		result1 uint32
+	}{result1}
+}
+
+func (fake *OrdererConfig) DuplicateTxIDWindowReturnsOnCall(i int, result1 uint32) {
+	fake.duplicateTxIDWindowMutex.Lock()
+	defer fake.duplicateTxIDWindowMutex.Unlock()
+	fake.DuplicateTxIDWindowStub = nil
+	if fake.duplicateTxIDWindowReturnsOnCall == nil {
+		fake.duplicateTxIDWindowReturnsOnCall = make(map[int]struct {
+			result1 uint32
+		})
+	}
+	fake.duplicateTxIDWindowReturnsOnCall[i] = struct {
+		result1 uint32
+	}{result1}
+}
+
 func (fake *OrdererConfig) KafkaBrokers() []string {
 	fake.kafkaBrokersMutex.Lock()
 	ret, specificReturn := fake.kafkaBrokersReturnsOnCall[len(fake.kafkaBrokersArgsForCall)]
@@ -572,9 +768,65 @@ func (fake *OrdererConfig) OrganizationsReturnsOnCall(i int, result1 map[string]
 	}{result1}
 }
 
+func (fake *OrdererConfig) RateLimits() *orderer.RateLimits {
+	fake.rateLimitsMutex.Lock()
+	ret, specificReturn := fake.rateLimitsReturnsOnCall[len(fake.rateLimitsArgsForCall)]
+	fake.rateLimitsArgsForCall = append(fake.rateLimitsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RateLimits", []interface{}{})
+	fake.rateLimitsMutex.Unlock()
+	if fake.RateLimitsStub != nil {
+		return fake.RateLimitsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rateLimitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) RateLimitsCallCount() int {
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
+	return len(fake.rateLimitsArgsForCall)
+}
+
+func (fake *OrdererConfig) RateLimitsCalls(stub func() *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = stub
+}
+
+func (fake *OrdererConfig) RateLimitsReturns(result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	fake.rateLimitsReturns = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
+func (fake *OrdererConfig) RateLimitsReturnsOnCall(i int, result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	if fake.rateLimitsReturnsOnCall == nil {
+		fake.rateLimitsReturnsOnCall = make(map[int]struct {
+			result1 *orderer.RateLimits
+		})
+	}
+	fake.rateLimitsReturnsOnCall[i] = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
 func (fake *OrdererConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.adaptiveBatchingMutex.RLock()
+	defer fake.adaptiveBatchingMutex.RUnlock()
+	fake.batchPrioritiesMutex.RLock()
+	defer fake.batchPrioritiesMutex.RUnlock()
 	fake.batchSizeMutex.RLock()
 	defer fake.batchSizeMutex.RUnlock()
 	fake.batchTimeoutMutex.RLock()
@@ -587,12 +839,16 @@ func (fake *OrdererConfig) Invocations() map[string][][]interface{} {
 	defer fake.consensusStateMutex.RUnlock()
 	fake.consensusTypeMutex.RLock()
 	defer fake.consensusTypeMutex.RUnlock()
+	fake.duplicateTxIDWindowMutex.RLock()
+	defer fake.duplicateTxIDWindowMutex.RUnlock()
 	fake.kafkaBrokersMutex.RLock()
 	defer fake.kafkaBrokersMutex.RUnlock()
 	fake.maxChannelsCountMutex.RLock()
 	defer fake.maxChannelsCountMutex.RUnlock()
 	fake.organizationsMutex.RLock()
 	defer fake.organizationsMutex.RUnlock()
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,212 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockcutter
+
+import (
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+)
+
+// defaultWeight is the weight of the transactions matching no priority class
+const defaultWeight = 1
+
+// lanes queues the pending messages by priority class, and shares the
+// batches among the classes in proportion to their weights, by smooth
+// weighted round robin. The batches only depend on the order of the messages
+// and on the config, so that every consenter cuts the same batches.
+type lanes struct {
+	classes           []*ab.PriorityClass
+	maxPendingBatches uint32
+
+	// lanes holds a lane per class, followed by the lane of the messages
+	// matching no class
+	lanes []*lane
+	count uint32
+	bytes uint64
+}
+
+type lane struct {
+	weight   int64
+	credit   int64
+	messages []*cb.Envelope
+}
+
+// newLanes returns the lanes of the given priority classes, or nil if there
+// are none. Unless holdBack is set, no batch may be held back, so that the
+// batches are cut in the order the messages arrive, the messages of each
+// batch being ordered by priority.
+func newLanes(priorities *ab.BatchPriorities, holdBack bool) *lanes {
+	if len(priorities.GetClasses()) == 0 {
+		return nil
+	}
+
+	l := &lanes{classes: priorities.Classes}
+	if holdBack {
+		l.maxPendingBatches = priorities.MaxPendingBatches
+		if l.maxPendingBatches == 0 {
+			l.maxPendingBatches = 1
+		}
+	}
+	for _, class := range priorities.Classes {
+		l.lanes = append(l.lanes, &lane{weight: int64(class.Weight)})
+	}
+	l.lanes = append(l.lanes, &lane{weight: defaultWeight})
+	return l
+}
+
+func (l *lanes) empty() bool {
+	return l == nil || l.count == 0
+}
+
+// full reports whether the pending messages exceed the pending batch along
+// with the batches which may be held back
+func (l *lanes) full(batchSize *ab.BatchSize) bool {
+	batches := uint64(l.maxPendingBatches) + 1
+	return uint64(l.count) >= batches*uint64(batchSize.MaxMessageCount) || l.overflowing(batchSize)
+}
+
+// overflowing reports whether the size of the pending messages exceeds the
+// preferred max bytes of the pending batch along with the batches which may
+// be held back
+func (l *lanes) overflowing(batchSize *ab.BatchSize) bool {
+	batches := uint64(l.maxPendingBatches) + 1
+	return l.bytes > batches*uint64(batchSize.PreferredMaxBytes)
+}
+
+func (l *lanes) enqueue(msg *cb.Envelope) {
+	ln := l.lanes[l.classify(msg)]
+	ln.messages = append(ln.messages, msg)
+	l.count++
+	l.bytes += uint64(messageSizeBytes(msg))
+}
+
+// classify returns the index of the lane of the message
+func (l *lanes) classify(msg *cb.Envelope) int {
+	mspID, headerType := originOf(msg)
+	for i, class := range l.classes {
+		if matches(class.MspIds, mspID) && matches(class.HeaderTypes, headerType) {
+			return i
+		}
+	}
+	return len(l.classes)
+}
+
+// next removes the messages of the next batch from the lanes, up to the
+// max message count and the preferred max bytes, a message larger than the
+// preferred max bytes being isolated in its own batch
+func (l *lanes) next(batchSize *ab.BatchSize) []*cb.Envelope {
+	var batch []*cb.Envelope
+	var batchSizeBytes uint32
+	for l.count > 0 && uint32(len(batch)) < batchSize.MaxMessageCount {
+		ln := l.pick()
+		messageSizeBytes := messageSizeBytes(ln.messages[0])
+		if len(batch) > 0 && batchSizeBytes+messageSizeBytes > batchSize.PreferredMaxBytes {
+			break
+		}
+
+		batch = append(batch, l.take(ln))
+		batchSizeBytes += messageSizeBytes
+	}
+	return batch
+}
+
+// prioritize returns the messages of the batch ordered by priority
+func (l *lanes) prioritize(batch []*cb.Envelope) []*cb.Envelope {
+	for _, msg := range batch {
+		l.enqueue(msg)
+	}
+	prioritized := make([]*cb.Envelope, 0, len(batch))
+	for l.count > 0 {
+		prioritized = append(prioritized, l.take(l.pick()))
+	}
+	return prioritized
+}
+
+// take removes the first message of the lane, which is charged for it
+func (l *lanes) take(ln *lane) *cb.Envelope {
+	l.charge(ln)
+
+	msg := ln.messages[0]
+	ln.messages = ln.messages[1:]
+	if len(ln.messages) == 0 {
+		// An idle lane neither accumulates nor owes credit
+		ln.messages = nil
+		ln.credit = 0
+	}
+	l.count--
+	l.bytes -= uint64(messageSizeBytes(msg))
+	return msg
+}
+
+// pick returns the non empty lane with the most credit once replenished,
+// the first one in case of a tie
+func (l *lanes) pick() *lane {
+	var picked *lane
+	for _, ln := range l.lanes {
+		if len(ln.messages) == 0 {
+			continue
+		}
+		if picked == nil || ln.credit+ln.weight > picked.credit+picked.weight {
+			picked = ln
+		}
+	}
+	return picked
+}
+
+// charge replenishes the credit of the non empty lanes and charges the
+// picked lane for the message it is given
+func (l *lanes) charge(picked *lane) {
+	var total int64
+	for _, ln := range l.lanes {
+		if len(ln.messages) == 0 {
+			continue
+		}
+		ln.credit += ln.weight
+		total += ln.weight
+	}
+	picked.credit -= total
+}
+
+func matches(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// originOf returns the MSP ID of the creator of the message and the name
+// of the type of its header, either of which is empty if it cannot be
+// determined
+func originOf(msg *cb.Envelope) (string, string) {
+	payload, err := utils.UnmarshalPayload(msg.Payload)
+	if err != nil || payload.Header == nil {
+		return "", ""
+	}
+
+	var headerType string
+	if chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader); err == nil {
+		headerType = cb.HeaderType_name[chdr.Type]
+	}
+
+	var mspID string
+	if shdr, err := utils.GetSignatureHeader(payload.Header.SignatureHeader); err == nil {
+		creator := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(shdr.Creator, creator); err == nil {
+			mspID = creator.Mspid
+		}
+	}
+
+	return mspID, headerType
+}
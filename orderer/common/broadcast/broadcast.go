@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package broadcast
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -36,6 +37,10 @@ type ChannelSupportRegistrar interface {
 type ChannelSupport interface {
 	msgprocessor.Processor
 	Consenter
+
+	// RateLimits returns the limits of the rate at which transactions are
+	// accepted on the channel set by the channel config
+	RateLimits() *ab.RateLimits
 }
 
 // Consenter provides methods to send messages through consensus
@@ -60,6 +65,9 @@ type Consenter interface {
 type Handler struct {
 	SupportRegistrar ChannelSupportRegistrar
 	Metrics          *Metrics
+	// RateLimiter, when set, limits the rate at which normal messages
+	// are accepted
+	RateLimiter *RateLimiter
 }
 
 // Handle reads requests from a Broadcast stream, processes them, and returns the responses to the stream
@@ -160,6 +168,23 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 	if !isConfig {
 		logger.Debugf("[channel: %s] Broadcast is processing normal message from %s with txid '%s' of type %s", chdr.ChannelId, addr, chdr.TxId, cb.HeaderType_name[chdr.Type])
 
+		var client string
+		var rateLimits *ab.RateLimits
+		if bh.RateLimiter != nil {
+			var mspID string
+			mspID, client = clientOf(msg)
+			rateLimits = processor.RateLimits()
+			if limit, retryAfter := bh.RateLimiter.Admit(chdr.ChannelId, client, rateLimits); limit != "" {
+				bh.Metrics.RateLimitedCount.With("channel", chdr.ChannelId, "limit", limit, "mspid", mspID).Add(1)
+				// Not a warning, as flooding clients would flood the logs as well
+				logger.Debugf("[channel: %s] Rejecting broadcast of normal message from %s with SERVICE_UNAVAILABLE: %s rate limit exceeded", chdr.ChannelId, addr, limit)
+				return &ab.BroadcastResponse{
+					Status: cb.Status_SERVICE_UNAVAILABLE,
+					Info:   fmt.Sprintf("%s rate limit exceeded, retry after %s", limit, retryAfter.Truncate(time.Millisecond)+time.Millisecond),
+				}
+			}
+		}
+
 		configSeq, err := processor.ProcessNormalMsg(msg)
 		if err != nil {
 			logger.Warningf("[channel: %s] Rejecting broadcast of normal message from %s because of error: %s", chdr.ChannelId, addr, err)
@@ -167,6 +192,10 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 		}
 		tracker.EndValidate()
 
+		if bh.RateLimiter != nil {
+			bh.RateLimiter.Charge(chdr.ChannelId, client, rateLimits)
+		}
+
 		tracker.BeginEnqueue()
 		if err = processor.WaitReady(); err != nil {
 			logger.Warningf("[channel: %s] Rejecting broadcast of message from %s with SERVICE_UNAVAILABLE: rejected by Consenter: %s", chdr.ChannelId, addr, err)
@@ -215,6 +244,8 @@ func ClassifyError(err error) cb.Status {
 		return cb.Status_FORBIDDEN
 	case msgprocessor.ErrMaintenanceMode:
 		return cb.Status_SERVICE_UNAVAILABLE
+	case msgprocessor.ErrDuplicateTxID:
+		return cb.Status_CONFLICT
 	default:
 		return cb.Status_BAD_REQUEST
 	}
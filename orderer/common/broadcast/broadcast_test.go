@@ -197,6 +197,57 @@ var _ = Describe("Broadcast", func() {
 			})
 		})
 
+		Context("when the rate limit is exceeded", func() {
+			var fakeRateLimitedCounter *mock.MetricsCounter
+
+			BeforeEach(func() {
+				fakeRateLimitedCounter = &mock.MetricsCounter{}
+				fakeRateLimitedCounter.WithReturns(fakeRateLimitedCounter)
+				handler.Metrics.RateLimitedCount = fakeRateLimitedCounter
+				handler.RateLimiter = broadcast.NewRateLimiter(&ab.RateLimit{Rate: 1}, nil)
+
+				fakeABServer.RecvReturnsOnCall(1, fakeMsg, nil)
+				fakeABServer.RecvReturnsOnCall(2, nil, io.EOF)
+			})
+
+			It("rejects the message with a service unavailable status and a retry hint", func() {
+				err := handler.Handle(fakeABServer)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSupport.ProcessNormalMsgCallCount()).To(Equal(1))
+				Expect(fakeSupport.OrderCallCount()).To(Equal(1))
+
+				Expect(fakeABServer.SendCallCount()).To(Equal(2))
+				Expect(proto.Equal(fakeABServer.SendArgsForCall(0), &ab.BroadcastResponse{Status: cb.Status_SUCCESS})).To(BeTrue())
+				resp := fakeABServer.SendArgsForCall(1)
+				Expect(resp.Status).To(Equal(cb.Status_SERVICE_UNAVAILABLE))
+				Expect(resp.Info).To(MatchRegexp(`^channel rate limit exceeded, retry after (\d+ms|1s)$`))
+
+				Expect(fakeRateLimitedCounter.WithCallCount()).To(Equal(1))
+				Expect(fakeRateLimitedCounter.WithArgsForCall(0)).To(Equal([]string{
+					"channel", "fake-channel",
+					"limit", "channel",
+					"mspid", "",
+				}))
+				Expect(fakeRateLimitedCounter.AddCallCount()).To(Equal(1))
+				Expect(fakeRateLimitedCounter.AddArgsForCall(0)).To(Equal(float64(1)))
+			})
+
+			Context("when the channel config sets no limit", func() {
+				BeforeEach(func() {
+					fakeSupport.RateLimitsReturns(&ab.RateLimits{Channel: &ab.RateLimit{}})
+				})
+
+				It("accepts the messages", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeSupport.OrderCallCount()).To(Equal(2))
+					Expect(fakeRateLimitedCounter.WithCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the send to the client fails", func() {
 			BeforeEach(func() {
 				fakeABServer.SendReturns(fmt.Errorf("send-error"))
@@ -274,6 +325,23 @@ var _ = Describe("Broadcast", func() {
 					)).To(BeTrue())
 				})
 			})
+
+			Context("when the error cause is msgprocessor.ErrDuplicateTxID", func() {
+				BeforeEach(func() {
+					fakeSupport.ProcessNormalMsgReturns(0, msgprocessor.ErrDuplicateTxID)
+				})
+
+				It("returns the error and a conflict status", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeABServer.SendCallCount()).To(Equal(1))
+					Expect(proto.Equal(
+						fakeABServer.SendArgsForCall(0),
+						&ab.BroadcastResponse{Status: cb.Status_CONFLICT, Info: msgprocessor.ErrDuplicateTxID.Error()},
+					)).To(BeTrue())
+				})
+			})
 		})
 
 		Context("when the message is a config message", func() {
@@ -30,12 +30,20 @@ var (
 		LabelNames:   []string{"channel", "type", "status"},
 		StatsdFormat: "%{#fqname}.%{channel}.%{type}.%{status}",
 	}
+	rateLimitedCount = metrics.CounterOpts{
+		Namespace:    "broadcast",
+		Name:         "rate_limited_count",
+		Help:         "The number of transactions rejected for exceeding a rate limit.",
+		LabelNames:   []string{"channel", "limit", "mspid"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{limit}.%{mspid}",
+	}
 )
 
 type Metrics struct {
 	ValidateDuration metrics.Histogram
 	EnqueueDuration  metrics.Histogram
 	ProcessedCount   metrics.Counter
+	RateLimitedCount metrics.Counter
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
@@ -43,5 +51,6 @@ func NewMetrics(p metrics.Provider) *Metrics {
 		ValidateDuration: p.NewHistogram(validateDuration),
 		EnqueueDuration:  p.NewHistogram(enqueueDuration),
 		ProcessedCount:   p.NewCounter(processedCount),
+		RateLimitedCount: p.NewCounter(rateLimitedCount),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	broadcast "github.com/hyperledger/fabric/orderer/common/broadcast"
 	msgprocessor "github.com/hyperledger/fabric/orderer/common/msgprocessor"
 	common "github.com/hyperledger/fabric/protos/common"
+	orderer "github.com/hyperledger/fabric/protos/orderer"
 )
 
 type ChannelSupport struct {
@@ -88,6 +89,16 @@ type ChannelSupport struct {
 		result1 uint64
 		result2 error
 	}
+	RateLimitsStub        func() *orderer.RateLimits
+	rateLimitsMutex       sync.RWMutex
+	rateLimitsArgsForCall []struct {
+	}
+	rateLimitsReturns struct {
+		result1 *orderer.RateLimits
+	}
+	rateLimitsReturnsOnCall map[int]struct {
+		result1 *orderer.RateLimits
+	}
 	WaitReadyStub        func() error
 	waitReadyMutex       sync.RWMutex
 	waitReadyArgsForCall []struct {
@@ -479,6 +490,58 @@ func (fake *ChannelSupport) ProcessNormalMsgReturnsOnCall(i int, result1 uint64,
 	}{result1, result2}
 }
 
+func (fake *ChannelSupport) RateLimits() *orderer.RateLimits {
+	fake.rateLimitsMutex.Lock()
+	ret, specificReturn := fake.rateLimitsReturnsOnCall[len(fake.rateLimitsArgsForCall)]
+	fake.rateLimitsArgsForCall = append(fake.rateLimitsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RateLimits", []interface{}{})
+	fake.rateLimitsMutex.Unlock()
+	if fake.RateLimitsStub != nil {
+		return fake.RateLimitsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rateLimitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *ChannelSupport) RateLimitsCallCount() int {
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
+	return len(fake.rateLimitsArgsForCall)
+}
+
+func (fake *ChannelSupport) RateLimitsCalls(stub func() *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = stub
+}
+
+func (fake *ChannelSupport) RateLimitsReturns(result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	fake.rateLimitsReturns = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
+func (fake *ChannelSupport) RateLimitsReturnsOnCall(i int, result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	if fake.rateLimitsReturnsOnCall == nil {
+		fake.rateLimitsReturnsOnCall = make(map[int]struct {
+			result1 *orderer.RateLimits
+		})
+	}
+	fake.rateLimitsReturnsOnCall[i] = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
 func (fake *ChannelSupport) WaitReady() error {
 	fake.waitReadyMutex.Lock()
 	ret, specificReturn := fake.waitReadyReturnsOnCall[len(fake.waitReadyArgsForCall)]
@@ -546,6 +609,8 @@ func (fake *ChannelSupport) Invocations() map[string][][]interface{} {
 	defer fake.processConfigUpdateMsgMutex.RUnlock()
 	fake.processNormalMsgMutex.RLock()
 	defer fake.processNormalMsgMutex.RUnlock()
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
 	fake.waitReadyMutex.RLock()
 	defer fake.waitReadyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,213 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package broadcast
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+)
+
+const (
+	// ChannelLimit is the limit of a channel as a whole
+	ChannelLimit = "channel"
+	// ClientLimit is the limit of a client on a channel
+	ClientLimit = "client"
+)
+
+// pruneInterval is the interval at which the buckets which have been
+// refilled, and thus are no different from new ones, are discarded
+const pruneInterval = time.Minute
+
+// RateLimiter enforces limits of the rate at which transactions are
+// accepted, with a token bucket per channel and per client of each channel.
+// The limits are the ones of the channel config when set, or else the ones
+// the RateLimiter is created with.
+type RateLimiter struct {
+	channelLimit *ab.RateLimit
+	clientLimit  *ab.RateLimit
+	now          func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[bucketKey]*tokenBucket
+	lastPrune time.Time
+}
+
+type bucketKey struct {
+	channelID string
+	client    string
+}
+
+// NewRateLimiter creates a RateLimiter with the given default limits of
+// each channel and of each client, either of which may be nil.
+func NewRateLimiter(channelLimit, clientLimit *ab.RateLimit) *RateLimiter {
+	return &RateLimiter{
+		channelLimit: channelLimit,
+		clientLimit:  clientLimit,
+		now:          time.Now,
+		buckets:      map[bucketKey]*tokenBucket{},
+	}
+}
+
+// Admit reports whether a transaction of the client may be accepted on the
+// channel, given the limits set by the channel config, if any. No token is
+// taken until the transaction is validated and charged, so that invalid
+// transactions exhaust neither the bucket of the client, which they may be
+// impersonating, nor the one of the channel. Otherwise, it returns the limit
+// which is exceeded and the time after which to retry.
+func (rl *RateLimiter) Admit(channelID, client string, limits *ab.RateLimits) (string, time.Duration) {
+	channelLimit, clientLimit := rl.limits(limits)
+
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	rl.prune(now)
+
+	if client != "" {
+		clientBucket := rl.bucket(bucketKey{channelID: channelID, client: client}, clientLimit, now)
+		if wait := clientBucket.wait(); wait > 0 {
+			return ClientLimit, wait
+		}
+	}
+
+	channelBucket := rl.bucket(bucketKey{channelID: channelID}, channelLimit, now)
+	if wait := channelBucket.wait(); wait > 0 {
+		return ChannelLimit, wait
+	}
+
+	return "", 0
+}
+
+// Charge takes a token from the buckets of the channel and of the client,
+// once a transaction which has been admitted is validated. The buckets may
+// go into debt, delaying the next transactions accordingly.
+func (rl *RateLimiter) Charge(channelID, client string, limits *ab.RateLimits) {
+	channelLimit, clientLimit := rl.limits(limits)
+
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	rl.bucket(bucketKey{channelID: channelID}, channelLimit, now).take()
+	if client != "" {
+		rl.bucket(bucketKey{channelID: channelID, client: client}, clientLimit, now).take()
+	}
+}
+
+// limits returns the limits of the channel and of its clients
+func (rl *RateLimiter) limits(limits *ab.RateLimits) (*ab.RateLimit, *ab.RateLimit) {
+	channelLimit, clientLimit := rl.channelLimit, rl.clientLimit
+	if limits.GetChannel() != nil {
+		channelLimit = limits.Channel
+	}
+	if limits.GetClient() != nil {
+		clientLimit = limits.Client
+	}
+	return channelLimit, clientLimit
+}
+
+// bucket returns the bucket of the given key refilled up to now, or nil
+// when the limit is not set
+func (rl *RateLimiter) bucket(key bucketKey, limit *ab.RateLimit, now time.Time) *tokenBucket {
+	if limit.GetRate() == 0 {
+		delete(rl.buckets, key)
+		return nil
+	}
+
+	burst := limit.Burst
+	if burst == 0 {
+		burst = limit.Rate
+	}
+
+	tb, ok := rl.buckets[key]
+	if !ok {
+		tb = &tokenBucket{tokens: float64(burst), last: now}
+		rl.buckets[key] = tb
+	}
+	tb.refill(float64(limit.Rate), float64(burst), now)
+	return tb
+}
+
+func (rl *RateLimiter) prune(now time.Time) {
+	if now.Sub(rl.lastPrune) < pruneInterval {
+		return
+	}
+	rl.lastPrune = now
+
+	for key, tb := range rl.buckets {
+		if tb.full(now) {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+// tokenBucket holds up to burst tokens, replenished at rate tokens per
+// second, each transaction taking a token
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func (tb *tokenBucket) refill(rate, burst float64, now time.Time) {
+	tb.rate, tb.burst = rate, burst
+	if elapsed := now.Sub(tb.last); elapsed > 0 {
+		tb.tokens += elapsed.Seconds() * tb.rate
+		tb.last = now
+	}
+	if tb.tokens > tb.burst {
+		tb.tokens = tb.burst
+	}
+}
+
+func (tb *tokenBucket) full(now time.Time) bool {
+	return tb.tokens+now.Sub(tb.last).Seconds()*tb.rate >= tb.burst
+}
+
+// wait returns the time until a token is available, if any
+func (tb *tokenBucket) wait() time.Duration {
+	if tb == nil || tb.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+func (tb *tokenBucket) take() {
+	if tb != nil {
+		tb.tokens--
+	}
+}
+
+// clientOf returns the MSP ID of the creator of the message along with the
+// identifier of the client the rate limits apply to, which is empty if the
+// creator cannot be determined
+func clientOf(msg *cb.Envelope) (string, string) {
+	payload, err := utils.UnmarshalPayload(msg.Payload)
+	if err != nil || payload.Header == nil {
+		return "", ""
+	}
+	sigHeader, err := utils.GetSignatureHeader(payload.Header.SignatureHeader)
+	if err != nil {
+		return "", ""
+	}
+	creator := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(sigHeader.Creator, creator); err != nil || len(creator.IdBytes) == 0 {
+		return "", ""
+	}
+
+	hash := sha256.Sum256(creator.IdBytes)
+	return creator.Mspid, creator.Mspid + ":" + hex.EncodeToString(hash[:])
+}
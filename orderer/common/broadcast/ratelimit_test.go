@@ -0,0 +1,137 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package broadcast
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var (
+		rl  *RateLimiter
+		now time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		rl = NewRateLimiter(&ab.RateLimit{Rate: 10, Burst: 2}, &ab.RateLimit{Rate: 1})
+		rl.now = func() time.Time { return now }
+	})
+
+	It("admits transactions of a client up to its burst", func() {
+		limit, _ := rl.Admit("channel", "client", nil)
+		Expect(limit).To(BeEmpty())
+		rl.Charge("channel", "client", nil)
+
+		limit, retryAfter := rl.Admit("channel", "client", nil)
+		Expect(limit).To(Equal(ClientLimit))
+		Expect(retryAfter).To(Equal(time.Second))
+
+		By("admitting other clients and channels")
+		limit, _ = rl.Admit("channel", "other-client", nil)
+		Expect(limit).To(BeEmpty())
+		limit, _ = rl.Admit("other-channel", "client", nil)
+		Expect(limit).To(BeEmpty())
+
+		By("refilling the bucket over time")
+		now = now.Add(500 * time.Millisecond)
+		limit, retryAfter = rl.Admit("channel", "client", nil)
+		Expect(limit).To(Equal(ClientLimit))
+		Expect(retryAfter).To(Equal(500 * time.Millisecond))
+
+		now = now.Add(500 * time.Millisecond)
+		limit, _ = rl.Admit("channel", "client", nil)
+		Expect(limit).To(BeEmpty())
+	})
+
+	It("only charges clients and channels once their transactions are validated", func() {
+		for i := 0; i < 3; i++ {
+			limit, _ := rl.Admit("channel", "client", nil)
+			Expect(limit).To(BeEmpty())
+		}
+	})
+
+	It("admits transactions of a channel up to its burst", func() {
+		limit, _ := rl.Admit("channel", "client1", nil)
+		Expect(limit).To(BeEmpty())
+		rl.Charge("channel", "client1", nil)
+		limit, _ = rl.Admit("channel", "client2", nil)
+		Expect(limit).To(BeEmpty())
+		rl.Charge("channel", "client2", nil)
+
+		limit, retryAfter := rl.Admit("channel", "client3", nil)
+		Expect(limit).To(Equal(ChannelLimit))
+		Expect(retryAfter).To(Equal(100 * time.Millisecond))
+
+		By("admitting transactions without a client")
+		limit, _ = rl.Admit("other-channel", "", nil)
+		Expect(limit).To(BeEmpty())
+		rl.Charge("other-channel", "", nil)
+	})
+
+	It("applies the limits of the channel config over the default ones", func() {
+		limits := &ab.RateLimits{Client: &ab.RateLimit{}}
+		for i := 0; i < 2; i++ {
+			limit, _ := rl.Admit("channel", "client", limits)
+			Expect(limit).To(BeEmpty())
+			rl.Charge("channel", "client", limits)
+		}
+		limit, _ := rl.Admit("channel", "client", limits)
+		Expect(limit).To(Equal(ChannelLimit))
+
+		limits = &ab.RateLimits{Channel: &ab.RateLimit{Rate: 100}}
+		limit, _ = rl.Admit("other-channel", "client", limits)
+		Expect(limit).To(BeEmpty())
+		rl.Charge("other-channel", "client", limits)
+		limit, _ = rl.Admit("other-channel", "client", limits)
+		Expect(limit).To(Equal(ClientLimit))
+	})
+
+	It("discards the buckets which have been refilled", func() {
+		rl.Admit("channel", "client", nil)
+		rl.Charge("channel", "client", nil)
+		Expect(rl.buckets).To(HaveLen(2))
+
+		now = now.Add(pruneInterval)
+		rl.Admit("other-channel", "", nil)
+		rl.Charge("other-channel", "", nil)
+		Expect(rl.buckets).To(HaveLen(1))
+		Expect(rl.buckets).To(HaveKey(bucketKey{channelID: "other-channel"}))
+	})
+})
+
+var _ = Describe("clientOf", func() {
+	It("identifies the client by the creator of the message", func() {
+		creator, err := proto.Marshal(&msp.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte("cert")})
+		Expect(err).NotTo(HaveOccurred())
+		msg := &cb.Envelope{
+			Payload: utils.MarshalOrPanic(&cb.Payload{
+				Header: &cb.Header{
+					SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{Creator: creator}),
+				},
+			}),
+		}
+
+		mspID, client := clientOf(msg)
+		Expect(mspID).To(Equal("Org1MSP"))
+		Expect(client).To(Equal("Org1MSP:06298432e8066b29e2223bcc23aa9504b56ae508fabf3435508869b9c3190e22"))
+	})
+
+	It("returns no client when the creator cannot be determined", func() {
+		mspID, client := clientOf(&cb.Envelope{Payload: []byte("garbage")})
+		Expect(mspID).To(BeEmpty())
+		Expect(client).To(BeEmpty())
+	})
+})
@@ -56,6 +56,9 @@ type General struct {
 	LocalMSPID        string
 	BCCSP             *bccsp.FactoryOpts
 	Authentication    Authentication
+	CertRenewal       CertRenewal
+	RateLimits        RateLimits
+	TxFilters         []TxFilter
 }
 
 type Cluster struct {
@@ -78,6 +81,39 @@ type Cluster struct {
 	TLSHandshakeTimeShift                time.Duration
 }
 
+// CertRenewal contains configuration for the renewal of the enrollment
+// and TLS certificates of the orderer without a restart.
+type CertRenewal struct {
+	Enabled                    bool
+	PollInterval               time.Duration
+	ExpirationWarningThreshold time.Duration
+}
+
+// RateLimits contains configuration for the limits of the rate at which
+// the orderer accepts transactions broadcast by clients.
+type RateLimits struct {
+	Enabled bool
+	Channel RateLimit
+	Client  RateLimit
+}
+
+// RateLimit contains the rate, in transactions per second, and the burst
+// of a token bucket.
+type RateLimit struct {
+	Rate  uint32
+	Burst uint32
+}
+
+// TxFilter contains configuration for a filter of the normal transactions
+// broadcast on the standard channels, which is either compiled into the
+// orderer and found by name, or loaded from a Go plugin library.
+type TxFilter struct {
+	Name     string
+	Library  string
+	Channels []string
+	Config   map[string]interface{}
+}
+
 // Keepalive contains configuration for gRPC servers.
 type Keepalive struct {
 	ServerMinInterval time.Duration
@@ -102,6 +138,14 @@ type SASLPlain struct {
 	Password string
 }
 
+// SASLSCRAM contains configuration for SASL/SCRAM authentication
+type SASLSCRAM struct {
+	Enabled   bool
+	Mechanism string
+	User      string
+	Password  string
+}
+
 // Authentication contains configuration parameters related to authenticating
 // client messages.
 type Authentication struct {
@@ -133,6 +177,7 @@ type Kafka struct {
 	Version   sarama.KafkaVersion // TODO Move this to global config
 	TLS       TLS
 	SASLPlain SASLPlain
+	SASLSCRAM SASLSCRAM
 	Topic     Topic
 }
 
@@ -182,6 +227,28 @@ type Consumer struct {
 // Topic contains the settings to use when creating Kafka topics
 type Topic struct {
 	ReplicationFactor int16
+	NumPartitions     int32
+	Channels          map[string]ChannelTopic
+}
+
+// ChannelTopic contains the settings overriding the Topic ones when creating
+// the Kafka topic of a channel. Unset settings are inherited from Topic.
+type ChannelTopic struct {
+	ReplicationFactor int16
+	NumPartitions     int32
+}
+
+// validateChannels returns an error if a per-channel topic setting is invalid
+func (t Topic) validateChannels() error {
+	for channelID, channelTopic := range t.Channels {
+		switch {
+		case channelTopic.ReplicationFactor < 0:
+			return fmt.Errorf("General.Kafka.Topic.Channels.%s.ReplicationFactor must not be negative", channelID)
+		case channelTopic.NumPartitions < 0:
+			return fmt.Errorf("General.Kafka.Topic.Channels.%s.NumPartitions must not be negative", channelID)
+		}
+	}
+	return nil
 }
 
 // Debug contains configuration for the orderer's debug parameters.
@@ -241,6 +308,10 @@ var Defaults = TopLevel{
 		Authentication: Authentication{
 			TimeWindow: time.Duration(15 * time.Minute),
 		},
+		CertRenewal: CertRenewal{
+			PollInterval:               time.Minute,
+			ExpirationWarningThreshold: time.Hour * 24 * 7,
+		},
 	},
 	RAMLedger: RAMLedger{
 		HistorySize: 10000,
@@ -277,8 +348,12 @@ var Defaults = TopLevel{
 		TLS: TLS{
 			Enabled: false,
 		},
+		SASLSCRAM: SASLSCRAM{
+			Mechanism: sarama.SASLTypeSCRAMSHA512,
+		},
 		Topic: Topic{
 			ReplicationFactor: 3,
+			NumPartitions:     1,
 		},
 	},
 	Debug: Debug{
@@ -386,6 +461,18 @@ func (c *TopLevel) completeInitialization(configDir string) {
 		case c.Kafka.SASLPlain.Enabled && c.Kafka.SASLPlain.Password == "":
 			logger.Panic("General.Kafka.SASLPlain.Password must be set if General.Kafka.SASLPlain.Enabled is set to true.")
 
+		case c.Kafka.SASLSCRAM.Enabled && c.Kafka.SASLPlain.Enabled:
+			logger.Panic("General.Kafka.SASLSCRAM and General.Kafka.SASLPlain must not be both enabled.")
+		case c.Kafka.SASLSCRAM.Enabled && c.Kafka.SASLSCRAM.User == "":
+			logger.Panic("General.Kafka.SASLSCRAM.User must be set if General.Kafka.SASLSCRAM.Enabled is set to true.")
+		case c.Kafka.SASLSCRAM.Enabled && c.Kafka.SASLSCRAM.Password == "":
+			logger.Panic("General.Kafka.SASLSCRAM.Password must be set if General.Kafka.SASLSCRAM.Enabled is set to true.")
+		case c.Kafka.SASLSCRAM.Enabled && c.Kafka.SASLSCRAM.Mechanism == "":
+			logger.Infof("Kafka.SASLSCRAM.Mechanism unset, setting to %s", Defaults.Kafka.SASLSCRAM.Mechanism)
+			c.Kafka.SASLSCRAM.Mechanism = Defaults.Kafka.SASLSCRAM.Mechanism
+		case c.Kafka.SASLSCRAM.Enabled && c.Kafka.SASLSCRAM.Mechanism != sarama.SASLTypeSCRAMSHA256 && c.Kafka.SASLSCRAM.Mechanism != sarama.SASLTypeSCRAMSHA512:
+			logger.Panicf("General.Kafka.SASLSCRAM.Mechanism must be %s or %s, not %s.", sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512, c.Kafka.SASLSCRAM.Mechanism)
+
 		case c.General.Profile.Enabled && c.General.Profile.Address == "":
 			logger.Infof("Profiling enabled and General.Profile.Address unset, setting to %s", Defaults.General.Profile.Address)
 			c.General.Profile.Address = Defaults.General.Profile.Address
@@ -401,6 +488,13 @@ func (c *TopLevel) completeInitialization(configDir string) {
 			logger.Infof("General.Authentication.TimeWindow unset, setting to %s", Defaults.General.Authentication.TimeWindow)
 			c.General.Authentication.TimeWindow = Defaults.General.Authentication.TimeWindow
 
+		case c.General.CertRenewal.PollInterval == 0:
+			logger.Infof("General.CertRenewal.PollInterval unset, setting to %s", Defaults.General.CertRenewal.PollInterval)
+			c.General.CertRenewal.PollInterval = Defaults.General.CertRenewal.PollInterval
+		case c.General.CertRenewal.ExpirationWarningThreshold == 0:
+			logger.Infof("General.CertRenewal.ExpirationWarningThreshold unset, setting to %s", Defaults.General.CertRenewal.ExpirationWarningThreshold)
+			c.General.CertRenewal.ExpirationWarningThreshold = Defaults.General.CertRenewal.ExpirationWarningThreshold
+
 		case c.FileLedger.Prefix == "":
 			logger.Infof("FileLedger.Prefix unset, setting to %s", Defaults.FileLedger.Prefix)
 			c.FileLedger.Prefix = Defaults.FileLedger.Prefix
@@ -449,6 +543,21 @@ func (c *TopLevel) completeInitialization(configDir string) {
 		case c.Kafka.Version == sarama.KafkaVersion{}:
 			logger.Infof("Kafka.Version unset, setting to %v", Defaults.Kafka.Version)
 			c.Kafka.Version = Defaults.Kafka.Version
+		case c.Kafka.SASLSCRAM.Enabled && !c.Kafka.Version.IsAtLeast(sarama.V1_0_0_0):
+			logger.Panicf("General.Kafka.Version must be at least %s if General.Kafka.SASLSCRAM.Enabled is set to true.", sarama.V1_0_0_0)
+
+		case c.Kafka.Topic.ReplicationFactor == 0:
+			logger.Infof("Kafka.Topic.ReplicationFactor unset, setting to %v", Defaults.Kafka.Topic.ReplicationFactor)
+			c.Kafka.Topic.ReplicationFactor = Defaults.Kafka.Topic.ReplicationFactor
+		case c.Kafka.Topic.ReplicationFactor < 0:
+			logger.Panic("General.Kafka.Topic.ReplicationFactor must not be negative.")
+		case c.Kafka.Topic.NumPartitions == 0:
+			logger.Infof("Kafka.Topic.NumPartitions unset, setting to %v", Defaults.Kafka.Topic.NumPartitions)
+			c.Kafka.Topic.NumPartitions = Defaults.Kafka.Topic.NumPartitions
+		case c.Kafka.Topic.NumPartitions < 0:
+			logger.Panic("General.Kafka.Topic.NumPartitions must not be negative.")
+		case c.Kafka.Topic.validateChannels() != nil:
+			logger.Panic(c.Kafka.Topic.validateChannels())
 
 		default:
 			return
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Shopify/sarama"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/stretchr/testify/assert"
@@ -153,6 +154,102 @@ func TestKafkaSASLPlain(t *testing.T) {
 	}
 }
 
+func TestKafkaSASLSCRAM(t *testing.T) {
+	testCases := []struct {
+		name        string
+		sasl        SASLSCRAM
+		saslPlain   SASLPlain
+		version     sarama.KafkaVersion
+		shouldPanic bool
+	}{
+		{"Disabled", SASLSCRAM{Enabled: false}, SASLPlain{}, sarama.V0_10_2_0, false},
+		{"EnabledUserPassword", SASLSCRAM{Enabled: true, User: "user", Password: "pwd"}, SASLPlain{}, sarama.V1_0_0_0, false},
+		{"EnabledSHA256", SASLSCRAM{Enabled: true, Mechanism: "SCRAM-SHA-256", User: "user", Password: "pwd"}, SASLPlain{}, sarama.V1_0_0_0, false},
+		{"EnabledNoUserPassword", SASLSCRAM{Enabled: true}, SASLPlain{}, sarama.V1_0_0_0, true},
+		{"EnabledNoUser", SASLSCRAM{Enabled: true, Password: "pwd"}, SASLPlain{}, sarama.V1_0_0_0, true},
+		{"EnabledNoPassword", SASLSCRAM{Enabled: true, User: "user"}, SASLPlain{}, sarama.V1_0_0_0, true},
+		{"EnabledBadMechanism", SASLSCRAM{Enabled: true, Mechanism: "PLAIN", User: "user", Password: "pwd"}, SASLPlain{}, sarama.V1_0_0_0, true},
+		{"EnabledOldVersion", SASLSCRAM{Enabled: true, User: "user", Password: "pwd"}, SASLPlain{}, sarama.V0_11_0_0, true},
+		{"EnabledWithPlain", SASLSCRAM{Enabled: true, User: "user", Password: "pwd"}, SASLPlain{Enabled: true, User: "user", Password: "pwd"}, sarama.V1_0_0_0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uconf := &TopLevel{Kafka: Kafka{SASLSCRAM: tc.sasl, SASLPlain: tc.saslPlain, Version: tc.version}}
+			if tc.shouldPanic {
+				assert.Panics(t, func() { uconf.completeInitialization("/dummy/path") }, "Should panic")
+			} else {
+				assert.NotPanics(t, func() { uconf.completeInitialization("/dummy/path") }, "Should not panic")
+			}
+		})
+	}
+
+	uconf := &TopLevel{Kafka: Kafka{SASLSCRAM: SASLSCRAM{Enabled: true, User: "user", Password: "pwd"}, Version: sarama.V1_0_0_0}}
+	uconf.completeInitialization("/dummy/path")
+	assert.Equal(t, "SCRAM-SHA-512", uconf.Kafka.SASLSCRAM.Mechanism)
+}
+
+func TestKafkaTopic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		topic       Topic
+		shouldPanic bool
+	}{
+		{"Unset", Topic{}, false},
+		{"Channels", Topic{ReplicationFactor: 3, NumPartitions: 1, Channels: map[string]ChannelTopic{"mychannel": {ReplicationFactor: 5}}}, false},
+		{"NegativeReplicationFactor", Topic{ReplicationFactor: -1}, true},
+		{"NegativeNumPartitions", Topic{NumPartitions: -1}, true},
+		{"NegativeChannelReplicationFactor", Topic{Channels: map[string]ChannelTopic{"mychannel": {ReplicationFactor: -1}}}, true},
+		{"NegativeChannelNumPartitions", Topic{Channels: map[string]ChannelTopic{"mychannel": {NumPartitions: -1}}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uconf := &TopLevel{Kafka: Kafka{Topic: tc.topic}}
+			if tc.shouldPanic {
+				assert.Panics(t, func() { uconf.completeInitialization("/dummy/path") }, "Should panic")
+			} else {
+				assert.NotPanics(t, func() { uconf.completeInitialization("/dummy/path") }, "Should not panic")
+			}
+		})
+	}
+
+	uconf := &TopLevel{}
+	uconf.completeInitialization("/dummy/path")
+	assert.Equal(t, Defaults.Kafka.Topic.ReplicationFactor, uconf.Kafka.Topic.ReplicationFactor)
+	assert.Equal(t, Defaults.Kafka.Topic.NumPartitions, uconf.Kafka.Topic.NumPartitions)
+}
+
+func TestKafkaTopicChannels(t *testing.T) {
+	name, err := ioutil.TempDir("", "hyperledger_fabric")
+	assert.Nil(t, err, "Error creating temp dir: %s", err)
+	defer os.RemoveAll(name)
+
+	content := `---
+Kafka:
+  Topic:
+    ReplicationFactor: 3
+    Channels:
+      critical:
+        ReplicationFactor: 5
+      partitioned:
+        NumPartitions: 2
+`
+
+	err = ioutil.WriteFile(filepath.Join(name, "orderer.yaml"), []byte(content), 0600)
+	assert.NoError(t, err, "Error writing file")
+
+	os.Setenv("FABRIC_CFG_PATH", name)
+	defer os.Unsetenv("FABRIC_CFG_PATH")
+
+	conf, err := Load()
+	assert.NoError(t, err, "Load good config returned unexpected error")
+	assert.Equal(t, int16(3), conf.Kafka.Topic.ReplicationFactor)
+	assert.Equal(t, int32(1), conf.Kafka.Topic.NumPartitions)
+	assert.Equal(t, map[string]ChannelTopic{
+		"critical":    {ReplicationFactor: 5},
+		"partitioned": {NumPartitions: 2},
+	}, conf.Kafka.Topic.Channels)
+}
+
 func TestClusterDefaults(t *testing.T) {
 	cleanup := configtest.SetDevFabricConfigPath(t)
 	defer cleanup()
@@ -235,3 +332,37 @@ func TestConnectionTimeout(t *testing.T) {
 		assert.Equal(t, cfg.General.ConnectionTimeout, 10*time.Second)
 	})
 }
+
+func TestTxFilters(t *testing.T) {
+	name, err := ioutil.TempDir("", "hyperledger_fabric")
+	assert.Nil(t, err, "Error creating temp dir: %s", err)
+	defer os.RemoveAll(name)
+
+	content := `---
+General:
+  TxFilters:
+    - Name: AllowedChaincodes
+      Channels:
+        - mychannel
+      Config:
+        Chaincodes:
+          - mycc
+    - Name: SchemaCheck
+      Library: /path/to/schemacheck.so
+`
+
+	err = ioutil.WriteFile(filepath.Join(name, "orderer.yaml"), []byte(content), 0600)
+	assert.NoError(t, err, "Error writing file")
+
+	os.Setenv("FABRIC_CFG_PATH", name)
+	defer os.Unsetenv("FABRIC_CFG_PATH")
+
+	conf, err := Load()
+	assert.NoError(t, err, "Load good config returned unexpected error")
+	assert.Len(t, conf.General.TxFilters, 2)
+	assert.Equal(t, "AllowedChaincodes", conf.General.TxFilters[0].Name)
+	assert.Equal(t, []string{"mychannel"}, conf.General.TxFilters[0].Channels)
+	assert.Equal(t, []interface{}{"mycc"}, conf.General.TxFilters[0].Config["Chaincodes"])
+	assert.Equal(t, "SchemaCheck", conf.General.TxFilters[1].Name)
+	assert.Equal(t, "/path/to/schemacheck.so", conf.General.TxFilters[1].Library)
+}
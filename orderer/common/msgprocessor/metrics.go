@@ -0,0 +1,29 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import "github.com/hyperledger/fabric/common/metrics"
+
+var (
+	txFilterRejectedCount = metrics.CounterOpts{
+		Namespace:    "msgprocessor",
+		Name:         "tx_filter_rejected_count",
+		Help:         "The number of transactions rejected by a transaction filter.",
+		LabelNames:   []string{"channel", "filter"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{filter}",
+	}
+)
+
+type Metrics struct {
+	TxFilterRejectedCount metrics.Counter
+}
+
+func NewMetrics(p metrics.Provider) *Metrics {
+	return &Metrics{
+		TxFilterRejectedCount: p.NewCounter(txFilterRejectedCount),
+	}
+}
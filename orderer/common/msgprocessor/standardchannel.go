@@ -11,6 +11,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -41,6 +42,7 @@ type StandardChannelSupport interface {
 type StandardChannel struct {
 	support           StandardChannelSupport
 	filters           *RuleSet // Rules applicable to both normal and config messages
+	txFilters         *RuleSet // Rules applicable only to normal messages
 	maintenanceFilter Rule     // Rule applicable only to config messages
 }
 
@@ -53,15 +55,38 @@ func NewStandardChannel(support StandardChannelSupport, filters *RuleSet) *Stand
 	}
 }
 
+// SetTxFilters sets the rules applied to the normal messages once they pass
+// the filters of the channel. These rules come from the local config of the
+// orderer, so they may differ among the orderers of the channel.
+func (s *StandardChannel) SetTxFilters(txFilters *RuleSet) {
+	s.txFilters = txFilters
+}
+
+// StandardChannelFilterSupport includes the resources needed for the filters of a normal (non-system) chain.
+type StandardChannelFilterSupport interface {
+	channelconfig.Resources
+
+	// Height returns the number of blocks of the channel
+	Height() uint64
+
+	// Iterator returns an Iterator over the blocks of the channel
+	Iterator(startType *orderer.SeekPosition) (blockledger.Iterator, uint64)
+}
+
 // CreateStandardChannelFilters creates the set of filters for a normal (non-system) chain.
 //
 // In maintenance mode, require the signature of /Channel/Orderer/Writer. This will filter out configuration
 // changes that are not related to consensus-type migration (e.g on /Channel/Application).
-func CreateStandardChannelFilters(filterSupport channelconfig.Resources, config localconfig.TopLevel) *RuleSet {
+//
+// Transactions whose ID is found in the duplicate transaction ID window of the channel are rejected once
+// their signature is checked, so that the window is not revealed to clients which may not write to the channel.
+// The blocks written to the ledger of the channel must be passed to the Commit method of the txIDFilter.
+func CreateStandardChannelFilters(filterSupport StandardChannelFilterSupport, txIDFilter *TxIDFilter, config localconfig.TopLevel) *RuleSet {
 	rules := []Rule{
 		EmptyRejectRule,
 		NewSizeFilter(filterSupport),
 		NewSigFilter(policies.ChannelWriters, policies.ChannelOrdererWriters, filterSupport),
+		txIDFilter,
 	}
 
 	if !config.General.Authentication.NoExpirationChecks {
@@ -104,6 +129,11 @@ func (s *StandardChannel) ProcessNormalMsg(env *cb.Envelope) (configSeq uint64,
 
 	configSeq = s.support.Sequence()
 	err = s.filters.Apply(env)
+	if err != nil || s.txFilters == nil {
+		return
+	}
+
+	err = s.txFilters.Apply(env)
 	return
 }
 
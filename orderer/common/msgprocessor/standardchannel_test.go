@@ -95,6 +95,18 @@ func TestProcessNormalMsg(t *testing.T) {
 		_, err := NewStandardChannel(ms, NewRuleSet([]Rule{AcceptRule})).ProcessNormalMsg(nil)
 		assert.EqualError(t, err, "normal transactions are rejected: maintenance mode")
 	})
+	t.Run("Transaction Filters", func(t *testing.T) {
+		ms := &mockSystemChannelFilterSupport{
+			SequenceVal: 7,
+			OrdererConfigVal: &mockconfig.Orderer{
+				CapabilitiesVal: &mockconfig.OrdererCapabilities{},
+			},
+		}
+		stdChan := NewStandardChannel(ms, NewRuleSet([]Rule{AcceptRule}))
+		stdChan.SetTxFilters(NewRuleSet([]Rule{EmptyRejectRule}))
+		_, err := stdChan.ProcessNormalMsg(&cb.Envelope{})
+		assert.Equal(t, ErrEmptyMessage, err)
+	})
 }
 
 func TestConfigUpdateMsg(t *testing.T) {
@@ -0,0 +1,32 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package txfilter defines the API of the filters of the normal transactions
+// broadcast to the orderer. A filter is either compiled into the orderer, or
+// loaded from a Go plugin which exports a NewFilterFactory function of type
+//
+//   func(config []byte) (txfilter.FilterFactory, error)
+//
+// given the YAML encoded config of the filter.
+package txfilter
+
+import (
+	"github.com/hyperledger/fabric/protos/common"
+)
+
+// Filter admits or rejects the normal transactions broadcast on a channel
+type Filter interface {
+	// Apply returns an error if the transaction is rejected.
+	// It is invoked concurrently by the broadcast streams of the channel.
+	Apply(env *common.Envelope) error
+}
+
+// FilterFactory creates the filters of the channels
+type FilterFactory interface {
+	// New returns the filter of the channel with the given ID,
+	// or nil if the transactions of the channel are not filtered
+	New(channelID string) (Filter, error)
+}
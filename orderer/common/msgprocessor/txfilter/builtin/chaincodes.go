@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package builtin
+
+import (
+	txfilter "github.com/hyperledger/fabric/orderer/common/msgprocessor/txfilter/api"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// AllowedChaincodesConfig is the config of the AllowedChaincodes filter
+type AllowedChaincodesConfig struct {
+	// Chaincodes are the names of the chaincodes the endorser transactions
+	// may invoke
+	Chaincodes []string `yaml:"Chaincodes"`
+}
+
+// NewAllowedChaincodes creates the factory of the filters which reject the
+// endorser transactions invoking a chaincode which is not allowed
+func NewAllowedChaincodes(config []byte) (txfilter.FilterFactory, error) {
+	conf := &AllowedChaincodesConfig{}
+	if err := yaml.UnmarshalStrict(config, conf); err != nil {
+		return nil, errors.Wrap(err, "invalid AllowedChaincodes config")
+	}
+	if len(conf.Chaincodes) == 0 {
+		return nil, errors.New("invalid AllowedChaincodes config: no chaincode is allowed")
+	}
+
+	filter := &allowedChaincodes{chaincodes: map[string]struct{}{}}
+	for _, chaincode := range conf.Chaincodes {
+		filter.chaincodes[chaincode] = struct{}{}
+	}
+	return filter, nil
+}
+
+type allowedChaincodes struct {
+	chaincodes map[string]struct{}
+}
+
+// New returns the filter, which is the same for every channel
+func (a *allowedChaincodes) New(channelID string) (txfilter.Filter, error) {
+	return a, nil
+}
+
+// Apply returns an error if the message is an endorser transaction invoking
+// a chaincode which is not allowed
+func (a *allowedChaincodes) Apply(env *common.Envelope) error {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	if payload.Header == nil {
+		return errors.New("missing header")
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return err
+	}
+	if chdr.Type != int32(common.HeaderType_ENDORSER_TRANSACTION) {
+		return nil
+	}
+
+	ext, err := utils.GetChaincodeHeaderExtension(payload.Header)
+	if err != nil {
+		return err
+	}
+	name := ext.GetChaincodeId().GetName()
+	if _, ok := a.chaincodes[name]; !ok {
+		return errors.Errorf("chaincode %s is not allowed", name)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package builtin
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedChaincodes(t *testing.T) {
+	factory, err := NewAllowedChaincodes([]byte("Chaincodes: [mycc, othercc]"))
+	require.NoError(t, err)
+	filter, err := factory.New("mychannel")
+	require.NoError(t, err)
+
+	t.Run("Allowed", func(t *testing.T) {
+		assert.NoError(t, filter.Apply(makeEnvelope(common.HeaderType_ENDORSER_TRANSACTION, "mycc")))
+		assert.NoError(t, filter.Apply(makeEnvelope(common.HeaderType_ENDORSER_TRANSACTION, "othercc")))
+	})
+
+	t.Run("Not Allowed", func(t *testing.T) {
+		assert.EqualError(t, filter.Apply(makeEnvelope(common.HeaderType_ENDORSER_TRANSACTION, "badcc")), "chaincode badcc is not allowed")
+	})
+
+	t.Run("Not Endorser Transaction", func(t *testing.T) {
+		assert.NoError(t, filter.Apply(makeEnvelope(common.HeaderType_MESSAGE, "badcc")))
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		assert.Error(t, filter.Apply(&common.Envelope{Payload: []byte("garbage")}))
+		assert.EqualError(t, filter.Apply(&common.Envelope{Payload: utils.MarshalOrPanic(&common.Payload{})}), "missing header")
+	})
+}
+
+func TestAllowedChaincodesBadConfig(t *testing.T) {
+	_, err := NewAllowedChaincodes([]byte("{}"))
+	assert.EqualError(t, err, "invalid AllowedChaincodes config: no chaincode is allowed")
+
+	_, err = NewAllowedChaincodes([]byte("Chaincode: mycc"))
+	assert.Error(t, err)
+}
+
+func makeEnvelope(headerType common.HeaderType, chaincode string) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					Type: int32(headerType),
+					Extension: utils.MarshalOrPanic(&peer.ChaincodeHeaderExtension{
+						ChaincodeId: &peer.ChaincodeID{Name: chaincode},
+					}),
+				}),
+			},
+		}),
+	}
+}
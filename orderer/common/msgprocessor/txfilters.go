@@ -0,0 +1,155 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"fmt"
+	"os"
+	"plugin"
+
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	txfilter "github.com/hyperledger/fabric/orderer/common/msgprocessor/txfilter/api"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor/txfilter/builtin"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// txFilterPluginFactory is the name of the function a transaction filter
+// plugin exports to create its filter factory
+const txFilterPluginFactory = "NewFilterFactory"
+
+// compiledTxFilters are the transaction filters compiled into the orderer,
+// by name
+var compiledTxFilters = map[string]func(config []byte) (txfilter.FilterFactory, error){
+	"AllowedChaincodes": builtin.NewAllowedChaincodes,
+}
+
+// TxFilters creates the transaction filters of the standard channels, which
+// are applied to their normal messages. The filters come from the local config
+// of the orderer rather than from the channel config, so each orderer of a
+// channel applies its own filters.
+type TxFilters struct {
+	filters []*configuredTxFilter
+	metrics *Metrics
+}
+
+type configuredTxFilter struct {
+	name     string
+	channels map[string]struct{}
+	factory  txfilter.FilterFactory
+}
+
+// NewTxFilters loads the transaction filters of the given config, either
+// compiled into the orderer or from Go plugins.
+func NewTxFilters(config []localconfig.TxFilter, metrics *Metrics) (*TxFilters, error) {
+	txFilters := &TxFilters{metrics: metrics}
+	for _, conf := range config {
+		if conf.Name == "" {
+			return nil, errors.New("transaction filter has no name")
+		}
+
+		filterConfig, err := yaml.Marshal(conf.Config)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid config of transaction filter %s", conf.Name)
+		}
+
+		var newFactory func(config []byte) (txfilter.FilterFactory, error)
+		if conf.Library != "" {
+			newFactory, err = loadTxFilterPlugin(conf.Library)
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("failed loading transaction filter %s", conf.Name))
+			}
+		} else {
+			var ok bool
+			newFactory, ok = compiledTxFilters[conf.Name]
+			if !ok {
+				return nil, errors.Errorf("unknown transaction filter %s", conf.Name)
+			}
+		}
+
+		factory, err := newFactory(filterConfig)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed creating transaction filter %s", conf.Name))
+		}
+
+		filter := &configuredTxFilter{name: conf.Name, factory: factory}
+		if len(conf.Channels) != 0 {
+			filter.channels = map[string]struct{}{}
+			for _, channel := range conf.Channels {
+				filter.channels[channel] = struct{}{}
+			}
+		}
+		txFilters.filters = append(txFilters.filters, filter)
+		logger.Infof("Loaded transaction filter %s", conf.Name)
+	}
+	return txFilters, nil
+}
+
+func loadTxFilterPlugin(path string) (func(config []byte) (txfilter.FilterFactory, error), error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Wrapf(err, "could not find plugin at path %s", path)
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening plugin at path %s", path)
+	}
+	symbol, err := p.Lookup(txFilterPluginFactory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "plugin at path %s does not export %s", path, txFilterPluginFactory)
+	}
+	newFactory, ok := symbol.(func(config []byte) (txfilter.FilterFactory, error))
+	if !ok {
+		return nil, errors.Errorf("%s of plugin at path %s is not a func(config []byte) (txfilter.FilterFactory, error)", txFilterPluginFactory, path)
+	}
+	return newFactory, nil
+}
+
+// Create returns the rules applying the transaction filters of the given
+// channel, which count the transactions they reject.
+func (t *TxFilters) Create(channelID string) (*RuleSet, error) {
+	var rules []Rule
+	for _, filter := range t.filters {
+		if filter.channels != nil {
+			if _, ok := filter.channels[channelID]; !ok {
+				continue
+			}
+		}
+
+		f, err := filter.factory.New(channelID)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed creating transaction filter %s for channel %s", filter.name, channelID))
+		}
+		if f == nil {
+			continue
+		}
+		rules = append(rules, &txFilterRule{
+			name:      filter.name,
+			channelID: channelID,
+			filter:    f,
+			metrics:   t.metrics,
+		})
+	}
+	return NewRuleSet(rules), nil
+}
+
+// txFilterRule implements the Rule interface.
+type txFilterRule struct {
+	name      string
+	channelID string
+	filter    txfilter.Filter
+	metrics   *Metrics
+}
+
+// Apply returns an error if the transaction filter rejects the message.
+func (r *txFilterRule) Apply(message *cb.Envelope) error {
+	if err := r.filter.Apply(message); err != nil {
+		r.metrics.TxFilterRejectedCount.With("channel", r.channelID, "filter", r.name).Add(1)
+		return errors.WithMessage(err, fmt.Sprintf("transaction rejected by filter %s", r.name))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxFilters(t *testing.T) {
+	counter := &metricsfakes.Counter{}
+	counter.WithReturns(counter)
+	metrics := &Metrics{TxFilterRejectedCount: counter}
+
+	txFilters, err := NewTxFilters([]localconfig.TxFilter{
+		{
+			Name:     "AllowedChaincodes",
+			Channels: []string{"mychannel"},
+			Config:   map[string]interface{}{"Chaincodes": []interface{}{"mycc"}},
+		},
+	}, metrics)
+	require.NoError(t, err)
+
+	t.Run("Accepted", func(t *testing.T) {
+		rules, err := txFilters.Create("mychannel")
+		require.NoError(t, err)
+		assert.NoError(t, rules.Apply(makeChaincodeMessage("mycc")))
+		assert.Equal(t, 0, counter.AddCallCount())
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		rules, err := txFilters.Create("mychannel")
+		require.NoError(t, err)
+		assert.EqualError(t, rules.Apply(makeChaincodeMessage("badcc")), "transaction rejected by filter AllowedChaincodes: chaincode badcc is not allowed")
+		assert.Equal(t, 1, counter.AddCallCount())
+		assert.Equal(t, float64(1), counter.AddArgsForCall(0))
+		assert.Equal(t, []string{"channel", "mychannel", "filter", "AllowedChaincodes"}, counter.WithArgsForCall(0))
+	})
+
+	t.Run("Other Channel", func(t *testing.T) {
+		rules, err := txFilters.Create("otherchannel")
+		require.NoError(t, err)
+		assert.NoError(t, rules.Apply(makeChaincodeMessage("badcc")))
+	})
+}
+
+func TestTxFiltersBadConfig(t *testing.T) {
+	metrics := &Metrics{TxFilterRejectedCount: &metricsfakes.Counter{}}
+
+	_, err := NewTxFilters([]localconfig.TxFilter{{}}, metrics)
+	assert.EqualError(t, err, "transaction filter has no name")
+
+	_, err = NewTxFilters([]localconfig.TxFilter{{Name: "Foo"}}, metrics)
+	assert.EqualError(t, err, "unknown transaction filter Foo")
+
+	_, err = NewTxFilters([]localconfig.TxFilter{{Name: "AllowedChaincodes"}}, metrics)
+	assert.EqualError(t, err, "failed creating transaction filter AllowedChaincodes: invalid AllowedChaincodes config: no chaincode is allowed")
+
+	_, err = NewTxFilters([]localconfig.TxFilter{{Name: "Foo", Library: "/nonexistent/foo.so"}}, metrics)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed loading transaction filter Foo: could not find plugin at path /nonexistent/foo.so")
+}
+
+func makeChaincodeMessage(chaincode string) *cb.Envelope {
+	return &cb.Envelope{
+		Payload: utils.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{
+				ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{
+					Type: int32(cb.HeaderType_ENDORSER_TRANSACTION),
+					Extension: utils.MarshalOrPanic(&peer.ChaincodeHeaderExtension{
+						ChaincodeId: &peer.ChaincodeID{Name: chaincode},
+					}),
+				}),
+			},
+		}),
+	}
+}
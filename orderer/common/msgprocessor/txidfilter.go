@@ -0,0 +1,229 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// TxIDFilterResources defines the subset of the channel resources required to create this filter
+type TxIDFilterResources interface {
+	// OrdererConfig returns the config.Orderer for the channel and whether the Orderer config exists
+	OrdererConfig() (channelconfig.Orderer, bool)
+
+	// Height returns the number of blocks of the channel
+	Height() uint64
+
+	// Iterator returns an Iterator over the blocks of the channel
+	Iterator(startType *ab.SeekPosition) (blockledger.Iterator, uint64)
+}
+
+// NewTxIDFilter creates a filter which rejects messages whose transaction ID
+// is the one of a transaction of the last blocks of the channel, as set by the
+// duplicate transaction ID window of the orderer config, or the one of a
+// different transaction which the filter admitted but which is not yet found
+// in a block.
+//
+// The window is read from the blocks of the ledger when the filter is first
+// applied, or when the window grows, and is then kept up to date by passing
+// the blocks written to the ledger to Commit, so that it is the same on every
+// orderer of the channel, whichever orderer the transactions were broadcast
+// to. The transactions admitted by the other orderers are only known once
+// they are found in a block.
+func NewTxIDFilter(resources TxIDFilterResources) *TxIDFilter {
+	return &TxIDFilter{resources: resources}
+}
+
+// TxIDFilter implements the Rule interface.
+type TxIDFilter struct {
+	resources TxIDFilterResources
+
+	mutex sync.Mutex
+	// start and height are the numbers of the first block of the window and
+	// of the block following its last block
+	start  uint64
+	height uint64
+	// blocks holds the transaction IDs of the blocks of the window, in order,
+	// or is nil when the window has not been read from the ledger
+	blocks [][]string
+	// txIDs maps the transaction IDs of the window to the number of the last
+	// block they are found in
+	txIDs map[string]uint64
+	// pending maps the transaction IDs admitted by the filter, which are not
+	// yet found in a block, to their transaction
+	pending map[string]pendingTx
+}
+
+// pendingTx is a transaction admitted by the filter
+type pendingTx struct {
+	// digest is the hash of the payload of the transaction
+	digest [sha256.Size]byte
+	// height is the height of the ledger when the transaction was admitted
+	height uint64
+}
+
+// Apply returns an error if the transaction ID of the message is found in the
+// last blocks of the channel, or is the one of a different transaction which
+// is being ordered. The transactions whose payload is the same as the one of
+// the pending transaction are admitted, as they are either revalidated by the
+// consenter or resubmitted by their client after a failed broadcast.
+func (r *TxIDFilter) Apply(message *cb.Envelope) error {
+	ordererConf, ok := r.resources.OrdererConfig()
+	if !ok {
+		logger.Panic("Programming error: orderer config not found")
+	}
+
+	window := uint64(ordererConf.DuplicateTxIDWindow())
+	if window == 0 {
+		r.mutex.Lock()
+		r.reset()
+		r.mutex.Unlock()
+		return nil
+	}
+
+	chdr, err := utils.ChannelHeader(message)
+	if err != nil {
+		return errors.WithMessage(err, "could not extract the transaction ID")
+	}
+	if chdr.TxId == "" {
+		return nil
+	}
+	digest := sha256.Sum256(message.Payload)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.blocks == nil || (r.start > 0 && r.height-r.start < window) {
+		// The window was just set, or grew, so its blocks are read again
+		r.load(window)
+	}
+	r.trim(window)
+
+	if number, ok := r.txIDs[chdr.TxId]; ok {
+		return errors.WithMessage(ErrDuplicateTxID, fmt.Sprintf("transaction %s was already ordered in block %d", chdr.TxId, number))
+	}
+	if tx, ok := r.pending[chdr.TxId]; ok && tx.digest != digest {
+		return errors.WithMessage(ErrDuplicateTxID, fmt.Sprintf("transaction %s is already being ordered", chdr.TxId))
+	}
+	r.pending[chdr.TxId] = pendingTx{digest: digest, height: r.height}
+	return nil
+}
+
+// Commit adds the transaction IDs of a block written to the ledger to the
+// window, which moves past the blocks falling out of it. The transactions
+// still pending once the window moved past the block they were admitted at
+// are forgotten, as they were dropped before being ordered.
+func (r *TxIDFilter) Commit(block *cb.Block) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.blocks == nil {
+		return
+	}
+
+	number := block.GetHeader().GetNumber()
+	if number < r.height {
+		// The block was read from the ledger along with the window
+		return
+	}
+	if number > r.height {
+		logger.Warningf("Block %d is not the next block of the duplicate transaction ID window, which ends at block %d, the window will be read again from the ledger", number, r.height)
+		r.reset()
+		return
+	}
+
+	txIDs := blockTxIDs(block)
+	r.add(txIDs)
+	for _, txID := range txIDs {
+		delete(r.pending, txID)
+	}
+
+	ordererConf, ok := r.resources.OrdererConfig()
+	if !ok {
+		logger.Panic("Programming error: orderer config not found")
+	}
+	window := uint64(ordererConf.DuplicateTxIDWindow())
+	r.trim(window)
+	for txID, tx := range r.pending {
+		if tx.height+window <= r.height {
+			delete(r.pending, txID)
+		}
+	}
+}
+
+// reset forgets the window and the pending transactions
+func (r *TxIDFilter) reset() {
+	r.start, r.height = 0, 0
+	r.blocks, r.txIDs, r.pending = nil, nil, nil
+}
+
+// load reads the window from the last blocks of the ledger
+func (r *TxIDFilter) load(window uint64) {
+	height := r.resources.Height()
+	var start uint64
+	if height > window {
+		start = height - window
+	}
+
+	r.start, r.height = start, start
+	r.blocks = [][]string{}
+	r.txIDs = map[string]uint64{}
+	if r.pending == nil {
+		r.pending = map[string]pendingTx{}
+	}
+	for r.height < height {
+		r.add(blockTxIDs(blockledger.GetBlock(r.resources, r.height)))
+	}
+}
+
+// add appends the transaction IDs of the block following the window to it
+func (r *TxIDFilter) add(txIDs []string) {
+	for _, txID := range txIDs {
+		r.txIDs[txID] = r.height
+	}
+	r.blocks = append(r.blocks, txIDs)
+	r.height++
+}
+
+// trim removes the blocks of the window exceeding its size
+func (r *TxIDFilter) trim(window uint64) {
+	for ; r.height-r.start > window; r.start++ {
+		for _, txID := range r.blocks[0] {
+			if r.txIDs[txID] == r.start {
+				delete(r.txIDs, txID)
+			}
+		}
+		r.blocks = r.blocks[1:]
+	}
+}
+
+// blockTxIDs returns the transaction IDs of the block, or none if the block
+// cannot be read
+func blockTxIDs(block *cb.Block) []string {
+	var txIDs []string
+	for i := range block.GetData().GetData() {
+		env, err := utils.ExtractEnvelope(block, i)
+		if err != nil {
+			continue
+		}
+		chdr, err := utils.ChannelHeader(env)
+		if err != nil || chdr.TxId == "" {
+			continue
+		}
+		txIDs = append(txIDs, chdr.TxId)
+	}
+	return txIDs
+}
@@ -0,0 +1,178 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	ramledger "github.com/hyperledger/fabric/common/ledger/blockledger/ram"
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTxIDFilterResources struct {
+	*mockconfig.Resources
+	blockledger.ReadWriter
+}
+
+func TestTxIDFilter(t *testing.T) {
+	ledger, err := ramledger.New(10).GetOrCreate("mychannel")
+	require.NoError(t, err)
+	ordererConfig := &mockconfig.Orderer{DuplicateTxIDWindowVal: 2}
+	resources := &mockTxIDFilterResources{
+		Resources:  &mockconfig.Resources{OrdererConfigVal: ordererConfig},
+		ReadWriter: ledger,
+	}
+	filter := NewTxIDFilter(resources)
+
+	appendBlock := func(txIDs ...string) {
+		var envs []*cb.Envelope
+		for _, txID := range txIDs {
+			envs = append(envs, makeTxIDMessage(txID))
+		}
+		block := blockledger.CreateNextBlock(ledger, envs)
+		require.NoError(t, ledger.Append(block))
+		filter.Commit(block)
+	}
+
+	appendBlock("tx0")
+	appendBlock("tx1", "tx2")
+
+	t.Run("Unique", func(t *testing.T) {
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx3")))
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := filter.Apply(makeTxIDMessage("tx2"))
+		assert.EqualError(t, err, "transaction tx2 was already ordered in block 1: duplicate transaction ID")
+		assert.Equal(t, ErrDuplicateTxID, errors.Cause(err))
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx0")))
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx3")), "Revalidation of a pending transaction")
+		otherTx := &cb.Envelope{
+			Payload: utils.MarshalOrPanic(&cb.Payload{
+				Header: &cb.Header{
+					ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{
+						Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+						ChannelId: "mychannel",
+						TxId:      "tx3",
+					}),
+				},
+				Data: []byte("other"),
+			}),
+		}
+		err := filter.Apply(otherTx)
+		assert.EqualError(t, err, "transaction tx3 is already being ordered: duplicate transaction ID")
+		assert.Equal(t, ErrDuplicateTxID, errors.Cause(err))
+	})
+
+	t.Run("No Transaction ID", func(t *testing.T) {
+		appendBlock("")
+		assert.NoError(t, filter.Apply(makeTxIDMessage("")))
+	})
+
+	t.Run("Outside Window", func(t *testing.T) {
+		appendBlock("tx3")
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx0")))
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx1")))
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx3")))
+	})
+
+	t.Run("Window Grows", func(t *testing.T) {
+		ordererConfig.DuplicateTxIDWindowVal = 10
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx0")))
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx1")))
+	})
+
+	t.Run("Window Shrinks", func(t *testing.T) {
+		ordererConfig.DuplicateTxIDWindowVal = 1
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx2")))
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx3")))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		ordererConfig.DuplicateTxIDWindowVal = 0
+		assert.NoError(t, filter.Apply(makeTxIDMessage("tx3")))
+		assert.NoError(t, filter.Apply(makeMessage(nil)))
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		ordererConfig.DuplicateTxIDWindowVal = 1
+		assert.Error(t, filter.Apply(makeMessage(nil)))
+	})
+}
+
+func TestTxIDFilterCommit(t *testing.T) {
+	ledger, err := ramledger.New(10).GetOrCreate("mychannel")
+	require.NoError(t, err)
+	ordererConfig := &mockconfig.Orderer{DuplicateTxIDWindowVal: 2}
+	resources := &mockTxIDFilterResources{
+		Resources:  &mockconfig.Resources{OrdererConfigVal: ordererConfig},
+		ReadWriter: ledger,
+	}
+	filter := NewTxIDFilter(resources)
+
+	nextBlock := func(txIDs ...string) *cb.Block {
+		var envs []*cb.Envelope
+		for _, txID := range txIDs {
+			envs = append(envs, makeTxIDMessage(txID))
+		}
+		return blockledger.CreateNextBlock(ledger, envs)
+	}
+
+	require.NoError(t, ledger.Append(nextBlock("tx0")))
+	assert.NoError(t, filter.Apply(makeTxIDMessage("tx1")))
+	assert.NoError(t, filter.Apply(makeTxIDMessage("tx2")))
+
+	t.Run("Incremental", func(t *testing.T) {
+		block := nextBlock("tx1")
+		require.NoError(t, ledger.Append(block))
+		filter.Commit(block)
+		assert.EqualError(t, filter.Apply(makeTxIDMessage("tx1")), "transaction tx1 was already ordered in block 1: duplicate transaction ID")
+		filter.Commit(block)
+		assert.Len(t, filter.blocks, 2, "Blocks are added to the window once")
+	})
+
+	t.Run("Dropped Transaction", func(t *testing.T) {
+		assert.Contains(t, filter.pending, "tx2")
+		block := nextBlock()
+		require.NoError(t, ledger.Append(block))
+		filter.Commit(block)
+		assert.NotContains(t, filter.pending, "tx2")
+	})
+
+	t.Run("Missed Block", func(t *testing.T) {
+		require.NoError(t, ledger.Append(nextBlock("tx3")))
+		block := nextBlock("tx4")
+		require.NoError(t, ledger.Append(block))
+		filter.Commit(block)
+		assert.Nil(t, filter.blocks, "The window is read again from the ledger")
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx3")))
+		assert.Error(t, filter.Apply(makeTxIDMessage("tx4")))
+	})
+}
+
+func makeTxIDMessage(txID string) *cb.Envelope {
+	return &cb.Envelope{
+		Payload: utils.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{
+				ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{
+					Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+					ChannelId: "mychannel",
+					TxId:      txID,
+				}),
+			},
+		}),
+	}
+}
@@ -39,6 +39,8 @@ type BlockWriter struct {
 	lastConfigSeq      uint64
 	lastBlock          *cb.Block
 	committingBlock    sync.Mutex
+	// onCommit, when set, is called with each block once it is written to the ledger
+	onCommit func(block *cb.Block)
 }
 
 func newBlockWriter(lastBlock *cb.Block, r *Registrar, support blockWriterSupport) *BlockWriter {
@@ -191,6 +193,10 @@ func (bw *BlockWriter) commitBlock(encodedMetadataValue []byte) {
 		logger.Panicf("[channel: %s] Could not append block: %s", bw.support.ChainID(), err)
 	}
 	logger.Debugf("[channel: %s] Wrote block [%d]", bw.support.ChainID(), bw.lastBlock.GetHeader().Number)
+
+	if bw.onCommit != nil {
+		bw.onCommit(bw.lastBlock)
+	}
 }
 
 func (bw *BlockWriter) addBlockSignature(block *cb.Block) {
@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
 )
@@ -57,10 +58,18 @@ func newChainSupport(
 	}
 
 	// Set up the msgprocessor
-	cs.Processor = msgprocessor.NewStandardChannel(cs, msgprocessor.CreateStandardChannelFilters(cs, registrar.config))
+	txFilters, err := registrar.txFilters.Create(cs.ChainID())
+	if err != nil {
+		logger.Panicf("[channel: %s] Error creating transaction filters: %s", cs.ChainID(), err)
+	}
+	txIDFilter := msgprocessor.NewTxIDFilter(cs)
+	standardChannel := msgprocessor.NewStandardChannel(cs, msgprocessor.CreateStandardChannelFilters(cs, txIDFilter, registrar.config))
+	standardChannel.SetTxFilters(txFilters)
+	cs.Processor = standardChannel
 
 	// Set up the block writer
 	cs.BlockWriter = newBlockWriter(lastBlock, registrar, cs)
+	cs.BlockWriter.onCommit = txIDFilter.Commit
 
 	// Set up the consenter
 	consenterType := ledgerResources.SharedConfig().ConsensusType()
@@ -130,6 +139,11 @@ func (cs *ChainSupport) ProposeConfigUpdate(configtx *cb.Envelope) (*cb.ConfigEn
 	return env, cs.ValidateNew(bundle)
 }
 
+// RateLimits returns the rate limits set by the channel config
+func (cs *ChainSupport) RateLimits() *ab.RateLimits {
+	return cs.SharedConfig().RateLimits()
+}
+
 // ChainID passes through to the underlying configtx.Validator
 func (cs *ChainSupport) ChainID() string {
 	return cs.ConfigtxValidator().ChainID()
@@ -99,6 +99,7 @@ type Registrar struct {
 	ledgerFactory      blockledger.Factory
 	signer             crypto.LocalSigner
 	blockcutterMetrics *blockcutter.Metrics
+	txFilters          *msgprocessor.TxFilters
 	systemChannelID    string
 	systemChannel      *ChainSupport
 	templator          msgprocessor.ChannelConfigTemplator
@@ -141,6 +142,12 @@ func NewRegistrar(
 		callbacks:          callbacks,
 	}
 
+	txFilters, err := msgprocessor.NewTxFilters(config.General.TxFilters, msgprocessor.NewMetrics(metricsProvider))
+	if err != nil {
+		logger.Panicf("Failed loading transaction filters: %s", err)
+	}
+	r.txFilters = txFilters
+
 	return r
 }
 
@@ -9,6 +9,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -16,11 +17,14 @@ import (
 	_ "net/http/pprof" // This is essentially the main package for the orderer
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-lib-go/healthz"
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/flogging"
@@ -35,11 +39,13 @@ import (
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/common/tools/protolator"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/comm"
 	"github.com/hyperledger/fabric/core/operations"
 	"github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/orderer/common/bootstrap/file"
+	"github.com/hyperledger/fabric/orderer/common/broadcast"
 	"github.com/hyperledger/fabric/orderer/common/cluster"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/metadata"
@@ -51,6 +57,7 @@ import (
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"gopkg.in/alecthomas/kingpin.v2"
@@ -66,6 +73,9 @@ var (
 	version   = app.Command("version", "Show version information")
 	benchmark = app.Command("benchmark", "Run orderer in benchmark mode")
 
+	migrateRaftStorage = app.Command("migrate-raft-storage", "Re-encrypt the etcdraft WAL and snapshots with the configured encryption key")
+	migrateFromKey     = migrateRaftStorage.Flag("from-key", "Hex encoded SKI of the key the storage is currently encrypted with, if any").String()
+
 	clusterTypes = map[string]struct{}{"etcdraft": {}}
 )
 
@@ -87,6 +97,20 @@ func Main() {
 	initializeLogging()
 	initializeLocalMsp(conf)
 
+	// "migrate-raft-storage" command
+	if fullCmd == migrateRaftStorage.FullCommand() {
+		csp, err := factory.GetBCCSPFromOpts(conf.General.BCCSP)
+		if err != nil {
+			logger.Errorf("Failed initializing the BCCSP: %s", err)
+			os.Exit(1)
+		}
+		if err := migrateEtcdRaftStorage(conf, csp, *migrateFromKey); err != nil {
+			logger.Errorf("Failed migrating etcdraft storage: %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	prettyPrintStruct(conf)
 	Start(fullCmd, conf)
 }
@@ -148,6 +172,8 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		servers = append(servers, clusterGRPCServer)
 	}
 
+	initializeCertificateRenewal(conf, grpcServer, clusterGRPCServer, metricsProvider, opsSystem)
+
 	tlsCallback := func(bundle *channelconfig.Bundle) {
 		// only need to do this if mutual TLS is required or if the orderer node is part of a cluster
 		if grpcServer.MutualTLSRequired() || clusterType {
@@ -162,7 +188,7 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	manager := initializeMultichannelRegistrar(clusterBootBlock, r, clusterDialer, clusterServerConfig, clusterGRPCServer, conf, signer, metricsProvider, opsSystem, lf, tlsCallback)
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	expiration := conf.General.Authentication.NoExpirationChecks
-	server := NewServer(manager, metricsProvider, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS, expiration)
+	server := NewServer(manager, metricsProvider, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS, expiration, newRateLimiter(conf.General.RateLimits))
 
 	logger.Infof("Starting %s", metadata.GetVersionInfo())
 	go handleSignals(addPlatformSignals(map[os.Signal]func(){
@@ -599,6 +625,136 @@ func initializeLocalMsp(conf *localconfig.TopLevel) {
 	}
 }
 
+// migrateEtcdRaftStorage re-encrypts the etcdraft WAL and snapshots of every
+// channel with the key configured in the Consensus section. The storage is
+// currently encrypted with the key whose SKI is hex encoded in fromKeySKI, or
+// unencrypted if fromKeySKI is empty.
+func migrateEtcdRaftStorage(conf *localconfig.TopLevel, csp bccsp.BCCSP, fromKeySKI string) error {
+	var cfg etcdraft.Config
+	if err := viperutil.Decode(conf.Consensus, &cfg); err != nil {
+		return errors.Wrap(err, "failed to decode etcdraft configuration")
+	}
+
+	from, err := etcdraft.LoadStorageCipher(csp, fromKeySKI)
+	if err != nil {
+		return errors.Wrap(err, "failed to load the current encryption key")
+	}
+	to, err := etcdraft.LoadStorageCipher(csp, cfg.EncryptionKeySKI)
+	if err != nil {
+		return errors.Wrap(err, "failed to load the configured encryption key")
+	}
+
+	channels, err := ioutil.ReadDir(cfg.WALDir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read WAL directory %s", cfg.WALDir)
+	}
+
+	lg := flogging.MustGetLogger("orderer.consensus.etcdraft")
+	for _, channel := range channels {
+		if !channel.IsDir() {
+			continue
+		}
+		logger.Infof("Migrating etcdraft storage of channel %s", channel.Name())
+		walDir := filepath.Join(cfg.WALDir, channel.Name())
+		snapDir := filepath.Join(cfg.SnapDir, channel.Name())
+		if err := etcdraft.MigrateStorage(lg, walDir, snapDir, from, to); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed to migrate storage of channel %s", channel.Name()))
+		}
+	}
+
+	return nil
+}
+
+// newRateLimiter returns the rate limiter of the broadcast handler, or nil
+// if rate limiting is disabled
+func newRateLimiter(conf localconfig.RateLimits) *broadcast.RateLimiter {
+	if !conf.Enabled {
+		return nil
+	}
+	logger.Infof("Limiting the rate of broadcast transactions per channel to %+v and per client to %+v", conf.Channel, conf.Client)
+	return broadcast.NewRateLimiter(
+		&ab.RateLimit{Rate: conf.Channel.Rate, Burst: conf.Channel.Burst},
+		&ab.RateLimit{Rate: conf.Client.Rate, Burst: conf.Client.Burst},
+	)
+}
+
+// initializeCertificateRenewal tracks the expiration of the enrollment and
+// TLS certificates of the orderer, and registers a health check that fails
+// once one of them has expired. If certificate renewal is enabled, it also
+// watches the local MSP and TLS files and swaps in renewed certificates and
+// keys without a restart.
+func initializeCertificateRenewal(
+	conf *localconfig.TopLevel,
+	srv *comm.GRPCServer,
+	clusterSrv *comm.GRPCServer,
+	metricsProvider metrics.Provider,
+	healthChecker healthChecker,
+) *crypto.ExpirationTracker {
+	tracker := crypto.NewExpirationTracker(metricsProvider, conf.General.CertRenewal.ExpirationWarningThreshold)
+	trackEnrollmentCertificate(tracker, mspmgmt.GetLocalMSP())
+	if srv.TLSEnabled() {
+		tracker.Track("tls", crypto.TLSCertificateExpiresAt(srv.ServerCertificate()))
+	}
+	if clusterSrv != srv {
+		tracker.Track("cluster", crypto.TLSCertificateExpiresAt(clusterSrv.ServerCertificate()))
+	}
+	if err := healthChecker.RegisterChecker("certificates", tracker); err != nil {
+		logger.Panicf("Failed registering certificates health check: %s", err)
+	}
+	tracker.Start(time.Hour)
+
+	if !conf.General.CertRenewal.Enabled {
+		return tracker
+	}
+
+	interval := conf.General.CertRenewal.PollInterval
+	mspWatcher := mspmgmt.NewLocalMspWatcher(
+		conf.General.LocalMSPDir,
+		conf.General.BCCSP,
+		conf.General.LocalMSPID,
+		msp.ProviderTypeToString(msp.FABRIC),
+		interval,
+		func(localMsp msp.MSP) { trackEnrollmentCertificate(tracker, localMsp) },
+	)
+	mspWatcher.Start()
+
+	if srv.TLSEnabled() {
+		comm.NewKeyPairWatcher(conf.General.TLS.Certificate, conf.General.TLS.PrivateKey, interval,
+			srv.SetServerCertificate,
+			func(cert tls.Certificate) {
+				tracker.Track("tls", crypto.TLSCertificateExpiresAt(cert))
+			},
+		).Start()
+	}
+	if clusterSrv != srv {
+		comm.NewKeyPairWatcher(conf.General.Cluster.ServerCertificate, conf.General.Cluster.ServerPrivateKey, interval,
+			clusterSrv.SetServerCertificate,
+			func(cert tls.Certificate) {
+				tracker.Track("cluster", crypto.TLSCertificateExpiresAt(cert))
+			},
+		).Start()
+	}
+
+	logger.Infof("Certificate renewal enabled, polling every %s", interval)
+	return tracker
+}
+
+// trackEnrollmentCertificate tracks the expiration of the certificate of
+// the default signing identity of the given local MSP
+func trackEnrollmentCertificate(tracker *crypto.ExpirationTracker, localMsp msp.MSP) {
+	signer, err := localMsp.GetDefaultSigningIdentity()
+	if err != nil {
+		logger.Warningf("Failed obtaining the local signing identity: %s", err)
+		return
+	}
+	serializedIdentity, err := signer.Serialize()
+	if err != nil {
+		logger.Warningf("Failed serializing the local signing identity: %s", err)
+		return
+	}
+	tracker.Track("enrollment", crypto.ExpiresAt(serializedIdentity))
+}
+
 //go:generate counterfeiter -o mocks/health_checker.go -fake-name HealthChecker . healthChecker
 
 // HealthChecker defines the contract for health checker
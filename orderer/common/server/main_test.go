@@ -4,6 +4,8 @@
 package server
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -15,7 +17,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/sw"
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	deliver_mocks "github.com/hyperledger/fabric/common/deliver/mock"
@@ -39,12 +43,16 @@ import (
 	"github.com/hyperledger/fabric/orderer/common/server/mocks"
 	server_mocks "github.com/hyperledger/fabric/orderer/common/server/mocks"
 	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
+	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -472,6 +480,70 @@ func TestUpdateTrustedRoots(t *testing.T) {
 	grpcServer.Listener().Close()
 }
 
+func TestInitializeCertificateRenewal(t *testing.T) {
+	gt := NewGomegaWithT(t)
+
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	keyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "certrenewal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	require.NoError(t, ioutil.WriteFile(certFile, keyPair.Cert, 0644))
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPair.Key, 0600))
+
+	srv, err := comm.NewGRPCServer("127.0.0.1:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:      true,
+			Certificate: keyPair.Cert,
+			Key:         keyPair.Key,
+		},
+	})
+	require.NoError(t, err)
+	defer srv.Listener().Close()
+
+	localMSPDir, err := configtest.GetDevMspDir()
+	require.NoError(t, err)
+	conf := &localconfig.TopLevel{
+		General: localconfig.General{
+			TLS: localconfig.TLS{
+				Certificate: certFile,
+				PrivateKey:  keyFile,
+			},
+			LocalMSPDir: localMSPDir,
+			LocalMSPID:  "SampleOrg",
+			CertRenewal: localconfig.CertRenewal{
+				Enabled:                    true,
+				PollInterval:               10 * time.Millisecond,
+				ExpirationWarningThreshold: time.Hour,
+			},
+		},
+	}
+
+	healthChecker := &mocks.HealthChecker{}
+	tracker := initializeCertificateRenewal(conf, srv, srv, &disabled.Provider{}, healthChecker)
+	defer tracker.Stop()
+
+	require.Equal(t, 1, healthChecker.RegisterCheckerCallCount())
+	component, checker := healthChecker.RegisterCheckerArgsForCall(0)
+	assert.Equal(t, "certificates", component)
+	assert.Equal(t, tracker, checker)
+	assert.NoError(t, tracker.HealthCheck(context.Background()))
+
+	renewedKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(keyFile, renewedKeyPair.Key, 0600))
+	require.NoError(t, ioutil.WriteFile(certFile, renewedKeyPair.Cert, 0644))
+
+	gt.Eventually(func() []byte {
+		return srv.ServerCertificate().Certificate[0]
+	}).Should(Equal(renewedKeyPair.TLSCert.Raw))
+}
+
 func TestConfigureClusterListener(t *testing.T) {
 	logEntries := make(chan string, 100)
 
@@ -758,3 +830,52 @@ func TestCreateReplicator(t *testing.T) {
 	err = r.verifierRetriever.RetrieveVerifier("system").VerifyBlockSignature(nil, nil)
 	assert.NoError(t, err)
 }
+
+func TestMigrateEtcdRaftStorage(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "migrate-raft-storage")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	csp, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewInMemoryKeyStore())
+	require.NoError(t, err)
+	key, err := csp.KeyGen(&bccsp.AES256KeyGenOpts{})
+	require.NoError(t, err)
+	keySKI := hex.EncodeToString(key.SKI())
+
+	walDir, snapDir := filepath.Join(tempDir, "wal"), filepath.Join(tempDir, "snapshot")
+	lg := flogging.MustGetLogger("test")
+	storage, err := etcdraft.CreateStorage(lg, filepath.Join(walDir, "mychannel"), filepath.Join(snapDir, "mychannel"), raft.NewMemoryStorage(), nil)
+	require.NoError(t, err)
+	require.NoError(t, storage.Store([]raftpb.Entry{{Index: 1, Data: []byte("block")}}, raftpb.HardState{Commit: 1}, raftpb.Snapshot{}))
+	require.NoError(t, storage.Close())
+
+	conf := &localconfig.TopLevel{
+		Consensus: map[string]interface{}{
+			"WALDir":           walDir,
+			"SnapDir":          snapDir,
+			"EncryptionKeySKI": keySKI,
+		},
+	}
+
+	err = migrateEtcdRaftStorage(conf, csp, "0102")
+	assert.Contains(t, err.Error(), "failed to load the current encryption key")
+
+	require.NoError(t, migrateEtcdRaftStorage(conf, csp, ""))
+
+	_, err = etcdraft.CreateStorage(lg, filepath.Join(walDir, "mychannel"), filepath.Join(snapDir, "mychannel"), raft.NewMemoryStorage(), nil)
+	assert.Contains(t, err.Error(), "data is encrypted but no encryption key is configured")
+
+	cipher, err := etcdraft.LoadStorageCipher(csp, keySKI)
+	require.NoError(t, err)
+	ram := raft.NewMemoryStorage()
+	storage, err = etcdraft.CreateStorage(lg, filepath.Join(walDir, "mychannel"), filepath.Join(snapDir, "mychannel"), ram, cipher)
+	require.NoError(t, err)
+	defer storage.Close()
+	entries, err := ram.Entries(1, 2, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("block"), entries[0].Data)
+
+	conf.Consensus = map[string]interface{}{"WALDir": filepath.Join(tempDir, "missing")}
+	err = migrateEtcdRaftStorage(conf, csp, keySKI)
+	assert.Contains(t, err.Error(), "failed to read WAL directory")
+}
@@ -79,6 +79,7 @@ func NewServer(
 	timeWindow time.Duration,
 	mutualTLS bool,
 	expirationCheckDisabled bool,
+	rateLimiter *broadcast.RateLimiter,
 ) ab.AtomicBroadcastServer {
 	s := &server{
 		dh: deliver.NewHandler(
@@ -91,6 +92,7 @@ func NewServer(
 		bh: &broadcast.Handler{
 			SupportRegistrar: broadcastSupport{Registrar: r},
 			Metrics:          broadcast.NewMetrics(metricsProvider),
+			RateLimiter:      rateLimiter,
 		},
 		debug:     debug,
 		Registrar: r,
@@ -96,6 +96,7 @@ type Options struct {
 
 	WALDir               string
 	SnapDir              string
+	StorageCipher        StorageCipher
 	SnapshotIntervalSize uint32
 
 	// This is configurable mainly for testing purpose. Users are not
@@ -204,8 +205,14 @@ func NewChain(
 
 	lg := opts.Logger.With("channel", support.ChainID(), "node", opts.RaftID)
 
+	// an interrupted storage migration may have moved the WAL away, in which
+	// case the node must not start as a fresh one
+	if err := checkInterruptedMigration(lg, opts.WALDir, opts.SnapDir); err != nil {
+		return nil, err
+	}
+
 	fresh := !wal.Exist(opts.WALDir)
-	storage, err := CreateStorage(lg, opts.WALDir, opts.SnapDir, opts.MemoryStorage)
+	storage, err := CreateStorage(lg, opts.WALDir, opts.SnapDir, opts.MemoryStorage, opts.StorageCipher)
 	if err != nil {
 		return nil, errors.Errorf("failed to restore persisted raft data: %s", err)
 	}
@@ -576,7 +583,7 @@ func (c *Chain) serveRequest() {
 	startTimer := func() {
 		if !ticking {
 			ticking = true
-			timer.Reset(c.support.SharedConfig().BatchTimeout())
+			timer.Reset(c.support.BlockCutter().BatchTimeout(c.support.SharedConfig()))
 		}
 	}
 
@@ -646,7 +653,7 @@ func (c *Chain) serveRequest() {
 	becomeFollower := func() {
 		cancelProp()
 		c.blockInflight = 0
-		_ = c.support.BlockCutter().Cut()
+		_ = c.cutAll()
 		stopTimer()
 		submitC = c.submitC
 		bc = nil
@@ -777,14 +784,14 @@ func (c *Chain) serveRequest() {
 		case <-timer.C():
 			ticking = false
 
-			batch := c.support.BlockCutter().Cut()
-			if len(batch) == 0 {
+			batches := c.cutOnTimeout()
+			if len(batches) == 0 {
 				c.logger.Warningf("Batch timer expired with no pending requests, this might indicate a bug")
 				continue
 			}
 
 			c.logger.Debugf("Batch timer expired, creating block")
-			c.propose(propC, bc, batch) // we are certain this is normal block, no need to block
+			c.propose(propC, bc, batches...) // we are certain this is normal block, no need to block
 
 		case sn := <-c.snapC:
 			if sn.Metadata.Index != 0 {
@@ -872,12 +879,7 @@ func (c *Chain) ordered(msg *orderer.SubmitRequest) (batches [][]*common.Envelop
 				return nil, true, errors.Errorf("bad config message: %s", err)
 			}
 		}
-		batch := c.support.BlockCutter().Cut()
-		batches = [][]*common.Envelope{}
-		if len(batch) != 0 {
-			batches = append(batches, batch)
-		}
-		batches = append(batches, []*common.Envelope{msg.Payload})
+		batches = append(c.cutAll(), []*common.Envelope{msg.Payload})
 		return batches, false, nil
 	}
 	// it is a normal message
@@ -893,6 +895,25 @@ func (c *Chain) ordered(msg *orderer.SubmitRequest) (batches [][]*common.Envelop
 
 }
 
+// cutAll cuts the pending messages, which may take several batches when
+// batch priorities are set
+func (c *Chain) cutAll() [][]*common.Envelope {
+	batches := [][]*common.Envelope{}
+	for batch := c.support.BlockCutter().Cut(); len(batch) != 0; batch = c.support.BlockCutter().Cut() {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// cutOnTimeout cuts the pending messages once the batch timer expired
+func (c *Chain) cutOnTimeout() [][]*common.Envelope {
+	batch := c.support.BlockCutter().CutOnTimeout()
+	if len(batch) == 0 {
+		return nil
+	}
+	return append([][]*common.Envelope{batch}, c.cutAll()...)
+}
+
 func (c *Chain) propose(ch chan<- *common.Block, bc *blockCreator, batches ...[]*common.Envelope) {
 	for _, batch := range batches {
 		b := bc.createNextBlock(batch)
@@ -14,6 +14,7 @@ import (
 
 	"code.cloudfoundry.org/clock"
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/viperutil"
@@ -57,6 +58,7 @@ type Config struct {
 	WALDir            string // WAL data of <my-channel> is stored in WALDir/<my-channel>
 	SnapDir           string // Snapshots of <my-channel> are stored in SnapDir/<my-channel>
 	EvictionSuspicion string // Duration threshold that the node samples in order to suspect its eviction from the channel.
+	EncryptionKeySKI  string // Hex encoded SKI of the BCCSP key that WAL data and snapshots are encrypted with, if set.
 }
 
 // Consenter implements etcdraft consenter
@@ -72,6 +74,7 @@ type Consenter struct {
 	OrdererConfig  localconfig.TopLevel
 	Cert           []byte
 	Metrics        *Metrics
+	StorageCipher  StorageCipher
 }
 
 // TargetChannel extracts the channel from the given proto.Message.
@@ -204,6 +207,7 @@ func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *co
 
 		WALDir:            path.Join(c.EtcdRaftConfig.WALDir, support.ChainID()),
 		SnapDir:           path.Join(c.EtcdRaftConfig.SnapDir, support.ChainID()),
+		StorageCipher:     c.StorageCipher,
 		EvictionSuspicion: evictionSuspicion,
 		Cert:              c.Cert,
 		Metrics:           c.Metrics,
@@ -267,6 +271,18 @@ func New(
 		logger.Panicf("Failed to decode etcdraft configuration: %s", err)
 	}
 
+	var storageCipher StorageCipher
+	if cfg.EncryptionKeySKI != "" {
+		csp, err := factory.GetBCCSPFromOpts(conf.General.BCCSP)
+		if err != nil {
+			logger.Panicf("Failed to initialize the BCCSP of the etcdraft storage encryption key: %s", err)
+		}
+		storageCipher, err = LoadStorageCipher(csp, cfg.EncryptionKeySKI)
+		if err != nil {
+			logger.Panicf("Failed to load etcdraft storage encryption key: %s", err)
+		}
+	}
+
 	consenter := &Consenter{
 		CreateChain:           r.CreateChain,
 		Cert:                  srvConf.SecOpts.Certificate,
@@ -277,6 +293,7 @@ func New(
 		Dialer:                clusterDialer,
 		Metrics:               NewMetrics(metricsProvider),
 		InactiveChainRegistry: icr,
+		StorageCipher:         storageCipher,
 	}
 	consenter.Dispatcher = &Dispatcher{
 		Logger:        logger,
@@ -0,0 +1,498 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/etcdserver/api/snap"
+	"go.etcd.io/etcd/pkg/fileutil"
+	"go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/wal"
+	"go.etcd.io/etcd/wal/walpb"
+)
+
+// encryptedDataPrefix marks the raft entry and snapshot data encrypted by a
+// StorageCipher, and is followed by the version of the encryption format.
+// The data of etcd/raft entries and snapshots is a marshaled protobuf
+// message, which never starts with a zero byte, hence data persisted before
+// encryption was enabled remains readable.
+var encryptedDataPrefix = []byte{0x00, 'e', 'n', 'c'}
+
+// encryptedDataVersion is the version of the encryption format: the data is
+// encrypted with AES in CBC mode, followed by an HMAC of the prefix, the
+// version and the ciphertext (encrypt-then-MAC).
+const encryptedDataVersion = 0x02
+
+// Labels of the keys derived from the storage key
+var (
+	encryptionKeyLabel     = []byte("etcdraft storage encryption")
+	authenticationKeyLabel = []byte("etcdraft storage authentication")
+)
+
+// StorageCipher encrypts the etcd/raft data persisted on disk, namely the data
+// of WAL entries and snapshots. Decrypt must fail if the ciphertext has been
+// tampered with or was encrypted with another key.
+type StorageCipher interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// bccspStorageCipher encrypts with AES in CBC mode with PKCS7 padding and
+// authenticates the ciphertext with an HMAC, using two keys derived from a
+// key of the BCCSP.
+type bccspStorageCipher struct {
+	csp     bccsp.BCCSP
+	encKey  bccsp.Key
+	macKey  bccsp.Key
+	macSize int
+}
+
+// NewStorageCipher returns a StorageCipher that encrypts with the symmetric
+// key of the BCCSP identified by ski.
+func NewStorageCipher(csp bccsp.BCCSP, ski []byte) (StorageCipher, error) {
+	key, err := csp.GetKey(ski)
+	if err != nil {
+		return nil, errors.Errorf("failed to get key %x: %s", ski, err)
+	}
+
+	if !key.Symmetric() || !key.Private() {
+		return nil, errors.Errorf("key %x is not a symmetric secret key", ski)
+	}
+
+	encKey, err := csp.KeyDeriv(key, &bccsp.HMACTruncated256AESDeriveKeyOpts{Temporary: true, Arg: encryptionKeyLabel})
+	if err != nil {
+		return nil, errors.Errorf("failed to derive encryption key from key %x: %s", ski, err)
+	}
+	macKey, err := csp.KeyDeriv(key, &bccsp.HMACTruncated256AESDeriveKeyOpts{Temporary: true, Arg: authenticationKeyLabel})
+	if err != nil {
+		return nil, errors.Errorf("failed to derive authentication key from key %x: %s", ski, err)
+	}
+
+	c := &bccspStorageCipher{csp: csp, encKey: encKey, macKey: macKey}
+	// the size of the MAC depends on the hash function of the BCCSP
+	mac, err := c.mac(nil)
+	if err != nil {
+		return nil, err
+	}
+	c.macSize = len(mac)
+
+	return c, nil
+}
+
+// LoadStorageCipher returns the StorageCipher of the key whose SKI is hex
+// encoded in keySKI, or nil if keySKI is empty.
+func LoadStorageCipher(csp bccsp.BCCSP, keySKI string) (StorageCipher, error) {
+	if keySKI == "" {
+		return nil, nil
+	}
+
+	ski, err := hex.DecodeString(keySKI)
+	if err != nil {
+		return nil, errors.Errorf("invalid key SKI %s: %s", keySKI, err)
+	}
+
+	return NewStorageCipher(csp, ski)
+}
+
+// Encrypt encrypts plaintext with the key of the cipher, and appends the
+// MAC of the header and the ciphertext
+func (c *bccspStorageCipher) Encrypt(plaintext []byte) ([]byte, error) {
+	ciphertext, err := c.csp.Encrypt(c.encKey, plaintext, &bccsp.AESCBCPKCS7ModeOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := c.mac(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ciphertext, mac...), nil
+}
+
+// Decrypt verifies the MAC of ciphertext, and decrypts it with the key of
+// the cipher
+func (c *bccspStorageCipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < c.macSize {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	ciphertext, tag := ciphertext[:len(ciphertext)-c.macSize], ciphertext[len(ciphertext)-c.macSize:]
+	mac, err := c.mac(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, tag) {
+		return nil, errors.New("message authentication failed")
+	}
+
+	return c.csp.Decrypt(c.encKey, ciphertext, &bccsp.AESCBCPKCS7ModeOpts{})
+}
+
+// mac returns the HMAC of the header of encrypted data and ciphertext
+func (c *bccspStorageCipher) mac(ciphertext []byte) ([]byte, error) {
+	msg := append(append(append([]byte{}, encryptedDataPrefix...), encryptedDataVersion), ciphertext...)
+	key, err := c.csp.KeyDeriv(c.macKey, &bccsp.HMACDeriveKeyOpts{Temporary: true, Arg: msg})
+	if err != nil {
+		return nil, errors.Errorf("failed to compute MAC: %s", err)
+	}
+	return key.Bytes()
+}
+
+// sealData encrypts data with cipher, if any, and marks it as encrypted.
+func sealData(cipher StorageCipher, data []byte) ([]byte, error) {
+	if cipher == nil || len(data) == 0 {
+		return data, nil
+	}
+
+	ciphertext, err := cipher.Encrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(append([]byte{}, encryptedDataPrefix...), encryptedDataVersion), ciphertext...), nil
+}
+
+// openData decrypts data sealed by sealData. Data that isn't marked as
+// encrypted is only accepted when no cipher is configured, so that it cannot
+// be substituted for encrypted data.
+func openData(cipher StorageCipher, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	if !bytes.HasPrefix(data, encryptedDataPrefix) {
+		if cipher != nil {
+			return nil, errors.New("data is not encrypted, the storage must be migrated before enabling encryption")
+		}
+		return data, nil
+	}
+
+	if cipher == nil {
+		return nil, errors.New("data is encrypted but no encryption key is configured")
+	}
+
+	header := len(encryptedDataPrefix) + 1
+	if len(data) < header || data[header-1] != encryptedDataVersion {
+		return nil, errors.New("unsupported encryption format")
+	}
+
+	return cipher.Decrypt(data[header:])
+}
+
+// sealEntries returns copies of the entries with their data encrypted
+func sealEntries(cipher StorageCipher, entries []raftpb.Entry) ([]raftpb.Entry, error) {
+	if cipher == nil {
+		return entries, nil
+	}
+
+	sealed := make([]raftpb.Entry, len(entries))
+	for i, e := range entries {
+		data, err := sealData(cipher, e.Data)
+		if err != nil {
+			return nil, errors.Errorf("failed to encrypt entry at index %d: %s", e.Index, err)
+		}
+		sealed[i] = e
+		sealed[i].Data = data
+	}
+
+	return sealed, nil
+}
+
+// openEntries decrypts the data of entries in place
+func openEntries(cipher StorageCipher, entries []raftpb.Entry) error {
+	for i := range entries {
+		data, err := openData(cipher, entries[i].Data)
+		if err != nil {
+			return errors.Errorf("failed to decrypt entry at index %d: %s", entries[i].Index, err)
+		}
+		entries[i].Data = data
+	}
+
+	return nil
+}
+
+// MigrateStorage re-encrypts the etcd/raft WAL and snapshots stored in walDir
+// and snapDir. Data encrypted with from is decrypted, and then encrypted with
+// to. Either cipher may be nil, in which case data is read or written
+// unencrypted. The WAL is rebuilt from the newest snapshot, so that entries
+// preceding it, which are no longer replayed, are dropped. The storage must
+// not be in use while it is migrated.
+//
+// The migrated WAL and snapshots are first staged next to the original
+// directories and synced to disk. A marker file is then created, after which
+// the staged directories replace the original ones. If the migration is
+// interrupted before the marker exists, the original storage is left intact
+// and the staged directories are discarded. Otherwise, running MigrateStorage
+// again completes the interrupted migration, and the chain refuses to start
+// until then (see checkInterruptedMigration).
+func MigrateStorage(lg *flogging.FabricLogger, walDir, snapDir string, from, to StorageCipher) error {
+	if fileExists(migrationMarker(walDir)) {
+		lg.Infof("Completing the interrupted migration of the storage at '%s'", walDir)
+		return commitMigration(walDir, snapDir)
+	}
+	if err := discardMigration(walDir, snapDir); err != nil {
+		return err
+	}
+
+	if err := stageMigration(lg, walDir, snapDir, from, to); err != nil {
+		return err
+	}
+
+	// the staged storage is complete and durable, from now on the
+	// migration can only be rolled forward
+	if err := createFileSynced(migrationMarker(walDir)); err != nil {
+		return errors.Errorf("failed to create migration marker: %s", err)
+	}
+
+	return commitMigration(walDir, snapDir)
+}
+
+// stageMigration writes the migrated WAL and snapshots to the staging
+// directories of walDir and snapDir.
+func stageMigration(lg *flogging.FabricLogger, walDir, snapDir string, from, to StorageCipher) error {
+	if !wal.Exist(walDir) {
+		return errors.Errorf("no WAL found at %s", walDir)
+	}
+
+	sn, err := createSnapshotter(lg, snapDir)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := sn.Load()
+	if err != nil && err != snap.ErrNoSnapshot {
+		return errors.Errorf("failed to load snapshot: %s", err)
+	}
+
+	w, st, ents, err := createOrReadWAL(lg, walDir, snapshot)
+	if err != nil {
+		return errors.Errorf("failed to read WAL: %s", err)
+	}
+	if err := w.Close(); err != nil {
+		return errors.Errorf("failed to close WAL: %s", err)
+	}
+
+	if err := openEntries(from, ents); err != nil {
+		return err
+	}
+
+	if err := stageSnapshots(lg, snapDir, from, to); err != nil {
+		return err
+	}
+
+	lg.Infof("Rewriting %d WAL entries at path '%s'", len(ents), walDir)
+	ents, err = sealEntries(to, ents)
+	if err != nil {
+		return err
+	}
+
+	w, err = wal.Create(lg.Zap(), stagingDir(walDir), nil)
+	if err != nil {
+		return errors.Errorf("failed to create WAL: %s", err)
+	}
+
+	if snapshot != nil {
+		walsnap := walpb.Snapshot{Index: snapshot.Metadata.Index, Term: snapshot.Metadata.Term}
+		if err := w.SaveSnapshot(walsnap); err != nil {
+			w.Close()
+			return errors.Errorf("failed to save snapshot to WAL: %s", err)
+		}
+	}
+
+	if err := w.Save(st, ents); err != nil {
+		w.Close()
+		return errors.Errorf("failed to save entries to WAL: %s", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return errors.Errorf("failed to close WAL: %s", err)
+	}
+
+	return nil
+}
+
+// stageSnapshots re-encrypts the snapshot files in snapDir into the staging
+// directory of snapDir.
+func stageSnapshots(lg *flogging.FabricLogger, snapDir string, from, to StorageCipher) error {
+	filenames, err := ioutil.ReadDir(snapDir)
+	if err != nil {
+		return errors.Errorf("failed to read snapshot directory %s: %s", snapDir, err)
+	}
+
+	var snapfiles []string
+	for _, f := range filenames {
+		if strings.HasSuffix(f.Name(), ".snap") {
+			snapfiles = append(snapfiles, f.Name())
+		}
+	}
+	sort.Strings(snapfiles)
+
+	staged := stagingDir(snapDir)
+	if err := os.MkdirAll(staged, os.ModePerm); err != nil {
+		return errors.Errorf("failed to mkdir '%s': %s", staged, err)
+	}
+
+	migrated := snap.New(lg.Zap(), staged)
+	for _, snapfile := range snapfiles {
+		fpath := filepath.Join(snapDir, snapfile)
+		s, err := snap.Read(lg.Zap(), fpath)
+		if err != nil {
+			lg.Warnf("Skipping corrupted snapshot file %s: %s", fpath, err)
+			continue
+		}
+
+		if s.Data, err = openData(from, s.Data); err != nil {
+			return errors.Errorf("failed to decrypt snapshot file %s: %s", fpath, err)
+		}
+		if s.Data, err = sealData(to, s.Data); err != nil {
+			return errors.Errorf("failed to encrypt snapshot file %s: %s", fpath, err)
+		}
+
+		// SaveSnap syncs the snapshot file
+		if err := migrated.SaveSnap(*s); err != nil {
+			return errors.Errorf("failed to save snapshot file %s: %s", fpath, err)
+		}
+
+		lg.Debugf("Migrated snapshot file %s", fpath)
+	}
+
+	return syncDir(staged)
+}
+
+// checkInterruptedMigration returns an error if a migration of the storage
+// in walDir and snapDir was interrupted after the staged storage started to
+// replace the original one, in which case neither can be trusted until the
+// migration is completed. The leftovers of a migration interrupted earlier
+// are discarded, as the original storage is still intact.
+func checkInterruptedMigration(lg *flogging.FabricLogger, walDir, snapDir string) error {
+	if fileExists(migrationMarker(walDir)) {
+		return errors.Errorf("the migration of the storage at '%s' was interrupted, "+
+			"run \"orderer migrate-raft-storage\" again to complete it", walDir)
+	}
+
+	if fileExists(stagingDir(walDir)) || fileExists(stagingDir(snapDir)) {
+		lg.Warnf("Discarding the leftovers of an interrupted migration of the storage at '%s'", walDir)
+		return discardMigration(walDir, snapDir)
+	}
+
+	return nil
+}
+
+// commitMigration replaces the storage in walDir and snapDir with the staged
+// one, and removes the migration marker. It can be run again if interrupted.
+func commitMigration(walDir, snapDir string) error {
+	for _, dir := range []string{walDir, snapDir} {
+		if err := replaceDir(dir, stagingDir(dir)); err != nil {
+			return err
+		}
+	}
+
+	marker := migrationMarker(walDir)
+	if err := os.Remove(marker); err != nil {
+		return errors.Errorf("failed to remove '%s': %s", marker, err)
+	}
+	return syncDir(filepath.Dir(marker))
+}
+
+// discardMigration removes the staged storage of an incomplete migration
+func discardMigration(walDir, snapDir string) error {
+	for _, dir := range []string{stagingDir(walDir), stagingDir(snapDir)} {
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Errorf("failed to remove '%s': %s", dir, err)
+		}
+	}
+	return nil
+}
+
+// replaceDir replaces the directory dir with the directory newDir. If newDir
+// doesn't exist, dir is assumed to have been replaced already, and only the
+// backup of the original directory is removed.
+func replaceDir(dir, newDir string) error {
+	oldDir := filepath.Clean(dir) + ".old"
+
+	if fileExists(newDir) {
+		if fileExists(dir) {
+			if err := os.RemoveAll(oldDir); err != nil {
+				return errors.Errorf("failed to remove '%s': %s", oldDir, err)
+			}
+			if err := os.Rename(dir, oldDir); err != nil {
+				return errors.Errorf("failed to rename '%s' to '%s': %s", dir, oldDir, err)
+			}
+		}
+		if err := os.Rename(newDir, dir); err != nil {
+			return errors.Errorf("failed to rename '%s' to '%s': %s", newDir, dir, err)
+		}
+		if err := syncDir(filepath.Dir(dir)); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(oldDir); err != nil {
+		return errors.Errorf("failed to remove '%s': %s", oldDir, err)
+	}
+	return nil
+}
+
+// stagingDir returns the directory where the migrated content of dir is staged
+func stagingDir(dir string) string {
+	return filepath.Clean(dir) + ".migrated"
+}
+
+// migrationMarker returns the path of the file marking that the migration of
+// the storage whose WAL is in walDir is being committed
+func migrationMarker(walDir string) string {
+	return filepath.Clean(walDir) + ".migrating"
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// createFileSynced creates an empty file at path, and syncs it and its
+// directory to disk
+func createFileSynced(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := fileutil.Fsync(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDir syncs the directory dir to disk, so that the creation, removal and
+// renaming of its entries is durable
+func syncDir(dir string) error {
+	d, err := fileutil.OpenDir(dir)
+	if err != nil {
+		return errors.Errorf("failed to open '%s': %s", dir, err)
+	}
+	defer d.Close()
+
+	if err := fileutil.Fsync(d); err != nil {
+		return errors.Errorf("failed to sync '%s': %s", dir, err)
+	}
+	return nil
+}
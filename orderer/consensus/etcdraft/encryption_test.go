@@ -0,0 +1,258 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/wal"
+)
+
+func newTestCSP(t *testing.T) bccsp.BCCSP {
+	csp, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewInMemoryKeyStore())
+	require.NoError(t, err)
+	return csp
+}
+
+func newTestStorageCipher(t *testing.T, csp bccsp.BCCSP) StorageCipher {
+	key, err := csp.KeyGen(&bccsp.AES256KeyGenOpts{})
+	require.NoError(t, err)
+	cipher, err := LoadStorageCipher(csp, hex.EncodeToString(key.SKI()))
+	require.NoError(t, err)
+	return cipher
+}
+
+// storeEntries persists entries with index [from, to) and takes a snapshot at snapIndex
+func storeEntries(t *testing.T, from, to, snapIndex uint64) {
+	for i := from; i < to; i++ {
+		err := store.Store(
+			[]raftpb.Entry{{Index: i, Data: []byte(fmt.Sprintf("block-%d", i))}},
+			raftpb.HardState{Commit: i},
+			raftpb.Snapshot{},
+		)
+		require.NoError(t, err)
+	}
+	err := store.TakeSnapshot(snapIndex, raftpb.ConfState{Nodes: []uint64{1}}, []byte(fmt.Sprintf("snapshot-%d", snapIndex)))
+	require.NoError(t, err)
+}
+
+// assertStorage asserts the data restored from disk with cipher
+func assertStorage(t *testing.T, cipher StorageCipher, snapIndex, last uint64) {
+	ram := raft.NewMemoryStorage()
+	s, err := CreateStorage(logger, walDir, snapDir, ram, cipher)
+	require.NoError(t, err)
+	defer s.Close()
+
+	snapshot, err := ram.Snapshot()
+	assert.NoError(t, err)
+	assert.Equal(t, snapIndex, snapshot.Metadata.Index)
+	assert.Equal(t, []byte(fmt.Sprintf("snapshot-%d", snapIndex)), snapshot.Data)
+
+	ents, err := ram.Entries(snapIndex+1, last+1, ^uint64(0))
+	assert.NoError(t, err)
+	require.Len(t, ents, int(last-snapIndex))
+	for _, e := range ents {
+		assert.Equal(t, []byte(fmt.Sprintf("block-%d", e.Index)), e.Data)
+	}
+}
+
+// assertPlaintextOnDisk asserts whether the given plaintext appears in the files of dir
+func assertPlaintextOnDisk(t *testing.T, dir string, plaintext string, expected bool) {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	var found bool
+	for _, f := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		found = found || bytes.Contains(content, []byte(plaintext))
+	}
+	assert.Equal(t, expected, found)
+}
+
+func TestLoadStorageCipher(t *testing.T) {
+	csp := newTestCSP(t)
+
+	cipher, err := LoadStorageCipher(csp, "")
+	assert.NoError(t, err)
+	assert.Nil(t, cipher)
+
+	_, err = LoadStorageCipher(csp, "not hex")
+	assert.Contains(t, err.Error(), "invalid key SKI not hex")
+
+	_, err = LoadStorageCipher(csp, "0102")
+	assert.Contains(t, err.Error(), "failed to get key 0102")
+
+	ecKey, err := csp.KeyGen(&bccsp.ECDSAKeyGenOpts{})
+	require.NoError(t, err)
+	_, err = NewStorageCipher(csp, ecKey.SKI())
+	assert.EqualError(t, err, fmt.Sprintf("key %x is not a symmetric secret key", ecKey.SKI()))
+
+	cipher = newTestStorageCipher(t, csp)
+	sealed, err := sealData(cipher, []byte("block"))
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(sealed, encryptedDataPrefix))
+	assert.NotContains(t, string(sealed), "block")
+
+	data, err := openData(cipher, sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("block"), data)
+
+	_, err = openData(nil, sealed)
+	assert.EqualError(t, err, "data is encrypted but no encryption key is configured")
+
+	_, err = openData(cipher, []byte("block"))
+	assert.EqualError(t, err, "data is not encrypted, the storage must be migrated before enabling encryption")
+
+	// tampered data and data encrypted with another key are rejected
+	tampered := append([]byte{}, sealed...)
+	tampered[len(encryptedDataPrefix)+1] ^= 1
+	_, err = openData(cipher, tampered)
+	assert.EqualError(t, err, "message authentication failed")
+
+	_, err = openData(newTestStorageCipher(t, csp), sealed)
+	assert.EqualError(t, err, "message authentication failed")
+
+	_, err = openData(cipher, sealed[:len(encryptedDataPrefix)+4])
+	assert.EqualError(t, err, "ciphertext is too short")
+
+	unsupported := append([]byte{}, sealed...)
+	unsupported[len(encryptedDataPrefix)] = 0x01
+	_, err = openData(cipher, unsupported)
+	assert.EqualError(t, err, "unsupported encryption format")
+}
+
+func TestEncryptedStorage(t *testing.T) {
+	csp := newTestCSP(t)
+	cipher := newTestStorageCipher(t, csp)
+
+	t.Run("Encrypted", func(t *testing.T) {
+		setup(t)
+		defer clean(t)
+		require.NoError(t, store.Close())
+		store, err = CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), cipher)
+		require.NoError(t, err)
+
+		entries := []raftpb.Entry{{Index: 1, Data: []byte("block-1")}}
+		require.NoError(t, store.Store(entries, raftpb.HardState{Commit: 1}, raftpb.Snapshot{}))
+		// the entries of the caller are left in plaintext
+		assert.Equal(t, []byte("block-1"), entries[0].Data)
+		storeEntries(t, 2, 6, 3)
+
+		assertPlaintextOnDisk(t, walDir, "block-", false)
+		assertPlaintextOnDisk(t, snapDir, "snapshot-", false)
+		assert.Equal(t, []byte("snapshot-3"), store.Snapshot().Data)
+
+		require.NoError(t, store.Close())
+		assertStorage(t, cipher, 3, 5)
+
+		_, err := CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), nil)
+		assert.Contains(t, err.Error(), "failed to decrypt snapshot: data is encrypted but no encryption key is configured")
+
+		store, err = CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), cipher)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unencrypted data is rejected", func(t *testing.T) {
+		setup(t)
+		defer clean(t)
+
+		storeEntries(t, 1, 6, 3)
+		assertPlaintextOnDisk(t, walDir, "block-5", true)
+
+		require.NoError(t, store.Close())
+		_, err := CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), cipher)
+		assert.Contains(t, err.Error(), "failed to decrypt snapshot: data is not encrypted")
+
+		store, err = CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestMigrateStorage(t *testing.T) {
+	csp := newTestCSP(t)
+	cipher := newTestStorageCipher(t, csp)
+	otherCipher := newTestStorageCipher(t, csp)
+
+	setup(t)
+	defer clean(t)
+
+	storeEntries(t, 1, 6, 3)
+	require.NoError(t, store.Close())
+
+	t.Logf("Encrypt the unencrypted storage")
+	require.NoError(t, MigrateStorage(logger, walDir, snapDir, nil, cipher))
+	assertPlaintextOnDisk(t, walDir, "block-", false)
+	assertPlaintextOnDisk(t, snapDir, "snapshot-", false)
+	assertStorage(t, cipher, 3, 5)
+
+	t.Logf("Rotate the encryption key")
+	require.NoError(t, MigrateStorage(logger, walDir, snapDir, cipher, otherCipher))
+	assertStorage(t, otherCipher, 3, 5)
+
+	t.Logf("Decrypt the storage")
+	require.NoError(t, MigrateStorage(logger, walDir, snapDir, otherCipher, nil))
+	assertPlaintextOnDisk(t, walDir, "block-5", true)
+	assertStorage(t, nil, 3, 5)
+
+	err := MigrateStorage(logger, filepath.Join(dataDir, "missing"), snapDir, nil, cipher)
+	assert.EqualError(t, err, fmt.Sprintf("no WAL found at %s", filepath.Join(dataDir, "missing")))
+
+	store, err = CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), nil)
+	require.NoError(t, err)
+}
+
+func TestInterruptedMigration(t *testing.T) {
+	csp := newTestCSP(t)
+	cipher := newTestStorageCipher(t, csp)
+
+	setup(t)
+	defer clean(t)
+
+	storeEntries(t, 1, 6, 3)
+	require.NoError(t, store.Close())
+
+	t.Logf("Interrupted while staging")
+	require.NoError(t, stageMigration(logger, walDir, snapDir, nil, cipher))
+	require.NoError(t, checkInterruptedMigration(logger, walDir, snapDir))
+	assert.False(t, fileExists(stagingDir(walDir)))
+	assert.False(t, fileExists(stagingDir(snapDir)))
+	assertStorage(t, nil, 3, 5)
+
+	t.Logf("Interrupted while replacing the storage")
+	require.NoError(t, stageMigration(logger, walDir, snapDir, nil, cipher))
+	require.NoError(t, createFileSynced(migrationMarker(walDir)))
+	require.NoError(t, os.Rename(walDir, walDir+".old"))
+	assert.False(t, wal.Exist(walDir))
+
+	err := checkInterruptedMigration(logger, walDir, snapDir)
+	assert.EqualError(t, err, fmt.Sprintf("the migration of the storage at '%s' was interrupted, "+
+		"run \"orderer migrate-raft-storage\" again to complete it", walDir))
+
+	// the interrupted migration is completed regardless of the ciphers
+	require.NoError(t, MigrateStorage(logger, walDir, snapDir, nil, nil))
+	require.NoError(t, checkInterruptedMigration(logger, walDir, snapDir))
+	assert.False(t, fileExists(walDir+".old"))
+	assert.False(t, fileExists(snapDir+".old"))
+	assertPlaintextOnDisk(t, walDir, "block-", false)
+	assertStorage(t, cipher, 3, 5)
+
+	store, err = CreateStorage(logger, walDir, snapDir, raft.NewMemoryStorage(), cipher)
+	require.NoError(t, err)
+}
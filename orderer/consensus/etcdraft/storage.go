@@ -57,17 +57,22 @@ type RaftStorage struct {
 	wal  *wal.WAL
 	snap *snap.Snapshotter
 
+	// encrypts the data of entries and snapshots on disk, if not nil
+	cipher StorageCipher
+
 	// a queue that keeps track of indices of snapshots on disk
 	snapshotIndex []uint64
 }
 
 // CreateStorage attempts to create a storage to persist etcd/raft data.
 // If data presents in specified disk, they are loaded to reconstruct storage state.
+// If cipher is not nil, the data of entries and snapshots is encrypted on disk.
 func CreateStorage(
 	lg *flogging.FabricLogger,
 	walDir string,
 	snapDir string,
 	ram MemoryStorage,
+	cipher StorageCipher,
 ) (*RaftStorage, error) {
 
 	sn, err := createSnapshotter(lg, snapDir)
@@ -86,6 +91,10 @@ func CreateStorage(
 		// snapshot found
 		lg.Debugf("Loaded snapshot at Term %d and Index %d, Nodes: %+v",
 			snapshot.Metadata.Term, snapshot.Metadata.Index, snapshot.Metadata.ConfState.Nodes)
+
+		if snapshot.Data, err = openData(cipher, snapshot.Data); err != nil {
+			return nil, errors.Errorf("failed to decrypt snapshot: %s", err)
+		}
 	}
 
 	w, st, ents, err := createOrReadWAL(lg, walDir, snapshot)
@@ -93,6 +102,11 @@ func CreateStorage(
 		return nil, errors.Errorf("failed to create or read WAL: %s", err)
 	}
 
+	if err := openEntries(cipher, ents); err != nil {
+		w.Close()
+		return nil, errors.Errorf("failed to decrypt WAL: %s", err)
+	}
+
 	if snapshot != nil {
 		lg.Debugf("Applying snapshot to raft MemoryStorage")
 		if err := ram.ApplySnapshot(*snapshot); err != nil {
@@ -111,6 +125,7 @@ func CreateStorage(
 		ram:           ram,
 		wal:           w,
 		snap:          sn,
+		cipher:        cipher,
 		walDir:        walDir,
 		snapDir:       snapDir,
 		snapshotIndex: ListSnapshots(lg, snapDir),
@@ -242,7 +257,12 @@ func (rs *RaftStorage) Snapshot() raftpb.Snapshot {
 
 // Store persists etcd/raft data
 func (rs *RaftStorage) Store(entries []raftpb.Entry, hardstate raftpb.HardState, snapshot raftpb.Snapshot) error {
-	if err := rs.wal.Save(hardstate, entries); err != nil {
+	sealed, err := sealEntries(rs.cipher, entries)
+	if err != nil {
+		return err
+	}
+
+	if err := rs.wal.Save(hardstate, sealed); err != nil {
 		return err
 	}
 
@@ -283,6 +303,12 @@ func (rs *RaftStorage) saveSnap(snap raftpb.Snapshot) error {
 		return errors.Errorf("failed to save snapshot to WAL: %s", err)
 	}
 
+	data, err := sealData(rs.cipher, snap.Data)
+	if err != nil {
+		return errors.Errorf("failed to encrypt snapshot: %s", err)
+	}
+	snap.Data = data
+
 	if err := rs.snap.SaveSnap(snap); err != nil {
 		return errors.Errorf("failed to save snapshot to disk: %s", err)
 	}
@@ -291,7 +291,7 @@ func startThread(chain *chainImpl) {
 	var err error
 
 	// Create topic if it does not exist (requires Kafka v0.10.1.0)
-	err = setupTopicForChannel(chain.consenter.retryOptions(), chain.haltChan, chain.SharedConfig().KafkaBrokers(), chain.consenter.brokerConfig(), chain.consenter.topicDetail(), chain.channel)
+	err = setupTopicForChannel(chain.consenter.retryOptions(), chain.haltChan, chain.SharedConfig().KafkaBrokers(), chain.consenter.brokerConfig(), chain.consenter.topicDetail(chain.ChainID()), chain.channel)
 	if err != nil {
 		// log for now and fallback to auto create topics setting for broker
 		logger.Infof("[channel: %s]: failed to create Kafka topic = %s", chain.channel.topic(), err)
@@ -624,8 +624,9 @@ func (chain *chainImpl) processRegular(regularMessage *ab.KafkaMessageRegular, r
 			chain.timer = nil
 		case chain.timer == nil && pending:
 			// Timer is not already running and there are messages pending, so start it
-			chain.timer = time.After(chain.SharedConfig().BatchTimeout())
-			logger.Debugf("[channel: %s] Just began %s batch timer", chain.ChainID(), chain.SharedConfig().BatchTimeout().String())
+			batchTimeout := chain.BlockCutter().BatchTimeout(chain.SharedConfig())
+			chain.timer = time.After(batchTimeout)
+			logger.Debugf("[channel: %s] Just began %s batch timer", chain.ChainID(), batchTimeout.String())
 		default:
 			// Do nothing when:
 			// 1. Timer is already running and there are messages pending
@@ -904,7 +905,7 @@ func (chain *chainImpl) processTimeToCut(ttcMessage *ab.KafkaMessageTimeToCut, r
 	if ttcNumber == chain.lastCutBlockNumber+1 {
 		chain.timer = nil
 		logger.Debugf("[channel: %s] Nil'd the timer", chain.ChainID())
-		batch := chain.BlockCutter().Cut()
+		batch := chain.BlockCutter().CutOnTimeout()
 		if len(batch) == 0 {
 			return fmt.Errorf("got right time-to-cut message (for block [%d]),"+
 				" no pending requests though; this might indicate a bug", chain.lastCutBlockNumber+1)
@@ -1089,6 +1090,10 @@ func setupTopicForChannel(retryOptions localconfig.Retry, haltChan chan struct{}
 					if topic.Name == channel.topic() {
 						if topic.Err != sarama.ErrUnknownTopicOrPartition {
 							// auto create topics must be enabled so return
+							if err := validateTopic(topic, topicDetail); err != nil {
+								logger.Warningf("[channel: %s] Existing topic does not match the configured topic settings: %s",
+									channel.topic(), err)
+							}
 							return nil
 						}
 					}
@@ -1103,6 +1108,12 @@ func setupTopicForChannel(retryOptions localconfig.Retry, haltChan chan struct{}
 					channel.topic())
 			}
 
+			if int(topicDetail.ReplicationFactor) > len(clusterMembers) {
+				return fmt.Errorf(
+					"error creating topic [%s]; replication factor %d exceeds the %d brokers of the cluster",
+					channel.topic(), topicDetail.ReplicationFactor, len(clusterMembers))
+			}
+
 			// get the controller
 			controller := clusterMembers[controllerId]
 			err = controller.Open(brokerConfig)
@@ -1155,6 +1166,26 @@ func setupTopicForChannel(retryOptions localconfig.Retry, haltChan chan struct{}
 	return setupTopic.retry()
 }
 
+// validateTopic returns an error if an existing topic does not match the
+// configured topic settings, which only apply to the topics the orderer
+// creates
+func validateTopic(topic *sarama.TopicMetadata, topicDetail *sarama.TopicDetail) error {
+	if topic.Err != sarama.ErrNoError {
+		return nil
+	}
+	if int32(len(topic.Partitions)) < topicDetail.NumPartitions {
+		return fmt.Errorf("topic has %d partitions, fewer than the %d configured",
+			len(topic.Partitions), topicDetail.NumPartitions)
+	}
+	for _, partition := range topic.Partitions {
+		if len(partition.Replicas) != int(topicDetail.ReplicationFactor) {
+			return fmt.Errorf("partition %d has %d replicas, the configured replication factor is %d",
+				partition.ID, len(partition.Replicas), topicDetail.ReplicationFactor)
+		}
+	}
+	return nil
+}
+
 // Replica ID information can accurately be retrieved only when the cluster
 // is healthy. Otherwise, the replica request does not return the full set
 // of initial replicas. This information is needed to provide context when
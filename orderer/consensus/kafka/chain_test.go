@@ -62,7 +62,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 		mockSupport = &mockmultichannel.ConsenterSupport{
@@ -213,7 +213,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -237,7 +237,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -266,7 +266,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -287,7 +287,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -320,7 +320,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -354,7 +354,7 @@ func TestChain(t *testing.T) {
 			"OffsetRequest": sarama.NewMockOffsetResponse(t).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 				SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 				SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 		})
 
@@ -401,7 +401,7 @@ func TestChain(t *testing.T) {
 				"OffsetRequest": sarama.NewMockOffsetResponse(t).
 					SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 					SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-				"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+				"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 					SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 			})
 
@@ -444,7 +444,7 @@ func TestChain(t *testing.T) {
 				"OffsetRequest": sarama.NewMockOffsetResponse(t).
 					SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 					SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-				"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+				"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 					SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 			})
 
@@ -607,7 +607,7 @@ func TestSetupTopicForChannel(t *testing.T) {
 				test.brokerConfig,
 				&sarama.TopicDetail{
 					NumPartitions:     1,
-					ReplicationFactor: 2},
+					ReplicationFactor: 1},
 				mockChannel)
 			if test.expectErr {
 				assert.Contains(t, err.Error(), test.errorMsg)
@@ -617,6 +617,75 @@ func TestSetupTopicForChannel(t *testing.T) {
 		})
 	}
 
+	t.Run("Replication Factor Exceeds Brokers", func(t *testing.T) {
+		brokerConfig := sarama.NewConfig()
+		brokerConfig.Version = sarama.V0_10_2_0
+		err := setupTopicForChannel(
+			mockRetryOptions,
+			haltChan,
+			[]string{mockBrokerNoError.Addr()},
+			brokerConfig,
+			&sarama.TopicDetail{
+				NumPartitions:     1,
+				ReplicationFactor: 2},
+			mockChannel)
+		assert.EqualError(t, err, fmt.Sprintf("error creating topic [%s]; replication factor 2 exceeds the 1 brokers of the cluster", mockChannel.topic()))
+	})
+}
+
+func TestValidateTopic(t *testing.T) {
+	topicDetail := &sarama.TopicDetail{NumPartitions: 2, ReplicationFactor: 3}
+
+	testCases := []struct {
+		name        string
+		topic       *sarama.TopicMetadata
+		expectedErr string
+	}{
+		{
+			name: "Matching",
+			topic: &sarama.TopicMetadata{
+				Partitions: []*sarama.PartitionMetadata{
+					{ID: 0, Replicas: []int32{0, 1, 2}},
+					{ID: 1, Replicas: []int32{0, 1, 2}},
+				},
+			},
+		},
+		{
+			name: "Fewer Partitions",
+			topic: &sarama.TopicMetadata{
+				Partitions: []*sarama.PartitionMetadata{
+					{ID: 0, Replicas: []int32{0, 1, 2}},
+				},
+			},
+			expectedErr: "topic has 1 partitions, fewer than the 2 configured",
+		},
+		{
+			name: "Different Replication Factor",
+			topic: &sarama.TopicMetadata{
+				Partitions: []*sarama.PartitionMetadata{
+					{ID: 0, Replicas: []int32{0, 1, 2}},
+					{ID: 1, Replicas: []int32{0}},
+				},
+			},
+			expectedErr: "partition 1 has 1 replicas, the configured replication factor is 3",
+		},
+		{
+			name:  "Topic Error",
+			topic: &sarama.TopicMetadata{Err: sarama.ErrLeaderNotAvailable},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTopic(tc.topic, topicDetail)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestSetupProducerForChannel(t *testing.T) {
@@ -634,7 +703,7 @@ func TestSetupProducerForChannel(t *testing.T) {
 	t.Run("Proper", func(t *testing.T) {
 		metadataResponse := new(sarama.MetadataResponse)
 		metadataResponse.AddBroker(mockBroker.Addr(), mockBroker.BrokerID())
-		metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, sarama.ErrNoError)
+		metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, nil, sarama.ErrNoError)
 		mockBroker.Returns(metadataResponse)
 
 		producer, err := setupProducerForChannel(mockConsenter.retryOptions(), haltChan, []string{mockBroker.Addr()}, mockBrokerConfig, mockChannel)
@@ -660,7 +729,7 @@ func TestGetHealthyClusterReplicaInfo(t *testing.T) {
 		ids := []int32{int32(1), int32(2)}
 		metadataResponse := new(sarama.MetadataResponse)
 		metadataResponse.AddBroker(mockBroker.Addr(), mockBroker.BrokerID())
-		metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), ids, nil, sarama.ErrNoError)
+		metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), ids, nil, nil, sarama.ErrNoError)
 		mockBroker.Returns(metadataResponse)
 
 		replicaIDs, err := getHealthyClusterReplicaInfo(mockConsenter.retryOptions(), haltChan, []string{mockBroker.Addr()}, mockBrokerConfig, mockChannel)
@@ -693,7 +762,7 @@ func TestSetupConsumerForChannel(t *testing.T) {
 		"OffsetRequest": sarama.NewMockOffsetResponse(t).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 			SetMessage(mockChannel.topic(), mockChannel.partition(), startFrom, message),
 	})
 
@@ -751,7 +820,7 @@ func TestCloseKafkaObjects(t *testing.T) {
 		"OffsetRequest": sarama.NewMockOffsetResponse(t).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 			SetMessage(mockChannel.topic(), mockChannel.partition(), startFrom, message),
 	})
 
@@ -885,7 +954,7 @@ func TestSendConnectMessage(t *testing.T) {
 
 	metadataResponse := new(sarama.MetadataResponse)
 	metadataResponse.AddBroker(mockBroker.Addr(), mockBroker.BrokerID())
-	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, sarama.ErrNoError)
+	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, nil, sarama.ErrNoError)
 	mockBroker.Returns(metadataResponse)
 
 	producer, _ := sarama.NewSyncProducer([]string{mockBroker.Addr()}, mockBrokerConfig)
@@ -924,7 +993,7 @@ func TestSendTimeToCut(t *testing.T) {
 
 	metadataResponse := new(sarama.MetadataResponse)
 	metadataResponse.AddBroker(mockBroker.Addr(), mockBroker.BrokerID())
-	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, sarama.ErrNoError)
+	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, nil, sarama.ErrNoError)
 	mockBroker.Returns(metadataResponse)
 
 	producer, err := sarama.NewSyncProducer([]string{mockBroker.Addr()}, mockBrokerConfig)
@@ -969,7 +1038,7 @@ func TestProcessMessagesToBlocks(t *testing.T) {
 
 	metadataResponse := new(sarama.MetadataResponse)
 	metadataResponse.AddBroker(mockBroker.Addr(), mockBroker.BrokerID())
-	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, sarama.ErrNoError)
+	metadataResponse.AddTopicPartition(mockChannel.topic(), mockChannel.partition(), mockBroker.BrokerID(), nil, nil, nil, sarama.ErrNoError)
 	mockBroker.Returns(metadataResponse)
 
 	producer, _ := sarama.NewSyncProducer([]string{mockBroker.Addr()}, mockBrokerConfig)
@@ -3422,13 +3491,13 @@ func TestDeliverSession(t *testing.T) {
 				SetOffset(topic, partition, sarama.OffsetOldest, 0).
 				SetOffset(topic, partition, sarama.OffsetNewest, nextOffset),
 			// respond to fetch requests with empty response while starting up
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1),
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1),
 		})
 
 		// configure broker2 with a default fetch request response
 		broker2.SetHandlerByMap(map[string]sarama.MockResponse{
 			// respond to fetch requests with empty response while starting up
-			"FetchRequest": sarama.NewMockFetchResponse(t, 1),
+			"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1),
 		})
 
 		// setup mock blockcutter
@@ -3510,7 +3579,7 @@ func TestDeliverSession(t *testing.T) {
 		})
 
 		// send a few messages from broker1
-		fetchResponse1 := sarama.NewMockFetchResponse(t, 1)
+		fetchResponse1 := sarama.NewMockFetchResponse(t, 1).SetVersion(1)
 		for i := 0; i < 5; i++ {
 			fetchResponse1.SetMessage(env.topic, env.partition, env.nextOffset, env.testMsg)
 			env.nextOffset++
@@ -3529,7 +3598,7 @@ func TestDeliverSession(t *testing.T) {
 		}
 
 		// prepare broker2 to send a few messages
-		fetchResponse2 := sarama.NewMockFetchResponse(t, 1)
+		fetchResponse2 := sarama.NewMockFetchResponse(t, 1).SetVersion(1)
 		for i := 0; i < 5; i++ {
 			fetchResponse2.SetMessage(env.topic, env.partition, env.nextOffset, env.testMsg)
 			env.nextOffset++
@@ -3661,7 +3730,7 @@ func TestDeliverSession(t *testing.T) {
 		})
 
 		metadataResponse := new(sarama.MetadataResponse)
-		metadataResponse.AddTopicPartition(env.topic, env.partition, -1, []int32{}, []int32{}, sarama.ErrBrokerNotAvailable)
+		metadataResponse.AddTopicPartition(env.topic, env.partition, -1, []int32{}, []int32{}, []int32{}, sarama.ErrBrokerNotAvailable)
 
 		// configure seed broker to return error on metadata request, otherwise the
 		// consumer client will keep 'subscribing' successfully to the topic/partition
@@ -3747,6 +3816,16 @@ func (r *mockReceiver) Cut() []*cb.Envelope {
 	return args.Get(0).([]*cb.Envelope)
 }
 
+func (r *mockReceiver) CutOnTimeout() []*cb.Envelope {
+	args := r.Called()
+	return args.Get(0).([]*cb.Envelope)
+}
+
+func (r *mockReceiver) BatchTimeout(ordererConfig channelconfig.Orderer) time.Duration {
+	args := r.Called(ordererConfig)
+	return args.Get(0).(time.Duration)
+}
+
 type mockConsenterSupport struct {
 	mock.Mock
 }
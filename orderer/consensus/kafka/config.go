@@ -18,6 +18,7 @@ import (
 func newBrokerConfig(
 	tlsConfig localconfig.TLS,
 	saslPlain localconfig.SASLPlain,
+	saslSCRAM localconfig.SASLSCRAM,
 	retryOptions localconfig.Retry,
 	kafkaVersion sarama.KafkaVersion,
 	chosenStaticPartition int32) *sarama.Config {
@@ -65,6 +66,13 @@ func newBrokerConfig(
 		brokerConfig.Net.SASL.User = saslPlain.User
 		brokerConfig.Net.SASL.Password = saslPlain.Password
 	}
+	if saslSCRAM.Enabled {
+		brokerConfig.Net.SASL.Enable = true
+		brokerConfig.Net.SASL.Mechanism = sarama.SASLMechanism(saslSCRAM.Mechanism)
+		brokerConfig.Net.SASL.User = saslSCRAM.User
+		brokerConfig.Net.SASL.Password = saslSCRAM.Password
+		brokerConfig.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(saslSCRAM.Mechanism)
+	}
 
 	// Set equivalent of Kafka producer config max.request.bytes to the default
 	// value of a Kafka broker's socket.request.max.bytes property (100 MiB).
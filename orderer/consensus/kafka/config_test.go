@@ -43,6 +43,7 @@ func TestBrokerConfig(t *testing.T) {
 		mockBrokerConfig2 := newBrokerConfig(
 			mockLocalConfig.General.TLS,
 			mockLocalConfig.Kafka.SASLPlain,
+			mockLocalConfig.Kafka.SASLSCRAM,
 			mockLocalConfig.Kafka.Retry,
 			mockLocalConfig.Kafka.Version,
 			differentPartition)
@@ -91,6 +92,7 @@ func TestBrokerConfigTLSConfigEnabled(t *testing.T) {
 			RootCAs:     []string{caPublicKey},
 		},
 			mockLocalConfig.Kafka.SASLPlain,
+			mockLocalConfig.Kafka.SASLSCRAM,
 			mockLocalConfig.Kafka.Retry,
 			mockLocalConfig.Kafka.Version,
 			defaultPartition)
@@ -111,6 +113,7 @@ func TestBrokerConfigTLSConfigEnabled(t *testing.T) {
 			RootCAs:     []string{caPublicKey},
 		},
 			mockLocalConfig.Kafka.SASLPlain,
+			mockLocalConfig.Kafka.SASLSCRAM,
 			mockLocalConfig.Kafka.Retry,
 			mockLocalConfig.Kafka.Version,
 			defaultPartition)
@@ -133,6 +136,7 @@ func TestBrokerConfigTLSConfigBadCert(t *testing.T) {
 				RootCAs:     []string{caPublicKey},
 			},
 				mockLocalConfig.Kafka.SASLPlain,
+				mockLocalConfig.Kafka.SASLSCRAM,
 				mockLocalConfig.Kafka.Retry,
 				mockLocalConfig.Kafka.Version,
 				defaultPartition)
@@ -147,6 +151,7 @@ func TestBrokerConfigTLSConfigBadCert(t *testing.T) {
 				RootCAs:     []string{caPublicKey},
 			},
 				mockLocalConfig.Kafka.SASLPlain,
+				mockLocalConfig.Kafka.SASLSCRAM,
 				mockLocalConfig.Kafka.Retry,
 				mockLocalConfig.Kafka.Version,
 				defaultPartition)
@@ -161,6 +166,7 @@ func TestBrokerConfigTLSConfigBadCert(t *testing.T) {
 				RootCAs:     []string{"TRASH"},
 			},
 				mockLocalConfig.Kafka.SASLPlain,
+				mockLocalConfig.Kafka.SASLSCRAM,
 				mockLocalConfig.Kafka.Retry,
 				mockLocalConfig.Kafka.Version,
 				defaultPartition)
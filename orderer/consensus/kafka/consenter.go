@@ -32,23 +32,40 @@ func New(config localconfig.Kafka, metricsProvider metrics.Provider, healthCheck
 	brokerConfig := newBrokerConfig(
 		config.TLS,
 		config.SASLPlain,
+		config.SASLSCRAM,
 		config.Retry,
 		config.Version,
 		defaultPartition)
 
 	metrics := NewMetrics(metricsProvider, brokerConfig.MetricRegistry)
 
+	channelTopicDetails := map[string]*sarama.TopicDetail{}
+	for channelID, channelTopic := range config.Topic.Channels {
+		topicDetail := &sarama.TopicDetail{
+			NumPartitions:     config.Topic.NumPartitions,
+			ReplicationFactor: config.Topic.ReplicationFactor,
+		}
+		if channelTopic.NumPartitions > 0 {
+			topicDetail.NumPartitions = channelTopic.NumPartitions
+		}
+		if channelTopic.ReplicationFactor > 0 {
+			topicDetail.ReplicationFactor = channelTopic.ReplicationFactor
+		}
+		channelTopicDetails[channelID] = topicDetail
+	}
+
 	return &consenterImpl{
 		brokerConfigVal: brokerConfig,
 		tlsConfigVal:    config.TLS,
 		retryOptionsVal: config.Retry,
 		kafkaVersionVal: config.Version,
 		topicDetailVal: &sarama.TopicDetail{
-			NumPartitions:     1,
+			NumPartitions:     config.Topic.NumPartitions,
 			ReplicationFactor: config.Topic.ReplicationFactor,
 		},
-		healthChecker: healthChecker,
-		metrics:       metrics,
+		channelTopicDetailsVal: channelTopicDetails,
+		healthChecker:          healthChecker,
+		metrics:                metrics,
 	}, metrics
 }
 
@@ -64,6 +81,10 @@ type consenterImpl struct {
 	metricsProvider metrics.Provider
 	healthChecker   healthChecker
 	metrics         *Metrics
+
+	// channelTopicDetailsVal holds the topic details of the channels whose
+	// topic settings override the topicDetailVal ones
+	channelTopicDetailsVal map[string]*sarama.TopicDetail
 }
 
 // HandleChain creates/returns a reference to a consensus.Chain object for the
@@ -88,7 +109,7 @@ func (consenter *consenterImpl) HandleChain(support consensus.ConsenterSupport,
 type commonConsenter interface {
 	brokerConfig() *sarama.Config
 	retryOptions() localconfig.Retry
-	topicDetail() *sarama.TopicDetail
+	topicDetail(channelID string) *sarama.TopicDetail
 	Metrics() *Metrics
 }
 
@@ -104,6 +125,9 @@ func (consenter *consenterImpl) retryOptions() localconfig.Retry {
 	return consenter.retryOptionsVal
 }
 
-func (consenter *consenterImpl) topicDetail() *sarama.TopicDetail {
+func (consenter *consenterImpl) topicDetail(channelID string) *sarama.TopicDetail {
+	if topicDetail, ok := consenter.channelTopicDetailsVal[channelID]; ok {
+		return topicDetail
+	}
 	return consenter.topicDetailVal
 }
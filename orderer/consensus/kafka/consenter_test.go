@@ -60,6 +60,7 @@ func init() {
 	mockBrokerConfig = newMockBrokerConfig(
 		mockLocalConfig.General.TLS,
 		mockLocalConfig.Kafka.SASLPlain,
+		mockLocalConfig.Kafka.SASLSCRAM,
 		mockLocalConfig.Kafka.Retry,
 		mockLocalConfig.Kafka.Version,
 		defaultPartition)
@@ -76,6 +77,24 @@ func TestNew(t *testing.T) {
 	_ = consensus.Consenter(c)
 }
 
+func TestTopicDetail(t *testing.T) {
+	kafkaConfig := mockLocalConfig.Kafka
+	kafkaConfig.Topic = localconfig.Topic{
+		ReplicationFactor: 3,
+		NumPartitions:     1,
+		Channels: map[string]localconfig.ChannelTopic{
+			"critical":   {ReplicationFactor: 5},
+			"partitions": {NumPartitions: 2},
+		},
+	}
+	c, _ := New(kafkaConfig, &disabled.Provider{}, &mock.HealthChecker{})
+	consenter := c.(*consenterImpl)
+
+	assert.Equal(t, &sarama.TopicDetail{NumPartitions: 1, ReplicationFactor: 3}, consenter.topicDetail("other"))
+	assert.Equal(t, &sarama.TopicDetail{NumPartitions: 1, ReplicationFactor: 5}, consenter.topicDetail("critical"))
+	assert.Equal(t, &sarama.TopicDetail{NumPartitions: 2, ReplicationFactor: 3}, consenter.topicDetail("partitions"))
+}
+
 func TestHandleChain(t *testing.T) {
 	consenter, _ := New(mockLocalConfig.Kafka, &disabled.Provider{}, &mock.HealthChecker{})
 
@@ -95,7 +114,7 @@ func TestHandleChain(t *testing.T) {
 		"OffsetRequest": sarama.NewMockOffsetResponse(t).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetOldest, oldestOffset).
 			SetOffset(mockChannel.topic(), mockChannel.partition(), sarama.OffsetNewest, newestOffset),
-		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).SetVersion(1).
 			SetMessage(mockChannel.topic(), mockChannel.partition(), newestOffset, message),
 	})
 
@@ -128,6 +147,7 @@ func extractEncodedOffset(marshalledOrdererMetadata []byte) int64 {
 func newMockBrokerConfig(
 	tlsConfig localconfig.TLS,
 	saslPlain localconfig.SASLPlain,
+	saslSCRAM localconfig.SASLSCRAM,
 	retryOptions localconfig.Retry,
 	kafkaVersion sarama.KafkaVersion,
 	chosenStaticPartition int32) *sarama.Config {
@@ -135,6 +155,7 @@ func newMockBrokerConfig(
 	brokerConfig := newBrokerConfig(
 		tlsConfig,
 		saslPlain,
+		saslSCRAM,
 		retryOptions,
 		kafkaVersion,
 		chosenStaticPartition)
@@ -0,0 +1,58 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kafka
+
+import (
+	"crypto/sha512"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+)
+
+// scramClient adapts a SCRAM client conversation (RFC 5802) to the
+// sarama.SCRAMClient interface.
+type scramClient struct {
+	hashGenerator scram.HashGeneratorFcn
+	// nonceGenerator, when set, replaces the random client nonce
+	nonceGenerator scram.NonceGeneratorFcn
+	conversation   *scram.ClientConversation
+}
+
+// newSCRAMClientGenerator returns a generator of the SCRAM clients of the
+// given mechanism
+func newSCRAMClientGenerator(mechanism string) func() sarama.SCRAMClient {
+	hashGenerator := scram.HashGeneratorFcn(sha512.New)
+	if mechanism == sarama.SASLTypeSCRAMSHA256 {
+		hashGenerator = scram.SHA256
+	}
+	return func() sarama.SCRAMClient {
+		return &scramClient{hashGenerator: hashGenerator}
+	}
+}
+
+// Begin prepares the client for the exchange
+func (sc *scramClient) Begin(userName, password, authzID string) error {
+	client, err := sc.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	if sc.nonceGenerator != nil {
+		client = client.WithNonceGenerator(sc.nonceGenerator)
+	}
+	sc.conversation = client.NewConversation()
+	return nil
+}
+
+// Step returns the client message answering the server challenge
+func (sc *scramClient) Step(challenge string) (string, error) {
+	return sc.conversation.Step(challenge)
+}
+
+// Done returns whether the exchange is over
+func (sc *scramClient) Done() bool {
+	return sc.conversation.Done()
+}
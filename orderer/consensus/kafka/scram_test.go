@@ -0,0 +1,169 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	localconfig "github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xdg/scram"
+)
+
+// SCRAM-SHA-256 exchange of RFC 7677
+const (
+	scramClientNonce = "rOprNGfwEbeRWgbNEkqO"
+	scramClientFirst = "n,,n=user,r=rOprNGfwEbeRWgbNEkqO"
+	scramServerFirst = "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"
+	scramClientFinal = "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ="
+	scramServerFinal = "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4="
+)
+
+func newTestSCRAMClient() sarama.SCRAMClient {
+	return &scramClient{
+		hashGenerator:  scram.SHA256,
+		nonceGenerator: func() string { return scramClientNonce },
+	}
+}
+
+func TestSCRAMClient(t *testing.T) {
+	t.Run("Exchange", func(t *testing.T) {
+		client := newTestSCRAMClient()
+		require.NoError(t, client.Begin("user", "pencil", ""))
+
+		message, err := client.Step("")
+		assert.NoError(t, err)
+		assert.Equal(t, scramClientFirst, message)
+		assert.False(t, client.Done())
+
+		message, err = client.Step(scramServerFirst)
+		assert.NoError(t, err)
+		assert.Equal(t, scramClientFinal, message)
+		assert.False(t, client.Done())
+
+		_, err = client.Step(scramServerFinal)
+		assert.NoError(t, err)
+		assert.True(t, client.Done())
+	})
+
+	t.Run("Escaped Names", func(t *testing.T) {
+		client := newTestSCRAMClient()
+		require.NoError(t, client.Begin("us=er,", "pencil", ""))
+		message, err := client.Step("")
+		assert.NoError(t, err)
+		assert.Equal(t, "n,,n=us=3Der=2C,r="+scramClientNonce, message)
+	})
+
+	testCases := []struct {
+		name        string
+		serverFirst string
+		serverFinal string
+		expectedErr string
+	}{
+		{"Server Error", "e=other-error", "", "not enough fields in first server message"},
+		{"Foreign Nonce", "r=fyko+d2lbbFgONRv9qkxdawL3rfcNHYJY1ZVvWVs7j,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096", "", "server nonce did not extend client nonce"},
+		{"Bad Salt", "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=%%%,i=4096", "", "illegal base64 data"},
+		{"Bad Iteration Count", "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=0", "", "server requested too few iterations (0)"},
+		{"Rejected Proof", scramServerFirst, "e=invalid-proof", "server error: invalid-proof"},
+		{"Bad Server Signature", scramServerFirst, "v=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", "server validation failed"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestSCRAMClient()
+			require.NoError(t, client.Begin("user", "pencil", ""))
+			_, err := client.Step("")
+			require.NoError(t, err)
+
+			_, err = client.Step(tc.serverFirst)
+			if tc.serverFinal != "" {
+				require.NoError(t, err)
+				_, err = client.Step(tc.serverFinal)
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestBrokerConfigSASLSCRAM(t *testing.T) {
+	newSCRAMBrokerConfig := func() *sarama.Config {
+		brokerConfig := newBrokerConfig(
+			mockLocalConfig.General.TLS,
+			mockLocalConfig.Kafka.SASLPlain,
+			localconfig.SASLSCRAM{
+				Enabled:   true,
+				Mechanism: sarama.SASLTypeSCRAMSHA256,
+				User:      "user",
+				Password:  "pencil",
+			},
+			mockLocalConfig.Kafka.Retry,
+			sarama.V1_0_0_0,
+			defaultPartition)
+		brokerConfig.Net.SASL.SCRAMClientGeneratorFunc = newTestSCRAMClient
+		return brokerConfig
+	}
+
+	newSCRAMBroker := func(serverFinal string) *sarama.MockBroker {
+		mockBroker := sarama.NewMockBroker(t, 0)
+		mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+			"SaslHandshakeRequest": sarama.NewMockSaslHandshakeResponse(t).
+				SetEnabledMechanisms([]string{sarama.SASLTypeSCRAMSHA256}),
+			"SaslAuthenticateRequest": sarama.NewMockSequence(
+				sarama.NewMockSaslAuthenticateResponse(t).SetAuthBytes([]byte(scramServerFirst)),
+				sarama.NewMockSaslAuthenticateResponse(t).SetAuthBytes([]byte(serverFinal)),
+			),
+		})
+		return mockBroker
+	}
+
+	t.Run("Config", func(t *testing.T) {
+		brokerConfig := newBrokerConfig(
+			mockLocalConfig.General.TLS,
+			mockLocalConfig.Kafka.SASLPlain,
+			localconfig.SASLSCRAM{
+				Enabled:   true,
+				Mechanism: sarama.SASLTypeSCRAMSHA512,
+				User:      "user",
+				Password:  "pencil",
+			},
+			mockLocalConfig.Kafka.Retry,
+			sarama.V1_0_0_0,
+			defaultPartition)
+		assert.True(t, brokerConfig.Net.SASL.Enable)
+		assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), brokerConfig.Net.SASL.Mechanism)
+		assert.Equal(t, "user", brokerConfig.Net.SASL.User)
+		assert.Equal(t, "pencil", brokerConfig.Net.SASL.Password)
+		assert.NotNil(t, brokerConfig.Net.SASL.SCRAMClientGeneratorFunc)
+		assert.NoError(t, brokerConfig.Validate())
+	})
+
+	t.Run("Authenticated", func(t *testing.T) {
+		mockBroker := newSCRAMBroker(scramServerFinal)
+		defer mockBroker.Close()
+
+		broker := sarama.NewBroker(mockBroker.Addr())
+		require.NoError(t, broker.Open(newSCRAMBrokerConfig()))
+		defer broker.Close()
+		connected, err := broker.Connected()
+		assert.True(t, connected)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Bad Server Signature", func(t *testing.T) {
+		mockBroker := newSCRAMBroker("v=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+		defer mockBroker.Close()
+
+		broker := sarama.NewBroker(mockBroker.Addr())
+		require.NoError(t, broker.Open(newSCRAMBrokerConfig()))
+		connected, err := broker.Connected()
+		assert.False(t, connected)
+		assert.EqualError(t, err, "server validation failed")
+	})
+}
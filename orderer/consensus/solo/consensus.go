@@ -130,8 +130,9 @@ func (ch *chain) main() {
 					timer = nil
 				case timer == nil && pending:
 					// Timer is not already running and there are messages pending, so start it
-					timer = time.After(ch.support.SharedConfig().BatchTimeout())
-					logger.Debugf("Just began %s batch timer", ch.support.SharedConfig().BatchTimeout().String())
+					batchTimeout := ch.support.BlockCutter().BatchTimeout(ch.support.SharedConfig())
+					timer = time.After(batchTimeout)
+					logger.Debugf("Just began %s batch timer", batchTimeout.String())
 				default:
 					// Do nothing when:
 					// 1. Timer is already running and there are messages pending
@@ -147,8 +148,7 @@ func (ch *chain) main() {
 						continue
 					}
 				}
-				batch := ch.support.BlockCutter().Cut()
-				if batch != nil {
+				for batch := ch.support.BlockCutter().Cut(); len(batch) != 0; batch = ch.support.BlockCutter().Cut() {
 					block := ch.support.CreateNextBlock(batch)
 					ch.support.WriteBlock(block, nil)
 				}
@@ -161,14 +161,16 @@ func (ch *chain) main() {
 			//clear the timer
 			timer = nil
 
-			batch := ch.support.BlockCutter().Cut()
+			batch := ch.support.BlockCutter().CutOnTimeout()
 			if len(batch) == 0 {
 				logger.Warningf("Batch timer expired with no pending requests, this might indicate a bug")
 				continue
 			}
-			logger.Debugf("Batch timer expired, creating block")
-			block := ch.support.CreateNextBlock(batch)
-			ch.support.WriteBlock(block, nil)
+			for ; len(batch) != 0; batch = ch.support.BlockCutter().Cut() {
+				logger.Debugf("Batch timer expired, creating block")
+				block := ch.support.CreateNextBlock(batch)
+				ch.support.WriteBlock(block, nil)
+			}
 		case <-ch.exitChan:
 			logger.Debugf("Exiting")
 			return
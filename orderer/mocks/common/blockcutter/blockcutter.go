@@ -8,7 +8,9 @@ package blockcutter
 
 import (
 	"sync"
+	"time"
 
+	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	cb "github.com/hyperledger/fabric/protos/common"
 )
@@ -98,6 +100,16 @@ func (mbc *Receiver) Cut() []*cb.Envelope {
 	return res
 }
 
+// CutOnTimeout terminates the current batch, returning it
+func (mbc *Receiver) CutOnTimeout() []*cb.Envelope {
+	return mbc.Cut()
+}
+
+// BatchTimeout returns the batch timeout of the orderer config
+func (mbc *Receiver) BatchTimeout(ordererConfig channelconfig.Orderer) time.Duration {
+	return ordererConfig.BatchTimeout()
+}
+
 func (mbc *Receiver) CurBatch() []*cb.Envelope {
 	mbc.mutex.Lock()
 	defer mbc.mutex.Unlock()
@@ -153,12 +153,14 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 }
 
 type collectionConfigJson struct {
-	Name           string `json:"name"`
-	Policy         string `json:"policy"`
-	RequiredCount  int32  `json:"requiredPeerCount"`
-	MaxPeerCount   int32  `json:"maxPeerCount"`
-	BlockToLive    uint64 `json:"blockToLive"`
-	MemberOnlyRead bool   `json:"memberOnlyRead"`
+	Name                         string `json:"name"`
+	Policy                       string `json:"policy"`
+	RequiredCount                int32  `json:"requiredPeerCount"`
+	MaxPeerCount                 int32  `json:"maxPeerCount"`
+	BlockToLive                  uint64 `json:"blockToLive"`
+	MemberOnlyRead               bool   `json:"memberOnlyRead"`
+	ExcludeHistoryFromNewMembers bool   `json:"excludeHistoryFromNewMembers"`
+	NewMembersHistoryDepth       uint64 `json:"newMembersHistoryDepth"`
 }
 
 // getCollectionConfig retrieves the collection configuration
@@ -199,12 +201,14 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 		cc := &pcommon.CollectionConfig{
 			Payload: &pcommon.CollectionConfig_StaticCollectionConfig{
 				StaticCollectionConfig: &pcommon.StaticCollectionConfig{
-					Name:              cconfitem.Name,
-					MemberOrgsPolicy:  cpc,
-					RequiredPeerCount: cconfitem.RequiredCount,
-					MaximumPeerCount:  cconfitem.MaxPeerCount,
-					BlockToLive:       cconfitem.BlockToLive,
-					MemberOnlyRead:    cconfitem.MemberOnlyRead,
+					Name:                         cconfitem.Name,
+					MemberOrgsPolicy:             cpc,
+					RequiredPeerCount:            cconfitem.RequiredCount,
+					MaximumPeerCount:             cconfitem.MaxPeerCount,
+					BlockToLive:                  cconfitem.BlockToLive,
+					MemberOnlyRead:               cconfitem.MemberOnlyRead,
+					ExcludeHistoryFromNewMembers: cconfitem.ExcludeHistoryFromNewMembers,
+					NewMembersHistoryDepth:       cconfitem.NewMembersHistoryDepth,
 				},
 			},
 		}
@@ -183,7 +183,9 @@ const sampleCollectionConfigGood = `[
 		"requiredPeerCount": 3,
 		"maxPeerCount": 483279847,
 		"blockToLive":10,
-		"memberOnlyRead": true
+		"memberOnlyRead": true,
+		"excludeHistoryFromNewMembers": true,
+		"newMembersHistoryDepth": 100
 	}
 ]`
 
@@ -210,6 +212,8 @@ func TestCollectionParsing(t *testing.T) {
 	assert.Equal(t, pol, conf.MemberOrgsPolicy.GetSignaturePolicy())
 	assert.Equal(t, 10, int(conf.BlockToLive))
 	assert.Equal(t, true, conf.MemberOnlyRead)
+	assert.Equal(t, true, conf.ExcludeHistoryFromNewMembers)
+	assert.Equal(t, 100, int(conf.NewMembersHistoryDepth))
 	t.Logf("conf=%s", conf)
 
 	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBad))
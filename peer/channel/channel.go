@@ -42,7 +42,9 @@ var (
 	timeout       time.Duration
 
 	// fetch related variables
-	bestEffort bool
+	bestEffort  bool
+	headersOnly bool
+	blockRange  string
 )
 
 // Cmd returns the cobra command for Node
@@ -81,6 +83,8 @@ func resetFlags() {
 	flags.StringVarP(&outputBlock, "outputBlock", "", common.UndefinedParamValue, `The path to write the genesis block for the channel. (default ./<channelID>.block)`)
 	flags.DurationVarP(&timeout, "timeout", "t", 10*time.Second, "Channel creation timeout")
 	flags.BoolVarP(&bestEffort, "bestEffort", "", false, "Whether fetch requests should ignore errors and return blocks on a best effort basis")
+	flags.BoolVarP(&headersOnly, "headers-only", "", false, "Whether fetch requests should return only the header and metadata of the blocks, config blocks being still returned whole")
+	flags.StringVarP(&blockRange, "range", "", "", "The range of blocks to fetch, as <start>:<stop> with both included, written to <channelID>_<number>.block files in the output directory given instead of the output file")
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
@@ -108,6 +112,7 @@ type BroadcastClientFactory func() (common.BroadcastClient, error)
 
 type deliverClientIntf interface {
 	GetSpecifiedBlock(num uint64) (*cb.Block, error)
+	GetSpecifiedBlocks(start, stop uint64, handleBlock func(*cb.Block) error) error
 	GetOldestBlock() (*cb.Block, error)
 	GetNewestBlock() (*cb.Block, error)
 	Close() error
@@ -154,10 +159,12 @@ func InitCmdFactory(isEndorserRequired, isPeerDeliverRequired, isOrdererRequired
 
 	// for fetching blocks from a peer
 	if isPeerDeliverRequired {
-		cf.DeliverClient, err = common.NewDeliverClientForPeer(channelID, bestEffort)
+		deliverClient, err := common.NewDeliverClientForPeer(channelID, bestEffort)
 		if err != nil {
 			return nil, errors.WithMessage(err, "error getting deliver client for channel")
 		}
+		deliverClient.HeadersOnly = headersOnly
+		cf.DeliverClient = deliverClient
 	}
 
 	// for create and fetch, we need the orderer as well
@@ -165,10 +172,12 @@ func InitCmdFactory(isEndorserRequired, isPeerDeliverRequired, isOrdererRequired
 		if len(strings.Split(common.OrderingEndpoint, ":")) != 2 {
 			return nil, errors.Errorf("ordering service endpoint %s is not valid or missing", common.OrderingEndpoint)
 		}
-		cf.DeliverClient, err = common.NewDeliverClientForOrderer(channelID, bestEffort)
+		deliverClient, err := common.NewDeliverClientForOrderer(channelID, bestEffort)
 		if err != nil {
 			return nil, err
 		}
+		deliverClient.HeadersOnly = headersOnly
+		cf.DeliverClient = deliverClient
 	}
 
 	logger.Infof("Endorser and orderer connections initialized")
@@ -111,6 +111,19 @@ func (m *mockDeliverClient) GetSpecifiedBlock(num uint64) (*cb.Block, error) {
 	return m.readBlock()
 }
 
+func (m *mockDeliverClient) GetSpecifiedBlocks(start, stop uint64, handleBlock func(*cb.Block) error) error {
+	for num := start; num <= stop; num++ {
+		block, err := m.readBlock()
+		if err != nil {
+			return err
+		}
+		if err := handleBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mockDeliverClient) GetOldestBlock() (*cb.Block, error) {
 	return m.readBlock()
 }
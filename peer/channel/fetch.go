@@ -9,6 +9,7 @@ package channel
 import (
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -23,7 +24,7 @@ func fetchCmd(cf *ChannelCmdFactory) *cobra.Command {
 	fetchCmd := &cobra.Command{
 		Use:   "fetch <newest|oldest|config|(number)> [outputfile]",
 		Short: "Fetch a block",
-		Long:  "Fetch a specified block, writing it to a file.",
+		Long:  "Fetch a specified block, writing it to a file, or a range of blocks given with --range, writing them to an output directory.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return fetch(cmd, args, cf)
 		},
@@ -31,6 +32,8 @@ func fetchCmd(cf *ChannelCmdFactory) *cobra.Command {
 	flagList := []string{
 		"channelID",
 		"bestEffort",
+		"headers-only",
+		"range",
 	}
 	attachFlags(fetchCmd, flagList)
 
@@ -38,10 +41,19 @@ func fetchCmd(cf *ChannelCmdFactory) *cobra.Command {
 }
 
 func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
-	if len(args) == 0 {
+	var start, stop uint64
+	maxArgs := 2
+	if blockRange != "" {
+		var err error
+		start, stop, err = parseBlockRange(blockRange)
+		if err != nil {
+			return err
+		}
+		maxArgs = 1
+	} else if len(args) == 0 {
 		return fmt.Errorf("fetch target required, oldest, newest, config, or a number")
 	}
-	if len(args) > 2 {
+	if len(args) > maxArgs {
 		return fmt.Errorf("trailing args detected")
 	}
 	// Parsing of the command line is done so silence cmd usage
@@ -63,6 +75,14 @@ func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
 		}
 	}
 
+	if blockRange != "" {
+		dir := "."
+		if len(args) == 1 {
+			dir = args[0]
+		}
+		return fetchRange(cf, start, stop, dir)
+	}
+
 	var block *cb.Block
 
 	switch args[0] {
@@ -111,3 +131,32 @@ func fetch(cmd *cobra.Command, args []string, cf *ChannelCmdFactory) error {
 
 	return nil
 }
+
+// parseBlockRange parses a <start>:<stop> block range
+func parseBlockRange(blockRange string) (uint64, uint64, error) {
+	bounds := strings.Split(blockRange, ":")
+	if len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("fetch range illegal: %s", blockRange)
+	}
+	start, err := strconv.ParseUint(bounds[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("fetch range illegal: %s", blockRange)
+	}
+	stop, err := strconv.ParseUint(bounds[1], 10, 64)
+	if err != nil || stop < start {
+		return 0, 0, fmt.Errorf("fetch range illegal: %s", blockRange)
+	}
+	return start, stop, nil
+}
+
+// fetchRange writes the blocks from start to stop to the directory
+func fetchRange(cf *ChannelCmdFactory, start, stop uint64, dir string) error {
+	return cf.DeliverClient.GetSpecifiedBlocks(start, stop, func(block *cb.Block) error {
+		b, err := proto.Marshal(block)
+		if err != nil {
+			return err
+		}
+		file := filepath.Join(dir, fmt.Sprintf("%s_%d.block", channelID, block.Header.Number))
+		return ioutil.WriteFile(file, b, 0644)
+	})
+}
@@ -94,6 +94,77 @@ func TestFetch(t *testing.T) {
 	}
 }
 
+func TestFetchRange(t *testing.T) {
+	defer resetFlags()
+	InitMSP()
+	resetFlags()
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+
+	mockchain := "mockchain"
+
+	signer, err := common.GetDefaultSigner()
+	if err != nil {
+		t.Fatalf("Get default signer error: %v", err)
+	}
+
+	mockService := &mock.DeliverService{}
+	for i := 0; i < 3; i++ {
+		block := createTestBlock()
+		block.Header.Number = uint64(i + 2)
+		mockService.RecvReturnsOnCall(i, &ab.DeliverResponse{
+			Type: &ab.DeliverResponse_Block{Block: block},
+		}, nil)
+	}
+	mockService.RecvReturnsOnCall(3, &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_Status{Status: cb.Status_SUCCESS},
+	}, nil)
+	mockCF := &ChannelCmdFactory{
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+		DeliverClient: &common.DeliverClient{
+			Service:     mockService,
+			ChannelID:   mockchain,
+			HeadersOnly: true,
+		},
+	}
+
+	tempDir, err := ioutil.TempDir("", "fetch-output")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory")
+	}
+	defer os.RemoveAll(tempDir)
+
+	cmd := fetchCmd(mockCF)
+	AddFlags(cmd)
+
+	cmd.SetArgs([]string{"-c", mockchain, "--headers-only", "--range", "2:4", tempDir})
+	err = cmd.Execute()
+	assert.NoError(t, err, "fetch command expected to succeed")
+	for _, number := range []string{"2", "3", "4"} {
+		_, err := os.Stat(filepath.Join(tempDir, mockchain+"_"+number+".block"))
+		assert.NoError(t, err, "expected block %s to be fetched", number)
+	}
+
+	seekInfo := &ab.SeekInfo{}
+	_, err = putils.UnmarshalEnvelopeOfType(mockService.SendArgsForCall(0), cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), seekInfo.Start.GetSpecified().Number)
+	assert.Equal(t, uint64(4), seekInfo.Stop.GetSpecified().Number)
+	assert.Equal(t, ab.SeekInfo_HEADER_WITH_SIG, seekInfo.ContentType)
+
+	// failure cases
+	for _, blockRange := range []string{"4:2", "2", "a:4", "2:b", "2:4:6"} {
+		cmd.SetArgs([]string{"-c", mockchain, "--range", blockRange, tempDir})
+		err = cmd.Execute()
+		assert.EqualError(t, err, fmt.Sprintf("fetch range illegal: %s", blockRange))
+	}
+
+	cmd.SetArgs([]string{"-c", mockchain, "--range", "2:4", tempDir, "kiwi"})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "trailing args detected")
+}
+
 func TestFetchArgs(t *testing.T) {
 	// failure - no args
 	cmd := fetchCmd(nil)
@@ -40,27 +40,39 @@ type DeliverClient struct {
 	ChannelID   string
 	TLSCertHash []byte
 	BestEffort  bool
+	// HeadersOnly requests the header and metadata of the blocks only, config
+	// blocks being still delivered whole
+	HeadersOnly bool
 }
 
-func (d *DeliverClient) seekSpecified(blockNumber uint64) error {
-	seekPosition := &ab.SeekPosition{
+func seekSpecified(blockNumber uint64) *ab.SeekPosition {
+	return &ab.SeekPosition{
 		Type: &ab.SeekPosition_Specified{
 			Specified: &ab.SeekSpecified{
 				Number: blockNumber,
 			},
 		},
 	}
-	env := seekHelper(d.ChannelID, seekPosition, d.TLSCertHash, d.BestEffort)
+}
+
+func (d *DeliverClient) seekSpecified(blockNumber uint64) error {
+	seekPosition := seekSpecified(blockNumber)
+	env := seekHelper(d.ChannelID, seekPosition, seekPosition, d.TLSCertHash, d.BestEffort, d.HeadersOnly)
+	return d.Service.Send(env)
+}
+
+func (d *DeliverClient) seekRange(start, stop uint64) error {
+	env := seekHelper(d.ChannelID, seekSpecified(start), seekSpecified(stop), d.TLSCertHash, d.BestEffort, d.HeadersOnly)
 	return d.Service.Send(env)
 }
 
 func (d *DeliverClient) seekOldest() error {
-	env := seekHelper(d.ChannelID, seekOldest, d.TLSCertHash, d.BestEffort)
+	env := seekHelper(d.ChannelID, seekOldest, seekOldest, d.TLSCertHash, d.BestEffort, d.HeadersOnly)
 	return d.Service.Send(env)
 }
 
 func (d *DeliverClient) seekNewest() error {
-	env := seekHelper(d.ChannelID, seekNewest, d.TLSCertHash, d.BestEffort)
+	env := seekHelper(d.ChannelID, seekNewest, seekNewest, d.TLSCertHash, d.BestEffort, d.HeadersOnly)
 	return d.Service.Send(env)
 }
 
@@ -82,6 +94,45 @@ func (d *DeliverClient) readBlock() (*cb.Block, error) {
 	}
 }
 
+// readBlocks reads blocks until the status reply ending the delivery
+func (d *DeliverClient) readBlocks(handleBlock func(*cb.Block) error) error {
+	for {
+		msg, err := d.Service.Recv()
+		if err != nil {
+			return errors.Wrap(err, "error receiving")
+		}
+		switch t := msg.Type.(type) {
+		case *ab.DeliverResponse_Status:
+			if t.Status != cb.Status_SUCCESS {
+				logger.Infof("Got status: %v", t)
+				return errors.Errorf("can't read the blocks: %v", t)
+			}
+			return nil
+		case *ab.DeliverResponse_Block:
+			logger.Infof("Received block: %v", t.Block.Header.Number)
+			if err := handleBlock(t.Block); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("response error: unknown type %T", t)
+		}
+	}
+}
+
+// GetSpecifiedBlocks gets the blocks from start to stop, both included, from
+// a peer/orderer's deliver service, handling them as they are received
+func (d *DeliverClient) GetSpecifiedBlocks(start, stop uint64, handleBlock func(*cb.Block) error) error {
+	if stop < start {
+		return errors.Errorf("invalid block range %d:%d", start, stop)
+	}
+	err := d.seekRange(start, stop)
+	if err != nil {
+		return errors.WithMessage(err, "error getting specified blocks")
+	}
+
+	return d.readBlocks(handleBlock)
+}
+
 // GetSpecifiedBlock gets the specified block from a peer/orderer's deliver
 // service
 func (d *DeliverClient) GetSpecifiedBlock(num uint64) (*cb.Block, error) {
@@ -120,13 +171,15 @@ func (d *DeliverClient) Close() error {
 
 func seekHelper(
 	channelID string,
-	position *ab.SeekPosition,
+	start *ab.SeekPosition,
+	stop *ab.SeekPosition,
 	tlsCertHash []byte,
 	bestEffort bool,
+	headersOnly bool,
 ) *cb.Envelope {
 	seekInfo := &ab.SeekInfo{
-		Start:    position,
-		Stop:     position,
+		Start:    start,
+		Stop:     stop,
 		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
 	}
 
@@ -134,6 +187,10 @@ func seekHelper(
 		seekInfo.ErrorResponse = ab.SeekInfo_BEST_EFFORT
 	}
 
+	if headersOnly {
+		seekInfo.ContentType = ab.SeekInfo_HEADER_WITH_SIG
+	}
+
 	env, err := utils.CreateSignedEnvelopeWithTLSBinding(
 		cb.HeaderType_DELIVER_SEEK_INFO,
 		channelID,
@@ -91,7 +91,7 @@ func TestDeliverClientErrors(t *testing.T) {
 
 func TestSeekHelper(t *testing.T) {
 	t.Run("Standard", func(t *testing.T) {
-		env := seekHelper("channel-id", &ab.SeekPosition{}, nil, false)
+		env := seekHelper("channel-id", &ab.SeekPosition{}, &ab.SeekPosition{}, nil, false, false)
 		assert.NotNil(t, env)
 		seekInfo := &ab.SeekInfo{}
 		_, err := utils.UnmarshalEnvelopeOfType(env, cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
@@ -101,13 +101,83 @@ func TestSeekHelper(t *testing.T) {
 	})
 
 	t.Run("BestEffort", func(t *testing.T) {
-		env := seekHelper("channel-id", &ab.SeekPosition{}, nil, true)
+		env := seekHelper("channel-id", &ab.SeekPosition{}, &ab.SeekPosition{}, nil, true, false)
 		assert.NotNil(t, env)
 		seekInfo := &ab.SeekInfo{}
 		_, err := utils.UnmarshalEnvelopeOfType(env, cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
 		assert.NoError(t, err)
 		assert.Equal(t, seekInfo.ErrorResponse, ab.SeekInfo_BEST_EFFORT)
 	})
+
+	t.Run("HeadersOnly", func(t *testing.T) {
+		env := seekHelper("channel-id", &ab.SeekPosition{}, &ab.SeekPosition{}, nil, false, true)
+		assert.NotNil(t, env)
+		seekInfo := &ab.SeekInfo{}
+		_, err := utils.UnmarshalEnvelopeOfType(env, cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
+		assert.NoError(t, err)
+		assert.Equal(t, seekInfo.ContentType, ab.SeekInfo_HEADER_WITH_SIG)
+	})
+}
+
+func TestGetSpecifiedBlocks(t *testing.T) {
+	InitMSP()
+
+	mockClient := &mock.DeliverService{}
+	o := &DeliverClient{
+		Service:     mockClient,
+		ChannelID:   "channel-id",
+		HeadersOnly: true,
+	}
+
+	blockResponse := func(number uint64) *ab.DeliverResponse {
+		return &ab.DeliverResponse{
+			Type: &ab.DeliverResponse_Block{Block: &cb.Block{Header: &cb.BlockHeader{Number: number}}},
+		}
+	}
+	mockClient.RecvReturnsOnCall(0, blockResponse(2), nil)
+	mockClient.RecvReturnsOnCall(1, blockResponse(3), nil)
+	mockClient.RecvReturnsOnCall(2, &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_Status{Status: cb.Status_SUCCESS},
+	}, nil)
+
+	var numbers []uint64
+	err := o.GetSpecifiedBlocks(2, 3, func(block *cb.Block) error {
+		numbers = append(numbers, block.Header.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, numbers)
+
+	assert.Equal(t, 1, mockClient.SendCallCount())
+	seekInfo := &ab.SeekInfo{}
+	_, err = utils.UnmarshalEnvelopeOfType(mockClient.SendArgsForCall(0), cb.HeaderType_DELIVER_SEEK_INFO, seekInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), seekInfo.Start.GetSpecified().Number)
+	assert.Equal(t, uint64(3), seekInfo.Stop.GetSpecified().Number)
+	assert.Equal(t, ab.SeekInfo_HEADER_WITH_SIG, seekInfo.ContentType)
+
+	// failure - invalid range
+	err = o.GetSpecifiedBlocks(3, 2, nil)
+	assert.EqualError(t, err, "invalid block range 3:2")
+
+	// failure - status
+	mockClient.RecvReturnsOnCall(3, &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_Status{Status: cb.Status_NOT_FOUND},
+	}, nil)
+	err = o.GetSpecifiedBlocks(2, 3, func(*cb.Block) error { return nil })
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't read the blocks")
+
+	// failure - block handling
+	mockClient.RecvReturnsOnCall(4, blockResponse(2), nil)
+	err = o.GetSpecifiedBlocks(2, 3, func(*cb.Block) error { return errors.New("kiwi") })
+	assert.EqualError(t, err, "kiwi")
+
+	// failure - send returns error
+	mockClient.SendReturns(errors.New("gorilla"))
+	err = o.GetSpecifiedBlocks(2, 3, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error getting specified blocks: gorilla")
 }
 
 func TestNewOrdererDeliverClient(t *testing.T) {
@@ -8,25 +8,28 @@ package node
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/common/cauthdsl"
 	ccdef "github.com/hyperledger/fabric/common/chaincode"
+	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/crypto/tlsgen"
 	"github.com/hyperledger/fabric/common/deliver"
 	"github.com/hyperledger/fabric/common/flogging"
 	floggingmetrics "github.com/hyperledger/fabric/common/flogging/metrics"
 	"github.com/hyperledger/fabric/common/grpclogging"
 	"github.com/hyperledger/fabric/common/grpcmetrics"
-	"github.com/hyperledger/fabric/common/localmsp"
 	"github.com/hyperledger/fabric/common/metadata"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/policies"
@@ -48,6 +51,7 @@ import (
 	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/privdata"
+	coreconfig "github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/container"
 	"github.com/hyperledger/fabric/core/container/dockercontroller"
 	"github.com/hyperledger/fabric/core/container/inproccontroller"
@@ -77,6 +81,7 @@ import (
 	gossipcommon "github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/msp"
+	mspcache "github.com/hyperledger/fabric/msp/cache"
 	"github.com/hyperledger/fabric/msp/mgmt"
 	peergossip "github.com/hyperledger/fabric/peer/gossip"
 	"github.com/hyperledger/fabric/peer/version"
@@ -99,6 +104,13 @@ const (
 	chaincodeListenAddrKey = "peer.chaincodeListenAddress"
 	defaultChaincodePort   = 7052
 	grpcMaxConcurrency     = 2500
+
+	defaultCertRenewalPollInterval = time.Minute
+	defaultCertExpirationWarning   = 7 * 24 * time.Hour
+	certExpirationCheckInterval    = time.Hour
+	enrollmentCertificateType      = "enrollment"
+	tlsCertificateType             = "tls"
+	tlsClientCertificateType       = "tlsclient"
 )
 
 var chaincodeDevMode bool
@@ -145,6 +157,10 @@ func serve(args []string) error {
 
 	logger.Infof("Starting %s", version.GetInfo())
 
+	if err := service.ValidateLeaderElectionRanking(); err != nil {
+		return err
+	}
+
 	//startup aclmgmt with default ACL providers (resource based and default 1.0 policies based).
 	//Users can pass in their own ACLProvider to RegisterACLProvider (currently unit tests do this)
 	aclProvider := aclmgmt.NewACLProvider(
@@ -175,6 +191,23 @@ func serve(args []string) error {
 	logObserver := floggingmetrics.NewObserver(metricsProvider)
 	flogging.Global.SetObserver(logObserver)
 
+	ocspOpts, err := ocspOptions(metricsProvider)
+	if err != nil {
+		return err
+	}
+	if ocspOpts != nil {
+		// OCSP is only consulted by the local MSP, which has to be set up
+		// again to take the options into account
+		mgmt.SetLocalMspOCSPOptions(ocspOpts)
+		dir, bccspConfig, mspID, mspType, err := localMspConfig()
+		if err != nil {
+			return err
+		}
+		if err := mgmt.ReloadLocalMsp(dir, bccspConfig, mspID, mspType); err != nil {
+			return errors.WithMessage(err, "failed to set up the local MSP with OCSP checking")
+		}
+	}
+
 	membershipInfoProvider := privdata.NewMembershipInfoProvider(createSelfSignedData(), identityDeserializerFactory)
 	//initialize resource management exit
 	ledgermgmt.Initialize(
@@ -234,6 +267,9 @@ func serve(args []string) error {
 		throttle.StreamServerInterceptor,
 	)
 
+	if serverConfig.SecOpts.RequireClientCert {
+		serverConfig.SecOpts.VerifyCertificate = msp.NewOCSPVerifier(ocspOpts)
+	}
 	peerServer, err := peer.NewPeerServer(listenAddr, serverConfig)
 	if err != nil {
 		logger.Fatalf("Failed to create peer server (%s)", err)
@@ -257,6 +293,17 @@ func serve(args []string) error {
 		comm.GetCredentialSupport().SetClientCertificate(clientCert)
 	}
 
+	gossipCerts, err := newGossipTLSCertificates(peerServer)
+	if err != nil {
+		return err
+	}
+
+	stopCertRenewal, err := startCertificateRenewal(peerServer, gossipCerts, opsSystem, metricsProvider)
+	if err != nil {
+		return err
+	}
+	defer stopCertRenewal()
+
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	policyCheckerProvider := func(resourceName string) deliver.PolicyCheckerFunc {
 		return func(env *cb.Envelope, channelID string) error {
@@ -264,7 +311,17 @@ func serve(args []string) error {
 		}
 	}
 
-	abServer := peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider)
+	var abServer pb.DeliverServer
+	if viper.GetBool("peer.deliverCheckpoints.enabled") {
+		maxConsumers := viper.GetInt("peer.deliverCheckpoints.maxConsumersPerIdentity")
+		if maxConsumers <= 0 {
+			logger.Fatalf("Invalid peer.deliverCheckpoints.maxConsumersPerIdentity %d, it must be positive", maxConsumers)
+		}
+		checkpoints := peer.NewCheckpointStore(peer.GetCheckpointStorePath(), maxConsumers)
+		abServer = peer.NewDeliverEventsServerWithCheckpoints(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider, checkpoints)
+	} else {
+		abServer = peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider)
+	}
 	pb.RegisterDeliverServer(peerServer.Server(), abServer)
 
 	// Initialize chaincode service
@@ -293,7 +350,7 @@ func serve(args []string) error {
 
 	authFilters := reg.Lookup(library.Auth).([]authHandler.Filter)
 	endorserSupport := &endorser.SupportImpl{
-		SignerSupport:    signingIdentity,
+		SignerSupport:    localSigningIdentity{},
 		Peer:             peer.Default,
 		PeerSupport:      peer.DefaultSupport,
 		ChaincodeSupport: chaincodeSupport,
@@ -317,7 +374,7 @@ func serve(args []string) error {
 	policyMgr := peer.NewChannelPolicyManagerGetter()
 
 	// Initialize gossip component
-	err = initGossipService(policyMgr, metricsProvider, peerServer, serializedIdentity, peerEndpoint.Address)
+	err = initGossipService(policyMgr, metricsProvider, peerServer, gossipCerts, signingIdentity, serializedIdentity, peerEndpoint.Address)
 	if err != nil {
 		return err
 	}
@@ -798,6 +855,172 @@ func adminHasSeparateListener(peerListenAddr string, adminListenAddress string)
 	return adminPort != peerPort
 }
 
+// ocspOptions returns the OCSP options used by the local MSP and to verify
+// TLS client certificates, or nil if OCSP checking is disabled
+func ocspOptions(metricsProvider metrics.Provider) (*msp.OCSPOptions, error) {
+	var mode msp.OCSPMode
+	switch m := viper.GetString("peer.ocsp.mode"); m {
+	case "", "disabled":
+		return nil, nil
+	case "softfail":
+		mode = msp.OCSPSoftFail
+	case "hardfail":
+		mode = msp.OCSPHardFail
+	default:
+		return nil, errors.Errorf("invalid OCSP mode %s, must be one of disabled, softfail or hardfail", m)
+	}
+
+	logger.Infof("OCSP checking of identities enabled in %s mode", viper.GetString("peer.ocsp.mode"))
+	return &msp.OCSPOptions{
+		Mode:            mode,
+		Timeout:         viper.GetDuration("peer.ocsp.timeout"),
+		RecheckInterval: viper.GetDuration("peer.ocsp.recheckInterval"),
+		ResponseCache:   mspcache.NewOCSPResponseCache(),
+		MetricsProvider: metricsProvider,
+	}, nil
+}
+
+// localMspConfig returns the directory, BCCSP configuration, ID and type
+// of the local MSP
+func localMspConfig() (dir string, bccspConfig *factory.FactoryOpts, mspID, mspType string, err error) {
+	if err := viperutil.EnhancedExactUnmarshalKey("peer.BCCSP", &bccspConfig); err != nil {
+		return "", nil, "", "", errors.WithMessage(err, "could not parse YAML config")
+	}
+	mspType = viper.GetString("peer.localMspType")
+	if mspType == "" {
+		mspType = msp.ProviderTypeToString(msp.FABRIC)
+	}
+	return coreconfig.GetPath("peer.mspConfigPath"), bccspConfig, viper.GetString("peer.localMspId"), mspType, nil
+}
+
+// startCertificateRenewal tracks the expiration of the enrollment and TLS
+// certificates of the peer, and registers a health check that fails once
+// one of them has expired. If certificate renewal is enabled, it also
+// watches the local MSP and TLS files and swaps in renewed certificates
+// and keys without a restart, including the TLS certificates gossip
+// presents in its handshakes. It returns a function that stops it.
+func startCertificateRenewal(peerServer *comm.GRPCServer, gossipCerts *gossipcommon.TLSCertificates,
+	ops *operations.System, metricsProvider metrics.Provider) (func(), error) {
+	warningThreshold := viper.GetDuration("peer.certRenewal.expirationWarningThreshold")
+	if warningThreshold == 0 {
+		warningThreshold = defaultCertExpirationWarning
+	}
+	tracker := crypto.NewExpirationTracker(metricsProvider, warningThreshold)
+	trackEnrollmentCertificate(tracker, mgmt.GetLocalMSP())
+	if peerServer.TLSEnabled() {
+		tracker.Track(tlsCertificateType, crypto.TLSCertificateExpiresAt(peerServer.ServerCertificate()))
+	}
+	if err := ops.RegisterChecker("certificates", tracker); err != nil {
+		return nil, errors.WithMessage(err, "failed to register certificates health check")
+	}
+	tracker.Start(certExpirationCheckInterval)
+
+	watchers := []*crypto.FileWatcher{}
+	stop := func() {
+		for _, w := range watchers {
+			w.Stop()
+		}
+		tracker.Stop()
+	}
+	if !viper.GetBool("peer.certRenewal.enabled") {
+		return stop, nil
+	}
+
+	interval := viper.GetDuration("peer.certRenewal.pollInterval")
+	if interval == 0 {
+		interval = defaultCertRenewalPollInterval
+	}
+
+	dir, bccspConfig, mspID, mspType, err := localMspConfig()
+	if err != nil {
+		return nil, err
+	}
+	watchers = append(watchers, mgmt.NewLocalMspWatcher(
+		dir,
+		bccspConfig,
+		mspID,
+		mspType,
+		interval,
+		func(localMsp msp.MSP) {
+			trackEnrollmentCertificate(tracker, localMsp)
+			logger.Warning("Gossip keeps using the enrollment certificate the peer was started with until the peer is restarted")
+		},
+	))
+
+	if peerServer.TLSEnabled() {
+		onReload := []func(tls.Certificate){
+			peerServer.SetServerCertificate,
+			func(cert tls.Certificate) {
+				tracker.Track(tlsCertificateType, crypto.TLSCertificateExpiresAt(cert))
+			},
+		}
+		separateClientCert := viper.GetString("peer.tls.clientCert.file") != ""
+		if !separateClientCert {
+			// the TLS server key pair is also used as client key pair
+			onReload = append(onReload,
+				comm.GetCredentialSupport().SetClientCertificate,
+				reloadGossipCertificate(&gossipCerts.TLSServerCert, &gossipCerts.TLSClientCert),
+			)
+		} else {
+			onReload = append(onReload, reloadGossipCertificate(&gossipCerts.TLSServerCert))
+		}
+		watchers = append(watchers, comm.NewKeyPairWatcher(
+			coreconfig.GetPath("peer.tls.cert.file"),
+			coreconfig.GetPath("peer.tls.key.file"),
+			interval,
+			onReload...,
+		))
+
+		if separateClientCert {
+			watchers = append(watchers, comm.NewKeyPairWatcher(
+				coreconfig.GetPath("peer.tls.clientCert.file"),
+				coreconfig.GetPath("peer.tls.clientKey.file"),
+				interval,
+				comm.GetCredentialSupport().SetClientCertificate,
+				reloadGossipCertificate(&gossipCerts.TLSClientCert),
+				func(cert tls.Certificate) {
+					tracker.Track(tlsClientCertificateType, crypto.TLSCertificateExpiresAt(cert))
+				},
+			))
+		}
+	}
+
+	for _, w := range watchers {
+		w.Start()
+	}
+	logger.Infof("Certificate renewal enabled, polling every %s", interval)
+	return stop, nil
+}
+
+// localSigningIdentity is a signing identity that resolves the default
+// signing identity of the local MSP on each use, so that renewed
+// certificates and keys are used as soon as the local MSP is reloaded
+type localSigningIdentity struct{}
+
+func (localSigningIdentity) Sign(msg []byte) ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Sign(msg)
+}
+
+func (localSigningIdentity) Serialize() ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Serialize()
+}
+
+// trackEnrollmentCertificate tracks the expiration of the certificate of
+// the default signing identity of the given local MSP
+func trackEnrollmentCertificate(tracker *crypto.ExpirationTracker, localMsp msp.MSP) {
+	signer, err := localMsp.GetDefaultSigningIdentity()
+	if err != nil {
+		logger.Warningf("Failed obtaining the local signing identity: %s", err)
+		return
+	}
+	serializedIdentity, err := signer.Serialize()
+	if err != nil {
+		logger.Warningf("Failed serializing the local signing identity: %s", err)
+		return
+	}
+	tracker.Track(enrollmentCertificateType, crypto.ExpiresAt(serializedIdentity))
+}
+
 func startAdminServer(peerListenAddr string, peerServer *grpc.Server, metricsProvider metrics.Provider) {
 	adminListenAddress := viper.GetString("peer.adminService.listenAddress")
 	separateLsnrForAdmin := adminHasSeparateListener(peerListenAddr, adminListenAddress)
@@ -865,28 +1088,48 @@ func secureDialOpts() []grpc.DialOption {
 	return dialOpts
 }
 
+// newGossipTLSCertificates returns the TLS certificates gossip presents in
+// its handshakes, or nil if TLS is disabled.
+func newGossipTLSCertificates(peerServer *comm.GRPCServer) (*gossipcommon.TLSCertificates, error) {
+	if !peerServer.TLSEnabled() {
+		return nil, nil
+	}
+	serverCert := peerServer.ServerCertificate()
+	clientCert, err := peer.GetClientCertificate()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed obtaining client certificates")
+	}
+	certs := &gossipcommon.TLSCertificates{}
+	certs.TLSServerCert.Store(&serverCert)
+	certs.TLSClientCert.Store(&clientCert)
+	return certs, nil
+}
+
+// reloadGossipCertificate returns a function that stores a reloaded TLS
+// certificate into the given gossip certificates, so that gossip handshakes
+// carry the hash of the certificate the connection is actually made with.
+func reloadGossipCertificate(certs ...*atomic.Value) func(tls.Certificate) {
+	return func(cert tls.Certificate) {
+		for _, c := range certs {
+			c.Store(&cert)
+		}
+	}
+}
+
 // initGossipService will initialize the gossip service by:
 // 1. Enable TLS if configured;
 // 2. Init the message crypto service;
 // 3. Init the security advisor;
 // 4. Init gossip related struct.
 func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, metricsProvider metrics.Provider,
-	peerServer *comm.GRPCServer, serializedIdentity []byte, peerAddr string) error {
-	var certs *gossipcommon.TLSCertificates
-	if peerServer.TLSEnabled() {
-		serverCert := peerServer.ServerCertificate()
-		clientCert, err := peer.GetClientCertificate()
-		if err != nil {
-			return errors.Wrap(err, "failed obtaining client certificates")
-		}
-		certs = &gossipcommon.TLSCertificates{}
-		certs.TLSServerCert.Store(&serverCert)
-		certs.TLSClientCert.Store(&clientCert)
-	}
-
+	peerServer *comm.GRPCServer, certs *gossipcommon.TLSCertificates, signingIdentity msp.SigningIdentity,
+	serializedIdentity []byte, peerAddr string) error {
+	// gossip messages are signed with the identity gossip advertises, which
+	// cannot change while the peer runs, rather than with the current
+	// signing identity of the local MSP
 	messageCryptoService := peergossip.NewMCS(
 		policyMgr,
-		localmsp.NewSigner(),
+		crypto.NewSignatureHeaderCreator(signingIdentity),
 		mgmt.NewDeserializersManager(),
 	)
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
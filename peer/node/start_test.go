@@ -13,8 +13,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/handlers/library"
+	"github.com/hyperledger/fabric/msp"
 	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	"github.com/hyperledger/fabric/peer/node/mock"
 	"github.com/hyperledger/fabric/protos/common"
@@ -96,6 +98,36 @@ func TestHandlerMap(t *testing.T) {
 	assert.Equal(t, "filter2", libConf.AuthFilters[1].Name)
 }
 
+func TestOCSPOptions(t *testing.T) {
+	defer viper.Reset()
+	provider := &disabled.Provider{}
+
+	viper.Set("peer.ocsp.mode", "disabled")
+	opts, err := ocspOptions(provider)
+	assert.NoError(t, err)
+	assert.Nil(t, opts)
+
+	viper.Set("peer.ocsp.mode", "hardfail")
+	viper.Set("peer.ocsp.timeout", "2s")
+	viper.Set("peer.ocsp.recheckInterval", "1m")
+	opts, err = ocspOptions(provider)
+	assert.NoError(t, err)
+	assert.Equal(t, msp.OCSPHardFail, opts.Mode)
+	assert.Equal(t, 2*time.Second, opts.Timeout)
+	assert.Equal(t, time.Minute, opts.RecheckInterval)
+	assert.NotNil(t, opts.ResponseCache)
+	assert.Equal(t, provider, opts.MetricsProvider)
+
+	viper.Set("peer.ocsp.mode", "softfail")
+	opts, err = ocspOptions(provider)
+	assert.NoError(t, err)
+	assert.Equal(t, msp.OCSPSoftFail, opts.Mode)
+
+	viper.Set("peer.ocsp.mode", "sometimes")
+	_, err = ocspOptions(provider)
+	assert.EqualError(t, err, "invalid OCSP mode sometimes, must be one of disabled, softfail or hardfail")
+}
+
 func TestComputeChaincodeEndpoint(t *testing.T) {
 	/*** Scenario 1: chaincodeAddress and chaincodeListenAddress are not set ***/
 	viper.Set(chaincodeAddrKey, nil)
@@ -32,7 +32,7 @@ func (m *CollectionConfigPackage) Reset()         { *m = CollectionConfigPackage
 func (m *CollectionConfigPackage) String() string { return proto.CompactTextString(m) }
 func (*CollectionConfigPackage) ProtoMessage()    {}
 func (*CollectionConfigPackage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_12a2cf6632dc7d83, []int{0}
+	return fileDescriptor_collection_37ca75ae53f1412d, []int{0}
 }
 func (m *CollectionConfigPackage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionConfigPackage.Unmarshal(m, b)
@@ -75,7 +75,7 @@ func (m *CollectionConfig) Reset()         { *m = CollectionConfig{} }
 func (m *CollectionConfig) String() string { return proto.CompactTextString(m) }
 func (*CollectionConfig) ProtoMessage()    {}
 func (*CollectionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_12a2cf6632dc7d83, []int{1}
+	return fileDescriptor_collection_37ca75ae53f1412d, []int{1}
 }
 func (m *CollectionConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionConfig.Unmarshal(m, b)
@@ -199,17 +199,28 @@ type StaticCollectionConfig struct {
 	// can read the private data (if set to true), or even non members can
 	// read the data (if set to false, for example if you want to implement more granular
 	// access logic in the chaincode)
-	MemberOnlyRead       bool     `protobuf:"varint,6,opt,name=member_only_read,json=memberOnlyRead,proto3" json:"member_only_read,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MemberOnlyRead bool `protobuf:"varint,6,opt,name=member_only_read,json=memberOnlyRead,proto3" json:"member_only_read,omitempty"`
+	// The exclude history from new members denotes whether organizations that
+	// are added to the collection by a collection config update are denied the
+	// private data that was committed before they became members (if set to true),
+	// or can pull it from the other members (if set to false)
+	ExcludeHistoryFromNewMembers bool `protobuf:"varint,7,opt,name=exclude_history_from_new_members,json=excludeHistoryFromNewMembers,proto3" json:"exclude_history_from_new_members,omitempty"`
+	// The number of most recent blocks whose private data is shared with
+	// organizations that were added to the collection after the data was committed.
+	// For instance if the value is set to 100 and the ledger height is 1000,
+	// new members can pull private data committed at block number 900 and above.
+	// A zero value is treated same as MaxUint64
+	NewMembersHistoryDepth uint64   `protobuf:"varint,8,opt,name=new_members_history_depth,json=newMembersHistoryDepth,proto3" json:"new_members_history_depth,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *StaticCollectionConfig) Reset()         { *m = StaticCollectionConfig{} }
 func (m *StaticCollectionConfig) String() string { return proto.CompactTextString(m) }
 func (*StaticCollectionConfig) ProtoMessage()    {}
 func (*StaticCollectionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_12a2cf6632dc7d83, []int{2}
+	return fileDescriptor_collection_37ca75ae53f1412d, []int{2}
 }
 func (m *StaticCollectionConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StaticCollectionConfig.Unmarshal(m, b)
@@ -271,6 +282,20 @@ func (m *StaticCollectionConfig) GetMemberOnlyRead() bool {
 	return false
 }
 
+func (m *StaticCollectionConfig) GetExcludeHistoryFromNewMembers() bool {
+	if m != nil {
+		return m.ExcludeHistoryFromNewMembers
+	}
+	return false
+}
+
+func (m *StaticCollectionConfig) GetNewMembersHistoryDepth() uint64 {
+	if m != nil {
+		return m.NewMembersHistoryDepth
+	}
+	return 0
+}
+
 // Collection policy configuration. Initially, the configuration can only
 // contain a SignaturePolicy. In the future, the SignaturePolicy may be a
 // more general Policy. Instead of containing the actual policy, the
@@ -288,7 +313,7 @@ func (m *CollectionPolicyConfig) Reset()         { *m = CollectionPolicyConfig{}
 func (m *CollectionPolicyConfig) String() string { return proto.CompactTextString(m) }
 func (*CollectionPolicyConfig) ProtoMessage()    {}
 func (*CollectionPolicyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_12a2cf6632dc7d83, []int{3}
+	return fileDescriptor_collection_37ca75ae53f1412d, []int{3}
 }
 func (m *CollectionPolicyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionPolicyConfig.Unmarshal(m, b)
@@ -403,7 +428,7 @@ func (m *CollectionCriteria) Reset()         { *m = CollectionCriteria{} }
 func (m *CollectionCriteria) String() string { return proto.CompactTextString(m) }
 func (*CollectionCriteria) ProtoMessage()    {}
 func (*CollectionCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_collection_12a2cf6632dc7d83, []int{4}
+	return fileDescriptor_collection_37ca75ae53f1412d, []int{4}
 }
 func (m *CollectionCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollectionCriteria.Unmarshal(m, b)
@@ -459,38 +484,42 @@ func init() {
 	proto.RegisterType((*CollectionCriteria)(nil), "common.CollectionCriteria")
 }
 
-func init() { proto.RegisterFile("common/collection.proto", fileDescriptor_collection_12a2cf6632dc7d83) }
-
-var fileDescriptor_collection_12a2cf6632dc7d83 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x51, 0x6b, 0xdb, 0x30,
-	0x10, 0xc7, 0xeb, 0x36, 0x4d, 0xe7, 0x0b, 0xdb, 0x32, 0x95, 0xa5, 0x66, 0x8c, 0x2e, 0x84, 0x3d,
-	0x18, 0x36, 0x9c, 0xd1, 0x7d, 0x83, 0x86, 0x41, 0xc7, 0x02, 0x0b, 0xea, 0x9e, 0xfa, 0x62, 0x14,
-	0xf9, 0xea, 0x88, 0xca, 0x92, 0x2b, 0x2b, 0x21, 0x7e, 0xdc, 0x97, 0xd9, 0xe7, 0x1c, 0x91, 0xec,
-	0x24, 0x0d, 0x79, 0xf3, 0xdd, 0xff, 0x77, 0xe7, 0xbb, 0xfb, 0xdb, 0x70, 0xc5, 0x75, 0x51, 0x68,
-	0x35, 0xe6, 0x5a, 0x4a, 0xe4, 0x56, 0x68, 0x95, 0x94, 0x46, 0x5b, 0x4d, 0xba, 0x5e, 0xf8, 0xf0,
-	0xbe, 0x01, 0x4a, 0x2d, 0x05, 0x17, 0x58, 0x79, 0x79, 0xf4, 0x0b, 0xae, 0x26, 0xdb, 0x92, 0x89,
-	0x56, 0x8f, 0x22, 0x9f, 0x31, 0xfe, 0xc4, 0x72, 0x24, 0xdf, 0xa0, 0xcb, 0x5d, 0x22, 0x0a, 0x86,
-	0x67, 0x71, 0xef, 0x26, 0x4a, 0x7c, 0x8b, 0xe4, 0xb0, 0x80, 0x36, 0xdc, 0xa8, 0x86, 0xfe, 0xa1,
-	0x46, 0x1e, 0x20, 0xaa, 0x2c, 0xb3, 0x82, 0xa7, 0xbb, 0xd1, 0xd2, 0x6d, 0xdf, 0x20, 0xee, 0xdd,
-	0x5c, 0xb7, 0x7d, 0xef, 0x1d, 0x77, 0xd8, 0xe1, 0xee, 0x84, 0x0e, 0xaa, 0xa3, 0xca, 0x6d, 0x08,
-	0x17, 0x25, 0xab, 0xa5, 0x66, 0xd9, 0xe8, 0xdf, 0x29, 0x0c, 0x8e, 0xd7, 0x13, 0x02, 0x1d, 0xc5,
-	0x0a, 0x74, 0x6f, 0x0b, 0xa9, 0x7b, 0x26, 0x53, 0x20, 0x05, 0x16, 0x73, 0x34, 0xa9, 0x36, 0x79,
-	0x95, 0xba, 0xa3, 0xd4, 0xd1, 0xe9, 0xcb, 0x79, 0x76, 0x9d, 0x66, 0x4e, 0x6f, 0xb6, 0xed, 0xfb,
-	0xca, 0xdf, 0x26, 0xaf, 0x7c, 0x9e, 0x24, 0x70, 0x69, 0xf0, 0x79, 0x29, 0x0c, 0x66, 0x69, 0x89,
-	0x68, 0x52, 0xae, 0x97, 0xca, 0x46, 0x67, 0xc3, 0x20, 0x3e, 0xa7, 0xef, 0x5a, 0x69, 0x86, 0x68,
-	0x26, 0x1b, 0x81, 0x7c, 0x05, 0x52, 0xb0, 0xb5, 0x28, 0x96, 0xc5, 0x3e, 0xde, 0x71, 0x78, 0xbf,
-	0x51, 0x76, 0xf4, 0x08, 0x5e, 0xcf, 0xa5, 0xe6, 0x4f, 0xa9, 0xd5, 0xa9, 0x14, 0x2b, 0x8c, 0xce,
-	0x87, 0x41, 0xdc, 0xa1, 0x3d, 0x97, 0xfc, 0xa3, 0xa7, 0x62, 0x85, 0x24, 0x86, 0x7e, 0xbb, 0x8f,
-	0x92, 0x75, 0x6a, 0x90, 0x65, 0x51, 0x77, 0x18, 0xc4, 0xaf, 0xe8, 0x9b, 0x66, 0x5a, 0x25, 0x6b,
-	0x8a, 0x2c, 0x1b, 0x3d, 0xc3, 0xe0, 0xf8, 0x5e, 0x64, 0x0a, 0xfd, 0x4a, 0xe4, 0x8a, 0xd9, 0xa5,
-	0xc1, 0xf6, 0x22, 0xde, 0xa1, 0x4f, 0x5b, 0x87, 0x5a, 0xdd, 0x17, 0xfe, 0x50, 0x2b, 0x94, 0xba,
-	0xc4, 0xbb, 0x13, 0xfa, 0xb6, 0x7a, 0x29, 0xed, 0x7b, 0xf3, 0x37, 0x00, 0xb2, 0xe7, 0x8a, 0x11,
-	0x16, 0x8d, 0x60, 0x24, 0x82, 0x0b, 0xbe, 0x60, 0x4a, 0xa1, 0x6c, 0xac, 0x69, 0x43, 0x72, 0x09,
-	0xe7, 0x76, 0x9d, 0x8a, 0xcc, 0x19, 0x12, 0xd2, 0x8e, 0x5d, 0xff, 0xcc, 0xc8, 0x35, 0xc0, 0xee,
-	0x0b, 0x72, 0xb7, 0x0d, 0xe9, 0x5e, 0x86, 0x7c, 0x84, 0x70, 0x63, 0x6d, 0x55, 0x32, 0x8e, 0xee,
-	0x96, 0x21, 0xdd, 0x25, 0x6e, 0xef, 0xe1, 0xb3, 0x36, 0x79, 0xb2, 0xa8, 0x4b, 0x34, 0x12, 0xb3,
-	0x1c, 0x4d, 0xf2, 0xc8, 0xe6, 0x46, 0x70, 0xff, 0x1f, 0x54, 0xcd, 0x86, 0x0f, 0x5f, 0x72, 0x61,
-	0x17, 0xcb, 0xf9, 0x26, 0x1c, 0xef, 0xc1, 0x63, 0x0f, 0x8f, 0x3d, 0x3c, 0xf6, 0xf0, 0xbc, 0xeb,
-	0xc2, 0xef, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x3b, 0x7c, 0x15, 0x7d, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("common/collection.proto", fileDescriptor_collection_37ca75ae53f1412d) }
+
+var fileDescriptor_collection_37ca75ae53f1412d = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x4f, 0xdb, 0x30,
+	0x18, 0xc6, 0xe9, 0x28, 0x85, 0xbc, 0x68, 0x5b, 0x67, 0xb4, 0x92, 0x4d, 0x88, 0x55, 0xd5, 0x0e,
+	0x91, 0x36, 0xa5, 0x13, 0x3b, 0xed, 0x0a, 0x1b, 0x62, 0x1a, 0xdb, 0x50, 0xd8, 0x89, 0x8b, 0xe5,
+	0x3a, 0x2f, 0xa9, 0x85, 0x63, 0x07, 0xc7, 0x81, 0xe6, 0xb8, 0x8f, 0xb3, 0x6f, 0x39, 0xd5, 0x4e,
+	0xda, 0x80, 0xb8, 0xd5, 0xef, 0xf3, 0x7b, 0x9e, 0xfa, 0xcf, 0x13, 0xd8, 0xe7, 0x3a, 0xcf, 0xb5,
+	0x9a, 0x72, 0x2d, 0x25, 0x72, 0x2b, 0xb4, 0x8a, 0x0b, 0xa3, 0xad, 0x26, 0x03, 0x2f, 0xbc, 0x7d,
+	0xdd, 0x00, 0x85, 0x96, 0x82, 0x0b, 0x2c, 0xbd, 0x3c, 0xf9, 0x01, 0xfb, 0x27, 0x2b, 0xcb, 0x89,
+	0x56, 0xd7, 0x22, 0xbb, 0x60, 0xfc, 0x86, 0x65, 0x48, 0x3e, 0xc1, 0x80, 0xbb, 0x41, 0xd8, 0x1b,
+	0x6f, 0x46, 0xbb, 0x47, 0x61, 0xec, 0x23, 0xe2, 0xc7, 0x86, 0xa4, 0xe1, 0x26, 0x35, 0x0c, 0x1f,
+	0x6b, 0xe4, 0x0a, 0xc2, 0xd2, 0x32, 0x2b, 0x38, 0x5d, 0x6f, 0x8d, 0xae, 0x72, 0x7b, 0xd1, 0xee,
+	0xd1, 0x61, 0x9b, 0x7b, 0xe9, 0xb8, 0xc7, 0x09, 0x67, 0x1b, 0xc9, 0xa8, 0x7c, 0x52, 0x39, 0x0e,
+	0x60, 0xbb, 0x60, 0xb5, 0xd4, 0x2c, 0x9d, 0xfc, 0xdb, 0x84, 0xd1, 0xd3, 0x7e, 0x42, 0xa0, 0xaf,
+	0x58, 0x8e, 0xee, 0xdf, 0x82, 0xc4, 0xfd, 0x26, 0xe7, 0x40, 0x72, 0xcc, 0x67, 0x68, 0xa8, 0x36,
+	0x59, 0x49, 0xdd, 0xa5, 0xd4, 0xe1, 0xb3, 0x87, 0xfb, 0x59, 0x27, 0x5d, 0x38, 0xbd, 0x39, 0xed,
+	0xd0, 0x3b, 0x7f, 0x9b, 0xac, 0xf4, 0x73, 0x12, 0xc3, 0x9e, 0xc1, 0xdb, 0x4a, 0x18, 0x4c, 0x69,
+	0x81, 0x68, 0x28, 0xd7, 0x95, 0xb2, 0xe1, 0xe6, 0xb8, 0x17, 0x6d, 0x25, 0xaf, 0x5a, 0xe9, 0x02,
+	0xd1, 0x9c, 0x2c, 0x05, 0xf2, 0x11, 0x48, 0xce, 0x16, 0x22, 0xaf, 0xf2, 0x2e, 0xde, 0x77, 0xf8,
+	0xb0, 0x51, 0xd6, 0xf4, 0x04, 0x9e, 0xcf, 0xa4, 0xe6, 0x37, 0xd4, 0x6a, 0x2a, 0xc5, 0x1d, 0x86,
+	0x5b, 0xe3, 0x5e, 0xd4, 0x4f, 0x76, 0xdd, 0xf0, 0x8f, 0x3e, 0x17, 0x77, 0x48, 0x22, 0x18, 0xb6,
+	0xe7, 0x51, 0xb2, 0xa6, 0x06, 0x59, 0x1a, 0x0e, 0xc6, 0xbd, 0x68, 0x27, 0x79, 0xd1, 0xec, 0x56,
+	0xc9, 0x3a, 0x41, 0x96, 0x92, 0x53, 0x18, 0xe3, 0x82, 0xcb, 0x2a, 0x45, 0x3a, 0x17, 0xa5, 0xd5,
+	0xa6, 0xa6, 0xd7, 0x46, 0xe7, 0x54, 0xe1, 0x3d, 0xf5, 0x68, 0x19, 0x6e, 0x3b, 0xe7, 0x41, 0xc3,
+	0x9d, 0x79, 0xec, 0xd4, 0xe8, 0xfc, 0x17, 0xde, 0xff, 0xf4, 0x0c, 0xf9, 0x02, 0x6f, 0x3a, 0x96,
+	0x55, 0x56, 0x8a, 0x85, 0x9d, 0x87, 0x3b, 0x6e, 0x87, 0x23, 0xb5, 0xc2, 0x9b, 0x8c, 0xaf, 0x4b,
+	0x75, 0x72, 0x0b, 0xa3, 0xa7, 0xaf, 0x96, 0x9c, 0xc3, 0xb0, 0x14, 0x99, 0x62, 0xb6, 0x32, 0xd8,
+	0x3e, 0x8a, 0x2f, 0xc9, 0xbb, 0x55, 0x49, 0x5a, 0xdd, 0x1b, 0xbf, 0xa9, 0x3b, 0x94, 0xba, 0xc0,
+	0xb3, 0x8d, 0xe4, 0x65, 0xf9, 0x50, 0xea, 0xd6, 0xe3, 0x6f, 0x0f, 0x48, 0xa7, 0x18, 0x46, 0x58,
+	0x34, 0x82, 0x91, 0x10, 0xb6, 0xf9, 0x9c, 0x29, 0x85, 0xb2, 0x69, 0x47, 0xbb, 0x24, 0x7b, 0xb0,
+	0x65, 0x17, 0x54, 0xa4, 0xae, 0x13, 0x41, 0xd2, 0xb7, 0x8b, 0xef, 0x29, 0x39, 0x04, 0x58, 0x97,
+	0xd8, 0x3d, 0x6f, 0x90, 0x74, 0x26, 0xe4, 0x00, 0x82, 0x65, 0xbb, 0xca, 0x82, 0x71, 0x74, 0xcf,
+	0x19, 0x24, 0xeb, 0xc1, 0xf1, 0x25, 0xbc, 0xd7, 0x26, 0x8b, 0xe7, 0x75, 0x81, 0x46, 0x62, 0x9a,
+	0xa1, 0x89, 0xaf, 0xd9, 0xcc, 0x08, 0xee, 0x3f, 0xc5, 0xb2, 0x39, 0xe1, 0xd5, 0x87, 0x4c, 0xd8,
+	0x79, 0x35, 0x5b, 0x2e, 0xa7, 0x1d, 0x78, 0xea, 0xe1, 0xa9, 0x87, 0xa7, 0x1e, 0x9e, 0x0d, 0xdc,
+	0xf2, 0xf3, 0xff, 0x01, 0x00, 0x7c, 0x56, 0x61, 0xbc, 0x00, 0x04, 0x00, 0x00,
 }
@@ -28,6 +28,7 @@ const (
 	Status_BAD_REQUEST              Status = 400
 	Status_FORBIDDEN                Status = 403
 	Status_NOT_FOUND                Status = 404
+	Status_CONFLICT                 Status = 409
 	Status_REQUEST_ENTITY_TOO_LARGE Status = 413
 	Status_INTERNAL_SERVER_ERROR    Status = 500
 	Status_NOT_IMPLEMENTED          Status = 501
@@ -40,6 +41,7 @@ var Status_name = map[int32]string{
 	400: "BAD_REQUEST",
 	403: "FORBIDDEN",
 	404: "NOT_FOUND",
+	409: "CONFLICT",
 	413: "REQUEST_ENTITY_TOO_LARGE",
 	500: "INTERNAL_SERVER_ERROR",
 	501: "NOT_IMPLEMENTED",
@@ -51,6 +53,7 @@ var Status_value = map[string]int32{
 	"BAD_REQUEST":              400,
 	"FORBIDDEN":                403,
 	"NOT_FOUND":                404,
+	"CONFLICT":                 409,
 	"REQUEST_ENTITY_TOO_LARGE": 413,
 	"INTERNAL_SERVER_ERROR":    500,
 	"NOT_IMPLEMENTED":          501,
@@ -61,7 +64,7 @@ func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{0}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{0}
 }
 
 type HeaderType int32
@@ -76,18 +79,20 @@ const (
 	HeaderType_CHAINCODE_PACKAGE    HeaderType = 6
 	HeaderType_PEER_ADMIN_OPERATION HeaderType = 8
 	HeaderType_TOKEN_TRANSACTION    HeaderType = 9
+	HeaderType_DELIVER_ACK          HeaderType = 10
 )
 
 var HeaderType_name = map[int32]string{
-	0: "MESSAGE",
-	1: "CONFIG",
-	2: "CONFIG_UPDATE",
-	3: "ENDORSER_TRANSACTION",
-	4: "ORDERER_TRANSACTION",
-	5: "DELIVER_SEEK_INFO",
-	6: "CHAINCODE_PACKAGE",
-	8: "PEER_ADMIN_OPERATION",
-	9: "TOKEN_TRANSACTION",
+	0:  "MESSAGE",
+	1:  "CONFIG",
+	2:  "CONFIG_UPDATE",
+	3:  "ENDORSER_TRANSACTION",
+	4:  "ORDERER_TRANSACTION",
+	5:  "DELIVER_SEEK_INFO",
+	6:  "CHAINCODE_PACKAGE",
+	8:  "PEER_ADMIN_OPERATION",
+	9:  "TOKEN_TRANSACTION",
+	10: "DELIVER_ACK",
 }
 var HeaderType_value = map[string]int32{
 	"MESSAGE":              0,
@@ -99,13 +104,14 @@ var HeaderType_value = map[string]int32{
 	"CHAINCODE_PACKAGE":    6,
 	"PEER_ADMIN_OPERATION": 8,
 	"TOKEN_TRANSACTION":    9,
+	"DELIVER_ACK":          10,
 }
 
 func (x HeaderType) String() string {
 	return proto.EnumName(HeaderType_name, int32(x))
 }
 func (HeaderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{1}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{1}
 }
 
 // This enum enlists indexes of the block metadata array
@@ -138,7 +144,7 @@ func (x BlockMetadataIndex) String() string {
 	return proto.EnumName(BlockMetadataIndex_name, int32(x))
 }
 func (BlockMetadataIndex) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{2}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{2}
 }
 
 // LastConfig is the encoded value for the Metadata message which is encoded in the LAST_CONFIGURATION block metadata index
@@ -153,7 +159,7 @@ func (m *LastConfig) Reset()         { *m = LastConfig{} }
 func (m *LastConfig) String() string { return proto.CompactTextString(m) }
 func (*LastConfig) ProtoMessage()    {}
 func (*LastConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{0}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{0}
 }
 func (m *LastConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LastConfig.Unmarshal(m, b)
@@ -193,7 +199,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{1}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{1}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Metadata.Unmarshal(m, b)
@@ -239,7 +245,7 @@ func (m *MetadataSignature) Reset()         { *m = MetadataSignature{} }
 func (m *MetadataSignature) String() string { return proto.CompactTextString(m) }
 func (*MetadataSignature) ProtoMessage()    {}
 func (*MetadataSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{2}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{2}
 }
 func (m *MetadataSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MetadataSignature.Unmarshal(m, b)
@@ -285,7 +291,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{3}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{3}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Header.Unmarshal(m, b)
@@ -358,7 +364,7 @@ func (m *ChannelHeader) Reset()         { *m = ChannelHeader{} }
 func (m *ChannelHeader) String() string { return proto.CompactTextString(m) }
 func (*ChannelHeader) ProtoMessage()    {}
 func (*ChannelHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{4}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{4}
 }
 func (m *ChannelHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelHeader.Unmarshal(m, b)
@@ -448,7 +454,7 @@ func (m *SignatureHeader) Reset()         { *m = SignatureHeader{} }
 func (m *SignatureHeader) String() string { return proto.CompactTextString(m) }
 func (*SignatureHeader) ProtoMessage()    {}
 func (*SignatureHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{5}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{5}
 }
 func (m *SignatureHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignatureHeader.Unmarshal(m, b)
@@ -497,7 +503,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{6}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{6}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payload.Unmarshal(m, b)
@@ -546,7 +552,7 @@ func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{7}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{7}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -597,7 +603,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{8}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{8}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Block.Unmarshal(m, b)
@@ -654,7 +660,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{9}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{9}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
@@ -706,7 +712,7 @@ func (m *BlockData) Reset()         { *m = BlockData{} }
 func (m *BlockData) String() string { return proto.CompactTextString(m) }
 func (*BlockData) ProtoMessage()    {}
 func (*BlockData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{10}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{10}
 }
 func (m *BlockData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockData.Unmarshal(m, b)
@@ -744,7 +750,7 @@ func (m *BlockMetadata) Reset()         { *m = BlockMetadata{} }
 func (m *BlockMetadata) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadata) ProtoMessage()    {}
 func (*BlockMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{11}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{11}
 }
 func (m *BlockMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadata.Unmarshal(m, b)
@@ -784,7 +790,7 @@ func (m *OrdererBlockMetadata) Reset()         { *m = OrdererBlockMetadata{} }
 func (m *OrdererBlockMetadata) String() string { return proto.CompactTextString(m) }
 func (*OrdererBlockMetadata) ProtoMessage()    {}
 func (*OrdererBlockMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_72f685cee4d0b877, []int{12}
+	return fileDescriptor_common_dfbcf49a893649f2, []int{12}
 }
 func (m *OrdererBlockMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrdererBlockMetadata.Unmarshal(m, b)
@@ -837,72 +843,74 @@ func init() {
 	proto.RegisterEnum("common.BlockMetadataIndex", BlockMetadataIndex_name, BlockMetadataIndex_value)
 }
 
-func init() { proto.RegisterFile("common/common.proto", fileDescriptor_common_72f685cee4d0b877) }
-
-var fileDescriptor_common_72f685cee4d0b877 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xde, 0xc4, 0xf9, 0xf9, 0xb2, 0x69, 0xdd, 0x49, 0x97, 0x35, 0x85, 0xd5, 0x56, 0x81, 0x45,
-	0xa5, 0x15, 0xa9, 0xe8, 0x5e, 0xe0, 0xe8, 0xd8, 0xd3, 0xd6, 0x6a, 0x62, 0x87, 0xb1, 0xb3, 0x88,
-	0x05, 0x69, 0xe4, 0x26, 0xd3, 0x24, 0xc2, 0xb1, 0x23, 0x7b, 0x52, 0xb5, 0x5c, 0xb9, 0x23, 0x24,
-	0xb8, 0xf2, 0xbf, 0x70, 0x44, 0xfc, 0x3d, 0x20, 0xae, 0x68, 0x3c, 0xb6, 0x9b, 0x94, 0x95, 0x38,
-	0xc5, 0xdf, 0x9b, 0x6f, 0xde, 0xfb, 0xe6, 0x7d, 0x2f, 0x33, 0xd0, 0x99, 0x44, 0xcb, 0x65, 0x14,
-	0x9e, 0xca, 0x9f, 0xde, 0x2a, 0x8e, 0x78, 0x84, 0x6a, 0x12, 0x1d, 0xbc, 0x9c, 0x45, 0xd1, 0x2c,
-	0x60, 0xa7, 0x69, 0xf4, 0x7a, 0x7d, 0x73, 0xca, 0x17, 0x4b, 0x96, 0x70, 0x7f, 0xb9, 0x92, 0xc4,
-	0x6e, 0x17, 0x60, 0xe0, 0x27, 0xdc, 0x88, 0xc2, 0x9b, 0xc5, 0x0c, 0xed, 0x43, 0x75, 0x11, 0x4e,
-	0xd9, 0x9d, 0x56, 0x3a, 0x2c, 0x1d, 0x55, 0x88, 0x04, 0xdd, 0x6f, 0xa1, 0x31, 0x64, 0xdc, 0x9f,
-	0xfa, 0xdc, 0x17, 0x8c, 0x5b, 0x3f, 0x58, 0xb3, 0x94, 0xf1, 0x94, 0x48, 0x80, 0xbe, 0x04, 0x48,
-	0x16, 0xb3, 0xd0, 0xe7, 0xeb, 0x98, 0x25, 0x5a, 0xf9, 0x50, 0x39, 0x6a, 0x9d, 0xbd, 0xdf, 0xcb,
-	0x14, 0xe5, 0x7b, 0xdd, 0x9c, 0x41, 0x36, 0xc8, 0xdd, 0xef, 0x60, 0xef, 0x3f, 0x04, 0xf4, 0x29,
-	0xa8, 0x05, 0x85, 0xce, 0x99, 0x3f, 0x65, 0x71, 0x56, 0x70, 0xb7, 0x88, 0x5f, 0xa6, 0x61, 0xf4,
-	0x21, 0x34, 0x8b, 0x90, 0x56, 0x4e, 0x39, 0x0f, 0x81, 0xee, 0x5b, 0xa8, 0x65, 0xbc, 0x57, 0xb0,
-	0x33, 0x99, 0xfb, 0x61, 0xc8, 0x82, 0xed, 0x84, 0xed, 0x2c, 0x9a, 0xd1, 0xde, 0x55, 0xb9, 0xfc,
-	0xce, 0xca, 0xdd, 0x1f, 0xcb, 0xd0, 0x36, 0xb6, 0x36, 0x23, 0xa8, 0xf0, 0xfb, 0x95, 0xec, 0x4d,
-	0x95, 0xa4, 0xdf, 0x48, 0x83, 0xfa, 0x2d, 0x8b, 0x93, 0x45, 0x14, 0xa6, 0x79, 0xaa, 0x24, 0x87,
-	0xe8, 0x0b, 0x68, 0x16, 0x6e, 0x68, 0xca, 0x61, 0xe9, 0xa8, 0x75, 0x76, 0xd0, 0x93, 0x7e, 0xf5,
-	0x72, 0xbf, 0x7a, 0x5e, 0xce, 0x20, 0x0f, 0x64, 0xf4, 0x02, 0x20, 0x3f, 0xcb, 0x62, 0xaa, 0x55,
-	0x0e, 0x4b, 0x47, 0x4d, 0xd2, 0xcc, 0x22, 0xd6, 0x14, 0x75, 0xa0, 0xca, 0xef, 0xc4, 0x4a, 0x35,
-	0x5d, 0xa9, 0xf0, 0x3b, 0x6b, 0x2a, 0x8c, 0x63, 0xab, 0x68, 0x32, 0xd7, 0x6a, 0xd2, 0xda, 0x14,
-	0x88, 0xee, 0xb1, 0x3b, 0xce, 0xc2, 0x54, 0x5f, 0x5d, 0x76, 0xaf, 0x08, 0xa0, 0x2e, 0xb4, 0x79,
-	0x90, 0xd0, 0x09, 0x8b, 0x39, 0x9d, 0xfb, 0xc9, 0x5c, 0x6b, 0xa4, 0x8c, 0x16, 0x0f, 0x12, 0x83,
-	0xc5, 0xfc, 0xd2, 0x4f, 0xe6, 0x5d, 0x1d, 0x76, 0xdd, 0x47, 0x96, 0x68, 0x50, 0x9f, 0xc4, 0xcc,
-	0xe7, 0x51, 0xde, 0xe3, 0x1c, 0x0a, 0x11, 0x61, 0x14, 0x4e, 0x72, 0xa3, 0x24, 0xe8, 0x62, 0xa8,
-	0x8f, 0xfc, 0xfb, 0x20, 0xf2, 0xa7, 0xe8, 0x13, 0xa8, 0x6d, 0xb8, 0xd3, 0x3a, 0xdb, 0xc9, 0x87,
-	0x48, 0xa6, 0x26, 0xd9, 0xaa, 0xe8, 0xb4, 0x98, 0x98, 0x2c, 0x4f, 0xfa, 0xdd, 0xed, 0x43, 0x03,
-	0x87, 0xb7, 0x2c, 0x88, 0x64, 0xd7, 0x57, 0x32, 0x65, 0x2e, 0x21, 0x83, 0xff, 0x33, 0x2f, 0x3f,
-	0x95, 0xa0, 0xda, 0x0f, 0xa2, 0xc9, 0xf7, 0xe8, 0xe4, 0x91, 0x92, 0x4e, 0xae, 0x24, 0x5d, 0x7e,
-	0x24, 0xe7, 0xd5, 0x86, 0x9c, 0xd6, 0xd9, 0xde, 0x16, 0xd5, 0xf4, 0xb9, 0x2f, 0x15, 0xa2, 0xcf,
-	0xa1, 0xb1, 0xcc, 0x66, 0x3d, 0x33, 0xfc, 0xd9, 0x16, 0x35, 0xff, 0x23, 0x90, 0x82, 0xd6, 0x9d,
-	0x41, 0x6b, 0xa3, 0x20, 0x7a, 0x0f, 0x6a, 0xe1, 0x7a, 0x79, 0x9d, 0xa9, 0xaa, 0x90, 0x0c, 0xa1,
-	0x8f, 0xa0, 0xbd, 0x8a, 0xd9, 0xed, 0x22, 0x5a, 0x27, 0xd2, 0x29, 0x79, 0xb2, 0xa7, 0x79, 0x50,
-	0x58, 0x85, 0x3e, 0x80, 0xa6, 0xc8, 0x29, 0x09, 0x4a, 0x4a, 0x68, 0x88, 0x40, 0xea, 0xe3, 0x4b,
-	0x68, 0x16, 0x72, 0x8b, 0xf6, 0x96, 0x0e, 0x95, 0xa2, 0xbd, 0x27, 0xd0, 0xde, 0x12, 0x89, 0x0e,
-	0x36, 0x4e, 0x23, 0x89, 0x0f, 0xb2, 0x7f, 0x80, 0x7d, 0x27, 0x9e, 0xb2, 0x98, 0xc5, 0xdb, 0x7b,
-	0x5e, 0x43, 0x2b, 0xf0, 0x13, 0x4e, 0x27, 0xe9, 0x7d, 0x93, 0xb5, 0x16, 0xe5, 0x4d, 0x78, 0xb8,
-	0x89, 0x08, 0x04, 0x0f, 0xb7, 0xd2, 0x67, 0x80, 0x26, 0x51, 0x98, 0xb0, 0x90, 0xb3, 0x98, 0x16,
-	0x25, 0xe5, 0x09, 0xf7, 0x8a, 0x95, 0xbc, 0xc6, 0xf1, 0xef, 0x25, 0xa8, 0xb9, 0xdc, 0xe7, 0xeb,
-	0x04, 0xb5, 0xa0, 0x3e, 0xb6, 0xaf, 0x6c, 0xe7, 0x6b, 0x5b, 0x7d, 0x82, 0x9e, 0x42, 0xdd, 0x1d,
-	0x1b, 0x06, 0x76, 0x5d, 0xf5, 0x8f, 0x12, 0x52, 0xa1, 0xd5, 0xd7, 0x4d, 0x4a, 0xf0, 0x57, 0x63,
-	0xec, 0x7a, 0xea, 0xcf, 0x0a, 0xda, 0x81, 0xe6, 0xb9, 0x43, 0xfa, 0x96, 0x69, 0x62, 0x5b, 0xfd,
-	0x25, 0xc5, 0xb6, 0xe3, 0xd1, 0x73, 0x67, 0x6c, 0x9b, 0xea, 0xaf, 0x0a, 0x7a, 0x01, 0x5a, 0xc6,
-	0xa6, 0xd8, 0xf6, 0x2c, 0xef, 0x1b, 0xea, 0x39, 0x0e, 0x1d, 0xe8, 0xe4, 0x02, 0xab, 0xbf, 0x29,
-	0xe8, 0x00, 0x9e, 0x59, 0xb6, 0x87, 0x89, 0xad, 0x0f, 0xa8, 0x8b, 0xc9, 0x1b, 0x4c, 0x28, 0x26,
-	0xc4, 0x21, 0xea, 0x5f, 0x0a, 0xda, 0x87, 0x5d, 0x91, 0xca, 0x1a, 0x8e, 0x06, 0x78, 0x88, 0x6d,
-	0x0f, 0x9b, 0xea, 0xdf, 0x0a, 0xd2, 0xa0, 0x23, 0x88, 0x96, 0x81, 0xe9, 0xd8, 0xd6, 0xdf, 0xe8,
-	0xd6, 0x40, 0xef, 0x0f, 0xb0, 0xfa, 0x8f, 0x72, 0xfc, 0x67, 0x09, 0x40, 0x3a, 0xee, 0x89, 0x3b,
-	0xa4, 0x05, 0xf5, 0x21, 0x76, 0x5d, 0xfd, 0x02, 0xab, 0x4f, 0x10, 0x40, 0xcd, 0x70, 0xec, 0x73,
-	0xeb, 0x42, 0x2d, 0xa1, 0x3d, 0x68, 0xcb, 0x6f, 0x3a, 0x1e, 0x99, 0xba, 0x87, 0xd5, 0x32, 0xd2,
-	0x60, 0x1f, 0xdb, 0xa6, 0x43, 0x5c, 0x4c, 0xa8, 0x47, 0x74, 0xdb, 0xd5, 0x0d, 0xcf, 0x72, 0x6c,
-	0x55, 0x41, 0xcf, 0xa1, 0xe3, 0x10, 0x13, 0x93, 0x47, 0x0b, 0x15, 0xf4, 0x0c, 0xf6, 0x4c, 0x3c,
-	0xb0, 0x84, 0x62, 0x17, 0xe3, 0x2b, 0x6a, 0xd9, 0xe7, 0x8e, 0x5a, 0x15, 0x61, 0xe3, 0x52, 0xb7,
-	0x6c, 0xc3, 0x31, 0x31, 0x1d, 0xe9, 0xc6, 0x95, 0xa8, 0x5f, 0x13, 0x05, 0x46, 0x18, 0x13, 0xaa,
-	0x9b, 0x43, 0xcb, 0xa6, 0xce, 0x08, 0x13, 0x3d, 0xcd, 0xd3, 0x10, 0x1b, 0x3c, 0xe7, 0x0a, 0xdb,
-	0x5b, 0xe9, 0x9b, 0xc7, 0x01, 0xa0, 0xad, 0x21, 0xb0, 0xc4, 0xa3, 0x82, 0x76, 0x00, 0x5c, 0xeb,
-	0xc2, 0xd6, 0xbd, 0x31, 0xc1, 0xae, 0xfa, 0x04, 0xed, 0x42, 0x6b, 0xa0, 0xbb, 0x1e, 0x2d, 0xce,
-	0xf6, 0x1c, 0x3a, 0x1b, 0x79, 0x5c, 0x7a, 0x6e, 0x0d, 0x3c, 0x4c, 0xd4, 0xb2, 0xe8, 0x46, 0x76,
-	0x0e, 0x55, 0x11, 0xdb, 0x0c, 0x67, 0x38, 0xb4, 0x3c, 0x7a, 0xa9, 0xbb, 0x97, 0x6a, 0xa5, 0xef,
-	0xc2, 0xc7, 0x51, 0x3c, 0xeb, 0xcd, 0xef, 0x57, 0x2c, 0x0e, 0xd8, 0x74, 0xc6, 0xe2, 0xde, 0x8d,
-	0x7f, 0x1d, 0x2f, 0x26, 0xf2, 0x4e, 0x4d, 0xb2, 0x59, 0x7b, 0x7b, 0x32, 0x5b, 0xf0, 0xf9, 0xfa,
-	0x5a, 0xc0, 0xd3, 0x0d, 0xf2, 0xa9, 0x24, 0xcb, 0x07, 0x33, 0xc9, 0x1e, 0xd5, 0xeb, 0x5a, 0x0a,
-	0x5f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xad, 0x85, 0xf1, 0xab, 0x6c, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("common/common.proto", fileDescriptor_common_dfbcf49a893649f2) }
+
+var fileDescriptor_common_dfbcf49a893649f2 = []byte{
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x6f, 0xe2, 0xfc, 0x7d, 0x69, 0x5a, 0x77, 0xd2, 0xb2, 0xa6, 0xb0, 0xda, 0xca, 0xb0, 0xa8,
+	0xb4, 0x22, 0x15, 0xdd, 0x0b, 0x1c, 0x1d, 0x7b, 0xda, 0x5a, 0x4d, 0xec, 0x30, 0x76, 0x16, 0xb1,
+	0x20, 0x59, 0x6e, 0x32, 0x4d, 0x22, 0x1c, 0x3b, 0xb2, 0x27, 0x55, 0xcb, 0x95, 0x3b, 0x42, 0x82,
+	0x0b, 0x07, 0xbe, 0x0f, 0x37, 0x3e, 0x00, 0x5f, 0x03, 0xc4, 0x15, 0x8d, 0xc7, 0x76, 0x93, 0xb2,
+	0xd2, 0x9e, 0x32, 0xef, 0xcd, 0xef, 0xbd, 0xf7, 0x7b, 0xef, 0xf7, 0x32, 0x86, 0xce, 0x38, 0x5a,
+	0x2c, 0xa2, 0xf0, 0x4c, 0xfc, 0x74, 0x97, 0x71, 0xc4, 0x22, 0x54, 0x13, 0xd6, 0xe1, 0x8b, 0x69,
+	0x14, 0x4d, 0x03, 0x7a, 0x96, 0x7a, 0x6f, 0x56, 0xb7, 0x67, 0x6c, 0xbe, 0xa0, 0x09, 0xf3, 0x17,
+	0x4b, 0x01, 0x54, 0x55, 0x80, 0xbe, 0x9f, 0x30, 0x3d, 0x0a, 0x6f, 0xe7, 0x53, 0xb4, 0x0f, 0xd5,
+	0x79, 0x38, 0xa1, 0xf7, 0x4a, 0xe9, 0xa8, 0x74, 0x5c, 0x21, 0xc2, 0x50, 0xbf, 0x85, 0xc6, 0x80,
+	0x32, 0x7f, 0xe2, 0x33, 0x9f, 0x23, 0xee, 0xfc, 0x60, 0x45, 0x53, 0xc4, 0x36, 0x11, 0x06, 0xfa,
+	0x12, 0x20, 0x99, 0x4f, 0x43, 0x9f, 0xad, 0x62, 0x9a, 0x28, 0xe5, 0x23, 0xe9, 0xb8, 0x75, 0xfe,
+	0x7e, 0x37, 0x63, 0x94, 0xc7, 0x3a, 0x39, 0x82, 0xac, 0x81, 0xd5, 0xef, 0x60, 0xef, 0x7f, 0x00,
+	0xf4, 0x29, 0xc8, 0x05, 0xc4, 0x9b, 0x51, 0x7f, 0x42, 0xe3, 0xac, 0xe0, 0x6e, 0xe1, 0xbf, 0x4a,
+	0xdd, 0xe8, 0x43, 0x68, 0x16, 0x2e, 0xa5, 0x9c, 0x62, 0x1e, 0x1d, 0xea, 0x1b, 0xa8, 0x65, 0xb8,
+	0x97, 0xb0, 0x33, 0x9e, 0xf9, 0x61, 0x48, 0x83, 0xcd, 0x84, 0xed, 0xcc, 0x9b, 0xc1, 0xde, 0x56,
+	0xb9, 0xfc, 0xd6, 0xca, 0xea, 0x8f, 0x65, 0x68, 0xeb, 0x1b, 0xc1, 0x08, 0x2a, 0xec, 0x61, 0x29,
+	0x66, 0x53, 0x25, 0xe9, 0x19, 0x29, 0x50, 0xbf, 0xa3, 0x71, 0x32, 0x8f, 0xc2, 0x34, 0x4f, 0x95,
+	0xe4, 0x26, 0xfa, 0x02, 0x9a, 0x85, 0x1a, 0x8a, 0x74, 0x54, 0x3a, 0x6e, 0x9d, 0x1f, 0x76, 0x85,
+	0x5e, 0xdd, 0x5c, 0xaf, 0xae, 0x9b, 0x23, 0xc8, 0x23, 0x18, 0x3d, 0x07, 0xc8, 0x7b, 0x99, 0x4f,
+	0x94, 0xca, 0x51, 0xe9, 0xb8, 0x49, 0x9a, 0x99, 0xc7, 0x9c, 0xa0, 0x0e, 0x54, 0xd9, 0x3d, 0xbf,
+	0xa9, 0xa6, 0x37, 0x15, 0x76, 0x6f, 0x4e, 0xb8, 0x70, 0x74, 0x19, 0x8d, 0x67, 0x4a, 0x4d, 0x48,
+	0x9b, 0x1a, 0x7c, 0x7a, 0xf4, 0x9e, 0xd1, 0x30, 0xe5, 0x57, 0x17, 0xd3, 0x2b, 0x1c, 0x48, 0x85,
+	0x36, 0x0b, 0x12, 0x6f, 0x4c, 0x63, 0xe6, 0xcd, 0xfc, 0x64, 0xa6, 0x34, 0x52, 0x44, 0x8b, 0x05,
+	0x89, 0x4e, 0x63, 0x76, 0xe5, 0x27, 0x33, 0x55, 0x83, 0x5d, 0xe7, 0x89, 0x24, 0x0a, 0xd4, 0xc7,
+	0x31, 0xf5, 0x59, 0x94, 0xcf, 0x38, 0x37, 0x39, 0x89, 0x30, 0x0a, 0xc7, 0xb9, 0x50, 0xc2, 0x50,
+	0x31, 0xd4, 0x87, 0xfe, 0x43, 0x10, 0xf9, 0x13, 0xf4, 0x09, 0xd4, 0xd6, 0xd4, 0x69, 0x9d, 0xef,
+	0xe4, 0x4b, 0x24, 0x52, 0x93, 0xda, 0xac, 0x98, 0x34, 0xdf, 0x98, 0x2c, 0x4f, 0x7a, 0x56, 0x7b,
+	0xd0, 0xc0, 0xe1, 0x1d, 0x0d, 0x22, 0x31, 0xf5, 0xa5, 0x48, 0x99, 0x53, 0xc8, 0xcc, 0x77, 0xec,
+	0xcb, 0x4f, 0x25, 0xa8, 0xf6, 0x82, 0x68, 0xfc, 0x3d, 0x3a, 0x7d, 0xc2, 0xa4, 0x93, 0x33, 0x49,
+	0xaf, 0x9f, 0xd0, 0x79, 0xb9, 0x46, 0xa7, 0x75, 0xbe, 0xb7, 0x01, 0x35, 0x7c, 0xe6, 0x0b, 0x86,
+	0xe8, 0x73, 0x68, 0x2c, 0xb2, 0x5d, 0xcf, 0x04, 0x3f, 0xd8, 0x80, 0xe6, 0x7f, 0x04, 0x52, 0xc0,
+	0xd4, 0x29, 0xb4, 0xd6, 0x0a, 0xa2, 0xf7, 0xa0, 0x16, 0xae, 0x16, 0x37, 0x19, 0xab, 0x0a, 0xc9,
+	0x2c, 0xf4, 0x11, 0xb4, 0x97, 0x31, 0xbd, 0x9b, 0x47, 0xab, 0x44, 0x28, 0x25, 0x3a, 0xdb, 0xce,
+	0x9d, 0x5c, 0x2a, 0xf4, 0x01, 0x34, 0x79, 0x4e, 0x01, 0x90, 0x52, 0x40, 0x83, 0x3b, 0x52, 0x1d,
+	0x5f, 0x40, 0xb3, 0xa0, 0x5b, 0x8c, 0xb7, 0x74, 0x24, 0x15, 0xe3, 0x3d, 0x85, 0xf6, 0x06, 0x49,
+	0x74, 0xb8, 0xd6, 0x8d, 0x00, 0x3e, 0xd2, 0xfe, 0x01, 0xf6, 0xed, 0x78, 0x42, 0x63, 0x1a, 0x6f,
+	0xc6, 0xbc, 0x82, 0x56, 0xe0, 0x27, 0xcc, 0x1b, 0xa7, 0xef, 0x4d, 0x36, 0x5a, 0x94, 0x0f, 0xe1,
+	0xf1, 0x25, 0x22, 0x10, 0x14, 0x67, 0xf4, 0x19, 0xa0, 0x71, 0x14, 0x26, 0x34, 0x64, 0x34, 0xf6,
+	0x8a, 0x92, 0xa2, 0xc3, 0xbd, 0xe2, 0x26, 0xaf, 0x71, 0xf2, 0x67, 0x09, 0x6a, 0x0e, 0xf3, 0xd9,
+	0x2a, 0x41, 0x2d, 0xa8, 0x8f, 0xac, 0x6b, 0xcb, 0xfe, 0xda, 0x92, 0xb7, 0xd0, 0x36, 0xd4, 0x9d,
+	0x91, 0xae, 0x63, 0xc7, 0x91, 0xff, 0x28, 0x21, 0x19, 0x5a, 0x3d, 0xcd, 0xf0, 0x08, 0xfe, 0x6a,
+	0x84, 0x1d, 0x57, 0xfe, 0x59, 0x42, 0x3b, 0xd0, 0xbc, 0xb0, 0x49, 0xcf, 0x34, 0x0c, 0x6c, 0xc9,
+	0xbf, 0xa4, 0xb6, 0x65, 0xbb, 0xde, 0x85, 0x3d, 0xb2, 0x0c, 0xf9, 0x57, 0x09, 0xb5, 0xa1, 0xa1,
+	0xdb, 0xd6, 0x45, 0xdf, 0xd4, 0x5d, 0xf9, 0x37, 0x09, 0x3d, 0x07, 0x25, 0x0b, 0xf6, 0xb0, 0xe5,
+	0x9a, 0xee, 0x37, 0x9e, 0x6b, 0xdb, 0x5e, 0x5f, 0x23, 0x97, 0x58, 0xfe, 0x5d, 0x42, 0x87, 0x70,
+	0x60, 0x5a, 0x2e, 0x26, 0x96, 0xd6, 0xf7, 0x1c, 0x4c, 0x5e, 0x63, 0xe2, 0x61, 0x42, 0x6c, 0x22,
+	0xff, 0x2d, 0xa1, 0x7d, 0xd8, 0xe5, 0x99, 0xcd, 0xc1, 0xb0, 0x8f, 0x07, 0xd8, 0x72, 0xb1, 0x21,
+	0xff, 0x23, 0x21, 0x05, 0x3a, 0x1c, 0x68, 0xea, 0xd8, 0x1b, 0x59, 0xda, 0x6b, 0xcd, 0xec, 0x6b,
+	0xbd, 0x3e, 0x96, 0xff, 0x95, 0x4e, 0xfe, 0x2a, 0x01, 0x88, 0x05, 0x70, 0xf9, 0x93, 0xd2, 0x82,
+	0xfa, 0x00, 0x3b, 0x8e, 0x76, 0x89, 0xe5, 0x2d, 0x04, 0x50, 0xe3, 0xac, 0xcc, 0x4b, 0xb9, 0x84,
+	0xf6, 0xa0, 0x2d, 0xce, 0xde, 0x68, 0x68, 0x68, 0x2e, 0x96, 0xcb, 0x48, 0x81, 0x7d, 0x6c, 0x19,
+	0x36, 0x71, 0x30, 0xf1, 0x5c, 0xa2, 0x59, 0x8e, 0xa6, 0xbb, 0xa6, 0x6d, 0xc9, 0x12, 0x7a, 0x06,
+	0x1d, 0x9b, 0x18, 0x98, 0x3c, 0xb9, 0xa8, 0xa0, 0x03, 0xd8, 0x33, 0x70, 0xdf, 0xe4, 0x8c, 0x1d,
+	0x8c, 0xaf, 0x3d, 0xd3, 0xba, 0xb0, 0xe5, 0x2a, 0x77, 0xeb, 0x57, 0x9a, 0x69, 0xe9, 0xb6, 0x81,
+	0xbd, 0xa1, 0xa6, 0x5f, 0xf3, 0xfa, 0x35, 0x5e, 0x60, 0x88, 0x31, 0xf1, 0x34, 0x63, 0x60, 0x5a,
+	0x9e, 0x3d, 0xc4, 0x44, 0x4b, 0xf3, 0x34, 0x78, 0x80, 0x6b, 0x5f, 0x63, 0x6b, 0x23, 0x7d, 0x13,
+	0xed, 0x42, 0x2b, 0x4f, 0xaf, 0xe9, 0xd7, 0x32, 0x9c, 0x04, 0x80, 0x36, 0x96, 0xc4, 0xe4, 0x1f,
+	0x1d, 0xb4, 0x03, 0xe0, 0x98, 0x97, 0x96, 0xe6, 0x8e, 0x08, 0x76, 0xe4, 0x2d, 0x1e, 0xd6, 0xd7,
+	0x1c, 0xd7, 0x2b, 0x9a, 0x7d, 0x06, 0x9d, 0xb5, 0xc4, 0x8e, 0x77, 0x61, 0xf6, 0x5d, 0x4c, 0xe4,
+	0x32, 0x1f, 0x4f, 0xd6, 0x98, 0x2c, 0xf1, 0x30, 0xdd, 0x1e, 0x0c, 0x4c, 0xd7, 0xbb, 0xd2, 0x9c,
+	0x2b, 0xb9, 0xd2, 0x73, 0xe0, 0xe3, 0x28, 0x9e, 0x76, 0x67, 0x0f, 0x4b, 0x1a, 0x07, 0x74, 0x32,
+	0xa5, 0x71, 0xf7, 0xd6, 0xbf, 0x89, 0xe7, 0x63, 0xf1, 0xe6, 0x26, 0xd9, 0x2e, 0xbe, 0x39, 0x9d,
+	0xce, 0xd9, 0x6c, 0x75, 0xc3, 0xcd, 0xb3, 0x35, 0xf0, 0x99, 0x00, 0x8b, 0x0f, 0x6a, 0x92, 0x7d,
+	0x74, 0x6f, 0x6a, 0xa9, 0xf9, 0xea, 0xbf, 0x01, 0x00, 0x87, 0xf8, 0xed, 0x9e, 0x8c, 0x07, 0x00,
+	0x00,
 }
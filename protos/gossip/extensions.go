@@ -344,6 +344,9 @@ type ConnectionInfo struct {
 	Auth     *AuthInfo
 	Identity api.PeerIdentityType
 	Endpoint string
+	// Compression is the compression algorithm negotiated
+	// for messages sent to the remote peer
+	Compression CompressionAlgorithm
 }
 
 // String returns a string representation of this ConnectionInfo
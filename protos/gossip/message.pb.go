@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// CompressionAlgorithm is an algorithm that is used to compress
+// the payloads of envelopes sent over a gossip connection
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithm_UNCOMPRESSED CompressionAlgorithm = 0
+	CompressionAlgorithm_GZIP         CompressionAlgorithm = 1
+	CompressionAlgorithm_SNAPPY       CompressionAlgorithm = 2
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "UNCOMPRESSED",
+	1: "GZIP",
+	2: "SNAPPY",
+}
+var CompressionAlgorithm_value = map[string]int32{
+	"UNCOMPRESSED": 0,
+	"GZIP":         1,
+	"SNAPPY":       2,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_message_b955cd184b3cc60a, []int{0}
+}
+
 type PullMsgType int32
 
 const (
@@ -47,7 +75,7 @@ func (x PullMsgType) String() string {
 	return proto.EnumName(PullMsgType_name, int32(x))
 }
 func (PullMsgType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{1}
 }
 
 type GossipMessage_Tag int32
@@ -82,7 +110,7 @@ func (x GossipMessage_Tag) String() string {
 	return proto.EnumName(GossipMessage_Tag_name, int32(x))
 }
 func (GossipMessage_Tag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3, 0}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{3, 0}
 }
 
 // Envelope contains a marshalled
@@ -90,19 +118,22 @@ func (GossipMessage_Tag) EnumDescriptor() ([]byte, []int) {
 // It may also contain a SecretEnvelope
 // which is a marshalled Secret
 type Envelope struct {
-	Payload              []byte          `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Signature            []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	SecretEnvelope       *SecretEnvelope `protobuf:"bytes,3,opt,name=secret_envelope,json=secretEnvelope,proto3" json:"secret_envelope,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Payload        []byte          `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature      []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	SecretEnvelope *SecretEnvelope `protobuf:"bytes,3,opt,name=secret_envelope,json=secretEnvelope,proto3" json:"secret_envelope,omitempty"`
+	// compression is the algorithm the payload is compressed with
+	// when the envelope is sent over a connection that negotiated it
+	Compression          CompressionAlgorithm `protobuf:"varint,4,opt,name=compression,proto3,enum=gossip.CompressionAlgorithm" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Envelope) Reset()         { *m = Envelope{} }
 func (m *Envelope) String() string { return proto.CompactTextString(m) }
 func (*Envelope) ProtoMessage()    {}
 func (*Envelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{0}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{0}
 }
 func (m *Envelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Envelope.Unmarshal(m, b)
@@ -143,6 +174,13 @@ func (m *Envelope) GetSecretEnvelope() *SecretEnvelope {
 	return nil
 }
 
+func (m *Envelope) GetCompression() CompressionAlgorithm {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionAlgorithm_UNCOMPRESSED
+}
+
 // SecretEnvelope is a marshalled Secret
 // and a signature over it.
 // The signature should be validated by the peer
@@ -160,7 +198,7 @@ func (m *SecretEnvelope) Reset()         { *m = SecretEnvelope{} }
 func (m *SecretEnvelope) String() string { return proto.CompactTextString(m) }
 func (*SecretEnvelope) ProtoMessage()    {}
 func (*SecretEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{1}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{1}
 }
 func (m *SecretEnvelope) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretEnvelope.Unmarshal(m, b)
@@ -210,7 +248,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{2}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{2}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Secret.Unmarshal(m, b)
@@ -349,7 +387,7 @@ func (m *GossipMessage) Reset()         { *m = GossipMessage{} }
 func (m *GossipMessage) String() string { return proto.CompactTextString(m) }
 func (*GossipMessage) ProtoMessage()    {}
 func (*GossipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{3}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{3}
 }
 func (m *GossipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipMessage.Unmarshal(m, b)
@@ -1128,7 +1166,7 @@ func (m *StateInfo) Reset()         { *m = StateInfo{} }
 func (m *StateInfo) String() string { return proto.CompactTextString(m) }
 func (*StateInfo) ProtoMessage()    {}
 func (*StateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{4}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{4}
 }
 func (m *StateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfo.Unmarshal(m, b)
@@ -1189,7 +1227,7 @@ func (m *Properties) Reset()         { *m = Properties{} }
 func (m *Properties) String() string { return proto.CompactTextString(m) }
 func (*Properties) ProtoMessage()    {}
 func (*Properties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{5}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{5}
 }
 func (m *Properties) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Properties.Unmarshal(m, b)
@@ -1242,7 +1280,7 @@ func (m *StateInfoSnapshot) Reset()         { *m = StateInfoSnapshot{} }
 func (m *StateInfoSnapshot) String() string { return proto.CompactTextString(m) }
 func (*StateInfoSnapshot) ProtoMessage()    {}
 func (*StateInfoSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{6}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{6}
 }
 func (m *StateInfoSnapshot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoSnapshot.Unmarshal(m, b)
@@ -1285,7 +1323,7 @@ func (m *StateInfoPullRequest) Reset()         { *m = StateInfoPullRequest{} }
 func (m *StateInfoPullRequest) String() string { return proto.CompactTextString(m) }
 func (*StateInfoPullRequest) ProtoMessage()    {}
 func (*StateInfoPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{7}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{7}
 }
 func (m *StateInfoPullRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateInfoPullRequest.Unmarshal(m, b)
@@ -1316,19 +1354,22 @@ func (m *StateInfoPullRequest) GetChannel_MAC() []byte {
 // Whenever a peer connects to another peer, it handshakes
 // with it by sending this message that proves its identity
 type ConnEstablish struct {
-	PkiId                []byte   `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
-	Identity             []byte   `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
-	TlsCertHash          []byte   `protobuf:"bytes,3,opt,name=tls_cert_hash,json=tlsCertHash,proto3" json:"tls_cert_hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PkiId       []byte `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	Identity    []byte `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	TlsCertHash []byte `protobuf:"bytes,3,opt,name=tls_cert_hash,json=tlsCertHash,proto3" json:"tls_cert_hash,omitempty"`
+	// compression_algorithms are the algorithms the peer
+	// can decompress, in the order of its preference
+	CompressionAlgorithms []CompressionAlgorithm `protobuf:"varint,4,rep,packed,name=compression_algorithms,json=compressionAlgorithms,proto3,enum=gossip.CompressionAlgorithm" json:"compression_algorithms,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *ConnEstablish) Reset()         { *m = ConnEstablish{} }
 func (m *ConnEstablish) String() string { return proto.CompactTextString(m) }
 func (*ConnEstablish) ProtoMessage()    {}
 func (*ConnEstablish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{8}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{8}
 }
 func (m *ConnEstablish) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnEstablish.Unmarshal(m, b)
@@ -1369,6 +1410,13 @@ func (m *ConnEstablish) GetTlsCertHash() []byte {
 	return nil
 }
 
+func (m *ConnEstablish) GetCompressionAlgorithms() []CompressionAlgorithm {
+	if m != nil {
+		return m.CompressionAlgorithms
+	}
+	return nil
+}
+
 // PeerIdentity defines the identity of the peer
 // Used to make other peers learn of the identity
 // of a certain peer
@@ -1385,7 +1433,7 @@ func (m *PeerIdentity) Reset()         { *m = PeerIdentity{} }
 func (m *PeerIdentity) String() string { return proto.CompactTextString(m) }
 func (*PeerIdentity) ProtoMessage()    {}
 func (*PeerIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{9}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{9}
 }
 func (m *PeerIdentity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerIdentity.Unmarshal(m, b)
@@ -1441,7 +1489,7 @@ func (m *DataRequest) Reset()         { *m = DataRequest{} }
 func (m *DataRequest) String() string { return proto.CompactTextString(m) }
 func (*DataRequest) ProtoMessage()    {}
 func (*DataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{10}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{10}
 }
 func (m *DataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataRequest.Unmarshal(m, b)
@@ -1497,7 +1545,7 @@ func (m *GossipHello) Reset()         { *m = GossipHello{} }
 func (m *GossipHello) String() string { return proto.CompactTextString(m) }
 func (*GossipHello) ProtoMessage()    {}
 func (*GossipHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{11}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{11}
 }
 func (m *GossipHello) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GossipHello.Unmarshal(m, b)
@@ -1553,7 +1601,7 @@ func (m *DataUpdate) Reset()         { *m = DataUpdate{} }
 func (m *DataUpdate) String() string { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()    {}
 func (*DataUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{12}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{12}
 }
 func (m *DataUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdate.Unmarshal(m, b)
@@ -1609,7 +1657,7 @@ func (m *DataDigest) Reset()         { *m = DataDigest{} }
 func (m *DataDigest) String() string { return proto.CompactTextString(m) }
 func (*DataDigest) ProtoMessage()    {}
 func (*DataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{13}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{13}
 }
 func (m *DataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataDigest.Unmarshal(m, b)
@@ -1662,7 +1710,7 @@ func (m *DataMessage) Reset()         { *m = DataMessage{} }
 func (m *DataMessage) String() string { return proto.CompactTextString(m) }
 func (*DataMessage) ProtoMessage()    {}
 func (*DataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{14}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{14}
 }
 func (m *DataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataMessage.Unmarshal(m, b)
@@ -1703,7 +1751,7 @@ func (m *PrivateDataMessage) Reset()         { *m = PrivateDataMessage{} }
 func (m *PrivateDataMessage) String() string { return proto.CompactTextString(m) }
 func (*PrivateDataMessage) ProtoMessage()    {}
 func (*PrivateDataMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{15}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{15}
 }
 func (m *PrivateDataMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivateDataMessage.Unmarshal(m, b)
@@ -1744,7 +1792,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{16}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{16}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Payload.Unmarshal(m, b)
@@ -1804,7 +1852,7 @@ func (m *PrivatePayload) Reset()         { *m = PrivatePayload{} }
 func (m *PrivatePayload) String() string { return proto.CompactTextString(m) }
 func (*PrivatePayload) ProtoMessage()    {}
 func (*PrivatePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{17}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{17}
 }
 func (m *PrivatePayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrivatePayload.Unmarshal(m, b)
@@ -1881,7 +1929,7 @@ func (m *AliveMessage) Reset()         { *m = AliveMessage{} }
 func (m *AliveMessage) String() string { return proto.CompactTextString(m) }
 func (*AliveMessage) ProtoMessage()    {}
 func (*AliveMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{18}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{18}
 }
 func (m *AliveMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AliveMessage.Unmarshal(m, b)
@@ -1928,6 +1976,7 @@ type LeadershipMessage struct {
 	PkiId                []byte    `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
 	Timestamp            *PeerTime `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	IsDeclaration        bool      `protobuf:"varint,3,opt,name=is_declaration,json=isDeclaration,proto3" json:"is_declaration,omitempty"`
+	Rank                 uint64    `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -1937,7 +1986,7 @@ func (m *LeadershipMessage) Reset()         { *m = LeadershipMessage{} }
 func (m *LeadershipMessage) String() string { return proto.CompactTextString(m) }
 func (*LeadershipMessage) ProtoMessage()    {}
 func (*LeadershipMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{19}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{19}
 }
 func (m *LeadershipMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LeadershipMessage.Unmarshal(m, b)
@@ -1978,6 +2027,13 @@ func (m *LeadershipMessage) GetIsDeclaration() bool {
 	return false
 }
 
+func (m *LeadershipMessage) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
 // PeerTime defines the logical time of a peer's life
 type PeerTime struct {
 	IncNum               uint64   `protobuf:"varint,1,opt,name=inc_num,json=incNum,proto3" json:"inc_num,omitempty"`
@@ -1991,7 +2047,7 @@ func (m *PeerTime) Reset()         { *m = PeerTime{} }
 func (m *PeerTime) String() string { return proto.CompactTextString(m) }
 func (*PeerTime) ProtoMessage()    {}
 func (*PeerTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{20}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{20}
 }
 func (m *PeerTime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerTime.Unmarshal(m, b)
@@ -2039,7 +2095,7 @@ func (m *MembershipRequest) Reset()         { *m = MembershipRequest{} }
 func (m *MembershipRequest) String() string { return proto.CompactTextString(m) }
 func (*MembershipRequest) ProtoMessage()    {}
 func (*MembershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{21}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{21}
 }
 func (m *MembershipRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipRequest.Unmarshal(m, b)
@@ -2086,7 +2142,7 @@ func (m *MembershipResponse) Reset()         { *m = MembershipResponse{} }
 func (m *MembershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MembershipResponse) ProtoMessage()    {}
 func (*MembershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{22}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{22}
 }
 func (m *MembershipResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MembershipResponse.Unmarshal(m, b)
@@ -2135,7 +2191,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{23}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{23}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Member.Unmarshal(m, b)
@@ -2187,7 +2243,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{24}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{24}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -2221,7 +2277,7 @@ func (m *RemoteStateRequest) Reset()         { *m = RemoteStateRequest{} }
 func (m *RemoteStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateRequest) ProtoMessage()    {}
 func (*RemoteStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{25}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{25}
 }
 func (m *RemoteStateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateRequest.Unmarshal(m, b)
@@ -2268,7 +2324,7 @@ func (m *RemoteStateResponse) Reset()         { *m = RemoteStateResponse{} }
 func (m *RemoteStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoteStateResponse) ProtoMessage()    {}
 func (*RemoteStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{26}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{26}
 }
 func (m *RemoteStateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteStateResponse.Unmarshal(m, b)
@@ -2295,6 +2351,133 @@ func (m *RemoteStateResponse) GetPayloads() []*Payload {
 	return nil
 }
 
+// BlockStreamRequest is used to request the blocks
+// in the range [start_seq_num...end_seq_num] of a channel
+// over the BlockStream service
+type BlockStreamRequest struct {
+	Channel     []byte `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	StartSeqNum uint64 `protobuf:"varint,2,opt,name=start_seq_num,json=startSeqNum,proto3" json:"start_seq_num,omitempty"`
+	EndSeqNum   uint64 `protobuf:"varint,3,opt,name=end_seq_num,json=endSeqNum,proto3" json:"end_seq_num,omitempty"`
+	// identity of the requesting peer, the Envelope
+	// that carries the request is signed by it
+	Identity []byte `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// time the request was created at, in nanoseconds
+	// since the epoch
+	Timestamp uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// hash of the TLS client certificate of the
+	// requesting peer
+	TlsCertHash          []byte   `protobuf:"bytes,6,opt,name=tls_cert_hash,json=tlsCertHash,proto3" json:"tls_cert_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockStreamRequest) Reset()         { *m = BlockStreamRequest{} }
+func (m *BlockStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockStreamRequest) ProtoMessage()    {}
+func (*BlockStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_b955cd184b3cc60a, []int{27}
+}
+func (m *BlockStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockStreamRequest.Unmarshal(m, b)
+}
+func (m *BlockStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockStreamRequest.Marshal(b, m, deterministic)
+}
+func (dst *BlockStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStreamRequest.Merge(dst, src)
+}
+func (m *BlockStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockStreamRequest.Size(m)
+}
+func (m *BlockStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStreamRequest proto.InternalMessageInfo
+
+func (m *BlockStreamRequest) GetChannel() []byte {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *BlockStreamRequest) GetStartSeqNum() uint64 {
+	if m != nil {
+		return m.StartSeqNum
+	}
+	return 0
+}
+
+func (m *BlockStreamRequest) GetEndSeqNum() uint64 {
+	if m != nil {
+		return m.EndSeqNum
+	}
+	return 0
+}
+
+func (m *BlockStreamRequest) GetIdentity() []byte {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+func (m *BlockStreamRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockStreamRequest) GetTlsCertHash() []byte {
+	if m != nil {
+		return m.TlsCertHash
+	}
+	return nil
+}
+
+// BlockStreamResponse carries a single block of
+// the range requested by a BlockStreamRequest
+type BlockStreamResponse struct {
+	Payload              *Payload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockStreamResponse) Reset()         { *m = BlockStreamResponse{} }
+func (m *BlockStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockStreamResponse) ProtoMessage()    {}
+func (*BlockStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_b955cd184b3cc60a, []int{28}
+}
+func (m *BlockStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockStreamResponse.Unmarshal(m, b)
+}
+func (m *BlockStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockStreamResponse.Marshal(b, m, deterministic)
+}
+func (dst *BlockStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStreamResponse.Merge(dst, src)
+}
+func (m *BlockStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockStreamResponse.Size(m)
+}
+func (m *BlockStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStreamResponse proto.InternalMessageInfo
+
+func (m *BlockStreamResponse) GetPayload() *Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 // RemotePrivateDataRequest message used to request
 // missing private rwset
 type RemotePvtDataRequest struct {
@@ -2308,7 +2491,7 @@ func (m *RemotePvtDataRequest) Reset()         { *m = RemotePvtDataRequest{} }
 func (m *RemotePvtDataRequest) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataRequest) ProtoMessage()    {}
 func (*RemotePvtDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{27}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{29}
 }
 func (m *RemotePvtDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataRequest.Unmarshal(m, b)
@@ -2351,7 +2534,7 @@ func (m *PvtDataDigest) Reset()         { *m = PvtDataDigest{} }
 func (m *PvtDataDigest) String() string { return proto.CompactTextString(m) }
 func (*PvtDataDigest) ProtoMessage()    {}
 func (*PvtDataDigest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{28}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{30}
 }
 func (m *PvtDataDigest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataDigest.Unmarshal(m, b)
@@ -2419,7 +2602,7 @@ func (m *RemotePvtDataResponse) Reset()         { *m = RemotePvtDataResponse{} }
 func (m *RemotePvtDataResponse) String() string { return proto.CompactTextString(m) }
 func (*RemotePvtDataResponse) ProtoMessage()    {}
 func (*RemotePvtDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{29}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{31}
 }
 func (m *RemotePvtDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePvtDataResponse.Unmarshal(m, b)
@@ -2459,7 +2642,7 @@ func (m *PvtDataElement) Reset()         { *m = PvtDataElement{} }
 func (m *PvtDataElement) String() string { return proto.CompactTextString(m) }
 func (*PvtDataElement) ProtoMessage()    {}
 func (*PvtDataElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{30}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{32}
 }
 func (m *PvtDataElement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataElement.Unmarshal(m, b)
@@ -2509,7 +2692,7 @@ func (m *PvtDataPayload) Reset()         { *m = PvtDataPayload{} }
 func (m *PvtDataPayload) String() string { return proto.CompactTextString(m) }
 func (*PvtDataPayload) ProtoMessage()    {}
 func (*PvtDataPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{31}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{33}
 }
 func (m *PvtDataPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PvtDataPayload.Unmarshal(m, b)
@@ -2554,7 +2737,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{32}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{34}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Acknowledgement.Unmarshal(m, b)
@@ -2596,7 +2779,7 @@ func (m *Chaincode) Reset()         { *m = Chaincode{} }
 func (m *Chaincode) String() string { return proto.CompactTextString(m) }
 func (*Chaincode) ProtoMessage()    {}
 func (*Chaincode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_7c42328ef5ef9997, []int{33}
+	return fileDescriptor_message_b955cd184b3cc60a, []int{35}
 }
 func (m *Chaincode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chaincode.Unmarshal(m, b)
@@ -2665,6 +2848,8 @@ func init() {
 	proto.RegisterType((*Empty)(nil), "gossip.Empty")
 	proto.RegisterType((*RemoteStateRequest)(nil), "gossip.RemoteStateRequest")
 	proto.RegisterType((*RemoteStateResponse)(nil), "gossip.RemoteStateResponse")
+	proto.RegisterType((*BlockStreamRequest)(nil), "gossip.BlockStreamRequest")
+	proto.RegisterType((*BlockStreamResponse)(nil), "gossip.BlockStreamResponse")
 	proto.RegisterType((*RemotePvtDataRequest)(nil), "gossip.RemotePvtDataRequest")
 	proto.RegisterType((*PvtDataDigest)(nil), "gossip.PvtDataDigest")
 	proto.RegisterType((*RemotePvtDataResponse)(nil), "gossip.RemotePvtDataResponse")
@@ -2672,6 +2857,7 @@ func init() {
 	proto.RegisterType((*PvtDataPayload)(nil), "gossip.PvtDataPayload")
 	proto.RegisterType((*Acknowledgement)(nil), "gossip.Acknowledgement")
 	proto.RegisterType((*Chaincode)(nil), "gossip.Chaincode")
+	proto.RegisterEnum("gossip.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterEnum("gossip.PullMsgType", PullMsgType_name, PullMsgType_value)
 	proto.RegisterEnum("gossip.GossipMessage_Tag", GossipMessage_Tag_name, GossipMessage_Tag_value)
 }
@@ -2818,126 +3004,232 @@ var _Gossip_serviceDesc = grpc.ServiceDesc{
 	Metadata: "gossip/message.proto",
 }
 
-func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_7c42328ef5ef9997) }
-
-var fileDescriptor_message_7c42328ef5ef9997 = []byte{
-	// 1874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0xe3, 0xc6,
-	0x15, 0x16, 0x6d, 0x5d, 0x8f, 0x2e, 0x96, 0xc7, 0xde, 0x5d, 0xc6, 0x49, 0x13, 0x87, 0xed, 0x26,
-	0xdb, 0x7a, 0x23, 0x6f, 0x9d, 0x16, 0x0d, 0x90, 0xb6, 0x0b, 0x5b, 0x52, 0x2c, 0x21, 0x2b, 0xad,
-	0x4b, 0x7b, 0xd1, 0xba, 0x2f, 0xc4, 0x98, 0x1c, 0x53, 0xac, 0xc9, 0x21, 0xcd, 0x19, 0x3b, 0xf6,
-	0x63, 0xd1, 0x87, 0x00, 0x7d, 0xe9, 0x6f, 0xe8, 0x53, 0xff, 0x66, 0x31, 0x33, 0xbc, 0x4a, 0xf6,
-	0x02, 0x1b, 0x20, 0x6f, 0x3c, 0xf7, 0x99, 0x33, 0x67, 0xbe, 0x73, 0x86, 0xb0, 0xed, 0x86, 0x8c,
-	0x79, 0xd1, 0x7e, 0x40, 0x18, 0xc3, 0x2e, 0x19, 0x44, 0x71, 0xc8, 0x43, 0x54, 0x57, 0xdc, 0x9d,
-	0x67, 0x76, 0x18, 0x04, 0x21, 0xdd, 0xb7, 0x43, 0xdf, 0x27, 0x36, 0xf7, 0x42, 0xaa, 0x14, 0x8c,
-	0x7f, 0x69, 0xd0, 0x1c, 0xd3, 0x5b, 0xe2, 0x87, 0x11, 0x41, 0x3a, 0x34, 0x22, 0x7c, 0xef, 0x87,
-	0xd8, 0xd1, 0xb5, 0x5d, 0xed, 0x45, 0xc7, 0x4c, 0x49, 0xf4, 0x09, 0xb4, 0x98, 0xe7, 0x52, 0xcc,
-	0x6f, 0x62, 0xa2, 0xaf, 0x49, 0x59, 0xce, 0x40, 0xaf, 0x61, 0x83, 0x11, 0x3b, 0x26, 0xdc, 0x22,
-	0x89, 0x2b, 0x7d, 0x7d, 0x57, 0x7b, 0xd1, 0x3e, 0x78, 0x3a, 0x50, 0xf1, 0x07, 0xa7, 0x52, 0x9c,
-	0x06, 0x32, 0x7b, 0xac, 0x44, 0x1b, 0x13, 0xe8, 0x95, 0x35, 0x7e, 0xea, 0x52, 0x8c, 0x43, 0xa8,
-	0x2b, 0x4f, 0xe8, 0x25, 0xf4, 0x3d, 0xca, 0x49, 0x4c, 0xb1, 0x3f, 0xa6, 0x4e, 0x14, 0x7a, 0x94,
-	0x4b, 0x57, 0xad, 0x49, 0xc5, 0x5c, 0x91, 0x1c, 0xb5, 0xa0, 0x61, 0x87, 0x94, 0x13, 0xca, 0x8d,
-	0x1f, 0xdb, 0xd0, 0x3d, 0x96, 0xcb, 0x9e, 0xa9, 0x5c, 0xa2, 0x6d, 0xa8, 0xd1, 0x90, 0xda, 0x44,
-	0xda, 0x57, 0x4d, 0x45, 0x88, 0x25, 0xda, 0x0b, 0x4c, 0x29, 0xf1, 0x93, 0x65, 0xa4, 0x24, 0xda,
-	0x83, 0x75, 0x8e, 0x5d, 0x99, 0x83, 0xde, 0xc1, 0x47, 0x69, 0x0e, 0x4a, 0x3e, 0x07, 0x67, 0xd8,
-	0x35, 0x85, 0x16, 0xfa, 0x1a, 0x5a, 0xd8, 0xf7, 0x6e, 0x89, 0x15, 0x30, 0x57, 0xaf, 0xc9, 0xb4,
-	0x6d, 0xa7, 0x26, 0x87, 0x42, 0x90, 0x58, 0x4c, 0x2a, 0x66, 0x53, 0x2a, 0xce, 0x98, 0x8b, 0x7e,
-	0x07, 0x8d, 0x80, 0x04, 0x56, 0x4c, 0xae, 0xf5, 0xba, 0x34, 0xc9, 0xa2, 0xcc, 0x48, 0x70, 0x41,
-	0x62, 0xb6, 0xf0, 0x22, 0x93, 0x5c, 0xdf, 0x10, 0xc6, 0x27, 0x15, 0xb3, 0x1e, 0x90, 0xc0, 0x24,
-	0xd7, 0xe8, 0xf7, 0xa9, 0x15, 0xd3, 0x1b, 0xd2, 0x6a, 0xe7, 0x21, 0x2b, 0x16, 0x85, 0x94, 0x91,
-	0xcc, 0x8c, 0xa1, 0x57, 0xd0, 0x74, 0x30, 0xc7, 0x72, 0x81, 0x4d, 0x69, 0xb7, 0x95, 0xda, 0x8d,
-	0x30, 0xc7, 0xf9, 0xfa, 0x1a, 0x42, 0x4d, 0x2c, 0x6f, 0x0f, 0x6a, 0x0b, 0xe2, 0xfb, 0xa1, 0xde,
-	0x2a, 0xab, 0xab, 0x14, 0x4c, 0x84, 0x68, 0x52, 0x31, 0x95, 0x0e, 0xda, 0x4f, 0xdc, 0x3b, 0x9e,
-	0xab, 0x83, 0xd4, 0x47, 0x45, 0xf7, 0x23, 0xcf, 0x55, 0xbb, 0x90, 0xde, 0x47, 0x9e, 0x9b, 0xad,
-	0x47, 0xec, 0xbe, 0xbd, 0xba, 0x9e, 0x7c, 0xdf, 0xd2, 0x42, 0x6d, 0xbc, 0x2d, 0x2d, 0x6e, 0x22,
-	0x07, 0x73, 0xa2, 0x77, 0x56, 0xa3, 0xbc, 0x93, 0x92, 0x49, 0xc5, 0x04, 0x27, 0xa3, 0xd0, 0x73,
-	0xa8, 0x91, 0x20, 0xe2, 0xf7, 0x7a, 0x57, 0x1a, 0x74, 0x53, 0x83, 0xb1, 0x60, 0x8a, 0x0d, 0x48,
-	0x29, 0xda, 0x83, 0xaa, 0x1d, 0x52, 0xaa, 0xf7, 0xa4, 0xd6, 0x93, 0x54, 0x6b, 0x18, 0x52, 0x3a,
-	0x66, 0x1c, 0x5f, 0xf8, 0x1e, 0x5b, 0x4c, 0x2a, 0xa6, 0x54, 0x42, 0x07, 0x00, 0x8c, 0x63, 0x4e,
-	0x2c, 0x8f, 0x5e, 0x86, 0xfa, 0x86, 0x34, 0xd9, 0xcc, 0xae, 0x89, 0x90, 0x4c, 0xe9, 0xa5, 0xc8,
-	0x4e, 0x8b, 0xa5, 0x04, 0x3a, 0x82, 0x9e, 0xb2, 0x61, 0x14, 0x47, 0x6c, 0x11, 0x72, 0xbd, 0x5f,
-	0x3e, 0xf4, 0xcc, 0xee, 0x34, 0x51, 0x98, 0x54, 0xcc, 0xae, 0x34, 0x49, 0x19, 0x68, 0x06, 0x5b,
-	0x79, 0x5c, 0x2b, 0xba, 0xf1, 0x7d, 0x99, 0xbf, 0x4d, 0xe9, 0xe8, 0x93, 0x15, 0x47, 0x27, 0x37,
-	0xbe, 0x9f, 0x27, 0xb2, 0xcf, 0x96, 0xf8, 0xe8, 0x10, 0x94, 0x7f, 0xe1, 0x44, 0x28, 0xe9, 0xa8,
-	0x5c, 0x50, 0x26, 0x09, 0x42, 0x4e, 0xa4, 0xbb, 0xdc, 0x4d, 0x87, 0x15, 0x68, 0x34, 0x4a, 0x77,
-	0x15, 0x27, 0x25, 0xa7, 0x6f, 0x49, 0x1f, 0x1f, 0x3f, 0xe8, 0x23, 0xab, 0xca, 0x2e, 0x2b, 0x32,
-	0x44, 0x6e, 0x7c, 0x82, 0x1d, 0x55, 0xbc, 0xb2, 0x44, 0xb7, 0xcb, 0xb9, 0x79, 0x93, 0x49, 0xf3,
-	0x42, 0xed, 0xe6, 0x26, 0xa2, 0x5c, 0xbf, 0x85, 0x6e, 0x44, 0x48, 0x6c, 0x79, 0x0e, 0xa1, 0xdc,
-	0xe3, 0xf7, 0xfa, 0x93, 0xf2, 0x35, 0x3c, 0x21, 0x24, 0x9e, 0x26, 0x32, 0xb1, 0x8d, 0xa8, 0x40,
-	0x8b, 0xcb, 0x8e, 0xed, 0x2b, 0xfd, 0xa9, 0x34, 0x79, 0x96, 0xdd, 0x5c, 0xfb, 0x8a, 0x86, 0x3f,
-	0xf8, 0xc4, 0x71, 0x49, 0x40, 0xa8, 0xd8, 0xbc, 0xd0, 0x42, 0x7f, 0x06, 0x88, 0x62, 0xef, 0x56,
-	0x65, 0x41, 0x7f, 0x56, 0x4e, 0xbe, 0xda, 0xef, 0xc9, 0x2d, 0x2f, 0x57, 0x71, 0xc1, 0x02, 0xbd,
-	0x2e, 0xd8, 0x33, 0x5d, 0x97, 0xf6, 0xbf, 0x78, 0xc4, 0x3e, 0xcb, 0x58, 0xc1, 0x04, 0xbd, 0x86,
-	0x4e, 0x42, 0x59, 0xa2, 0xd0, 0xf5, 0x8f, 0xca, 0xc7, 0x76, 0xa2, 0x64, 0xe5, 0x6b, 0xdd, 0x8e,
-	0x72, 0xae, 0x61, 0xc1, 0xfa, 0x19, 0x76, 0x51, 0x17, 0x5a, 0xef, 0xe6, 0xa3, 0xf1, 0x77, 0xd3,
-	0xf9, 0x78, 0xd4, 0xaf, 0xa0, 0x16, 0xd4, 0xc6, 0xb3, 0x93, 0xb3, 0xf3, 0xbe, 0x86, 0x3a, 0xd0,
-	0x7c, 0x6b, 0x1e, 0x5b, 0x6f, 0xe7, 0x6f, 0xce, 0xfb, 0x6b, 0x42, 0x6f, 0x38, 0x39, 0x9c, 0x2b,
-	0x72, 0x1d, 0xf5, 0xa1, 0x23, 0xc9, 0xc3, 0xf9, 0xc8, 0x7a, 0x6b, 0x1e, 0xf7, 0xab, 0x68, 0x03,
-	0xda, 0x4a, 0xc1, 0x94, 0x8c, 0x5a, 0x11, 0x89, 0xff, 0xa7, 0x41, 0x2b, 0xab, 0x48, 0x34, 0x80,
-	0x16, 0xf7, 0x02, 0xc2, 0x38, 0x0e, 0x22, 0x89, 0xb8, 0xed, 0x83, 0x7e, 0xf1, 0x84, 0xce, 0xbc,
-	0x80, 0x98, 0xb9, 0x0a, 0x7a, 0x02, 0xf5, 0xe8, 0xca, 0xb3, 0x3c, 0x47, 0x02, 0x71, 0xc7, 0xac,
-	0x45, 0x57, 0xde, 0xd4, 0x41, 0x9f, 0x41, 0x3b, 0xc1, 0x69, 0x6b, 0x76, 0x38, 0xd4, 0xab, 0x52,
-	0x06, 0x09, 0x6b, 0x76, 0x38, 0x14, 0x37, 0x34, 0x8a, 0xc3, 0x88, 0xc4, 0xdc, 0x23, 0x2c, 0x41,
-	0x64, 0x94, 0x27, 0x28, 0x95, 0x98, 0x05, 0x2d, 0xe3, 0x47, 0x0d, 0x20, 0x17, 0xa1, 0x5f, 0x42,
-	0x57, 0x1e, 0x7d, 0x6c, 0x2d, 0x88, 0xe7, 0x2e, 0x78, 0xd2, 0x38, 0x3a, 0x8a, 0x39, 0x91, 0x3c,
-	0xf4, 0x39, 0x74, 0x7c, 0x72, 0xc9, 0xad, 0x62, 0x13, 0x69, 0x9a, 0x6d, 0xc1, 0x1b, 0x26, 0x8d,
-	0xe4, 0xb7, 0x20, 0x16, 0xe6, 0x51, 0x3b, 0x74, 0x08, 0xd3, 0xd7, 0x77, 0xd7, 0x8b, 0x60, 0x31,
-	0x4c, 0x25, 0x66, 0x41, 0xc9, 0x38, 0x84, 0xcd, 0x15, 0x34, 0x40, 0x2f, 0xa1, 0x49, 0x7c, 0x59,
-	0x88, 0x4c, 0xd7, 0xa4, 0x97, 0x2c, 0x73, 0x59, 0x4f, 0xce, 0x34, 0x8c, 0x3f, 0xc0, 0xf6, 0x43,
-	0x38, 0xb0, 0x9c, 0x39, 0x6d, 0x39, 0x73, 0xc6, 0x25, 0x74, 0x4b, 0xa0, 0x57, 0x38, 0x02, 0xad,
-	0x78, 0x04, 0x3b, 0xd0, 0xcc, 0xae, 0x9a, 0x6a, 0x9d, 0x19, 0x8d, 0x0c, 0xe8, 0x72, 0x9f, 0x59,
-	0x36, 0x89, 0xb9, 0xb5, 0xc0, 0x6c, 0x91, 0x1c, 0x5e, 0x9b, 0xfb, 0x6c, 0x48, 0x62, 0x3e, 0xc1,
-	0x6c, 0x61, 0xbc, 0x83, 0x4e, 0xf1, 0x4a, 0x3e, 0x16, 0x06, 0x41, 0x55, 0xb8, 0x49, 0x42, 0xc8,
-	0x6f, 0x11, 0x3a, 0x20, 0x1c, 0xcb, 0xda, 0x57, 0x9e, 0x33, 0xda, 0x08, 0xa0, 0x5d, 0xb8, 0x79,
-	0x8f, 0x77, 0x7d, 0x47, 0x76, 0x24, 0xa6, 0xaf, 0xed, 0xae, 0x8b, 0xae, 0x9f, 0x90, 0x68, 0x00,
-	0xcd, 0x80, 0xb9, 0x16, 0xbf, 0x4f, 0xc6, 0x9f, 0x5e, 0xde, 0x96, 0x44, 0x16, 0x67, 0xcc, 0x3d,
-	0xbb, 0x8f, 0x88, 0xd9, 0x08, 0xd4, 0x87, 0x11, 0x42, 0xbb, 0xd0, 0x0f, 0x1f, 0x09, 0x57, 0x5c,
-	0xef, 0x5a, 0x79, 0xbd, 0x1f, 0x1c, 0xf0, 0x0e, 0x20, 0x6f, 0x75, 0x8f, 0xc4, 0xfb, 0x15, 0x54,
-	0x93, 0x58, 0x0f, 0x57, 0x49, 0xf5, 0x27, 0x45, 0xf6, 0x55, 0x64, 0xd5, 0xca, 0x7f, 0xf6, 0xc4,
-	0x7e, 0xa3, 0xce, 0x31, 0x9d, 0xde, 0x7e, 0x5d, 0x1e, 0x25, 0xdb, 0x07, 0x1b, 0x99, 0xb5, 0x62,
-	0x67, 0xb3, 0xa5, 0xf1, 0x1d, 0xa0, 0x55, 0x04, 0x44, 0xaf, 0x96, 0x1d, 0x3c, 0x5d, 0x82, 0xcb,
-	0x15, 0x3f, 0xe7, 0xd0, 0x48, 0x78, 0xe8, 0x19, 0x34, 0x18, 0xb9, 0xb6, 0xe8, 0x4d, 0x90, 0x6c,
-	0xb7, 0xce, 0xc8, 0xf5, 0xfc, 0x26, 0x10, 0xd5, 0x59, 0x38, 0x55, 0x95, 0xd7, 0xcf, 0x97, 0xd0,
-	0x79, 0x5d, 0x26, 0xa2, 0x84, 0xbf, 0xff, 0x59, 0x83, 0x5e, 0x39, 0x2c, 0xfa, 0x12, 0x36, 0xf2,
-	0xb9, 0xde, 0xa2, 0x38, 0x50, 0x99, 0x6d, 0x99, 0xbd, 0x9c, 0x3d, 0xc7, 0x01, 0x11, 0xa3, 0xb3,
-	0x90, 0xb2, 0x08, 0xdb, 0x6a, 0x74, 0x6e, 0x99, 0x39, 0x03, 0x6d, 0x41, 0x8d, 0xdf, 0xa5, 0x70,
-	0xd9, 0x32, 0xab, 0xfc, 0x6e, 0xea, 0x08, 0x24, 0x4b, 0x57, 0x14, 0xff, 0xc0, 0x08, 0x4f, 0xf0,
-	0x32, 0x5d, 0xa6, 0x29, 0x78, 0xe8, 0x25, 0xa0, 0x54, 0x89, 0x79, 0x41, 0x8a, 0x79, 0x35, 0xb9,
-	0xdd, 0x7e, 0x22, 0x39, 0xf5, 0x82, 0x04, 0xf7, 0xe6, 0x80, 0x0a, 0xcb, 0xb5, 0x43, 0x7a, 0xe9,
-	0xb9, 0x2c, 0x19, 0x63, 0x3f, 0x1b, 0xa8, 0x87, 0xca, 0x60, 0x98, 0x69, 0x0c, 0xa5, 0xc2, 0x09,
-	0xb6, 0xaf, 0xb0, 0x4b, 0xcc, 0x4d, 0x7b, 0x49, 0xc0, 0x8c, 0x7f, 0x6b, 0xd0, 0x29, 0x0e, 0xca,
-	0x68, 0x00, 0x10, 0x64, 0xf3, 0x6c, 0x72, 0x64, 0xbd, 0xf2, 0xa4, 0x6b, 0x16, 0x34, 0x3e, 0xb8,
-	0xb1, 0x14, 0xe1, 0xab, 0x5a, 0x86, 0x2f, 0xe3, 0x9f, 0x1a, 0x6c, 0xae, 0x4c, 0x1c, 0x8f, 0x01,
-	0xd4, 0x87, 0x06, 0x7e, 0x0e, 0x3d, 0x8f, 0x59, 0x0e, 0xb1, 0x7d, 0x1c, 0x63, 0x91, 0x02, 0x79,
-	0x54, 0x4d, 0xb3, 0xeb, 0xb1, 0x51, 0xce, 0x34, 0xfe, 0x08, 0xcd, 0xd4, 0x5a, 0x94, 0x9f, 0x47,
-	0xed, 0x62, 0xf9, 0x79, 0xd4, 0x16, 0xe5, 0x57, 0xa8, 0xcb, 0xb5, 0x62, 0x5d, 0x1a, 0x97, 0xb0,
-	0xb9, 0xf2, 0x86, 0x40, 0xdf, 0x42, 0x9f, 0x11, 0xff, 0x52, 0x0e, 0x8f, 0x71, 0xa0, 0x62, 0x6b,
-	0xe5, 0x05, 0x67, 0x10, 0xb1, 0x21, 0x34, 0xa7, 0xb9, 0xa2, 0xb8, 0xef, 0x62, 0x18, 0xa2, 0xc9,
-	0xbd, 0x56, 0x84, 0x71, 0x01, 0x68, 0xf5, 0xd5, 0x81, 0xbe, 0x80, 0x9a, 0x7c, 0xe4, 0x3c, 0xda,
-	0xa6, 0x94, 0x58, 0xe2, 0x14, 0xc1, 0xce, 0x7b, 0x70, 0x8a, 0x60, 0xc7, 0xf8, 0x2b, 0xd4, 0x55,
-	0x0c, 0x71, 0x66, 0xa4, 0xf4, 0x0a, 0x34, 0x33, 0xfa, 0xbd, 0x18, 0xfb, 0xf0, 0x10, 0x61, 0x34,
-	0xa0, 0x26, 0x1f, 0x01, 0xc6, 0xdf, 0x00, 0xad, 0x8e, 0xba, 0xa2, 0x89, 0x31, 0x8e, 0x63, 0x6e,
-	0x95, 0xaf, 0x7e, 0x5b, 0x32, 0x4f, 0xd5, 0xfd, 0xff, 0x14, 0xda, 0x84, 0x3a, 0x56, 0xf9, 0x10,
-	0x5a, 0x84, 0x3a, 0x4a, 0x6e, 0x1c, 0xc1, 0xd6, 0x03, 0x03, 0x30, 0xda, 0x83, 0x66, 0x82, 0x32,
-	0x69, 0x2b, 0x5f, 0x81, 0xb3, 0x4c, 0xc1, 0x38, 0x86, 0xed, 0x87, 0x86, 0x4a, 0xb4, 0x9f, 0x63,
-	0xad, 0xf2, 0x91, 0x3d, 0x5a, 0x12, 0x45, 0x85, 0xd4, 0x19, 0x04, 0x1b, 0xff, 0xd5, 0xa0, 0x5b,
-	0x12, 0xe5, 0x68, 0xa1, 0x15, 0xd0, 0xe2, 0xfd, 0x00, 0xf3, 0x29, 0x40, 0x7e, 0x7b, 0x13, 0x94,
-	0x29, 0x70, 0xd0, 0xc7, 0xd0, 0xba, 0xf0, 0x43, 0xfb, 0x4a, 0xe4, 0x44, 0x5e, 0xac, 0xaa, 0xd9,
-	0x94, 0x8c, 0x53, 0x72, 0x8d, 0x76, 0xa1, 0x23, 0x52, 0xe5, 0x51, 0x4b, 0xb2, 0x12, 0x74, 0x01,
-	0x46, 0xae, 0xa7, 0xf4, 0x48, 0x70, 0x8c, 0xef, 0xe1, 0xc9, 0x83, 0x13, 0x30, 0x3a, 0x58, 0x99,
-	0x7e, 0x9e, 0x2e, 0x6d, 0x77, 0xac, 0xc4, 0x85, 0x19, 0xe8, 0x1c, 0x7a, 0x65, 0x19, 0xfa, 0x0a,
-	0xea, 0x2a, 0x1b, 0x49, 0xe1, 0x3f, 0x92, 0xb2, 0x44, 0xa9, 0xf8, 0x03, 0x23, 0x69, 0x67, 0x69,
-	0x73, 0xf8, 0x4b, 0xe6, 0x3a, 0x05, 0xf0, 0xe7, 0xb0, 0xc1, 0xef, 0xac, 0xd2, 0xf6, 0x92, 0x81,
-	0x91, 0xdf, 0x9d, 0x66, 0x1b, 0x2c, 0xbb, 0x2c, 0xfe, 0x13, 0x31, 0xbe, 0x84, 0x8d, 0xa5, 0x07,
-	0x87, 0xb8, 0x74, 0x24, 0x8e, 0xc3, 0x38, 0x39, 0x1f, 0x45, 0x18, 0xef, 0xa0, 0x95, 0x8d, 0x8d,
-	0xa2, 0x03, 0x15, 0x9a, 0x85, 0xfc, 0x16, 0x31, 0x6e, 0x49, 0xcc, 0xc4, 0x01, 0xa9, 0xf3, 0x4b,
-	0xc9, 0xf7, 0x4d, 0x4e, 0xbf, 0xf9, 0x13, 0xb4, 0x0b, 0x9d, 0x78, 0xf9, 0x71, 0xd0, 0x85, 0xd6,
-	0xd1, 0x9b, 0xb7, 0xc3, 0xef, 0xad, 0xd9, 0xe9, 0x71, 0x5f, 0x13, 0x6f, 0x80, 0xe9, 0x68, 0x3c,
-	0x3f, 0x9b, 0x9e, 0x9d, 0x4b, 0xce, 0xda, 0xc1, 0x3f, 0xa0, 0xae, 0x26, 0x21, 0xf4, 0x0d, 0x74,
-	0xd4, 0xd7, 0x29, 0x8f, 0x09, 0x0e, 0xd0, 0xca, 0xc5, 0xde, 0x59, 0xe1, 0x18, 0x95, 0x17, 0xda,
-	0x2b, 0x0d, 0x7d, 0x01, 0xd5, 0x13, 0x8f, 0xba, 0xa8, 0xfc, 0x48, 0xdf, 0x29, 0x93, 0x46, 0xe5,
-	0xe8, 0xab, 0xbf, 0xef, 0xb9, 0x1e, 0x5f, 0xdc, 0x5c, 0x88, 0x4e, 0xb3, 0xbf, 0xb8, 0x8f, 0x48,
-	0xac, 0xa6, 0xf2, 0xfd, 0x4b, 0x7c, 0x11, 0x7b, 0xf6, 0xbe, 0xfc, 0x2f, 0xc6, 0xf6, 0x95, 0xd9,
-	0x45, 0x5d, 0x92, 0x5f, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x1d, 0xb3, 0x7e, 0x5f, 0x13,
-	0x00, 0x00,
+// BlockStreamClient is the client API for BlockStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockStreamClient interface {
+	// StreamBlocks streams the blocks requested by the
+	// BlockStreamRequest that is marshalled in the Envelope
+	StreamBlocks(ctx context.Context, in *Envelope, opts ...grpc.CallOption) (BlockStream_StreamBlocksClient, error)
+}
+
+type blockStreamClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockStreamClient(cc *grpc.ClientConn) BlockStreamClient {
+	return &blockStreamClient{cc}
+}
+
+func (c *blockStreamClient) StreamBlocks(ctx context.Context, in *Envelope, opts ...grpc.CallOption) (BlockStream_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockStream_serviceDesc.Streams[0], "/gossip.BlockStream/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockStreamStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockStream_StreamBlocksClient interface {
+	Recv() (*BlockStreamResponse, error)
+	grpc.ClientStream
+}
+
+type blockStreamStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockStreamStreamBlocksClient) Recv() (*BlockStreamResponse, error) {
+	m := new(BlockStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlockStreamServer is the server API for BlockStream service.
+type BlockStreamServer interface {
+	// StreamBlocks streams the blocks requested by the
+	// BlockStreamRequest that is marshalled in the Envelope
+	StreamBlocks(*Envelope, BlockStream_StreamBlocksServer) error
+}
+
+func RegisterBlockStreamServer(s *grpc.Server, srv BlockStreamServer) {
+	s.RegisterService(&_BlockStream_serviceDesc, srv)
+}
+
+func _BlockStream_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Envelope)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockStreamServer).StreamBlocks(m, &blockStreamStreamBlocksServer{stream})
+}
+
+type BlockStream_StreamBlocksServer interface {
+	Send(*BlockStreamResponse) error
+	grpc.ServerStream
+}
+
+type blockStreamStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockStreamStreamBlocksServer) Send(m *BlockStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BlockStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gossip.BlockStream",
+	HandlerType: (*BlockStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _BlockStream_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gossip/message.proto",
+}
+
+func init() { proto.RegisterFile("gossip/message.proto", fileDescriptor_message_b955cd184b3cc60a) }
+
+var fileDescriptor_message_b955cd184b3cc60a = []byte{
+	// 2059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x53, 0x23, 0xc7,
+	0x15, 0xd6, 0xa0, 0x0b, 0xd2, 0xd1, 0x05, 0xd1, 0x5c, 0x76, 0x8c, 0x37, 0x36, 0x99, 0x64, 0xed,
+	0x8d, 0x59, 0xc3, 0x06, 0x27, 0x15, 0x57, 0x39, 0xd9, 0x8d, 0x10, 0x32, 0x52, 0x79, 0x25, 0x94,
+	0x11, 0x54, 0x82, 0x5f, 0xa6, 0x86, 0x51, 0x33, 0x9a, 0x30, 0x37, 0xa6, 0x1b, 0x0c, 0xbf, 0xc0,
+	0x55, 0x79, 0xc9, 0x53, 0x7e, 0x40, 0x9e, 0xf2, 0x23, 0xf2, 0x98, 0x3f, 0x90, 0x9f, 0x94, 0xea,
+	0xee, 0xb9, 0xf4, 0x48, 0x02, 0xd7, 0x6e, 0x55, 0xde, 0xe6, 0x5c, 0xfb, 0xf4, 0xe9, 0xd3, 0xdf,
+	0x39, 0x3d, 0xb0, 0x69, 0x07, 0x84, 0x38, 0xe1, 0x81, 0x87, 0x09, 0x31, 0x6d, 0xbc, 0x1f, 0x46,
+	0x01, 0x0d, 0x50, 0x45, 0x70, 0x77, 0x9e, 0x59, 0x81, 0xe7, 0x05, 0xfe, 0x81, 0x15, 0xb8, 0x2e,
+	0xb6, 0xa8, 0x13, 0xf8, 0x42, 0x41, 0xfb, 0x8f, 0x02, 0xd5, 0x9e, 0x7f, 0x87, 0xdd, 0x20, 0xc4,
+	0x48, 0x85, 0xd5, 0xd0, 0x7c, 0x70, 0x03, 0x73, 0xaa, 0x2a, 0xbb, 0xca, 0xcb, 0x86, 0x9e, 0x90,
+	0xe8, 0x39, 0xd4, 0x88, 0x63, 0xfb, 0x26, 0xbd, 0x8d, 0xb0, 0xba, 0xc2, 0x65, 0x19, 0x03, 0xbd,
+	0x85, 0x35, 0x82, 0xad, 0x08, 0x53, 0x03, 0xc7, 0xae, 0xd4, 0xe2, 0xae, 0xf2, 0xb2, 0x7e, 0xb8,
+	0xbd, 0x2f, 0xd6, 0xdf, 0x9f, 0x70, 0x71, 0xb2, 0x90, 0xde, 0x22, 0x39, 0x1a, 0xbd, 0x81, 0xba,
+	0x15, 0x78, 0x61, 0x84, 0x09, 0x71, 0x02, 0x5f, 0x2d, 0xed, 0x2a, 0x2f, 0x5b, 0x87, 0xcf, 0x13,
+	0xe3, 0x6e, 0x26, 0xea, 0xb8, 0x76, 0x10, 0x39, 0x74, 0xe6, 0xe9, 0xb2, 0x81, 0xd6, 0x87, 0x56,
+	0x7e, 0x85, 0x0f, 0xdd, 0x8a, 0xd6, 0x81, 0x8a, 0xf0, 0x84, 0x5e, 0x41, 0xdb, 0xf1, 0x29, 0x8e,
+	0x7c, 0xd3, 0xed, 0xf9, 0xd3, 0x30, 0x70, 0x7c, 0xca, 0x5d, 0xd5, 0xfa, 0x05, 0x7d, 0x41, 0x72,
+	0x54, 0x83, 0x55, 0x2b, 0xf0, 0x29, 0xf6, 0xa9, 0xf6, 0x63, 0x1d, 0x9a, 0x27, 0x3c, 0xf2, 0xa1,
+	0x38, 0x0b, 0xb4, 0x09, 0x65, 0x3f, 0xf0, 0x2d, 0xcc, 0xed, 0x4b, 0xba, 0x20, 0x58, 0x88, 0xd6,
+	0xcc, 0xf4, 0x7d, 0xec, 0xc6, 0x61, 0x24, 0x24, 0xda, 0x83, 0x22, 0x35, 0x6d, 0x9e, 0xc3, 0xd6,
+	0xe1, 0x47, 0x49, 0x1a, 0x72, 0x3e, 0xf7, 0xcf, 0x4c, 0x5b, 0x67, 0x5a, 0xe8, 0x2b, 0xa8, 0x99,
+	0xae, 0x73, 0x87, 0x0d, 0x8f, 0xd8, 0x6a, 0x99, 0xa7, 0x7d, 0x33, 0x31, 0xe9, 0x30, 0x41, 0x6c,
+	0xd1, 0x2f, 0xe8, 0x55, 0xae, 0x38, 0x24, 0x36, 0xfa, 0x0d, 0xac, 0x7a, 0xd8, 0x33, 0x22, 0x7c,
+	0xa3, 0x56, 0xb8, 0x49, 0xba, 0xca, 0x10, 0x7b, 0x97, 0x38, 0x22, 0x33, 0x27, 0xd4, 0xf1, 0xcd,
+	0x2d, 0x26, 0xb4, 0x5f, 0xd0, 0x2b, 0x1e, 0xf6, 0x74, 0x7c, 0x83, 0x7e, 0x9b, 0x58, 0x11, 0x75,
+	0x95, 0x5b, 0xed, 0x2c, 0xb3, 0x22, 0x61, 0xe0, 0x13, 0x9c, 0x9a, 0x11, 0xf4, 0x1a, 0xaa, 0x53,
+	0x93, 0x9a, 0x3c, 0xc0, 0x2a, 0xb7, 0xdb, 0x48, 0xec, 0x8e, 0x4d, 0x6a, 0x66, 0xf1, 0xad, 0x32,
+	0x35, 0x16, 0xde, 0x1e, 0x94, 0x67, 0xd8, 0x75, 0x03, 0xb5, 0x96, 0x57, 0x17, 0x29, 0xe8, 0x33,
+	0x51, 0xbf, 0xa0, 0x0b, 0x1d, 0x74, 0x10, 0xbb, 0x9f, 0x3a, 0xb6, 0x0a, 0x5c, 0x1f, 0xc9, 0xee,
+	0x8f, 0x1d, 0x5b, 0xec, 0x82, 0x7b, 0x3f, 0x76, 0xec, 0x34, 0x1e, 0xb6, 0xfb, 0xfa, 0x62, 0x3c,
+	0xd9, 0xbe, 0xb9, 0x85, 0xd8, 0x78, 0x9d, 0x5b, 0xdc, 0x86, 0x53, 0x93, 0x62, 0xb5, 0xb1, 0xb8,
+	0xca, 0x39, 0x97, 0xf4, 0x0b, 0x3a, 0x4c, 0x53, 0x0a, 0xbd, 0x80, 0x32, 0xf6, 0x42, 0xfa, 0xa0,
+	0x36, 0xb9, 0x41, 0x33, 0x31, 0xe8, 0x31, 0x26, 0xdb, 0x00, 0x97, 0xa2, 0x3d, 0x28, 0x59, 0x81,
+	0xef, 0xab, 0x2d, 0xae, 0xb5, 0x95, 0x95, 0xbd, 0xef, 0xf7, 0x08, 0x35, 0x2f, 0x5d, 0x87, 0xcc,
+	0xfa, 0x05, 0x9d, 0x2b, 0xa1, 0x43, 0x00, 0x42, 0x4d, 0x8a, 0x0d, 0xc7, 0xbf, 0x0a, 0xd4, 0x35,
+	0x6e, 0xb2, 0x9e, 0x5e, 0x33, 0x26, 0x19, 0xf8, 0x57, 0x2c, 0x3b, 0x35, 0x92, 0x10, 0xe8, 0x08,
+	0x5a, 0xc2, 0x86, 0xf8, 0x66, 0x48, 0x66, 0x01, 0x55, 0xdb, 0xf9, 0x43, 0x4f, 0xed, 0x26, 0xb1,
+	0x42, 0xbf, 0xa0, 0x37, 0xb9, 0x49, 0xc2, 0x40, 0x43, 0xd8, 0xc8, 0xd6, 0x35, 0xc2, 0x5b, 0xd7,
+	0xe5, 0xf9, 0x5b, 0xe7, 0x8e, 0x9e, 0x2f, 0x38, 0x1a, 0xdf, 0xba, 0x6e, 0x96, 0xc8, 0x36, 0x99,
+	0xe3, 0xa3, 0x0e, 0x08, 0xff, 0x46, 0x24, 0x94, 0x54, 0x94, 0x2f, 0x28, 0x1d, 0x7b, 0x01, 0xc5,
+	0xdc, 0x5d, 0xe6, 0xa6, 0x41, 0x24, 0x1a, 0x1d, 0x27, 0xbb, 0x8a, 0xe2, 0x92, 0x53, 0x37, 0xb8,
+	0x8f, 0x8f, 0x97, 0xfa, 0x48, 0xab, 0xb2, 0x49, 0x64, 0x06, 0xcb, 0x8d, 0x8b, 0xcd, 0xa9, 0x28,
+	0x5e, 0x5e, 0xa2, 0x9b, 0xf9, 0xdc, 0xbc, 0x4b, 0xa5, 0x59, 0xa1, 0x36, 0x33, 0x13, 0x56, 0xae,
+	0xdf, 0x40, 0x33, 0xc4, 0x38, 0x32, 0x9c, 0x29, 0xf6, 0xa9, 0x43, 0x1f, 0xd4, 0xad, 0xfc, 0x35,
+	0x1c, 0x63, 0x1c, 0x0d, 0x62, 0x19, 0xdb, 0x46, 0x28, 0xd1, 0xec, 0xb2, 0x9b, 0xd6, 0xb5, 0xba,
+	0xcd, 0x4d, 0x9e, 0xa5, 0x37, 0xd7, 0xba, 0xf6, 0x83, 0x1f, 0x5c, 0x3c, 0xb5, 0xb1, 0x87, 0x7d,
+	0xb6, 0x79, 0xa6, 0x85, 0xde, 0x00, 0x84, 0x91, 0x73, 0x27, 0xb2, 0xa0, 0x3e, 0xcb, 0x27, 0x5f,
+	0xec, 0x77, 0x7c, 0x47, 0xf3, 0x55, 0x2c, 0x59, 0xa0, 0xb7, 0x92, 0x3d, 0x51, 0x55, 0x6e, 0xff,
+	0xb3, 0x47, 0xec, 0xd3, 0x8c, 0x49, 0x26, 0xe8, 0x2d, 0x34, 0x62, 0xca, 0x60, 0x85, 0xae, 0x7e,
+	0x94, 0x3f, 0xb6, 0xb1, 0x90, 0xe5, 0xaf, 0x75, 0x3d, 0xcc, 0xb8, 0x9a, 0x01, 0xc5, 0x33, 0xd3,
+	0x46, 0x4d, 0xa8, 0x9d, 0x8f, 0x8e, 0x7b, 0xdf, 0x0e, 0x46, 0xbd, 0xe3, 0x76, 0x01, 0xd5, 0xa0,
+	0xdc, 0x1b, 0x8e, 0xcf, 0x2e, 0xda, 0x0a, 0x6a, 0x40, 0xf5, 0x54, 0x3f, 0x31, 0x4e, 0x47, 0xef,
+	0x2e, 0xda, 0x2b, 0x4c, 0xaf, 0xdb, 0xef, 0x8c, 0x04, 0x59, 0x44, 0x6d, 0x68, 0x70, 0xb2, 0x33,
+	0x3a, 0x36, 0x4e, 0xf5, 0x93, 0x76, 0x09, 0xad, 0x41, 0x5d, 0x28, 0xe8, 0x9c, 0x51, 0x96, 0x91,
+	0xf8, 0x5f, 0x0a, 0xd4, 0xd2, 0x8a, 0x44, 0xfb, 0x50, 0xa3, 0x8e, 0x87, 0x09, 0x35, 0xbd, 0x90,
+	0x23, 0x6e, 0xfd, 0xb0, 0x2d, 0x9f, 0xd0, 0x99, 0xe3, 0x61, 0x3d, 0x53, 0x41, 0x5b, 0x50, 0x09,
+	0xaf, 0x1d, 0xc3, 0x99, 0x72, 0x20, 0x6e, 0xe8, 0xe5, 0xf0, 0xda, 0x19, 0x4c, 0xd1, 0xa7, 0x50,
+	0x8f, 0x71, 0xda, 0x18, 0x76, 0xba, 0xbc, 0x57, 0x35, 0x74, 0x88, 0x59, 0xc3, 0x4e, 0x97, 0xdd,
+	0xd0, 0x30, 0x0a, 0x42, 0x1c, 0x51, 0x07, 0x13, 0xb5, 0x9c, 0xc7, 0x8a, 0x71, 0x2a, 0xd1, 0x25,
+	0x2d, 0xed, 0x47, 0x05, 0x20, 0x13, 0xa1, 0x5f, 0x40, 0x93, 0x1f, 0x7d, 0x64, 0xcc, 0xb0, 0x63,
+	0xcf, 0x68, 0xdc, 0x38, 0x1a, 0x82, 0xd9, 0xe7, 0x3c, 0xf4, 0x73, 0x68, 0xb8, 0xf8, 0x8a, 0x1a,
+	0x72, 0x13, 0xa9, 0xea, 0x75, 0xc6, 0xeb, 0x0a, 0x16, 0xfa, 0x35, 0xb0, 0xc0, 0x1c, 0xdf, 0x0a,
+	0xa6, 0x98, 0xa8, 0xc5, 0xdd, 0xa2, 0x0c, 0x16, 0xdd, 0x44, 0xa2, 0x4b, 0x4a, 0x5a, 0x07, 0xd6,
+	0x17, 0xd0, 0x00, 0xbd, 0x82, 0x2a, 0x76, 0x79, 0x21, 0x12, 0x55, 0xd9, 0x2d, 0xca, 0x99, 0x4b,
+	0x7b, 0x7a, 0xaa, 0xa1, 0xfd, 0x0e, 0x36, 0x97, 0xe1, 0xc0, 0x7c, 0xe6, 0x94, 0xf9, 0xcc, 0x69,
+	0xff, 0x56, 0xa0, 0x99, 0x43, 0x3d, 0xe9, 0x0c, 0x14, 0xf9, 0x0c, 0x76, 0xa0, 0x9a, 0xde, 0x35,
+	0xd1, 0x3b, 0x53, 0x1a, 0x69, 0xd0, 0xa4, 0x2e, 0x31, 0x2c, 0x1c, 0x51, 0x63, 0x66, 0x92, 0x59,
+	0x7c, 0x7a, 0x75, 0xea, 0x92, 0x2e, 0x8e, 0x68, 0xdf, 0x24, 0x33, 0x34, 0x81, 0x6d, 0x69, 0x7c,
+	0x30, 0xcc, 0x64, 0xaa, 0x20, 0x6a, 0x69, 0xb7, 0xf8, 0x93, 0xa3, 0xc7, 0x96, 0xb5, 0x84, 0x4b,
+	0xb4, 0x73, 0x68, 0xc8, 0x17, 0xfd, 0xb1, 0xd8, 0x11, 0x94, 0x58, 0x6c, 0x71, 0xdc, 0xfc, 0x9b,
+	0xed, 0xc7, 0xc3, 0xd4, 0xe4, 0x37, 0x4a, 0x84, 0x9b, 0xd2, 0x9a, 0x07, 0x75, 0xe9, 0x3e, 0x3f,
+	0x3e, 0x4b, 0x4c, 0x79, 0x9f, 0x23, 0xea, 0xca, 0x6e, 0x91, 0xcd, 0x12, 0x31, 0x89, 0xf6, 0xa1,
+	0xea, 0x11, 0xdb, 0xa0, 0x0f, 0xf1, 0x50, 0xd6, 0xca, 0x9a, 0x1d, 0x3b, 0x9b, 0x21, 0xb1, 0xcf,
+	0x1e, 0x42, 0xac, 0xaf, 0x7a, 0xe2, 0x43, 0x0b, 0xa0, 0x2e, 0x75, 0xd9, 0x47, 0x96, 0x93, 0xe3,
+	0x5d, 0xc9, 0xc7, 0xfb, 0xde, 0x0b, 0xde, 0x03, 0x64, 0x0d, 0xf4, 0x91, 0xf5, 0x7e, 0x09, 0xa5,
+	0x78, 0xad, 0xe5, 0xb5, 0x57, 0xfa, 0xa0, 0x95, 0x5d, 0x80, 0x6c, 0x40, 0xf8, 0xbf, 0x27, 0xf6,
+	0x6b, 0xa8, 0x4b, 0xb0, 0x88, 0x7e, 0x95, 0x1f, 0x50, 0xeb, 0x87, 0x6b, 0xa9, 0xb5, 0x60, 0xa7,
+	0x13, 0xab, 0xf6, 0x2d, 0xa0, 0x45, 0x5c, 0x45, 0xaf, 0xe7, 0x1d, 0x6c, 0xcf, 0x81, 0xf0, 0x82,
+	0x9f, 0x0b, 0x58, 0x8d, 0x79, 0xe8, 0x19, 0xac, 0x12, 0x7c, 0x63, 0xf8, 0xb7, 0x5e, 0xbc, 0xdd,
+	0x0a, 0xc1, 0x37, 0xa3, 0x5b, 0x8f, 0x55, 0xa7, 0x74, 0xaa, 0xfc, 0x9b, 0x01, 0x4d, 0x0e, 0xf3,
+	0x8b, 0x3c, 0x11, 0x39, 0x54, 0xff, 0xfb, 0x0a, 0xb4, 0xf2, 0xcb, 0xa2, 0xcf, 0x61, 0x2d, 0x7b,
+	0x6d, 0x18, 0xbe, 0xe9, 0x89, 0xcc, 0xd6, 0xf4, 0x56, 0xc6, 0x1e, 0x99, 0x1e, 0x66, 0x03, 0x39,
+	0x93, 0x92, 0xd0, 0xb4, 0xc4, 0x40, 0x5e, 0xd3, 0x33, 0x06, 0xda, 0x80, 0x32, 0xbd, 0x4f, 0x40,
+	0xb8, 0xa6, 0x97, 0xe8, 0xfd, 0x60, 0xca, 0xf0, 0x31, 0x89, 0x28, 0xfa, 0x81, 0x60, 0x1a, 0xa3,
+	0x70, 0x12, 0xa6, 0xce, 0x78, 0xe8, 0x15, 0xa0, 0x44, 0x89, 0x38, 0x5e, 0x82, 0xa4, 0x65, 0xbe,
+	0xdd, 0x76, 0x2c, 0x99, 0x38, 0x5e, 0x8c, 0xa6, 0x23, 0x40, 0x52, 0xb8, 0x56, 0xe0, 0x5f, 0x39,
+	0x36, 0x89, 0x87, 0xe3, 0x4f, 0xf7, 0xc5, 0xf3, 0x69, 0xbf, 0x9b, 0x6a, 0x74, 0xb9, 0xc2, 0xd8,
+	0xb4, 0xae, 0x4d, 0x1b, 0xeb, 0xeb, 0xd6, 0x9c, 0x80, 0x68, 0x7f, 0x53, 0xa0, 0x21, 0x8f, 0xdf,
+	0x68, 0x1f, 0xc0, 0x4b, 0xa7, 0xe4, 0xf8, 0xc8, 0x5a, 0xf9, 0xf9, 0x59, 0x97, 0x34, 0xde, 0xbb,
+	0x5d, 0xc9, 0x98, 0x58, 0xca, 0x63, 0xa2, 0xf6, 0x0f, 0x05, 0xd6, 0x17, 0xe6, 0x98, 0xc7, 0x00,
+	0xea, 0x7d, 0x17, 0x7e, 0x01, 0x2d, 0x87, 0x18, 0x53, 0x6c, 0xb9, 0x66, 0x64, 0xb2, 0x14, 0xf0,
+	0xa3, 0xaa, 0xea, 0x4d, 0x87, 0x1c, 0x67, 0x4c, 0x56, 0x59, 0x91, 0xe9, 0x5f, 0xf3, 0xd8, 0x4a,
+	0x3a, 0xff, 0xd6, 0x7e, 0x0f, 0xd5, 0xc4, 0x23, 0x2b, 0x49, 0xc7, 0xb7, 0xe4, 0x92, 0x74, 0x7c,
+	0x8b, 0x95, 0xa4, 0x54, 0xab, 0x2b, 0x72, 0xad, 0x6a, 0x57, 0xb0, 0xbe, 0xf0, 0x5a, 0x41, 0xdf,
+	0x40, 0x9b, 0x60, 0xf7, 0x8a, 0x8f, 0xa9, 0x91, 0x27, 0xe2, 0x51, 0x76, 0x95, 0xa5, 0xb0, 0xb1,
+	0xc6, 0x34, 0x07, 0x99, 0x22, 0xc3, 0x00, 0x36, 0x76, 0xf9, 0xf1, 0x5d, 0x17, 0x84, 0x76, 0x09,
+	0x68, 0xf1, 0x7d, 0x83, 0x3e, 0x83, 0x32, 0x7f, 0x4e, 0x3d, 0xda, 0x10, 0x85, 0x98, 0x63, 0x17,
+	0x36, 0xa7, 0x4f, 0x60, 0x17, 0x36, 0xa7, 0xda, 0x9f, 0xa1, 0x22, 0xd6, 0x60, 0xe7, 0x88, 0x73,
+	0xef, 0x4d, 0x3d, 0xa5, 0x9f, 0xc4, 0xdd, 0xe5, 0xe3, 0x8a, 0xb6, 0x0a, 0x65, 0xfe, 0xdc, 0xd0,
+	0xfe, 0x02, 0x68, 0x71, 0xa8, 0x66, 0xdd, 0x92, 0x50, 0x33, 0xa2, 0x46, 0x1e, 0x0e, 0xea, 0x9c,
+	0x39, 0x11, 0x98, 0xf0, 0x09, 0xd4, 0xb1, 0x3f, 0x35, 0xf2, 0x87, 0x50, 0xc3, 0xfe, 0x54, 0xc8,
+	0xb5, 0x23, 0xd8, 0x58, 0x32, 0x6a, 0xa3, 0x3d, 0xa8, 0xc6, 0xc8, 0x93, 0x0c, 0x0d, 0x0b, 0x10,
+	0x97, 0x2a, 0x68, 0xff, 0x55, 0x00, 0x1d, 0xb9, 0x81, 0x75, 0x3d, 0xa1, 0x11, 0x36, 0xbd, 0x24,
+	0x3c, 0xe9, 0x8d, 0xac, 0xe4, 0xdf, 0xc8, 0x0b, 0x81, 0xaf, 0xfc, 0x64, 0xe0, 0xc5, 0xb9, 0xc0,
+	0x9f, 0xba, 0x32, 0x0c, 0x95, 0xb2, 0x5b, 0x20, 0x40, 0x23, 0x63, 0x2c, 0x0e, 0x19, 0x95, 0x85,
+	0x21, 0x43, 0xfb, 0x23, 0x6c, 0xe4, 0x76, 0x14, 0xa7, 0xe5, 0x3d, 0x80, 0xff, 0x04, 0x36, 0x97,
+	0xcd, 0xf4, 0xe8, 0x20, 0x6b, 0x4a, 0x22, 0xb1, 0xe9, 0x9b, 0x31, 0x56, 0x14, 0x2d, 0x2d, 0xed,
+	0x55, 0xda, 0x3f, 0x15, 0x68, 0xe6, 0x44, 0x19, 0xac, 0x2a, 0x12, 0xac, 0x3e, 0x8d, 0xc4, 0x9f,
+	0x00, 0x64, 0x30, 0x17, 0xc3, 0xb1, 0xc4, 0x41, 0x1f, 0x43, 0xed, 0x92, 0xed, 0x97, 0xe5, 0x3b,
+	0xbe, 0xe5, 0x55, 0xce, 0x98, 0xe0, 0x1b, 0xb4, 0x0b, 0x0d, 0x76, 0x0c, 0x8e, 0x6f, 0x70, 0x56,
+	0x9c, 0x51, 0x20, 0xf8, 0x66, 0xe0, 0xf3, 0x2c, 0x69, 0xdf, 0xc1, 0xd6, 0xd2, 0x07, 0x08, 0x3a,
+	0x5c, 0x18, 0x3e, 0xb7, 0xe7, 0xb6, 0xdb, 0x13, 0x62, 0x69, 0x04, 0xbd, 0x80, 0x56, 0x5e, 0x86,
+	0xbe, 0x84, 0x8a, 0xc8, 0x46, 0x9c, 0xf5, 0x47, 0x52, 0x16, 0x2b, 0xc9, 0xff, 0x8f, 0xe2, 0xbe,
+	0x9f, 0x1c, 0xca, 0x9f, 0x52, 0xd7, 0x49, 0xa7, 0x7b, 0x01, 0x6b, 0xf4, 0xde, 0xc8, 0x6d, 0x2f,
+	0x9e, 0xd7, 0xe9, 0xfd, 0x24, 0xdd, 0x60, 0xde, 0xa5, 0xfc, 0x4b, 0x4a, 0xfb, 0x1c, 0xd6, 0xe6,
+	0xde, 0x7b, 0x0c, 0x89, 0x70, 0x14, 0x05, 0x51, 0x7c, 0x3e, 0x82, 0xd0, 0xce, 0xa1, 0x96, 0x4e,
+	0xed, 0x0c, 0x50, 0xa5, 0xae, 0xca, 0xbf, 0xd9, 0x1a, 0x77, 0x38, 0xe2, 0x3f, 0xd1, 0xc4, 0xf9,
+	0x25, 0xe4, 0x53, 0x23, 0xe6, 0x17, 0x6f, 0x60, 0x73, 0xd9, 0xa0, 0xcb, 0x5e, 0x5b, 0xe7, 0xa3,
+	0xee, 0xe9, 0x70, 0xac, 0xf7, 0x26, 0x13, 0xfe, 0x4e, 0xab, 0x42, 0xe9, 0xe4, 0xfb, 0xc1, 0xb8,
+	0xad, 0x20, 0x80, 0xca, 0x64, 0xd4, 0x19, 0x8f, 0x2f, 0xda, 0x2b, 0x5f, 0xfc, 0x01, 0xea, 0xd2,
+	0xc8, 0x33, 0xff, 0xb6, 0x6b, 0x42, 0xed, 0xe8, 0xdd, 0x69, 0xf7, 0x3b, 0x63, 0x38, 0x39, 0x69,
+	0x2b, 0xcc, 0xe9, 0xe0, 0xb8, 0x37, 0x3a, 0x1b, 0x9c, 0x5d, 0x70, 0xce, 0xca, 0xe1, 0x5f, 0xa1,
+	0x22, 0x46, 0x4e, 0xf4, 0x35, 0x34, 0xc4, 0x97, 0xb8, 0x33, 0x68, 0x01, 0x2d, 0x77, 0x16, 0x38,
+	0x5a, 0xe1, 0xa5, 0xf2, 0x5a, 0x41, 0x9f, 0x41, 0x69, 0xec, 0xf8, 0x36, 0xca, 0xff, 0x63, 0xd9,
+	0xc9, 0x93, 0x5a, 0xe1, 0x70, 0x0c, 0x75, 0xe9, 0x52, 0xa2, 0x0e, 0x34, 0xc4, 0x17, 0x67, 0x92,
+	0x25, 0x0b, 0xa6, 0x7f, 0x13, 0x96, 0xdc, 0x65, 0xad, 0xf0, 0x5a, 0x39, 0xfa, 0xf2, 0xfb, 0x3d,
+	0xdb, 0xa1, 0xb3, 0xdb, 0x4b, 0x36, 0x24, 0x1c, 0xcc, 0x1e, 0x42, 0x1c, 0x89, 0x67, 0xda, 0xc1,
+	0x95, 0x79, 0x19, 0x39, 0xd6, 0x01, 0xff, 0xd1, 0x4a, 0x0e, 0x84, 0x9b, 0xcb, 0x0a, 0x27, 0xbf,
+	0xfa, 0xdf, 0x00, 0xf9, 0x1b, 0xa3, 0x43, 0xb0, 0x15, 0x00, 0x00,
 }
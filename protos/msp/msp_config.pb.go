@@ -36,7 +36,7 @@ func (m *MSPConfig) Reset()         { *m = MSPConfig{} }
 func (m *MSPConfig) String() string { return proto.CompactTextString(m) }
 func (*MSPConfig) ProtoMessage()    {}
 func (*MSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{0}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{0}
 }
 func (m *MSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPConfig.Unmarshal(m, b)
@@ -131,7 +131,7 @@ func (m *FabricMSPConfig) Reset()         { *m = FabricMSPConfig{} }
 func (m *FabricMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricMSPConfig) ProtoMessage()    {}
 func (*FabricMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{1}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{1}
 }
 func (m *FabricMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricMSPConfig.Unmarshal(m, b)
@@ -249,7 +249,7 @@ func (m *FabricCryptoConfig) Reset()         { *m = FabricCryptoConfig{} }
 func (m *FabricCryptoConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricCryptoConfig) ProtoMessage()    {}
 func (*FabricCryptoConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{2}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{2}
 }
 func (m *FabricCryptoConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricCryptoConfig.Unmarshal(m, b)
@@ -295,17 +295,20 @@ type IdemixMSPConfig struct {
 	// revocation_pk is the public key used for revocation of credentials
 	RevocationPk []byte `protobuf:"bytes,4,opt,name=revocation_pk,json=revocationPk,proto3" json:"revocation_pk,omitempty"`
 	// epoch represents the current epoch (time interval) used for revocation
-	Epoch                int64    `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Epoch int64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// signers may contain crypto material to configure additional signers,
+	// indexed by the name clients use to select them
+	Signers              map[string]*IdemixMSPSignerConfig `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *IdemixMSPConfig) Reset()         { *m = IdemixMSPConfig{} }
 func (m *IdemixMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPConfig) ProtoMessage()    {}
 func (*IdemixMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{3}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{3}
 }
 func (m *IdemixMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPConfig.Unmarshal(m, b)
@@ -360,6 +363,13 @@ func (m *IdemixMSPConfig) GetEpoch() int64 {
 	return 0
 }
 
+func (m *IdemixMSPConfig) GetSigners() map[string]*IdemixMSPSignerConfig {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
 // IdemixMSPSIgnerConfig contains the crypto material to set up an idemix signing identity
 type IdemixMSPSignerConfig struct {
 	// cred represents the serialized idemix credential of the default signer
@@ -373,17 +383,21 @@ type IdemixMSPSignerConfig struct {
 	// enrollment_id contains the enrollment id of this signer
 	EnrollmentId string `protobuf:"bytes,5,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
 	// credential_revocation_information contains a serialized CredentialRevocationInformation
-	CredentialRevocationInformation []byte   `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
-	XXX_NoUnkeyedLiteral            struct{} `json:"-"`
-	XXX_unrecognized                []byte   `json:"-"`
-	XXX_sizecache                   int32    `json:"-"`
+	CredentialRevocationInformation []byte `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
+	// disclosed_attributes contains the names of the attributes of the credential
+	// disclosed by the signer (OU, Role and EnrollmentID); if empty, the signer
+	// discloses its OU and Role
+	DisclosedAttributes  []string `protobuf:"bytes,7,rep,name=disclosed_attributes,json=disclosedAttributes,proto3" json:"disclosed_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IdemixMSPSignerConfig) Reset()         { *m = IdemixMSPSignerConfig{} }
 func (m *IdemixMSPSignerConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPSignerConfig) ProtoMessage()    {}
 func (*IdemixMSPSignerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{4}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{4}
 }
 func (m *IdemixMSPSignerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPSignerConfig.Unmarshal(m, b)
@@ -445,6 +459,13 @@ func (m *IdemixMSPSignerConfig) GetCredentialRevocationInformation() []byte {
 	return nil
 }
 
+func (m *IdemixMSPSignerConfig) GetDisclosedAttributes() []string {
+	if m != nil {
+		return m.DisclosedAttributes
+	}
+	return nil
+}
+
 // SigningIdentityInfo represents the configuration information
 // related to the signing identity the peer is to use for generating
 // endorsements
@@ -465,7 +486,7 @@ func (m *SigningIdentityInfo) Reset()         { *m = SigningIdentityInfo{} }
 func (m *SigningIdentityInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningIdentityInfo) ProtoMessage()    {}
 func (*SigningIdentityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{5}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{5}
 }
 func (m *SigningIdentityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SigningIdentityInfo.Unmarshal(m, b)
@@ -520,7 +541,7 @@ func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
 func (m *KeyInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyInfo) ProtoMessage()    {}
 func (*KeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{6}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{6}
 }
 func (m *KeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyInfo.Unmarshal(m, b)
@@ -577,7 +598,7 @@ func (m *FabricOUIdentifier) Reset()         { *m = FabricOUIdentifier{} }
 func (m *FabricOUIdentifier) String() string { return proto.CompactTextString(m) }
 func (*FabricOUIdentifier) ProtoMessage()    {}
 func (*FabricOUIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{7}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{7}
 }
 func (m *FabricOUIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricOUIdentifier.Unmarshal(m, b)
@@ -634,7 +655,7 @@ func (m *FabricNodeOUs) Reset()         { *m = FabricNodeOUs{} }
 func (m *FabricNodeOUs) String() string { return proto.CompactTextString(m) }
 func (*FabricNodeOUs) ProtoMessage()    {}
 func (*FabricNodeOUs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_c4b502fff5780348, []int{8}
+	return fileDescriptor_msp_config_168871156f6708fa, []int{8}
 }
 func (m *FabricNodeOUs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricNodeOUs.Unmarshal(m, b)
@@ -694,6 +715,7 @@ func init() {
 	proto.RegisterType((*FabricMSPConfig)(nil), "msp.FabricMSPConfig")
 	proto.RegisterType((*FabricCryptoConfig)(nil), "msp.FabricCryptoConfig")
 	proto.RegisterType((*IdemixMSPConfig)(nil), "msp.IdemixMSPConfig")
+	proto.RegisterMapType((map[string]*IdemixMSPSignerConfig)(nil), "msp.IdemixMSPConfig.SignersEntry")
 	proto.RegisterType((*IdemixMSPSignerConfig)(nil), "msp.IdemixMSPSignerConfig")
 	proto.RegisterType((*SigningIdentityInfo)(nil), "msp.SigningIdentityInfo")
 	proto.RegisterType((*KeyInfo)(nil), "msp.KeyInfo")
@@ -701,63 +723,68 @@ func init() {
 	proto.RegisterType((*FabricNodeOUs)(nil), "msp.FabricNodeOUs")
 }
 
-func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_c4b502fff5780348) }
-
-var fileDescriptor_msp_config_c4b502fff5780348 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x96, 0x7f, 0x77, 0x5d, 0x1e, 0xdb, 0xd9, 0xce, 0x0f, 0x23, 0xc4, 0xee, 0x3a, 0x03, 0x08,
-	0x5f, 0x70, 0xa4, 0x2c, 0x12, 0x12, 0xe2, 0xb4, 0x81, 0x85, 0x61, 0x09, 0x89, 0x3a, 0xca, 0x85,
-	0xcb, 0xa8, 0x3d, 0xd3, 0xb6, 0x5b, 0x9e, 0xe9, 0x1e, 0x75, 0xf7, 0x44, 0x18, 0x71, 0xe6, 0x05,
-	0x78, 0x07, 0xce, 0xbc, 0x09, 0xaf, 0x84, 0xfa, 0x27, 0xf6, 0x38, 0x89, 0xcc, 0xde, 0xaa, 0xab,
-	0xbe, 0xfa, 0xa6, 0xfa, 0xab, 0xaa, 0x1e, 0x38, 0x2a, 0x54, 0x79, 0x56, 0xa8, 0x32, 0x49, 0x05,
-	0x9f, 0xb3, 0xc5, 0xb4, 0x94, 0x42, 0x0b, 0xd4, 0x2a, 0x54, 0x19, 0x7d, 0x0d, 0xbd, 0xcb, 0x9b,
-	0xeb, 0x0b, 0xeb, 0x47, 0x08, 0xda, 0x7a, 0x5d, 0xd2, 0xb0, 0x31, 0x6e, 0x4c, 0x3a, 0xd8, 0xda,
-	0xe8, 0x04, 0xba, 0x2e, 0x2b, 0x6c, 0x8e, 0x1b, 0x93, 0x00, 0xfb, 0x53, 0xf4, 0x4f, 0x1b, 0x46,
-	0xef, 0xc8, 0x4c, 0xb2, 0x74, 0x27, 0x9f, 0x93, 0xc2, 0xe5, 0xf7, 0xb0, 0xb5, 0xd1, 0x4b, 0x00,
-	0x29, 0x84, 0x4e, 0x52, 0x2a, 0xb5, 0x0a, 0x9b, 0xe3, 0xd6, 0x24, 0xc0, 0x3d, 0xe3, 0xb9, 0x30,
-	0x0e, 0xf4, 0x25, 0x20, 0xc6, 0x35, 0x95, 0x05, 0xcd, 0x18, 0xd1, 0xd4, 0xc3, 0x5a, 0x16, 0xf6,
-	0xa2, 0x1e, 0x71, 0xf0, 0x13, 0xe8, 0x92, 0xac, 0x60, 0x5c, 0x85, 0x6d, 0x0b, 0xf1, 0x27, 0xf4,
-	0x05, 0x8c, 0x24, 0xbd, 0x13, 0x29, 0xd1, 0x4c, 0xf0, 0x24, 0x67, 0x4a, 0x87, 0x1d, 0x0b, 0x18,
-	0x6e, 0xdd, 0x3f, 0x33, 0xa5, 0xd1, 0x05, 0x1c, 0x28, 0xb6, 0xe0, 0x8c, 0x2f, 0x12, 0x96, 0x51,
-	0xae, 0x99, 0x5e, 0x87, 0xdd, 0x71, 0x63, 0xd2, 0x3f, 0x0f, 0xa7, 0x85, 0x2a, 0xa7, 0x37, 0x2e,
-	0x18, 0xfb, 0x58, 0xcc, 0xe7, 0x02, 0x8f, 0xd4, 0xae, 0x13, 0x25, 0xf0, 0x5a, 0xc8, 0x05, 0xe1,
-	0xec, 0x77, 0x4b, 0x4c, 0xf2, 0xa4, 0xe2, 0x4c, 0x7b, 0xc2, 0x39, 0xa3, 0x52, 0x85, 0xcf, 0xc6,
-	0xad, 0x49, 0xff, 0xfc, 0x23, 0xcb, 0xe9, 0x64, 0xba, 0xba, 0x8d, 0x37, 0x71, 0xfc, 0x72, 0x37,
-	0xff, 0x96, 0x33, 0xbd, 0x8d, 0x2a, 0xf4, 0x2d, 0x0c, 0x52, 0xb9, 0x2e, 0xb5, 0xf0, 0x1d, 0x0b,
-	0x9f, 0xdb, 0x12, 0xeb, 0x74, 0x17, 0x36, 0xee, 0x84, 0xc7, 0x41, 0x5a, 0x3b, 0xa1, 0xcf, 0x60,
-	0xa8, 0x73, 0x95, 0xd4, 0x64, 0xef, 0x59, 0x2d, 0x02, 0x9d, 0x2b, 0xbc, 0x51, 0xfe, 0x2b, 0x38,
-	0x31, 0xa8, 0x27, 0xd4, 0x07, 0x8b, 0x3e, 0xd2, 0xb9, 0x8a, 0x1f, 0x35, 0xe0, 0x1b, 0x18, 0xcd,
-	0xed, 0xf7, 0x13, 0x2e, 0x32, 0x9a, 0x88, 0x4a, 0x85, 0x7d, 0x5b, 0x1b, 0xaa, 0xd5, 0xf6, 0x8b,
-	0xc8, 0xe8, 0xd5, 0xad, 0xc2, 0x83, 0xf9, 0xf6, 0x58, 0xa9, 0xe8, 0xaf, 0x06, 0xa0, 0xc7, 0xc5,
-	0xa3, 0x73, 0x38, 0x36, 0x02, 0x13, 0x5d, 0x49, 0x9a, 0x2c, 0x89, 0x5a, 0x26, 0x73, 0x52, 0xb0,
-	0x7c, 0xed, 0xc7, 0xe8, 0x70, 0x13, 0xfc, 0x91, 0xa8, 0xe5, 0x3b, 0x1b, 0x42, 0x31, 0x9c, 0xde,
-	0xb7, 0xaf, 0x26, 0xbb, 0xcf, 0xae, 0x78, 0x6a, 0x64, 0xb5, 0x03, 0xdb, 0xc3, 0xaf, 0xee, 0x81,
-	0x5b, 0x81, 0x2d, 0x91, 0x47, 0x45, 0x7f, 0x37, 0x60, 0x14, 0x67, 0xb4, 0x60, 0xbf, 0xed, 0x1f,
-	0xe4, 0x03, 0x68, 0xb1, 0x72, 0xe5, 0xb7, 0xc0, 0x98, 0xe8, 0x1c, 0xba, 0xa6, 0x36, 0x2a, 0xc3,
-	0x96, 0x95, 0xe0, 0x63, 0x2b, 0xc1, 0x86, 0xeb, 0xc6, 0xc6, 0x7c, 0x87, 0x3c, 0x12, 0x7d, 0x0a,
-	0x83, 0xda, 0xa0, 0x96, 0xab, 0xb0, 0x6d, 0xf9, 0x82, 0xad, 0xf3, 0x7a, 0x85, 0x8e, 0xa0, 0x43,
-	0x4b, 0x91, 0x2e, 0xc3, 0xce, 0xb8, 0x31, 0x69, 0x61, 0x77, 0x88, 0xfe, 0x6c, 0xc2, 0xf1, 0x93,
-	0xe4, 0xa6, 0xdc, 0x54, 0xd2, 0xcc, 0x96, 0x1b, 0x60, 0x6b, 0xa3, 0x21, 0x34, 0xd5, 0x7d, 0xb5,
-	0x4d, 0xb5, 0x42, 0xdf, 0xc1, 0xab, 0xfd, 0x33, 0x6b, 0x2f, 0xd1, 0xc3, 0x9f, 0xec, 0x9b, 0x4c,
-	0xf3, 0x25, 0x29, 0x72, 0x6a, 0xab, 0xee, 0x60, 0x6b, 0x9b, 0x2b, 0x51, 0x2e, 0x45, 0x9e, 0x17,
-	0x94, 0x1b, 0x42, 0x5b, 0x75, 0x0f, 0x07, 0x5b, 0x67, 0x9c, 0xa1, 0x9f, 0xe0, 0xd4, 0x94, 0x65,
-	0x88, 0x48, 0x9e, 0xd4, 0x24, 0x60, 0x7c, 0x2e, 0x64, 0x61, 0x6d, 0xbb, 0x88, 0x01, 0x7e, 0xbd,
-	0x05, 0xe2, 0x0d, 0x2e, 0xde, 0xc2, 0x22, 0x01, 0x87, 0x4f, 0xac, 0xa9, 0xa9, 0xa3, 0xac, 0x66,
-	0x39, 0x4b, 0x13, 0xdf, 0x15, 0x27, 0x47, 0xe0, 0x9c, 0x4e, 0x30, 0xf4, 0x06, 0x86, 0xa5, 0x64,
-	0x77, 0x66, 0xd8, 0x3d, 0xaa, 0x69, 0x7b, 0x17, 0xd8, 0xde, 0xbd, 0xa7, 0x6e, 0xe3, 0x07, 0x1e,
-	0xe3, 0x92, 0xa2, 0x1b, 0x78, 0xe6, 0x23, 0xe8, 0x73, 0x18, 0xae, 0x68, 0x7d, 0xe6, 0xfc, 0x8c,
-	0x0c, 0x56, 0xb4, 0x36, 0x60, 0xe8, 0x14, 0x02, 0x03, 0x2b, 0x88, 0xa6, 0x92, 0x91, 0xdc, 0xf7,
-	0xa1, 0xbf, 0xa2, 0xeb, 0x4b, 0xef, 0x8a, 0xfe, 0xb8, 0x5f, 0x86, 0xfa, 0xc3, 0x80, 0xc6, 0xd0,
-	0x37, 0x4b, 0xc8, 0xe6, 0x2c, 0x25, 0x9a, 0xfa, 0x2b, 0xd4, 0x5d, 0x1f, 0xd0, 0xc8, 0xe6, 0xff,
-	0x37, 0x32, 0xfa, 0xb7, 0x09, 0x83, 0x9d, 0x65, 0x35, 0x4f, 0x2b, 0xe5, 0x64, 0x96, 0xbb, 0x8f,
-	0x3e, 0xc7, 0xfe, 0x84, 0x62, 0x38, 0x4a, 0x73, 0x66, 0x5a, 0x2b, 0xaa, 0x87, 0x5f, 0xd9, 0xf3,
-	0xc2, 0x21, 0x97, 0x74, 0x55, 0xd5, 0x2e, 0xf7, 0x3d, 0xa0, 0x92, 0x52, 0xf9, 0x80, 0xa8, 0xb5,
-	0x9f, 0xe8, 0xc0, 0xa4, 0xec, 0xd0, 0xfc, 0x00, 0x87, 0xf6, 0xd9, 0x7f, 0xc0, 0xd3, 0xde, 0xcf,
-	0xf3, 0xc2, 0xe6, 0xec, 0x10, 0xbd, 0x87, 0x63, 0x21, 0x33, 0x2a, 0x1f, 0x95, 0xd4, 0xd9, 0x4f,
-	0x75, 0xe8, 0xb3, 0xea, 0x64, 0x6f, 0x13, 0x38, 0x15, 0x72, 0x31, 0x5d, 0xae, 0x4b, 0x2a, 0x73,
-	0x9a, 0x2d, 0xa8, 0x9c, 0xba, 0xe7, 0xcf, 0xfd, 0x6e, 0x95, 0x21, 0x7b, 0x7b, 0x70, 0xa9, 0x4a,
-	0xb7, 0xb4, 0xd7, 0x24, 0x5d, 0x91, 0x05, 0xfd, 0x75, 0xb2, 0x60, 0x7a, 0x59, 0xcd, 0xa6, 0xa9,
-	0x28, 0xce, 0x6a, 0xb9, 0x67, 0x2e, 0xf7, 0xcc, 0xe5, 0x9a, 0x9f, 0xf7, 0xac, 0x6b, 0xed, 0x37,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x69, 0x49, 0xd1, 0x51, 0xce, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_168871156f6708fa) }
+
+var fileDescriptor_msp_config_168871156f6708fa = []byte{
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0x97, 0xcf, 0xb1, 0x53, 0x8f, 0xcf, 0x76, 0xba, 0x76, 0xc2, 0xa9, 0xa2, 0xad, 0x6d, 0x40,
+	0xf8, 0x05, 0x07, 0x52, 0x24, 0x50, 0xe1, 0x85, 0x86, 0x16, 0x4c, 0x09, 0x89, 0x36, 0x0a, 0x0f,
+	0xbc, 0x9c, 0xd6, 0x77, 0x6b, 0x7b, 0xe5, 0xbb, 0xdb, 0xd3, 0xee, 0x5e, 0x84, 0x11, 0xdf, 0x82,
+	0x2f, 0xc2, 0x2b, 0xe2, 0x43, 0xf0, 0x95, 0xd0, 0xfe, 0xb1, 0x7d, 0x4e, 0x22, 0xb7, 0x6f, 0xb3,
+	0x33, 0xbf, 0xf9, 0xcd, 0xde, 0x6f, 0x66, 0xc7, 0x86, 0x5e, 0x2a, 0xf3, 0xd3, 0x54, 0xe6, 0x61,
+	0xc4, 0xb3, 0x19, 0x9b, 0x8f, 0x73, 0xc1, 0x15, 0x47, 0xd5, 0x54, 0xe6, 0xc3, 0xaf, 0xa0, 0x71,
+	0x71, 0x7d, 0x75, 0x6e, 0xfc, 0x08, 0xc1, 0x81, 0x5a, 0xe5, 0x34, 0xa8, 0xf4, 0x2b, 0xa3, 0x1a,
+	0x36, 0x36, 0x3a, 0x81, 0xba, 0xcd, 0x0a, 0xbc, 0x7e, 0x65, 0xe4, 0x63, 0x77, 0x1a, 0xfe, 0x7d,
+	0x00, 0x9d, 0x37, 0x64, 0x2a, 0x58, 0xb4, 0x93, 0x9f, 0x91, 0xd4, 0xe6, 0x37, 0xb0, 0xb1, 0xd1,
+	0x53, 0x00, 0xc1, 0xb9, 0x0a, 0x23, 0x2a, 0x94, 0x0c, 0xbc, 0x7e, 0x75, 0xe4, 0xe3, 0x86, 0xf6,
+	0x9c, 0x6b, 0x07, 0xfa, 0x0c, 0x10, 0xcb, 0x14, 0x15, 0x29, 0x8d, 0x19, 0x51, 0xd4, 0xc1, 0xaa,
+	0x06, 0xf6, 0xb8, 0x1c, 0xb1, 0xf0, 0x13, 0xa8, 0x93, 0x38, 0x65, 0x99, 0x0c, 0x0e, 0x0c, 0xc4,
+	0x9d, 0xd0, 0xa7, 0xd0, 0x11, 0xf4, 0x96, 0x47, 0x44, 0x31, 0x9e, 0x85, 0x09, 0x93, 0x2a, 0xa8,
+	0x19, 0x40, 0x7b, 0xeb, 0xfe, 0x99, 0x49, 0x85, 0xce, 0xe1, 0x48, 0xb2, 0x79, 0xc6, 0xb2, 0x79,
+	0xc8, 0x62, 0x9a, 0x29, 0xa6, 0x56, 0x41, 0xbd, 0x5f, 0x19, 0x35, 0xcf, 0x82, 0x71, 0x2a, 0xf3,
+	0xf1, 0xb5, 0x0d, 0x4e, 0x5c, 0x6c, 0x92, 0xcd, 0x38, 0xee, 0xc8, 0x5d, 0x27, 0x0a, 0xe1, 0x39,
+	0x17, 0x73, 0x92, 0xb1, 0x3f, 0x0c, 0x31, 0x49, 0xc2, 0x22, 0x63, 0xca, 0x11, 0xce, 0x18, 0x15,
+	0x32, 0x38, 0xec, 0x57, 0x47, 0xcd, 0xb3, 0x0f, 0x0c, 0xa7, 0x95, 0xe9, 0xf2, 0x66, 0xb2, 0x89,
+	0xe3, 0xa7, 0xbb, 0xf9, 0x37, 0x19, 0x53, 0xdb, 0xa8, 0x44, 0xdf, 0x42, 0x2b, 0x12, 0xab, 0x5c,
+	0x71, 0xd7, 0xb1, 0xe0, 0x51, 0xbf, 0x72, 0x87, 0xee, 0xdc, 0xc4, 0xad, 0xf0, 0xd8, 0x8f, 0x4a,
+	0x27, 0xf4, 0x31, 0xb4, 0x55, 0x22, 0xc3, 0x92, 0xec, 0x0d, 0xa3, 0x85, 0xaf, 0x12, 0x89, 0x37,
+	0xca, 0x7f, 0x09, 0x27, 0x1a, 0xf5, 0x80, 0xfa, 0x60, 0xd0, 0x3d, 0x95, 0xc8, 0xc9, 0xbd, 0x06,
+	0xbc, 0x84, 0xce, 0xcc, 0xd4, 0x0f, 0x33, 0x1e, 0xd3, 0x90, 0x17, 0x32, 0x68, 0x9a, 0xbb, 0xa1,
+	0xd2, 0xdd, 0x7e, 0xe1, 0x31, 0xbd, 0xbc, 0x91, 0xb8, 0x35, 0xdb, 0x1e, 0x0b, 0x39, 0xfc, 0xab,
+	0x02, 0xe8, 0xfe, 0xe5, 0xd1, 0x19, 0x1c, 0x6b, 0x81, 0x89, 0x2a, 0x04, 0x0d, 0x17, 0x44, 0x2e,
+	0xc2, 0x19, 0x49, 0x59, 0xb2, 0x72, 0x63, 0xd4, 0xdd, 0x04, 0x7f, 0x24, 0x72, 0xf1, 0xc6, 0x84,
+	0xd0, 0x04, 0x06, 0xeb, 0xf6, 0x95, 0x64, 0x77, 0xd9, 0x45, 0x16, 0x69, 0x59, 0xcd, 0xc0, 0x36,
+	0xf0, 0xb3, 0x35, 0x70, 0x2b, 0xb0, 0x21, 0x72, 0xa8, 0xe1, 0xbf, 0x1e, 0x74, 0x26, 0x31, 0x4d,
+	0xd9, 0xef, 0xfb, 0x07, 0xf9, 0x08, 0xaa, 0x2c, 0x5f, 0xba, 0x57, 0xa0, 0x4d, 0x74, 0x06, 0x75,
+	0x7d, 0x37, 0x2a, 0x82, 0xaa, 0x91, 0xe0, 0x89, 0x91, 0x60, 0xc3, 0x75, 0x6d, 0x62, 0xae, 0x43,
+	0x0e, 0x89, 0x3e, 0x82, 0x56, 0x69, 0x50, 0xf3, 0x65, 0x70, 0x60, 0xf8, 0xfc, 0xad, 0xf3, 0x6a,
+	0x89, 0x7a, 0x50, 0xa3, 0x39, 0x8f, 0x16, 0x41, 0xad, 0x5f, 0x19, 0x55, 0xb1, 0x3d, 0xa0, 0x6f,
+	0xe0, 0xd0, 0x92, 0xc8, 0xa0, 0x6e, 0xa6, 0x6b, 0xb0, 0x5b, 0xcf, 0x56, 0x1a, 0xdb, 0xb2, 0xf2,
+	0x75, 0xa6, 0xc4, 0x0a, 0xaf, 0x33, 0x9e, 0xfc, 0x0a, 0x7e, 0x39, 0xa0, 0xbf, 0x66, 0x49, 0xd7,
+	0x12, 0x6b, 0x13, 0x7d, 0x0e, 0xb5, 0x5b, 0x92, 0x14, 0x34, 0xf0, 0xde, 0xf9, 0x31, 0x16, 0xf8,
+	0xd2, 0xfb, 0xba, 0x32, 0xfc, 0xc7, 0x83, 0xe3, 0x07, 0x41, 0x5a, 0xc3, 0x48, 0xd0, 0xd8, 0x94,
+	0xf0, 0xb1, 0xb1, 0x51, 0x1b, 0x3c, 0xb9, 0x96, 0xd0, 0x93, 0x4b, 0xf4, 0x3d, 0x3c, 0xdb, 0xff,
+	0x90, 0x8c, 0xb2, 0x0d, 0xfc, 0xe1, 0xbe, 0xe7, 0xa2, 0x2b, 0x09, 0x9e, 0x50, 0x23, 0x65, 0x0d,
+	0x1b, 0x5b, 0xeb, 0x4c, 0x33, 0xc1, 0x93, 0x24, 0xa5, 0x99, 0x26, 0x34, 0x52, 0x36, 0xb0, 0xbf,
+	0x75, 0x4e, 0x62, 0xf4, 0x13, 0x0c, 0xf4, 0xb5, 0x34, 0x11, 0x49, 0xc2, 0x52, 0x5f, 0x58, 0x36,
+	0xe3, 0x22, 0x35, 0xb6, 0xd9, 0x0e, 0x3e, 0x7e, 0xbe, 0x05, 0xe2, 0x0d, 0x6e, 0xb2, 0x85, 0xa1,
+	0x2f, 0xa0, 0x17, 0x33, 0x19, 0x25, 0x5c, 0xd2, 0x38, 0x24, 0x4a, 0x09, 0x36, 0x2d, 0x14, 0xb5,
+	0x8b, 0xa0, 0x81, 0xbb, 0x9b, 0xd8, 0x77, 0x9b, 0xd0, 0x90, 0x43, 0xf7, 0x81, 0x75, 0xa3, 0xaf,
+	0x9e, 0x17, 0xd3, 0x84, 0x45, 0xa1, 0x9b, 0x2e, 0xab, 0xa0, 0x6f, 0x9d, 0x56, 0x63, 0xf4, 0x02,
+	0xda, 0xb9, 0x60, 0xb7, 0xfa, 0xd1, 0x3a, 0x94, 0x6d, 0x9b, 0x6f, 0xda, 0xf6, 0x96, 0xda, 0xcd,
+	0xd5, 0x72, 0x18, 0x9b, 0x34, 0xbc, 0x86, 0x43, 0x17, 0x41, 0x9f, 0x40, 0x7b, 0x49, 0xcb, 0x6f,
+	0xc7, 0x8d, 0x42, 0x6b, 0x49, 0x4b, 0x0f, 0x05, 0x0d, 0xc0, 0xd7, 0xb0, 0x94, 0x28, 0x2a, 0x18,
+	0x49, 0x5c, 0xeb, 0x9a, 0x4b, 0xba, 0xba, 0x70, 0xae, 0xe1, 0x9f, 0x80, 0xee, 0x2f, 0x38, 0xd4,
+	0x87, 0xa6, 0x5e, 0x26, 0x6c, 0xc6, 0x22, 0xa2, 0xa8, 0xfb, 0x84, 0xb2, 0xeb, 0x3d, 0x7a, 0xef,
+	0xbd, 0xbb, 0xf7, 0xc3, 0xff, 0x3c, 0x68, 0xed, 0x2c, 0x1d, 0xfd, 0x13, 0x41, 0x33, 0x32, 0x4d,
+	0x6c, 0xd1, 0x47, 0xd8, 0x9d, 0xd0, 0x04, 0x7a, 0x51, 0xc2, 0xf4, 0x34, 0xf0, 0xe2, 0x6e, 0x95,
+	0x3d, 0x9b, 0x1a, 0xd9, 0xa4, 0xcb, 0xa2, 0xf4, 0x71, 0xaf, 0x01, 0xe5, 0x94, 0x8a, 0x3b, 0x44,
+	0xd5, 0xfd, 0x44, 0x47, 0x3a, 0x65, 0x87, 0xe6, 0x07, 0xe8, 0x9a, 0x9f, 0xaf, 0x3b, 0x3c, 0x07,
+	0xfb, 0x79, 0x1e, 0x9b, 0x9c, 0x1d, 0xa2, 0xb7, 0x70, 0xcc, 0x45, 0x4c, 0xc5, 0xbd, 0x2b, 0xd5,
+	0xf6, 0x53, 0x75, 0x5d, 0x56, 0x99, 0xec, 0x55, 0x08, 0x03, 0x2e, 0xe6, 0xe3, 0xc5, 0x2a, 0xa7,
+	0x22, 0xa1, 0xf1, 0x9c, 0x8a, 0xb1, 0x5d, 0xe3, 0xf6, 0x6f, 0x83, 0xd4, 0x64, 0xaf, 0x8e, 0x2e,
+	0x64, 0x6e, 0xdf, 0xf9, 0x15, 0x89, 0x96, 0x64, 0x4e, 0x7f, 0x1b, 0xcd, 0x99, 0x5a, 0x14, 0xd3,
+	0x71, 0xc4, 0xd3, 0xd3, 0x52, 0xee, 0xa9, 0xcd, 0x3d, 0xb5, 0xb9, 0xfa, 0x4f, 0xc8, 0xb4, 0x6e,
+	0xec, 0x17, 0xff, 0x0f, 0x00, 0xf6, 0x1f, 0x2d, 0x34, 0x96, 0x08, 0x00, 0x00,
 }
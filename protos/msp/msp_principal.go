@@ -37,6 +37,8 @@ func (mp *MSPPrincipal) VariablyOpaqueFieldProto(name string) (proto.Message, er
 		return &OrganizationUnit{}, nil
 	case MSPPrincipal_IDENTITY:
 		return &SerializedIdentity{}, nil
+	case MSPPrincipal_ATTRIBUTE:
+		return &MSPAttribute{}, nil
 	default:
 		return nil, fmt.Errorf("unable to decode MSP type: %v", mp.PrincipalClassification)
 	}
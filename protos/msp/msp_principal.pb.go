@@ -32,7 +32,8 @@ const (
 	// identity
 	MSPPrincipal_ANONYMITY MSPPrincipal_Classification = 3
 	// an identity to be anonymous or nominal.
-	MSPPrincipal_COMBINED MSPPrincipal_Classification = 4
+	MSPPrincipal_COMBINED  MSPPrincipal_Classification = 4
+	MSPPrincipal_ATTRIBUTE MSPPrincipal_Classification = 5
 )
 
 var MSPPrincipal_Classification_name = map[int32]string{
@@ -41,6 +42,7 @@ var MSPPrincipal_Classification_name = map[int32]string{
 	2: "IDENTITY",
 	3: "ANONYMITY",
 	4: "COMBINED",
+	5: "ATTRIBUTE",
 }
 var MSPPrincipal_Classification_value = map[string]int32{
 	"ROLE":              0,
@@ -48,13 +50,14 @@ var MSPPrincipal_Classification_value = map[string]int32{
 	"IDENTITY":          2,
 	"ANONYMITY":         3,
 	"COMBINED":          4,
+	"ATTRIBUTE":         5,
 }
 
 func (x MSPPrincipal_Classification) String() string {
 	return proto.EnumName(MSPPrincipal_Classification_name, int32(x))
 }
 func (MSPPrincipal_Classification) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{0, 0}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{0, 0}
 }
 
 type MSPRole_MSPRoleType int32
@@ -86,7 +89,7 @@ func (x MSPRole_MSPRoleType) String() string {
 	return proto.EnumName(MSPRole_MSPRoleType_name, int32(x))
 }
 func (MSPRole_MSPRoleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{2, 0}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{2, 0}
 }
 
 type MSPIdentityAnonymity_MSPIdentityAnonymityType int32
@@ -109,27 +112,28 @@ func (x MSPIdentityAnonymity_MSPIdentityAnonymityType) String() string {
 	return proto.EnumName(MSPIdentityAnonymity_MSPIdentityAnonymityType_name, int32(x))
 }
 func (MSPIdentityAnonymity_MSPIdentityAnonymityType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{3, 0}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{3, 0}
 }
 
 // MSPPrincipal aims to represent an MSP-centric set of identities.
 // In particular, this structure allows for definition of
-//  - a group of identities that are member of the same MSP
-//  - a group of identities that are member of the same organization unit
-//    in the same MSP
-//  - a group of identities that are administering a specific MSP
-//  - a specific identity
+//   - a group of identities that are member of the same MSP
+//   - a group of identities that are member of the same organization unit
+//     in the same MSP
+//   - a group of identities that are administering a specific MSP
+//   - a specific identity
+//
 // Expressing these groups is done given two fields of the fields below
-//  - Classification, that defines the type of classification of identities
-//    in an MSP this principal would be defined on; Classification can take
-//    three values:
+//   - Classification, that defines the type of classification of identities
+//     in an MSP this principal would be defined on; Classification can take
+//     three values:
 //     (i)  ByMSPRole: that represents a classification of identities within
-//          MSP based on one of the two pre-defined MSP rules, "member" and "admin"
+//     MSP based on one of the two pre-defined MSP rules, "member" and "admin"
 //     (ii) ByOrganizationUnit: that represents a classification of identities
-//          within MSP based on the organization unit an identity belongs to
+//     within MSP based on the organization unit an identity belongs to
 //     (iii)ByIdentity that denotes that MSPPrincipal is mapped to a single
-//          identity/certificate; this would mean that the Principal bytes
-//          message
+//     identity/certificate; this would mean that the Principal bytes
+//     message
 type MSPPrincipal struct {
 	// Classification describes the way that one should process
 	// Principal. An Classification value of "ByOrganizationUnit" reflects
@@ -146,6 +150,8 @@ type MSPPrincipal struct {
 	// identity, respectively.
 	// For the Combined Classification type, the Principal is a marshalled
 	// CombinedPrincipal.
+	// For the Attribute Classification type, the Principal is a marshalled
+	// MSPAttribute.
 	Principal            []byte   `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -156,7 +162,7 @@ func (m *MSPPrincipal) Reset()         { *m = MSPPrincipal{} }
 func (m *MSPPrincipal) String() string { return proto.CompactTextString(m) }
 func (*MSPPrincipal) ProtoMessage()    {}
 func (*MSPPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{0}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{0}
 }
 func (m *MSPPrincipal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPPrincipal.Unmarshal(m, b)
@@ -212,7 +218,7 @@ func (m *OrganizationUnit) Reset()         { *m = OrganizationUnit{} }
 func (m *OrganizationUnit) String() string { return proto.CompactTextString(m) }
 func (*OrganizationUnit) ProtoMessage()    {}
 func (*OrganizationUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{1}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{1}
 }
 func (m *OrganizationUnit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationUnit.Unmarshal(m, b)
@@ -272,7 +278,7 @@ func (m *MSPRole) Reset()         { *m = MSPRole{} }
 func (m *MSPRole) String() string { return proto.CompactTextString(m) }
 func (*MSPRole) ProtoMessage()    {}
 func (*MSPRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{2}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{2}
 }
 func (m *MSPRole) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPRole.Unmarshal(m, b)
@@ -318,7 +324,7 @@ func (m *MSPIdentityAnonymity) Reset()         { *m = MSPIdentityAnonymity{} }
 func (m *MSPIdentityAnonymity) String() string { return proto.CompactTextString(m) }
 func (*MSPIdentityAnonymity) ProtoMessage()    {}
 func (*MSPIdentityAnonymity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{3}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{3}
 }
 func (m *MSPIdentityAnonymity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPIdentityAnonymity.Unmarshal(m, b)
@@ -345,6 +351,68 @@ func (m *MSPIdentityAnonymity) GetAnonymityType() MSPIdentityAnonymity_MSPIdenti
 	return MSPIdentityAnonymity_NOMINAL
 }
 
+// MSPAttribute governs the organization of the Principal
+// field of an MSPPrincipal when it aims to define the identities
+// of an MSP that disclose an attribute with a given value
+type MSPAttribute struct {
+	// MSPIdentifier represents the identifier of the MSP this principal
+	// refers to
+	MspIdentifier string `protobuf:"bytes,1,opt,name=msp_identifier,json=mspIdentifier,proto3" json:"msp_identifier,omitempty"`
+	// Name is the name of the attribute, e.g. OU, Role or EnrollmentID
+	// for the attributes of an idemix credential
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Value is the value the disclosed attribute must have
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MSPAttribute) Reset()         { *m = MSPAttribute{} }
+func (m *MSPAttribute) String() string { return proto.CompactTextString(m) }
+func (*MSPAttribute) ProtoMessage()    {}
+func (*MSPAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{4}
+}
+func (m *MSPAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MSPAttribute.Unmarshal(m, b)
+}
+func (m *MSPAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MSPAttribute.Marshal(b, m, deterministic)
+}
+func (dst *MSPAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MSPAttribute.Merge(dst, src)
+}
+func (m *MSPAttribute) XXX_Size() int {
+	return xxx_messageInfo_MSPAttribute.Size(m)
+}
+func (m *MSPAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MSPAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MSPAttribute proto.InternalMessageInfo
+
+func (m *MSPAttribute) GetMspIdentifier() string {
+	if m != nil {
+		return m.MspIdentifier
+	}
+	return ""
+}
+
+func (m *MSPAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MSPAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // CombinedPrincipal governs the organization of the Principal
 // field of a policy principal when principal_classification has
 // indicated that a combined form of principals is required
@@ -360,7 +428,7 @@ func (m *CombinedPrincipal) Reset()         { *m = CombinedPrincipal{} }
 func (m *CombinedPrincipal) String() string { return proto.CompactTextString(m) }
 func (*CombinedPrincipal) ProtoMessage()    {}
 func (*CombinedPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_3b3c17f3e626d163, []int{4}
+	return fileDescriptor_msp_principal_cf9b62015a3b1b36, []int{5}
 }
 func (m *CombinedPrincipal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CombinedPrincipal.Unmarshal(m, b)
@@ -392,6 +460,7 @@ func init() {
 	proto.RegisterType((*OrganizationUnit)(nil), "common.OrganizationUnit")
 	proto.RegisterType((*MSPRole)(nil), "common.MSPRole")
 	proto.RegisterType((*MSPIdentityAnonymity)(nil), "common.MSPIdentityAnonymity")
+	proto.RegisterType((*MSPAttribute)(nil), "common.MSPAttribute")
 	proto.RegisterType((*CombinedPrincipal)(nil), "common.CombinedPrincipal")
 	proto.RegisterEnum("common.MSPPrincipal_Classification", MSPPrincipal_Classification_name, MSPPrincipal_Classification_value)
 	proto.RegisterEnum("common.MSPRole_MSPRoleType", MSPRole_MSPRoleType_name, MSPRole_MSPRoleType_value)
@@ -399,42 +468,45 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("msp/msp_principal.proto", fileDescriptor_msp_principal_3b3c17f3e626d163)
-}
-
-var fileDescriptor_msp_principal_3b3c17f3e626d163 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0x86, 0x63, 0xa0, 0x49, 0x98, 0x00, 0xda, 0xac, 0x88, 0x82, 0xd4, 0xa8, 0x42, 0x6e, 0x2b,
-	0x71, 0x32, 0x12, 0x69, 0x7b, 0x37, 0x60, 0x45, 0x96, 0xf0, 0xda, 0x5a, 0xcc, 0x21, 0x51, 0x54,
-	0x64, 0xcc, 0x42, 0x56, 0xb2, 0xbd, 0x96, 0xed, 0x1c, 0xdc, 0x47, 0xaa, 0x7a, 0xec, 0x53, 0xf5,
-	0x29, 0x2a, 0xdb, 0x01, 0x96, 0x36, 0x95, 0x7a, 0xb2, 0x67, 0xe6, 0xfb, 0xc7, 0xbf, 0x77, 0x67,
-	0xe0, 0x3a, 0x4c, 0xe3, 0x61, 0x98, 0xc6, 0xcb, 0x38, 0xe1, 0x91, 0xcf, 0x63, 0x2f, 0xd0, 0xe2,
-	0x44, 0x64, 0x02, 0x9f, 0xfa, 0x22, 0x0c, 0x45, 0xa4, 0xfe, 0x52, 0xa0, 0x65, 0xcd, 0x1d, 0x67,
-	0x57, 0xc6, 0x5f, 0xa1, 0xb7, 0x67, 0x97, 0x7e, 0xe0, 0xa5, 0x29, 0xdf, 0x70, 0xdf, 0xcb, 0xb8,
-	0x88, 0x7a, 0x4a, 0x5f, 0x19, 0x74, 0x46, 0xef, 0xb5, 0x4a, 0xab, 0xc9, 0x3a, 0x6d, 0x72, 0x84,
-	0xd2, 0xeb, 0x7d, 0x93, 0xe3, 0x02, 0xbe, 0x81, 0xe6, 0xbe, 0xd4, 0xab, 0xf5, 0x95, 0x41, 0x8b,
-	0x1e, 0x12, 0xea, 0x23, 0x74, 0xfe, 0xe0, 0xcf, 0xa1, 0x41, 0xed, 0x99, 0x81, 0x4e, 0xf0, 0x15,
-	0x5c, 0xda, 0xf4, 0x4e, 0x27, 0xe6, 0x83, 0xee, 0x9a, 0x36, 0x59, 0x2e, 0x88, 0xe9, 0x22, 0x05,
-	0xb7, 0xe0, 0xdc, 0x9c, 0x1a, 0xc4, 0x35, 0xdd, 0x7b, 0x54, 0xc3, 0x6d, 0x68, 0xea, 0xc4, 0x26,
-	0xf7, 0x56, 0x11, 0xd6, 0x8b, 0xe2, 0xc4, 0xb6, 0xc6, 0x26, 0x31, 0xa6, 0xa8, 0xa1, 0xfe, 0x54,
-	0x00, 0xd9, 0xc9, 0xd6, 0x8b, 0xf8, 0xb7, 0xb2, 0xf9, 0x22, 0xe2, 0x19, 0xfe, 0x08, 0x9d, 0xe2,
-	0x80, 0xf8, 0x9a, 0x45, 0x19, 0xdf, 0x70, 0x96, 0x94, 0xbf, 0xd9, 0xa4, 0xed, 0x30, 0x8d, 0xcd,
-	0x7d, 0x12, 0x4f, 0xe1, 0x9d, 0x90, 0xa4, 0x5e, 0xb0, 0x7c, 0x8e, 0x78, 0x26, 0xcb, 0x6a, 0xa5,
-	0xec, 0xe6, 0x98, 0x2a, 0x3e, 0x21, 0x75, 0xb9, 0x85, 0x2b, 0x9f, 0x25, 0x55, 0x90, 0xca, 0xe2,
-	0x7a, 0x79, 0x12, 0xdd, 0x43, 0xf1, 0x20, 0x52, 0xbf, 0x2b, 0x70, 0x66, 0xcd, 0x1d, 0x2a, 0x02,
-	0xf6, 0xbf, 0x6e, 0x87, 0xd0, 0x48, 0x44, 0xc0, 0x4a, 0x4f, 0x9d, 0xd1, 0x5b, 0xe9, 0xc6, 0x8a,
-	0x2e, 0xbb, 0xa7, 0x9b, 0xc7, 0x8c, 0x96, 0xa0, 0x7a, 0x07, 0x17, 0x52, 0x12, 0x03, 0x9c, 0x5a,
-	0x86, 0x35, 0x36, 0x28, 0x3a, 0xc1, 0x4d, 0x78, 0xa3, 0x4f, 0x2d, 0x93, 0x20, 0xa5, 0x48, 0x4f,
-	0x66, 0xa6, 0x41, 0x5c, 0x54, 0x2b, 0x2e, 0xc6, 0x31, 0x0c, 0x8a, 0xea, 0xf8, 0x02, 0xce, 0x6c,
-	0x3a, 0x35, 0xa8, 0x41, 0x51, 0x43, 0xfd, 0xa1, 0x40, 0xd7, 0x9a, 0x3b, 0x95, 0x97, 0x2c, 0xd7,
-	0x23, 0x11, 0xe5, 0x21, 0xcf, 0x72, 0xfc, 0x08, 0x1d, 0x6f, 0x17, 0x2c, 0xb3, 0x3c, 0x66, 0x2f,
-	0xe3, 0xf4, 0x59, 0x32, 0xf7, 0x97, 0xea, 0xd5, 0x64, 0x69, 0xbb, 0xed, 0xc9, 0xa1, 0xfa, 0x05,
-	0x7a, 0xff, 0x42, 0x0b, 0x7f, 0xc4, 0xb6, 0x4c, 0xa2, 0xcf, 0xd0, 0xc9, 0x61, 0x40, 0xec, 0xc5,
-	0x1c, 0x29, 0xaa, 0x09, 0x97, 0x13, 0x11, 0xae, 0x78, 0xc4, 0xd6, 0x87, 0x1d, 0xf8, 0x04, 0xb0,
-	0x1f, 0xc9, 0xb4, 0xa7, 0xf4, 0xeb, 0x83, 0x8b, 0x51, 0xf7, 0xb5, 0xa9, 0xa7, 0x12, 0x37, 0x76,
-	0xe0, 0x83, 0x48, 0xb6, 0xda, 0x53, 0x1e, 0xb3, 0x24, 0x60, 0xeb, 0x2d, 0x4b, 0xb4, 0x8d, 0xb7,
-	0x4a, 0xb8, 0x5f, 0xad, 0x5c, 0xfa, 0xd2, 0xe0, 0x61, 0xb0, 0xe5, 0xd9, 0xd3, 0xf3, 0xaa, 0x08,
-	0x87, 0x12, 0x3c, 0xac, 0xe0, 0x61, 0x05, 0x17, 0x4b, 0xbb, 0x3a, 0x2d, 0xdf, 0x6f, 0x7f, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x30, 0x91, 0xbc, 0x0d, 0xc6, 0x03, 0x00, 0x00,
+	proto.RegisterFile("msp/msp_principal.proto", fileDescriptor_msp_principal_cf9b62015a3b1b36)
+}
+
+var fileDescriptor_msp_principal_cf9b62015a3b1b36 = []byte{
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5b, 0x6b, 0xdb, 0x4c,
+	0x10, 0x8d, 0x6c, 0xe7, 0xe2, 0x49, 0x62, 0x36, 0x8b, 0x43, 0x0c, 0x5f, 0xf8, 0x08, 0x6a, 0x0b,
+	0x7e, 0x92, 0x21, 0x69, 0xfb, 0x2e, 0xdb, 0x22, 0x2c, 0x44, 0x17, 0xd6, 0xf2, 0x43, 0x42, 0xa9,
+	0x90, 0xe5, 0xb5, 0xb3, 0x54, 0x37, 0xa4, 0x75, 0x41, 0xfd, 0x49, 0xa5, 0x8f, 0xfd, 0x73, 0x7d,
+	0x2b, 0x5a, 0xf9, 0x22, 0xb7, 0x29, 0xe4, 0xc9, 0x3b, 0x73, 0xce, 0x99, 0x3d, 0x9e, 0xd9, 0x11,
+	0x5c, 0x45, 0x79, 0x3a, 0x88, 0xf2, 0xd4, 0x4b, 0x33, 0x1e, 0x07, 0x3c, 0xf5, 0x43, 0x2d, 0xcd,
+	0x12, 0x91, 0xe0, 0xa3, 0x20, 0x89, 0xa2, 0x24, 0x56, 0x7f, 0x29, 0x70, 0x66, 0x4e, 0x1c, 0x67,
+	0x03, 0xe3, 0xcf, 0xd0, 0xdb, 0x72, 0xbd, 0x20, 0xf4, 0xf3, 0x9c, 0x2f, 0x78, 0xe0, 0x0b, 0x9e,
+	0xc4, 0x3d, 0xe5, 0x46, 0xe9, 0x77, 0x6e, 0xdf, 0x68, 0x95, 0x56, 0xab, 0xeb, 0xb4, 0xd1, 0x1e,
+	0x95, 0x5e, 0x6d, 0x8b, 0xec, 0x03, 0xf8, 0x1a, 0xda, 0x5b, 0xa8, 0xd7, 0xb8, 0x51, 0xfa, 0x67,
+	0x74, 0x97, 0x50, 0xbf, 0x40, 0xe7, 0x0f, 0xfe, 0x09, 0xb4, 0xa8, 0xfd, 0x60, 0xa0, 0x03, 0x7c,
+	0x09, 0x17, 0x36, 0xbd, 0xd7, 0x2d, 0xf2, 0xa4, 0xbb, 0xc4, 0xb6, 0xbc, 0xa9, 0x45, 0x5c, 0xa4,
+	0xe0, 0x33, 0x38, 0x21, 0x63, 0xc3, 0x72, 0x89, 0xfb, 0x88, 0x1a, 0xf8, 0x1c, 0xda, 0xba, 0x65,
+	0x5b, 0x8f, 0x66, 0x19, 0x36, 0x4b, 0x70, 0x64, 0x9b, 0x43, 0x62, 0x19, 0x63, 0xd4, 0x92, 0xa0,
+	0xeb, 0x52, 0x32, 0x9c, 0xba, 0x06, 0x3a, 0x54, 0x7f, 0x2a, 0x80, 0xec, 0x6c, 0xe9, 0xc7, 0xfc,
+	0x9b, 0xbc, 0x6b, 0x1a, 0x73, 0x81, 0xdf, 0x41, 0xa7, 0xec, 0x17, 0x9f, 0xb3, 0x58, 0xf0, 0x05,
+	0x67, 0x99, 0xfc, 0xd7, 0x6d, 0x7a, 0x1e, 0xe5, 0x29, 0xd9, 0x26, 0xf1, 0x18, 0xfe, 0x4f, 0x6a,
+	0x52, 0x3f, 0xf4, 0x56, 0x31, 0x17, 0x75, 0x59, 0x43, 0xca, 0xae, 0xf7, 0x59, 0xe5, 0x15, 0xb5,
+	0x2a, 0x77, 0x70, 0x19, 0xb0, 0xac, 0x0a, 0xf2, 0xba, 0xb8, 0x29, 0x1b, 0xd3, 0xdd, 0x81, 0x3b,
+	0x91, 0xfa, 0x5d, 0x81, 0x63, 0x73, 0xe2, 0xd0, 0x24, 0x64, 0xaf, 0x75, 0x3b, 0x80, 0x56, 0x96,
+	0x84, 0x4c, 0x7a, 0xea, 0xdc, 0xfe, 0x57, 0x1b, 0x60, 0x59, 0x65, 0xf3, 0xeb, 0x16, 0x29, 0xa3,
+	0x92, 0xa8, 0xde, 0xc3, 0x69, 0x2d, 0x89, 0x01, 0x8e, 0x4c, 0xc3, 0x1c, 0x1a, 0x14, 0x1d, 0xe0,
+	0x36, 0x1c, 0xea, 0x63, 0x93, 0x58, 0x48, 0x29, 0xd3, 0xa3, 0x07, 0x62, 0x58, 0x2e, 0x6a, 0x94,
+	0x73, 0x72, 0x0c, 0x83, 0xa2, 0x26, 0x3e, 0x85, 0x63, 0x9b, 0x8e, 0x0d, 0x6a, 0x50, 0xd4, 0x52,
+	0x7f, 0x28, 0xd0, 0x35, 0x27, 0x4e, 0xe5, 0x45, 0x14, 0x7a, 0x9c, 0xc4, 0x45, 0xc4, 0x45, 0x81,
+	0x3f, 0x41, 0xc7, 0xdf, 0x04, 0x9e, 0x28, 0x52, 0xb6, 0x7e, 0x5d, 0x1f, 0x6a, 0xe6, 0xfe, 0x52,
+	0xbd, 0x98, 0x94, 0xb6, 0xcf, 0xfd, 0x7a, 0xa8, 0x7e, 0x84, 0xde, 0xbf, 0xa8, 0xa5, 0x3f, 0xcb,
+	0x36, 0x89, 0xa5, 0x3f, 0xa0, 0x83, 0xdd, 0x7b, 0xb1, 0xa7, 0x13, 0xa4, 0xa8, 0x9e, 0xdc, 0x06,
+	0x5d, 0x88, 0x8c, 0xcf, 0x56, 0xe2, 0xd5, 0xfd, 0xc5, 0xd0, 0x8a, 0xfd, 0x88, 0xad, 0x67, 0x2e,
+	0xcf, 0xb8, 0x0b, 0x87, 0x5f, 0xfd, 0x70, 0xc5, 0xe4, 0x2c, 0xdb, 0xb4, 0x0a, 0x54, 0x02, 0x17,
+	0xa3, 0x24, 0x9a, 0xf1, 0x98, 0xcd, 0x77, 0x3b, 0xf7, 0x1e, 0x60, 0xbb, 0x02, 0x79, 0x4f, 0xb9,
+	0x69, 0xf6, 0x4f, 0x6f, 0xbb, 0x2f, 0x6d, 0x19, 0xad, 0xf1, 0x86, 0x0e, 0xbc, 0x4d, 0xb2, 0xa5,
+	0xf6, 0x5c, 0xa4, 0x2c, 0x0b, 0xd9, 0x7c, 0xc9, 0x32, 0x6d, 0xe1, 0xcf, 0x32, 0x1e, 0x54, 0x2b,
+	0x9e, 0xaf, 0x0b, 0x3c, 0xf5, 0x97, 0x5c, 0x3c, 0xaf, 0x66, 0x65, 0x38, 0xa8, 0x91, 0x07, 0x15,
+	0x79, 0x50, 0x91, 0xcb, 0x8f, 0xc4, 0xec, 0x48, 0x9e, 0xef, 0x7e, 0x0f, 0x00, 0xc5, 0xab, 0x49,
+	0x9c, 0x36, 0x04, 0x00, 0x00,
 }
@@ -48,7 +48,7 @@ func (x SeekInfo_SeekBehavior) String() string {
 	return proto.EnumName(SeekInfo_SeekBehavior_name, int32(x))
 }
 func (SeekInfo_SeekBehavior) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{5, 0}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{6, 0}
 }
 
 // SeekErrorTolerance indicates to the server how block provider errors should be tolerated.  By default,
@@ -79,7 +79,35 @@ func (x SeekInfo_SeekErrorResponse) String() string {
 	return proto.EnumName(SeekInfo_SeekErrorResponse_name, int32(x))
 }
 func (SeekInfo_SeekErrorResponse) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{5, 1}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{6, 1}
+}
+
+// SeekContentType indicates what type of content to deliver in response to a request.  If BLOCK is specified,
+// the whole blocks are delivered, which is the default behavior.  If HEADER_WITH_SIG is specified, only the
+// header and the metadata, holding the signatures and the last config index, of the blocks are delivered,
+// which is enough to verify the hash chain.  Config blocks are still delivered whole, so that the signatures
+// of the following blocks can be verified against the channel config.
+type SeekInfo_SeekContentType int32
+
+const (
+	SeekInfo_BLOCK           SeekInfo_SeekContentType = 0
+	SeekInfo_HEADER_WITH_SIG SeekInfo_SeekContentType = 1
+)
+
+var SeekInfo_SeekContentType_name = map[int32]string{
+	0: "BLOCK",
+	1: "HEADER_WITH_SIG",
+}
+var SeekInfo_SeekContentType_value = map[string]int32{
+	"BLOCK":           0,
+	"HEADER_WITH_SIG": 1,
+}
+
+func (x SeekInfo_SeekContentType) String() string {
+	return proto.EnumName(SeekInfo_SeekContentType_name, int32(x))
+}
+func (SeekInfo_SeekContentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{6, 2}
 }
 
 type BroadcastResponse struct {
@@ -96,7 +124,7 @@ func (m *BroadcastResponse) Reset()         { *m = BroadcastResponse{} }
 func (m *BroadcastResponse) String() string { return proto.CompactTextString(m) }
 func (*BroadcastResponse) ProtoMessage()    {}
 func (*BroadcastResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{0}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{0}
 }
 func (m *BroadcastResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BroadcastResponse.Unmarshal(m, b)
@@ -140,7 +168,7 @@ func (m *SeekNewest) Reset()         { *m = SeekNewest{} }
 func (m *SeekNewest) String() string { return proto.CompactTextString(m) }
 func (*SeekNewest) ProtoMessage()    {}
 func (*SeekNewest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{1}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{1}
 }
 func (m *SeekNewest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekNewest.Unmarshal(m, b)
@@ -170,7 +198,7 @@ func (m *SeekOldest) Reset()         { *m = SeekOldest{} }
 func (m *SeekOldest) String() string { return proto.CompactTextString(m) }
 func (*SeekOldest) ProtoMessage()    {}
 func (*SeekOldest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{2}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{2}
 }
 func (m *SeekOldest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekOldest.Unmarshal(m, b)
@@ -201,7 +229,7 @@ func (m *SeekSpecified) Reset()         { *m = SeekSpecified{} }
 func (m *SeekSpecified) String() string { return proto.CompactTextString(m) }
 func (*SeekSpecified) ProtoMessage()    {}
 func (*SeekSpecified) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{3}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{3}
 }
 func (m *SeekSpecified) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekSpecified.Unmarshal(m, b)
@@ -228,11 +256,53 @@ func (m *SeekSpecified) GetNumber() uint64 {
 	return 0
 }
 
+// SeekCheckpoint resumes the delivery from the checkpoint acknowledged by a
+// named consumer on previous streams. It is only supported by the Deliver
+// service of the peer, and only as a start position.
+type SeekCheckpoint struct {
+	ConsumerId           string   `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SeekCheckpoint) Reset()         { *m = SeekCheckpoint{} }
+func (m *SeekCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SeekCheckpoint) ProtoMessage()    {}
+func (*SeekCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{4}
+}
+func (m *SeekCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SeekCheckpoint.Unmarshal(m, b)
+}
+func (m *SeekCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SeekCheckpoint.Marshal(b, m, deterministic)
+}
+func (dst *SeekCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekCheckpoint.Merge(dst, src)
+}
+func (m *SeekCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_SeekCheckpoint.Size(m)
+}
+func (m *SeekCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekCheckpoint proto.InternalMessageInfo
+
+func (m *SeekCheckpoint) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 type SeekPosition struct {
 	// Types that are valid to be assigned to Type:
 	//	*SeekPosition_Newest
 	//	*SeekPosition_Oldest
 	//	*SeekPosition_Specified
+	//	*SeekPosition_Checkpoint
 	Type                 isSeekPosition_Type `protobuf_oneof:"Type"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
@@ -243,7 +313,7 @@ func (m *SeekPosition) Reset()         { *m = SeekPosition{} }
 func (m *SeekPosition) String() string { return proto.CompactTextString(m) }
 func (*SeekPosition) ProtoMessage()    {}
 func (*SeekPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{4}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{5}
 }
 func (m *SeekPosition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekPosition.Unmarshal(m, b)
@@ -279,12 +349,18 @@ type SeekPosition_Specified struct {
 	Specified *SeekSpecified `protobuf:"bytes,3,opt,name=specified,proto3,oneof"`
 }
 
+type SeekPosition_Checkpoint struct {
+	Checkpoint *SeekCheckpoint `protobuf:"bytes,4,opt,name=checkpoint,proto3,oneof"`
+}
+
 func (*SeekPosition_Newest) isSeekPosition_Type() {}
 
 func (*SeekPosition_Oldest) isSeekPosition_Type() {}
 
 func (*SeekPosition_Specified) isSeekPosition_Type() {}
 
+func (*SeekPosition_Checkpoint) isSeekPosition_Type() {}
+
 func (m *SeekPosition) GetType() isSeekPosition_Type {
 	if m != nil {
 		return m.Type
@@ -313,12 +389,20 @@ func (m *SeekPosition) GetSpecified() *SeekSpecified {
 	return nil
 }
 
+func (m *SeekPosition) GetCheckpoint() *SeekCheckpoint {
+	if x, ok := m.GetType().(*SeekPosition_Checkpoint); ok {
+		return x.Checkpoint
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*SeekPosition) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _SeekPosition_OneofMarshaler, _SeekPosition_OneofUnmarshaler, _SeekPosition_OneofSizer, []interface{}{
 		(*SeekPosition_Newest)(nil),
 		(*SeekPosition_Oldest)(nil),
 		(*SeekPosition_Specified)(nil),
+		(*SeekPosition_Checkpoint)(nil),
 	}
 }
 
@@ -341,6 +425,11 @@ func _SeekPosition_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Specified); err != nil {
 			return err
 		}
+	case *SeekPosition_Checkpoint:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Checkpoint); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("SeekPosition.Type has unexpected type %T", x)
@@ -375,6 +464,14 @@ func _SeekPosition_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Type = &SeekPosition_Specified{msg}
 		return true, err
+	case 4: // Type.checkpoint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SeekCheckpoint)
+		err := b.DecodeMessage(msg)
+		m.Type = &SeekPosition_Checkpoint{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -399,6 +496,11 @@ func _SeekPosition_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *SeekPosition_Checkpoint:
+		s := proto.Size(x.Checkpoint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -415,6 +517,7 @@ type SeekInfo struct {
 	Stop                 *SeekPosition              `protobuf:"bytes,2,opt,name=stop,proto3" json:"stop,omitempty"`
 	Behavior             SeekInfo_SeekBehavior      `protobuf:"varint,3,opt,name=behavior,proto3,enum=orderer.SeekInfo_SeekBehavior" json:"behavior,omitempty"`
 	ErrorResponse        SeekInfo_SeekErrorResponse `protobuf:"varint,4,opt,name=error_response,json=errorResponse,proto3,enum=orderer.SeekInfo_SeekErrorResponse" json:"error_response,omitempty"`
+	ContentType          SeekInfo_SeekContentType   `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=orderer.SeekInfo_SeekContentType" json:"content_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -424,7 +527,7 @@ func (m *SeekInfo) Reset()         { *m = SeekInfo{} }
 func (m *SeekInfo) String() string { return proto.CompactTextString(m) }
 func (*SeekInfo) ProtoMessage()    {}
 func (*SeekInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{5}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{6}
 }
 func (m *SeekInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeekInfo.Unmarshal(m, b)
@@ -472,6 +575,13 @@ func (m *SeekInfo) GetErrorResponse() SeekInfo_SeekErrorResponse {
 	return SeekInfo_STRICT
 }
 
+func (m *SeekInfo) GetContentType() SeekInfo_SeekContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return SeekInfo_BLOCK
+}
+
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
 	//	*DeliverResponse_Status
@@ -486,7 +596,7 @@ func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ab_237e854d47750248, []int{6}
+	return fileDescriptor_ab_6057c9ecb959c53d, []int{7}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -617,11 +727,13 @@ func init() {
 	proto.RegisterType((*SeekNewest)(nil), "orderer.SeekNewest")
 	proto.RegisterType((*SeekOldest)(nil), "orderer.SeekOldest")
 	proto.RegisterType((*SeekSpecified)(nil), "orderer.SeekSpecified")
+	proto.RegisterType((*SeekCheckpoint)(nil), "orderer.SeekCheckpoint")
 	proto.RegisterType((*SeekPosition)(nil), "orderer.SeekPosition")
 	proto.RegisterType((*SeekInfo)(nil), "orderer.SeekInfo")
 	proto.RegisterType((*DeliverResponse)(nil), "orderer.DeliverResponse")
 	proto.RegisterEnum("orderer.SeekInfo_SeekBehavior", SeekInfo_SeekBehavior_name, SeekInfo_SeekBehavior_value)
 	proto.RegisterEnum("orderer.SeekInfo_SeekErrorResponse", SeekInfo_SeekErrorResponse_name, SeekInfo_SeekErrorResponse_value)
+	proto.RegisterEnum("orderer.SeekInfo_SeekContentType", SeekInfo_SeekContentType_name, SeekInfo_SeekContentType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -797,43 +909,50 @@ var _AtomicBroadcast_serviceDesc = grpc.ServiceDesc{
 	Metadata: "orderer/ab.proto",
 }
 
-func init() { proto.RegisterFile("orderer/ab.proto", fileDescriptor_ab_237e854d47750248) }
-
-var fileDescriptor_ab_237e854d47750248 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0xc7, 0x93, 0xd1, 0x65, 0xdb, 0xd9, 0xfa, 0xe5, 0x69, 0x53, 0xd5, 0x0b, 0x34, 0x05, 0x0d,
-	0x8a, 0x80, 0x64, 0x2a, 0x12, 0x17, 0x80, 0x84, 0x9a, 0xad, 0x55, 0x0b, 0x55, 0x8b, 0xdc, 0xec,
-	0x02, 0x6e, 0xa2, 0x24, 0x75, 0xdb, 0xb0, 0x36, 0x8e, 0x9c, 0xb4, 0x68, 0x4f, 0xc1, 0x8b, 0xf0,
-	0x44, 0x3c, 0x0d, 0xf2, 0x47, 0xda, 0x75, 0x1b, 0x5c, 0x25, 0xe7, 0xf8, 0x77, 0xce, 0xff, 0x6f,
-	0x1f, 0x1b, 0x2a, 0x94, 0x8d, 0x09, 0x23, 0xcc, 0xf6, 0x03, 0x2b, 0x61, 0x34, 0xa3, 0x68, 0x4f,
-	0x65, 0xea, 0xc7, 0x21, 0x5d, 0x2c, 0x68, 0x6c, 0xcb, 0x8f, 0x5c, 0x35, 0x87, 0x50, 0x75, 0x18,
-	0xf5, 0xc7, 0xa1, 0x9f, 0x66, 0x98, 0xa4, 0x09, 0x8d, 0x53, 0x82, 0x9e, 0x83, 0x91, 0x66, 0x7e,
-	0xb6, 0x4c, 0x6b, 0xfa, 0x99, 0xde, 0x28, 0x35, 0x4b, 0x96, 0xaa, 0x19, 0x89, 0x2c, 0x56, 0xab,
-	0x08, 0x41, 0x21, 0x8a, 0x27, 0xb4, 0xb6, 0x73, 0xa6, 0x37, 0x0e, 0xb0, 0xf8, 0x37, 0x8f, 0x00,
-	0x46, 0x84, 0xdc, 0x0c, 0xc8, 0x4f, 0x92, 0x66, 0x79, 0x34, 0x9c, 0x8f, 0x79, 0xf4, 0x02, 0x8a,
-	0x3c, 0x1a, 0x25, 0x24, 0x8c, 0x26, 0x11, 0x19, 0xa3, 0x53, 0x30, 0xe2, 0xe5, 0x22, 0x20, 0x4c,
-	0x08, 0x15, 0xb0, 0x8a, 0xcc, 0xdf, 0x3a, 0x1c, 0x71, 0xf2, 0x2b, 0x4d, 0xa3, 0x2c, 0xa2, 0x31,
-	0x7a, 0x03, 0x46, 0x2c, 0x3a, 0x0a, 0xf0, 0xb0, 0x79, 0x6c, 0xa9, 0x5d, 0x59, 0x1b, 0xb1, 0xae,
-	0x86, 0x15, 0xc4, 0x71, 0x2a, 0x24, 0x85, 0xb5, 0xfb, 0xb8, 0x74, 0xc3, 0x71, 0x09, 0xa1, 0x77,
-	0x70, 0x90, 0xe6, 0x9e, 0x6a, 0x4f, 0x44, 0xc5, 0xe9, 0x56, 0xc5, 0xda, 0x71, 0x57, 0xc3, 0x1b,
-	0xd4, 0x31, 0xa0, 0xe0, 0xde, 0x26, 0xc4, 0xfc, 0xb3, 0x03, 0xfb, 0x1c, 0xeb, 0xc5, 0x13, 0x8a,
-	0x5e, 0xc1, 0x6e, 0x9a, 0xf9, 0x2c, 0x77, 0x7a, 0xb2, 0xd5, 0x28, 0xdf, 0x10, 0x96, 0x0c, 0x7a,
-	0x09, 0x85, 0x34, 0xa3, 0x89, 0xb2, 0xf9, 0x0f, 0x56, 0x20, 0xe8, 0x3d, 0xec, 0x07, 0x64, 0xe6,
-	0xaf, 0x22, 0xca, 0x84, 0xc7, 0x52, 0xf3, 0xe9, 0x16, 0xce, 0xc5, 0xc5, 0x8f, 0xa3, 0x28, 0xbc,
-	0xe6, 0xd1, 0x67, 0x28, 0x11, 0xc6, 0x28, 0xf3, 0x98, 0x1a, 0x71, 0xad, 0x20, 0x3a, 0x3c, 0x7b,
-	0xbc, 0x43, 0x9b, 0xb3, 0xf9, 0x6d, 0xc0, 0x45, 0x72, 0x37, 0x34, 0x3f, 0xca, 0xd1, 0xe4, 0x2a,
-	0xe8, 0x04, 0xaa, 0x4e, 0x7f, 0x78, 0xf9, 0xc5, 0xbb, 0x1e, 0xb8, 0xbd, 0xbe, 0x87, 0xdb, 0xad,
-	0xab, 0x6f, 0x15, 0x8d, 0xa7, 0x3b, 0xad, 0x5e, 0xdf, 0xeb, 0x75, 0xbc, 0xc1, 0xd0, 0x55, 0x69,
-	0xdd, 0xbc, 0x80, 0xea, 0x03, 0x05, 0x04, 0x60, 0x8c, 0x5c, 0xdc, 0xbb, 0x74, 0x2b, 0x1a, 0x2a,
-	0xc3, 0xa1, 0xd3, 0x1e, 0xb9, 0x5e, 0xbb, 0xd3, 0x19, 0x62, 0xb7, 0xa2, 0x9b, 0x3f, 0xa0, 0x7c,
-	0x45, 0xe6, 0xd1, 0x8a, 0x6c, 0xf8, 0xc6, 0xff, 0xef, 0x27, 0x9f, 0xac, 0xba, 0xa1, 0xe7, 0xb0,
-	0x1b, 0xcc, 0x69, 0x78, 0xa3, 0x0e, 0xb8, 0x98, 0x83, 0x0e, 0x4f, 0x76, 0x35, 0x2c, 0x57, 0xf3,
-	0x41, 0x36, 0x7f, 0xe9, 0x50, 0x6e, 0x65, 0x74, 0x11, 0x85, 0xeb, 0x47, 0x81, 0x3e, 0xc1, 0xc1,
-	0x26, 0xa8, 0xe4, 0x0d, 0xda, 0xf1, 0x8a, 0xcc, 0x69, 0x42, 0xea, 0xf5, 0xf5, 0x11, 0x3e, 0x78,
-	0x47, 0xa6, 0xd6, 0xd0, 0x2f, 0x74, 0xf4, 0x01, 0xf6, 0xd4, 0x06, 0x1e, 0x29, 0xaf, 0xad, 0xcb,
-	0xef, 0x6d, 0x52, 0x16, 0x3b, 0xd7, 0x70, 0x4e, 0xd9, 0xd4, 0x9a, 0xdd, 0x26, 0x84, 0xcd, 0xc9,
-	0x78, 0x4a, 0x98, 0x35, 0xf1, 0x03, 0x16, 0x85, 0xf2, 0xfd, 0xa6, 0x79, 0xf9, 0xf7, 0xd7, 0xd3,
-	0x28, 0x9b, 0x2d, 0x03, 0x2e, 0x60, 0xdf, 0xa1, 0x6d, 0x49, 0xdb, 0x92, 0xb6, 0x15, 0x1d, 0x18,
-	0x22, 0x7e, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6c, 0xc9, 0x45, 0x2f, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("orderer/ab.proto", fileDescriptor_ab_6057c9ecb959c53d) }
+
+var fileDescriptor_ab_6057c9ecb959c53d = []byte{
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xeb, 0x6e, 0xda, 0x48,
+	0x14, 0xc7, 0xed, 0x04, 0x48, 0x38, 0x10, 0x20, 0x13, 0x25, 0x6b, 0xe5, 0xc3, 0x6e, 0xd6, 0xab,
+	0x6c, 0xa9, 0xda, 0x42, 0x42, 0xa5, 0x4a, 0xbd, 0x48, 0x15, 0x17, 0x53, 0xdc, 0x46, 0xa1, 0x1a,
+	0x1c, 0x55, 0xed, 0x17, 0xcb, 0x97, 0x21, 0xb8, 0x01, 0x8f, 0x35, 0x1e, 0x52, 0xe5, 0x29, 0xfa,
+	0x70, 0x7d, 0x88, 0xbe, 0x46, 0x35, 0x63, 0x9b, 0x4b, 0x82, 0xf2, 0x09, 0x9f, 0x33, 0xbf, 0xff,
+	0x39, 0xe7, 0x3f, 0xcc, 0x0c, 0xd4, 0x28, 0xf3, 0x09, 0x23, 0xac, 0xe9, 0xb8, 0x8d, 0x88, 0x51,
+	0x4e, 0xd1, 0x4e, 0x9a, 0x39, 0x3e, 0xf0, 0xe8, 0x6c, 0x46, 0xc3, 0x66, 0xf2, 0x93, 0xac, 0xea,
+	0x43, 0xd8, 0xef, 0x30, 0xea, 0xf8, 0x9e, 0x13, 0x73, 0x4c, 0xe2, 0x88, 0x86, 0x31, 0x41, 0xff,
+	0x43, 0x21, 0xe6, 0x0e, 0x9f, 0xc7, 0x9a, 0x7a, 0xa2, 0xd6, 0x2b, 0xad, 0x4a, 0x23, 0xd5, 0x8c,
+	0x64, 0x16, 0xa7, 0xab, 0x08, 0x41, 0x2e, 0x08, 0xc7, 0x54, 0xdb, 0x3a, 0x51, 0xeb, 0x45, 0x2c,
+	0xbf, 0xf5, 0x32, 0xc0, 0x88, 0x90, 0x9b, 0x4b, 0xf2, 0x83, 0xc4, 0x3c, 0x8b, 0x86, 0x53, 0x5f,
+	0x44, 0x4f, 0x60, 0x4f, 0x44, 0xa3, 0x88, 0x78, 0xc1, 0x38, 0x20, 0x3e, 0x3a, 0x82, 0x42, 0x38,
+	0x9f, 0xb9, 0x84, 0xc9, 0x46, 0x39, 0x9c, 0x46, 0xfa, 0x39, 0x54, 0x04, 0xd8, 0x9d, 0x10, 0xef,
+	0x26, 0xa2, 0x41, 0xc8, 0xd1, 0x3f, 0x50, 0xf2, 0x68, 0x18, 0xcf, 0x67, 0x84, 0xd9, 0x81, 0x2f,
+	0xf1, 0x22, 0x86, 0x2c, 0x65, 0xfa, 0xfa, 0x6f, 0x15, 0xca, 0x42, 0xf3, 0x99, 0xc6, 0x01, 0x0f,
+	0x68, 0x88, 0x5e, 0x40, 0x21, 0x94, 0x43, 0x48, 0xb8, 0xd4, 0x3a, 0x68, 0xa4, 0x1b, 0xd1, 0x58,
+	0xce, 0x37, 0x50, 0x70, 0x0a, 0x09, 0x9c, 0xca, 0x29, 0xb5, 0xad, 0x0d, 0x78, 0x62, 0x40, 0xe0,
+	0x09, 0x84, 0x5e, 0x41, 0x31, 0xce, 0x6c, 0x68, 0xdb, 0x52, 0x71, 0xb4, 0xa6, 0x58, 0x98, 0x1c,
+	0x28, 0x78, 0x89, 0xa2, 0xd7, 0x00, 0xde, 0xc2, 0x95, 0x96, 0x93, 0xc2, 0xbf, 0xd6, 0x84, 0x4b,
+	0xd3, 0x03, 0x05, 0xaf, 0xc0, 0x9d, 0x02, 0xe4, 0xac, 0xbb, 0x88, 0xe8, 0xbf, 0xb6, 0x61, 0x57,
+	0x80, 0x66, 0x38, 0xa6, 0xe8, 0x19, 0xe4, 0x63, 0xee, 0xb0, 0xcc, 0xe4, 0xe1, 0x5a, 0xa9, 0x6c,
+	0x2f, 0x70, 0xc2, 0xa0, 0xa7, 0x90, 0x8b, 0x39, 0x8d, 0xb4, 0xad, 0xc7, 0x58, 0x89, 0xa0, 0x37,
+	0xb0, 0xeb, 0x92, 0x89, 0x73, 0x1b, 0x50, 0x26, 0xed, 0x55, 0x5a, 0x7f, 0xaf, 0xe1, 0xa2, 0xb9,
+	0xfc, 0xe8, 0xa4, 0x14, 0x5e, 0xf0, 0xe8, 0x23, 0x54, 0x08, 0x63, 0x94, 0xd9, 0x2c, 0x3d, 0x50,
+	0xd2, 0x67, 0xa5, 0xf5, 0xdf, 0xe6, 0x0a, 0x86, 0x60, 0xb3, 0xb3, 0x87, 0xf7, 0xc8, 0x6a, 0x88,
+	0x7a, 0x50, 0xf6, 0x68, 0xc8, 0x49, 0xc8, 0x6d, 0x7e, 0x17, 0x11, 0x2d, 0x2f, 0x2b, 0xfd, 0xbb,
+	0xb9, 0x52, 0x37, 0x21, 0xc5, 0x2e, 0xe1, 0x92, 0xb7, 0x0c, 0xf4, 0x77, 0x50, 0x5e, 0x9d, 0x15,
+	0x1d, 0xc2, 0x7e, 0xe7, 0x62, 0xd8, 0xfd, 0x64, 0x5f, 0x5d, 0x5a, 0xe6, 0x85, 0x8d, 0x8d, 0x76,
+	0xef, 0x6b, 0x4d, 0x11, 0xe9, 0x7e, 0xdb, 0xbc, 0xb0, 0xcd, 0xbe, 0x7d, 0x39, 0xb4, 0xd2, 0xb4,
+	0xaa, 0x9f, 0xc1, 0xfe, 0x83, 0x39, 0x11, 0x40, 0x61, 0x64, 0x61, 0xb3, 0x6b, 0xd5, 0x14, 0x54,
+	0x85, 0x52, 0xc7, 0x18, 0x59, 0xb6, 0xd1, 0xef, 0x0f, 0xb1, 0x55, 0x53, 0xf5, 0x73, 0xa8, 0xde,
+	0x9b, 0x07, 0x15, 0x21, 0x2f, 0x5b, 0xd6, 0x14, 0x74, 0x00, 0xd5, 0x81, 0xd1, 0xee, 0x19, 0xd8,
+	0xfe, 0x62, 0x5a, 0x03, 0x7b, 0x64, 0x7e, 0xa8, 0xa9, 0xfa, 0x77, 0xa8, 0xf6, 0xc8, 0x34, 0xb8,
+	0x25, 0xcb, 0x16, 0xf5, 0xc7, 0xaf, 0xa1, 0x38, 0x8d, 0xe9, 0x45, 0x3c, 0x85, 0xbc, 0x3b, 0xa5,
+	0xde, 0x4d, 0xfa, 0xcf, 0xee, 0x65, 0x60, 0x47, 0x24, 0x07, 0x0a, 0x4e, 0x56, 0xb3, 0x13, 0xd4,
+	0xfa, 0xa9, 0x42, 0xb5, 0xcd, 0xe9, 0x2c, 0xf0, 0x16, 0x77, 0x1f, 0xbd, 0x87, 0xe2, 0x32, 0xa8,
+	0x65, 0x05, 0x8c, 0xf0, 0x96, 0x4c, 0x69, 0x44, 0x8e, 0x8f, 0x17, 0x3b, 0xfe, 0xe0, 0xb9, 0xd0,
+	0x95, 0xba, 0x7a, 0xa6, 0xa2, 0xb7, 0xb0, 0x93, 0x1a, 0xd8, 0x20, 0xd7, 0x16, 0xf2, 0x7b, 0x26,
+	0x13, 0x71, 0xe7, 0x0a, 0x4e, 0x29, 0xbb, 0x6e, 0x4c, 0xee, 0x22, 0xc2, 0xa6, 0xc4, 0xbf, 0x26,
+	0xac, 0x31, 0x76, 0x5c, 0x16, 0x78, 0xc9, 0x33, 0x15, 0x67, 0xf2, 0x6f, 0xcf, 0xaf, 0x03, 0x3e,
+	0x99, 0xbb, 0xa2, 0x41, 0x73, 0x85, 0x6e, 0x26, 0x74, 0x33, 0xa1, 0x9b, 0x29, 0xed, 0x16, 0x64,
+	0xfc, 0xf2, 0xcf, 0x00, 0x52, 0xbc, 0xff, 0xff, 0x16, 0x05, 0x00, 0x00,
 }
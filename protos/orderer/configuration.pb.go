@@ -41,7 +41,7 @@ func (x ConsensusType_State) String() string {
 	return proto.EnumName(ConsensusType_State_name, int32(x))
 }
 func (ConsensusType_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{0, 0}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{0, 0}
 }
 
 type ConsensusType struct {
@@ -60,7 +60,7 @@ func (m *ConsensusType) Reset()         { *m = ConsensusType{} }
 func (m *ConsensusType) String() string { return proto.CompactTextString(m) }
 func (*ConsensusType) ProtoMessage()    {}
 func (*ConsensusType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{0}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{0}
 }
 func (m *ConsensusType) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusType.Unmarshal(m, b)
@@ -120,7 +120,7 @@ func (m *BatchSize) Reset()         { *m = BatchSize{} }
 func (m *BatchSize) String() string { return proto.CompactTextString(m) }
 func (*BatchSize) ProtoMessage()    {}
 func (*BatchSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{1}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{1}
 }
 func (m *BatchSize) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchSize.Unmarshal(m, b)
@@ -174,7 +174,7 @@ func (m *BatchTimeout) Reset()         { *m = BatchTimeout{} }
 func (m *BatchTimeout) String() string { return proto.CompactTextString(m) }
 func (*BatchTimeout) ProtoMessage()    {}
 func (*BatchTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{2}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{2}
 }
 func (m *BatchTimeout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchTimeout.Unmarshal(m, b)
@@ -216,7 +216,7 @@ func (m *KafkaBrokers) Reset()         { *m = KafkaBrokers{} }
 func (m *KafkaBrokers) String() string { return proto.CompactTextString(m) }
 func (*KafkaBrokers) ProtoMessage()    {}
 func (*KafkaBrokers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{3}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{3}
 }
 func (m *KafkaBrokers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KafkaBrokers.Unmarshal(m, b)
@@ -255,7 +255,7 @@ func (m *ChannelRestrictions) Reset()         { *m = ChannelRestrictions{} }
 func (m *ChannelRestrictions) String() string { return proto.CompactTextString(m) }
 func (*ChannelRestrictions) ProtoMessage()    {}
 func (*ChannelRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{4}
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{4}
 }
 func (m *ChannelRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelRestrictions.Unmarshal(m, b)
@@ -282,45 +282,378 @@ func (m *ChannelRestrictions) GetMaxCount() uint64 {
 	return 0
 }
 
+// RateLimits bounds the rate at which the orderer accepts transactions
+// broadcast on the channel, overriding the limits of the local config of
+// the orderer
+type RateLimits struct {
+	// The limit of the channel as a whole
+	Channel *RateLimit `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The limit of each client of the channel, identified by the MSP ID
+	// and the hash of the certificate of the creator of the transactions
+	Client               *RateLimit `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RateLimits) Reset()         { *m = RateLimits{} }
+func (m *RateLimits) String() string { return proto.CompactTextString(m) }
+func (*RateLimits) ProtoMessage()    {}
+func (*RateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{5}
+}
+func (m *RateLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimits.Unmarshal(m, b)
+}
+func (m *RateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimits.Marshal(b, m, deterministic)
+}
+func (dst *RateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimits.Merge(dst, src)
+}
+func (m *RateLimits) XXX_Size() int {
+	return xxx_messageInfo_RateLimits.Size(m)
+}
+func (m *RateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimits proto.InternalMessageInfo
+
+func (m *RateLimits) GetChannel() *RateLimit {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *RateLimits) GetClient() *RateLimit {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+// RateLimit is the limit of a token bucket
+type RateLimit struct {
+	Rate                 uint32   `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst                uint32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{6}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimit.Unmarshal(m, b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+}
+func (dst *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(dst, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return xxx_messageInfo_RateLimit.Size(m)
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetRate() uint32 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateLimit) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+// BatchPriorities splits the transactions of the channel into priority
+// classes, each of which is given a weighted share of every batch, so that
+// transactions of a class are not held back by the backlog of another one
+type BatchPriorities struct {
+	// The classes of transactions, the first matching class of a
+	// transaction applies, while a transaction matching no class is given a
+	// weight of 1
+	Classes []*PriorityClass `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// The number of batches beyond the pending one which may be held back to
+	// give their share to the transactions of other classes, a value of 0
+	// indicates 1. It is ignored by the kafka consensus type, which holds no
+	// batch back.
+	MaxPendingBatches    uint32   `protobuf:"varint,2,opt,name=max_pending_batches,json=maxPendingBatches,proto3" json:"max_pending_batches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchPriorities) Reset()         { *m = BatchPriorities{} }
+func (m *BatchPriorities) String() string { return proto.CompactTextString(m) }
+func (*BatchPriorities) ProtoMessage()    {}
+func (*BatchPriorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{7}
+}
+func (m *BatchPriorities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchPriorities.Unmarshal(m, b)
+}
+func (m *BatchPriorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchPriorities.Marshal(b, m, deterministic)
+}
+func (dst *BatchPriorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPriorities.Merge(dst, src)
+}
+func (m *BatchPriorities) XXX_Size() int {
+	return xxx_messageInfo_BatchPriorities.Size(m)
+}
+func (m *BatchPriorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPriorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPriorities proto.InternalMessageInfo
+
+func (m *BatchPriorities) GetClasses() []*PriorityClass {
+	if m != nil {
+		return m.Classes
+	}
+	return nil
+}
+
+func (m *BatchPriorities) GetMaxPendingBatches() uint32 {
+	if m != nil {
+		return m.MaxPendingBatches
+	}
+	return 0
+}
+
+// PriorityClass is a class of transactions, matched by the MSP of their
+// creator or by the type of their header
+type PriorityClass struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight               uint32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MspIds               []string `protobuf:"bytes,3,rep,name=msp_ids,json=mspIds,proto3" json:"msp_ids,omitempty"`
+	HeaderTypes          []string `protobuf:"bytes,4,rep,name=header_types,json=headerTypes,proto3" json:"header_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriorityClass) Reset()         { *m = PriorityClass{} }
+func (m *PriorityClass) String() string { return proto.CompactTextString(m) }
+func (*PriorityClass) ProtoMessage()    {}
+func (*PriorityClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{8}
+}
+func (m *PriorityClass) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriorityClass.Unmarshal(m, b)
+}
+func (m *PriorityClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriorityClass.Marshal(b, m, deterministic)
+}
+func (dst *PriorityClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityClass.Merge(dst, src)
+}
+func (m *PriorityClass) XXX_Size() int {
+	return xxx_messageInfo_PriorityClass.Size(m)
+}
+func (m *PriorityClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorityClass proto.InternalMessageInfo
+
+func (m *PriorityClass) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriorityClass) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *PriorityClass) GetMspIds() []string {
+	if m != nil {
+		return m.MspIds
+	}
+	return nil
+}
+
+func (m *PriorityClass) GetHeaderTypes() []string {
+	if m != nil {
+		return m.HeaderTypes
+	}
+	return nil
+}
+
+// AdaptiveBatching lets the orderer tune the batch timeout and the preferred
+// max bytes of the batches to the observed arrival rate of the transactions,
+// within the given bounds
+type AdaptiveBatching struct {
+	// The lower bound of the batch timeout, BatchTimeout being the upper bound
+	MinBatchTimeout string `protobuf:"bytes,1,opt,name=min_batch_timeout,json=minBatchTimeout,proto3" json:"min_batch_timeout,omitempty"`
+	// The upper bound of the preferred max bytes, BatchSize.PreferredMaxBytes
+	// being the lower bound, a value of 0 indicates the preferred max bytes
+	// is not tuned
+	MaxPreferredMaxBytes uint32   `protobuf:"varint,2,opt,name=max_preferred_max_bytes,json=maxPreferredMaxBytes,proto3" json:"max_preferred_max_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdaptiveBatching) Reset()         { *m = AdaptiveBatching{} }
+func (m *AdaptiveBatching) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveBatching) ProtoMessage()    {}
+func (*AdaptiveBatching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{9}
+}
+func (m *AdaptiveBatching) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdaptiveBatching.Unmarshal(m, b)
+}
+func (m *AdaptiveBatching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdaptiveBatching.Marshal(b, m, deterministic)
+}
+func (dst *AdaptiveBatching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveBatching.Merge(dst, src)
+}
+func (m *AdaptiveBatching) XXX_Size() int {
+	return xxx_messageInfo_AdaptiveBatching.Size(m)
+}
+func (m *AdaptiveBatching) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveBatching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveBatching proto.InternalMessageInfo
+
+func (m *AdaptiveBatching) GetMinBatchTimeout() string {
+	if m != nil {
+		return m.MinBatchTimeout
+	}
+	return ""
+}
+
+func (m *AdaptiveBatching) GetMaxPreferredMaxBytes() uint32 {
+	if m != nil {
+		return m.MaxPreferredMaxBytes
+	}
+	return 0
+}
+
+// DuplicateTxIDWindow lets the orderer reject the transactions whose ID is
+// the one of a transaction of the last blocks of the channel
+type DuplicateTxIDWindow struct {
+	Blocks               uint32   `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicateTxIDWindow) Reset()         { *m = DuplicateTxIDWindow{} }
+func (m *DuplicateTxIDWindow) String() string { return proto.CompactTextString(m) }
+func (*DuplicateTxIDWindow) ProtoMessage()    {}
+func (*DuplicateTxIDWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_59d1e91394cbd0e1, []int{10}
+}
+func (m *DuplicateTxIDWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateTxIDWindow.Unmarshal(m, b)
+}
+func (m *DuplicateTxIDWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateTxIDWindow.Marshal(b, m, deterministic)
+}
+func (dst *DuplicateTxIDWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateTxIDWindow.Merge(dst, src)
+}
+func (m *DuplicateTxIDWindow) XXX_Size() int {
+	return xxx_messageInfo_DuplicateTxIDWindow.Size(m)
+}
+func (m *DuplicateTxIDWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateTxIDWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateTxIDWindow proto.InternalMessageInfo
+
+func (m *DuplicateTxIDWindow) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusType)(nil), "orderer.ConsensusType")
 	proto.RegisterType((*BatchSize)(nil), "orderer.BatchSize")
 	proto.RegisterType((*BatchTimeout)(nil), "orderer.BatchTimeout")
 	proto.RegisterType((*KafkaBrokers)(nil), "orderer.KafkaBrokers")
 	proto.RegisterType((*ChannelRestrictions)(nil), "orderer.ChannelRestrictions")
+	proto.RegisterType((*RateLimits)(nil), "orderer.RateLimits")
+	proto.RegisterType((*RateLimit)(nil), "orderer.RateLimit")
+	proto.RegisterType((*BatchPriorities)(nil), "orderer.BatchPriorities")
+	proto.RegisterType((*PriorityClass)(nil), "orderer.PriorityClass")
+	proto.RegisterType((*AdaptiveBatching)(nil), "orderer.AdaptiveBatching")
+	proto.RegisterType((*DuplicateTxIDWindow)(nil), "orderer.DuplicateTxIDWindow")
 	proto.RegisterEnum("orderer.ConsensusType_State", ConsensusType_State_name, ConsensusType_State_value)
 }
 
 func init() {
-	proto.RegisterFile("orderer/configuration.proto", fileDescriptor_configuration_3a2420d24de6d468)
-}
-
-var fileDescriptor_configuration_3a2420d24de6d468 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x8a, 0xdb, 0x30,
-	0x10, 0x86, 0xeb, 0x66, 0xb7, 0xbb, 0x19, 0x92, 0x36, 0xd1, 0x52, 0x30, 0xdd, 0x1e, 0x82, 0xa1,
-	0x10, 0xca, 0x22, 0x97, 0xf4, 0x09, 0x92, 0x90, 0x43, 0x69, 0x93, 0x82, 0xe2, 0x5e, 0x7a, 0x09,
-	0x63, 0x67, 0xe2, 0x98, 0x8d, 0x2d, 0x23, 0xc9, 0x90, 0xf4, 0x3d, 0xfa, 0x08, 0x7d, 0xcf, 0x22,
-	0xc9, 0xde, 0x6e, 0x6f, 0xf3, 0xff, 0xf3, 0x69, 0x98, 0xd1, 0x0f, 0xf7, 0x52, 0xed, 0x49, 0x91,
-	0x8a, 0x33, 0x59, 0x1d, 0x8a, 0xbc, 0x51, 0x68, 0x0a, 0x59, 0xf1, 0x5a, 0x49, 0x23, 0xd9, 0x4d,
-	0xdb, 0x8c, 0xfe, 0x04, 0x30, 0x5c, 0xca, 0x4a, 0x53, 0xa5, 0x1b, 0x9d, 0x5c, 0x6a, 0x62, 0x0c,
-	0xae, 0xcc, 0xa5, 0xa6, 0x30, 0x98, 0x04, 0xd3, 0xbe, 0x70, 0x35, 0x7b, 0x07, 0xb7, 0x25, 0x19,
-	0xdc, 0xa3, 0xc1, 0xf0, 0xe5, 0x24, 0x98, 0x0e, 0xc4, 0x93, 0x66, 0x33, 0xb8, 0xd6, 0x06, 0x0d,
-	0x85, 0xbd, 0x49, 0x30, 0x7d, 0x3d, 0x7b, 0xcf, 0xdb, 0xd1, 0xfc, 0xbf, 0xb1, 0x7c, 0x6b, 0x19,
-	0xe1, 0xd1, 0xe8, 0x13, 0x5c, 0x3b, 0xcd, 0x46, 0x30, 0xd8, 0x26, 0xf3, 0x64, 0xb5, 0xdb, 0x7c,
-	0x17, 0xeb, 0xf9, 0xb7, 0xd1, 0x0b, 0xf6, 0x16, 0xc6, 0xde, 0x59, 0xcf, 0xbf, 0x6c, 0x92, 0xd5,
-	0x66, 0xbe, 0x59, 0xae, 0x46, 0x41, 0xf4, 0x3b, 0x80, 0xfe, 0x02, 0x4d, 0x76, 0xdc, 0x16, 0xbf,
-	0x88, 0x7d, 0x84, 0x71, 0x89, 0xe7, 0x5d, 0x49, 0x5a, 0x63, 0x4e, 0xbb, 0x4c, 0x36, 0x95, 0x71,
-	0x0b, 0x0f, 0xc5, 0x9b, 0x12, 0xcf, 0x6b, 0xef, 0x2f, 0xad, 0xcd, 0x1e, 0x80, 0x61, 0xaa, 0xe5,
-	0xa9, 0x31, 0xb4, 0xb3, 0x8f, 0xd2, 0x8b, 0x21, 0xed, 0xae, 0x18, 0x8a, 0x51, 0xd7, 0x59, 0xe3,
-	0x79, 0x61, 0x7d, 0xc6, 0xe1, 0xae, 0x56, 0x74, 0x20, 0xa5, 0x68, 0xff, 0x0c, 0xef, 0x39, 0x7c,
-	0xfc, 0xd4, 0xea, 0xf8, 0x68, 0x0a, 0x03, 0xb7, 0x56, 0x52, 0x94, 0x24, 0x1b, 0xc3, 0x42, 0xb8,
-	0x31, 0xbe, 0x6c, 0x3f, 0xb0, 0x93, 0x96, 0xfc, 0x8a, 0x87, 0x47, 0x5c, 0x28, 0xf9, 0x48, 0x4a,
-	0x5b, 0x32, 0xf5, 0x65, 0x18, 0x4c, 0x7a, 0x96, 0x6c, 0x65, 0x34, 0x83, 0xbb, 0xe5, 0x11, 0xab,
-	0x8a, 0x4e, 0x82, 0xb4, 0x51, 0x45, 0x66, 0x83, 0xd3, 0xec, 0x1e, 0xfa, 0x76, 0xa1, 0x7f, 0xc7,
-	0x5e, 0x89, 0xdb, 0x12, 0xcf, 0xee, 0xca, 0xc5, 0x0f, 0xf8, 0x20, 0x55, 0xce, 0x8f, 0x97, 0x9a,
-	0xd4, 0x89, 0xf6, 0x39, 0x29, 0x7e, 0xc0, 0x54, 0x15, 0x99, 0x0f, 0x5c, 0x77, 0xa9, 0xfc, 0x7c,
-	0xc8, 0x0b, 0x73, 0x6c, 0x52, 0x9e, 0xc9, 0x32, 0x7e, 0x46, 0xc7, 0x9e, 0x8e, 0x3d, 0x1d, 0xb7,
-	0x74, 0xfa, 0xca, 0xe9, 0xcf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0x7c, 0x05, 0xd3, 0x4d,
-	0x02, 0x00, 0x00,
+	proto.RegisterFile("orderer/configuration.proto", fileDescriptor_configuration_59d1e91394cbd0e1)
+}
+
+var fileDescriptor_configuration_59d1e91394cbd0e1 = []byte{
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdf, 0x6e, 0xda, 0x4a,
+	0x10, 0xc6, 0x8f, 0xf3, 0x07, 0xc2, 0x04, 0x4e, 0xc8, 0x26, 0x27, 0x41, 0x27, 0xe7, 0x82, 0x63,
+	0xa9, 0x12, 0x8a, 0x52, 0x13, 0x51, 0xe5, 0x01, 0x80, 0xe4, 0x22, 0x6a, 0xa0, 0x91, 0x43, 0x55,
+	0xa9, 0x37, 0xd6, 0xda, 0x1e, 0xcc, 0x2a, 0xd8, 0x6b, 0xed, 0xae, 0x1b, 0xe8, 0x7b, 0xf4, 0x11,
+	0xfa, 0x9e, 0xd5, 0xee, 0x1a, 0x37, 0xa8, 0xed, 0xdd, 0xce, 0xcc, 0x8f, 0x61, 0x66, 0xbe, 0x0f,
+	0xe0, 0x82, 0x8b, 0x18, 0x05, 0x8a, 0x7e, 0xc4, 0xb3, 0x39, 0x4b, 0x0a, 0x41, 0x15, 0xe3, 0x99,
+	0x97, 0x0b, 0xae, 0x38, 0xa9, 0x97, 0x45, 0xf7, 0xbb, 0x03, 0xad, 0x31, 0xcf, 0x24, 0x66, 0xb2,
+	0x90, 0xb3, 0x75, 0x8e, 0x84, 0xc0, 0x9e, 0x5a, 0xe7, 0xd8, 0x71, 0xba, 0x4e, 0xaf, 0xe1, 0x9b,
+	0x37, 0xf9, 0x17, 0x0e, 0x52, 0x54, 0x34, 0xa6, 0x8a, 0x76, 0x76, 0xba, 0x4e, 0xaf, 0xe9, 0x57,
+	0x31, 0x19, 0xc0, 0xbe, 0x54, 0x54, 0x61, 0x67, 0xb7, 0xeb, 0xf4, 0xfe, 0x1e, 0xfc, 0xe7, 0x95,
+	0xad, 0xbd, 0xad, 0xb6, 0xde, 0x93, 0x66, 0x7c, 0x8b, 0xba, 0xd7, 0xb0, 0x6f, 0x62, 0xd2, 0x86,
+	0xe6, 0xd3, 0x6c, 0x38, 0xbb, 0x0b, 0xa6, 0x1f, 0xfc, 0xc9, 0xf0, 0xa1, 0xfd, 0x17, 0xf9, 0x07,
+	0x8e, 0x6d, 0x66, 0x32, 0xbc, 0x9f, 0xce, 0xee, 0xa6, 0xc3, 0xe9, 0xf8, 0xae, 0xed, 0xb8, 0xdf,
+	0x1c, 0x68, 0x8c, 0xa8, 0x8a, 0x16, 0x4f, 0xec, 0x2b, 0x92, 0x4b, 0x38, 0x4e, 0xe9, 0x2a, 0x48,
+	0x51, 0x4a, 0x9a, 0x60, 0x10, 0xf1, 0x22, 0x53, 0x66, 0xe0, 0x96, 0x7f, 0x94, 0xd2, 0xd5, 0xc4,
+	0xe6, 0xc7, 0x3a, 0x4d, 0xae, 0x80, 0xd0, 0x50, 0xf2, 0x65, 0xa1, 0x30, 0xd0, 0x1f, 0x0a, 0xd7,
+	0x0a, 0xa5, 0xd9, 0xa2, 0xe5, 0xb7, 0x37, 0x95, 0x09, 0x5d, 0x8d, 0x74, 0x9e, 0x78, 0x70, 0x92,
+	0x0b, 0x9c, 0xa3, 0x10, 0x18, 0xbf, 0xc2, 0x77, 0x0d, 0x7e, 0x5c, 0x95, 0x36, 0xbc, 0xdb, 0x83,
+	0xa6, 0x19, 0x6b, 0xc6, 0x52, 0xe4, 0x85, 0x22, 0x1d, 0xa8, 0x2b, 0xfb, 0x2c, 0x0f, 0xb8, 0x09,
+	0x35, 0xf9, 0x9e, 0xce, 0x9f, 0xe9, 0x48, 0xf0, 0x67, 0x14, 0x52, 0x93, 0xa1, 0x7d, 0x76, 0x9c,
+	0xee, 0xae, 0x26, 0xcb, 0xd0, 0x1d, 0xc0, 0xc9, 0x78, 0x41, 0xb3, 0x0c, 0x97, 0x3e, 0x4a, 0x25,
+	0x58, 0xa4, 0x85, 0x93, 0xe4, 0x02, 0x1a, 0x7a, 0xa0, 0x9f, 0xcb, 0xee, 0xf9, 0x07, 0x29, 0x5d,
+	0x99, 0x2d, 0xdd, 0x39, 0x80, 0x4f, 0x15, 0x3e, 0xb0, 0x94, 0x29, 0x49, 0xae, 0xa0, 0x1e, 0xd9,
+	0x0e, 0x06, 0x3c, 0x1c, 0x90, 0x4a, 0x95, 0x8a, 0xf2, 0x37, 0x08, 0xb9, 0x84, 0x5a, 0xb4, 0x64,
+	0x98, 0xa9, 0xce, 0xce, 0x1f, 0xe1, 0x92, 0x70, 0x6f, 0xa0, 0x51, 0x25, 0xb5, 0x55, 0x84, 0x56,
+	0xde, 0x5e, 0xde, 0xbc, 0xc9, 0x29, 0xec, 0x87, 0x85, 0x90, 0xaa, 0xbc, 0xb0, 0x0d, 0x5c, 0x09,
+	0x47, 0xe6, 0x4c, 0x8f, 0x82, 0x71, 0xc1, 0x14, 0x43, 0x49, 0xae, 0xa1, 0x1e, 0x2d, 0xa9, 0x94,
+	0x68, 0xf7, 0x3f, 0x1c, 0x9c, 0x55, 0x5f, 0x5b, 0x52, 0xeb, 0xb1, 0xae, 0xfb, 0x1b, 0x4c, 0x6b,
+	0xa3, 0x0f, 0x90, 0x63, 0x16, 0xb3, 0x2c, 0x09, 0x42, 0xdd, 0xb0, 0x92, 0x52, 0x1b, 0xe2, 0xd1,
+	0x56, 0x46, 0xb6, 0xe0, 0xbe, 0x40, 0x6b, 0xab, 0x93, 0x9e, 0x37, 0xa3, 0x69, 0x65, 0x6d, 0xfd,
+	0x26, 0x67, 0x50, 0x7b, 0x41, 0x96, 0x2c, 0x36, 0x03, 0x97, 0x11, 0x39, 0x87, 0x7a, 0x2a, 0xf3,
+	0x80, 0xc5, 0x5a, 0x7c, 0x2d, 0x4f, 0x2d, 0x95, 0xf9, 0x7d, 0x2c, 0xc9, 0xff, 0xd0, 0x5c, 0x20,
+	0x8d, 0x51, 0x04, 0xfa, 0xa7, 0x21, 0x3b, 0x7b, 0xa6, 0x7a, 0x68, 0x73, 0xda, 0xea, 0xd2, 0x2d,
+	0xa0, 0x3d, 0x8c, 0x69, 0xae, 0xd8, 0x17, 0x34, 0xb3, 0xb0, 0x2c, 0x31, 0x96, 0x65, 0x99, 0x1d,
+	0x3a, 0xd8, 0xb6, 0xc8, 0x51, 0xca, 0xb2, 0x2d, 0x13, 0xdd, 0xc0, 0xb9, 0x59, 0xf4, 0x37, 0x46,
+	0xb4, 0x43, 0x9e, 0xea, 0x65, 0x7f, 0xf1, 0xe2, 0x5b, 0x38, 0xb9, 0x2d, 0xf2, 0x25, 0x8b, 0xa8,
+	0xc2, 0xd9, 0xea, 0xfe, 0xf6, 0x13, 0xcb, 0x62, 0xfe, 0xa2, 0x37, 0x0c, 0x97, 0x3c, 0x7a, 0x96,
+	0xa5, 0x4e, 0x65, 0x34, 0xfa, 0x08, 0x6f, 0xb8, 0x48, 0xbc, 0xc5, 0x3a, 0x47, 0xb1, 0xc4, 0x38,
+	0x41, 0xe1, 0xcd, 0x69, 0x28, 0x58, 0x64, 0xff, 0x23, 0xe4, 0x46, 0x8e, 0xcf, 0x57, 0x09, 0x53,
+	0x8b, 0x22, 0xf4, 0x22, 0x9e, 0xf6, 0x5f, 0xd1, 0x7d, 0x4b, 0xf7, 0x2d, 0xdd, 0x2f, 0xe9, 0xb0,
+	0x66, 0xe2, 0x77, 0x3f, 0x06, 0x00, 0xd2, 0x82, 0x6d, 0xc0, 0x80, 0x04, 0x00, 0x00,
 }
@@ -39,7 +39,7 @@ func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
 func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
 func (*FilteredBlock) ProtoMessage()    {}
 func (*FilteredBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{0}
+	return fileDescriptor_events_d794000552bd52a2, []int{0}
 }
 func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredBlock.Unmarshal(m, b)
@@ -98,7 +98,7 @@ func (m *FilteredTransaction) Reset()         { *m = FilteredTransaction{} }
 func (m *FilteredTransaction) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransaction) ProtoMessage()    {}
 func (*FilteredTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{1}
+	return fileDescriptor_events_d794000552bd52a2, []int{1}
 }
 func (m *FilteredTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransaction.Unmarshal(m, b)
@@ -231,7 +231,7 @@ func (m *FilteredTransactionActions) Reset()         { *m = FilteredTransactionA
 func (m *FilteredTransactionActions) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransactionActions) ProtoMessage()    {}
 func (*FilteredTransactionActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{2}
+	return fileDescriptor_events_d794000552bd52a2, []int{2}
 }
 func (m *FilteredTransactionActions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransactionActions.Unmarshal(m, b)
@@ -271,7 +271,7 @@ func (m *FilteredChaincodeAction) Reset()         { *m = FilteredChaincodeAction
 func (m *FilteredChaincodeAction) String() string { return proto.CompactTextString(m) }
 func (*FilteredChaincodeAction) ProtoMessage()    {}
 func (*FilteredChaincodeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{3}
+	return fileDescriptor_events_d794000552bd52a2, []int{3}
 }
 func (m *FilteredChaincodeAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredChaincodeAction.Unmarshal(m, b)
@@ -298,12 +298,134 @@ func (m *FilteredChaincodeAction) GetChaincodeEvent() *ChaincodeEvent {
 	return nil
 }
 
+// DeliverFilter selects the transactions and chaincode events of the blocks
+// returned by DeliverWithFilter. It is carried as the extension of the
+// channel header of the DELIVER_SEEK_INFO envelope.
+type DeliverFilter struct {
+	// chaincode_names restricts the transactions to the ones invoking one of
+	// these chaincodes, all transactions being selected when it is empty
+	ChaincodeNames []string `protobuf:"bytes,1,rep,name=chaincode_names,json=chaincodeNames,proto3" json:"chaincode_names,omitempty"`
+	// event_name_pattern is a regular expression which the whole chaincode
+	// event name must match, only transactions emitting a matching event
+	// being selected when it is set
+	EventNamePattern string `protobuf:"bytes,2,opt,name=event_name_pattern,json=eventNamePattern,proto3" json:"event_name_pattern,omitempty"`
+	// only_valid restricts the transactions to the valid ones
+	OnlyValid            bool     `protobuf:"varint,3,opt,name=only_valid,json=onlyValid,proto3" json:"only_valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliverFilter) Reset()         { *m = DeliverFilter{} }
+func (m *DeliverFilter) String() string { return proto.CompactTextString(m) }
+func (*DeliverFilter) ProtoMessage()    {}
+func (*DeliverFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_d794000552bd52a2, []int{4}
+}
+func (m *DeliverFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliverFilter.Unmarshal(m, b)
+}
+func (m *DeliverFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliverFilter.Marshal(b, m, deterministic)
+}
+func (dst *DeliverFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverFilter.Merge(dst, src)
+}
+func (m *DeliverFilter) XXX_Size() int {
+	return xxx_messageInfo_DeliverFilter.Size(m)
+}
+func (m *DeliverFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverFilter proto.InternalMessageInfo
+
+func (m *DeliverFilter) GetChaincodeNames() []string {
+	if m != nil {
+		return m.ChaincodeNames
+	}
+	return nil
+}
+
+func (m *DeliverFilter) GetEventNamePattern() string {
+	if m != nil {
+		return m.EventNamePattern
+	}
+	return ""
+}
+
+func (m *DeliverFilter) GetOnlyValid() bool {
+	if m != nil {
+		return m.OnlyValid
+	}
+	return false
+}
+
+// DeliverCheckpoint is the position of the last transaction processed by a
+// named consumer of the Deliver service. It is acknowledged by the consumer
+// as the payload data of a DELIVER_ACK envelope, and sent back to it before
+// the blocks when it resumes from its checkpoint.
+type DeliverCheckpoint struct {
+	ConsumerId           string   `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	BlockNumber          uint64   `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TxIndex              uint64   `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliverCheckpoint) Reset()         { *m = DeliverCheckpoint{} }
+func (m *DeliverCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*DeliverCheckpoint) ProtoMessage()    {}
+func (*DeliverCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_d794000552bd52a2, []int{5}
+}
+func (m *DeliverCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliverCheckpoint.Unmarshal(m, b)
+}
+func (m *DeliverCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliverCheckpoint.Marshal(b, m, deterministic)
+}
+func (dst *DeliverCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverCheckpoint.Merge(dst, src)
+}
+func (m *DeliverCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_DeliverCheckpoint.Size(m)
+}
+func (m *DeliverCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverCheckpoint proto.InternalMessageInfo
+
+func (m *DeliverCheckpoint) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *DeliverCheckpoint) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *DeliverCheckpoint) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
 // DeliverResponse
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
 	//	*DeliverResponse_Status
 	//	*DeliverResponse_Block
 	//	*DeliverResponse_FilteredBlock
+	//	*DeliverResponse_Checkpoint
 	Type                 isDeliverResponse_Type `protobuf_oneof:"Type"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
@@ -314,7 +436,7 @@ func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{4}
+	return fileDescriptor_events_d794000552bd52a2, []int{6}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -350,12 +472,18 @@ type DeliverResponse_FilteredBlock struct {
 	FilteredBlock *FilteredBlock `protobuf:"bytes,3,opt,name=filtered_block,json=filteredBlock,proto3,oneof"`
 }
 
+type DeliverResponse_Checkpoint struct {
+	Checkpoint *DeliverCheckpoint `protobuf:"bytes,4,opt,name=checkpoint,proto3,oneof"`
+}
+
 func (*DeliverResponse_Status) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_Block) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_FilteredBlock) isDeliverResponse_Type() {}
 
+func (*DeliverResponse_Checkpoint) isDeliverResponse_Type() {}
+
 func (m *DeliverResponse) GetType() isDeliverResponse_Type {
 	if m != nil {
 		return m.Type
@@ -384,12 +512,20 @@ func (m *DeliverResponse) GetFilteredBlock() *FilteredBlock {
 	return nil
 }
 
+func (m *DeliverResponse) GetCheckpoint() *DeliverCheckpoint {
+	if x, ok := m.GetType().(*DeliverResponse_Checkpoint); ok {
+		return x.Checkpoint
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*DeliverResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _DeliverResponse_OneofMarshaler, _DeliverResponse_OneofUnmarshaler, _DeliverResponse_OneofSizer, []interface{}{
 		(*DeliverResponse_Status)(nil),
 		(*DeliverResponse_Block)(nil),
 		(*DeliverResponse_FilteredBlock)(nil),
+		(*DeliverResponse_Checkpoint)(nil),
 	}
 }
 
@@ -410,6 +546,11 @@ func _DeliverResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.FilteredBlock); err != nil {
 			return err
 		}
+	case *DeliverResponse_Checkpoint:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Checkpoint); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("DeliverResponse.Type has unexpected type %T", x)
@@ -443,6 +584,14 @@ func _DeliverResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Type = &DeliverResponse_FilteredBlock{msg}
 		return true, err
+	case 4: // Type.checkpoint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DeliverCheckpoint)
+		err := b.DecodeMessage(msg)
+		m.Type = &DeliverResponse_Checkpoint{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -465,6 +614,11 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *DeliverResponse_Checkpoint:
+		s := proto.Size(x.Checkpoint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -477,6 +631,8 @@ func init() {
 	proto.RegisterType((*FilteredTransaction)(nil), "protos.FilteredTransaction")
 	proto.RegisterType((*FilteredTransactionActions)(nil), "protos.FilteredTransactionActions")
 	proto.RegisterType((*FilteredChaincodeAction)(nil), "protos.FilteredChaincodeAction")
+	proto.RegisterType((*DeliverFilter)(nil), "protos.DeliverFilter")
+	proto.RegisterType((*DeliverCheckpoint)(nil), "protos.DeliverCheckpoint")
 	proto.RegisterType((*DeliverResponse)(nil), "protos.DeliverResponse")
 }
 
@@ -500,6 +656,12 @@ type DeliverClient interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverFilteredClient, error)
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message and the channel
+	// header extension as a marshaled DeliverFilter message, then a stream
+	// of filtered block replies with the selected transactions and chaincode
+	// events, including their payloads, is received
+	DeliverWithFilter(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithFilterClient, error)
 }
 
 type deliverClient struct {
@@ -572,6 +734,37 @@ func (x *deliverDeliverFilteredClient) Recv() (*DeliverResponse, error) {
 	return m, nil
 }
 
+func (c *deliverClient) DeliverWithFilter(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithFilterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Deliver_serviceDesc.Streams[2], "/protos.Deliver/DeliverWithFilter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deliverDeliverWithFilterClient{stream}
+	return x, nil
+}
+
+type Deliver_DeliverWithFilterClient interface {
+	Send(*common.Envelope) error
+	Recv() (*DeliverResponse, error)
+	grpc.ClientStream
+}
+
+type deliverDeliverWithFilterClient struct {
+	grpc.ClientStream
+}
+
+func (x *deliverDeliverWithFilterClient) Send(m *common.Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithFilterClient) Recv() (*DeliverResponse, error) {
+	m := new(DeliverResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DeliverServer is the server API for Deliver service.
 type DeliverServer interface {
 	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
@@ -582,6 +775,12 @@ type DeliverServer interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(Deliver_DeliverFilteredServer) error
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message and the channel
+	// header extension as a marshaled DeliverFilter message, then a stream
+	// of filtered block replies with the selected transactions and chaincode
+	// events, including their payloads, is received
+	DeliverWithFilter(Deliver_DeliverWithFilterServer) error
 }
 
 func RegisterDeliverServer(s *grpc.Server, srv DeliverServer) {
@@ -640,6 +839,32 @@ func (x *deliverDeliverFilteredServer) Recv() (*common.Envelope, error) {
 	return m, nil
 }
 
+func _Deliver_DeliverWithFilter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeliverServer).DeliverWithFilter(&deliverDeliverWithFilterServer{stream})
+}
+
+type Deliver_DeliverWithFilterServer interface {
+	Send(*DeliverResponse) error
+	Recv() (*common.Envelope, error)
+	grpc.ServerStream
+}
+
+type deliverDeliverWithFilterServer struct {
+	grpc.ServerStream
+}
+
+func (x *deliverDeliverWithFilterServer) Send(m *DeliverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithFilterServer) Recv() (*common.Envelope, error) {
+	m := new(common.Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Deliver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Deliver",
 	HandlerType: (*DeliverServer)(nil),
@@ -657,47 +882,63 @@ var _Deliver_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DeliverWithFilter",
+			Handler:       _Deliver_DeliverWithFilter_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "peer/events.proto",
 }
 
-func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_8af932975aef5a3c) }
-
-var fileDescriptor_events_8af932975aef5a3c = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x08, 0xea, 0x44, 0x49, 0xdb, 0x2d, 0x4d, 0xa3, 0x20, 0xd4, 0xc8, 0x12, 0xc8,
-	0x5c, 0x62, 0x64, 0x6e, 0x1c, 0x40, 0xa4, 0x3f, 0x0a, 0x12, 0x87, 0x6a, 0x09, 0x1c, 0x7a, 0xc0,
-	0x5a, 0xdb, 0x13, 0xc7, 0xd4, 0xf1, 0x5a, 0xbb, 0x9b, 0x28, 0x79, 0x04, 0xde, 0x80, 0x67, 0xe0,
-	0x09, 0x39, 0x22, 0xaf, 0xbd, 0x49, 0x9a, 0x52, 0x24, 0x4e, 0xf6, 0xce, 0x7c, 0x3f, 0x33, 0xb3,
-	0x63, 0xc3, 0x51, 0x8e, 0x28, 0x5c, 0x5c, 0x60, 0xa6, 0xe4, 0x20, 0x17, 0x5c, 0x71, 0xd2, 0xd0,
-	0x0f, 0xd9, 0x3b, 0x0e, 0xf9, 0x6c, 0xc6, 0x33, 0xb7, 0x7c, 0x94, 0xc9, 0xde, 0x59, 0xcc, 0x79,
-	0x9c, 0xa2, 0xab, 0x4f, 0xc1, 0x7c, 0xe2, 0xaa, 0x64, 0x86, 0x52, 0xb1, 0x59, 0x5e, 0x01, 0x7a,
-	0x5a, 0x30, 0x9c, 0xb2, 0x24, 0x0b, 0x79, 0x84, 0xbe, 0x96, 0xae, 0x72, 0x1d, 0x9d, 0x53, 0x82,
-	0x65, 0x92, 0x85, 0x2a, 0x31, 0xa2, 0xf6, 0x4f, 0x0b, 0x5a, 0x57, 0x49, 0xaa, 0x50, 0x60, 0x34,
-	0x4c, 0x79, 0x78, 0x4b, 0x9e, 0x03, 0x84, 0x53, 0x96, 0x65, 0x98, 0xfa, 0x49, 0xd4, 0xb5, 0xfa,
-	0x96, 0xb3, 0x4f, 0xf7, 0xab, 0xc8, 0xc7, 0x88, 0x74, 0xa0, 0x91, 0xcd, 0x67, 0x01, 0x8a, 0xee,
-	0xa3, 0xbe, 0xe5, 0xd4, 0x69, 0x75, 0x22, 0xd7, 0x70, 0x32, 0xa9, 0x74, 0xfc, 0x2d, 0x1b, 0xd9,
-	0xad, 0xf7, 0xf7, 0x9c, 0xa6, 0xf7, 0xac, 0xf4, 0x93, 0x03, 0x63, 0x36, 0xde, 0x60, 0xe8, 0xd3,
-	0xc9, 0xfd, 0xa0, 0xb4, 0x7f, 0x5b, 0x70, 0xfc, 0x17, 0x34, 0x21, 0x50, 0x57, 0xcb, 0x75, 0x69,
-	0xfa, 0x9d, 0xbc, 0x84, 0xba, 0x5a, 0xe5, 0xa8, 0x6b, 0x6a, 0x7b, 0x64, 0x50, 0x0d, 0x6e, 0x84,
-	0x2c, 0x42, 0x31, 0x5e, 0xe5, 0x48, 0x75, 0x9e, 0x5c, 0x01, 0x51, 0x4b, 0x7f, 0xc1, 0xd2, 0x24,
-	0x62, 0x85, 0x98, 0x5f, 0x0c, 0xaa, 0xbb, 0xa7, 0x59, 0x5d, 0x53, 0xe2, 0x78, 0xf9, 0x75, 0x0d,
-	0x38, 0xe7, 0x11, 0xd2, 0x43, 0xb5, 0x13, 0x21, 0x5f, 0xe0, 0x78, 0xab, 0x49, 0x7f, 0xd3, 0xab,
-	0xe5, 0x34, 0x3d, 0xfb, 0x1f, 0xbd, 0x7e, 0x28, 0x91, 0xa3, 0x1a, 0x25, 0xea, 0x5e, 0x74, 0xd8,
-	0x80, 0xfa, 0x05, 0x53, 0xcc, 0xfe, 0x0e, 0xbd, 0x87, 0xb9, 0xe4, 0x13, 0x1c, 0x6d, 0x2e, 0xd9,
-	0x58, 0x5b, 0x7a, 0xcc, 0x67, 0xbb, 0xd6, 0xe7, 0x06, 0x58, 0x92, 0xe9, 0x61, 0x78, 0x37, 0x20,
-	0xed, 0x1b, 0x38, 0x7d, 0x00, 0x4c, 0xde, 0xc3, 0xc1, 0xce, 0x36, 0xe9, 0xa1, 0x37, 0xbd, 0x8e,
-	0xb1, 0x59, 0x33, 0x2e, 0x8b, 0x2c, 0x6d, 0x87, 0x77, 0xce, 0xf6, 0x2f, 0x0b, 0x0e, 0x2e, 0x30,
-	0x4d, 0x16, 0x28, 0x28, 0xca, 0x9c, 0x67, 0x12, 0x89, 0x03, 0x0d, 0xa9, 0x98, 0x9a, 0x4b, 0xad,
-	0xd5, 0xf6, 0xda, 0xe6, 0xb2, 0x3e, 0xeb, 0xe8, 0xa8, 0x46, 0xab, 0x3c, 0x79, 0x01, 0x8f, 0x83,
-	0x62, 0x25, 0xf5, 0xad, 0x36, 0xbd, 0x96, 0x01, 0xea, 0x3d, 0x1d, 0xd5, 0x68, 0x99, 0x25, 0xef,
-	0xa0, 0xbd, 0xde, 0xbc, 0x12, 0xbf, 0xa7, 0xf1, 0x27, 0xbb, 0xb3, 0x30, 0xbc, 0xd6, 0x64, 0x3b,
-	0x50, 0x0c, 0xbd, 0xd8, 0x10, 0xef, 0x87, 0x05, 0x4f, 0xaa, 0x62, 0xc9, 0xdb, 0xcd, 0xeb, 0xa1,
-	0xb1, 0xbd, 0xcc, 0x16, 0x98, 0xf2, 0x1c, 0x7b, 0xa7, 0x46, 0x78, 0xa7, 0x35, 0xbb, 0xe6, 0x58,
-	0xaf, 0x2d, 0x32, 0x5c, 0xf7, 0x6c, 0x8c, 0xff, 0x5b, 0x63, 0xf8, 0x0d, 0x6c, 0x2e, 0xe2, 0xc1,
-	0x74, 0x95, 0xa3, 0x48, 0x31, 0x8a, 0x51, 0x0c, 0x26, 0x2c, 0x10, 0x49, 0x68, 0x68, 0xc5, 0xe7,
-	0x3c, 0x6c, 0xe9, 0x29, 0xcb, 0x6b, 0x16, 0xde, 0xb2, 0x18, 0x6f, 0x5e, 0xc5, 0x89, 0x9a, 0xce,
-	0x83, 0xc2, 0xcb, 0xdd, 0x62, 0xba, 0x25, 0xb3, 0xfc, 0x6f, 0x48, 0xb7, 0x60, 0x06, 0xe5, 0x8f,
-	0xe6, 0xcd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0xd1, 0xa9, 0x84, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_d794000552bd52a2) }
+
+var fileDescriptor_events_d794000552bd52a2 = []byte{
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6e, 0x13, 0x31,
+	0x10, 0xce, 0xd2, 0x90, 0x36, 0x0e, 0x49, 0x53, 0x97, 0xb6, 0x69, 0x10, 0x6a, 0x59, 0x09, 0x08,
+	0x12, 0x4a, 0x50, 0x78, 0x03, 0x09, 0x44, 0xfa, 0xa3, 0x54, 0x42, 0x55, 0x65, 0x0a, 0x48, 0x7d,
+	0x60, 0xe5, 0xec, 0x4e, 0x92, 0xa5, 0xbb, 0xf6, 0x6a, 0xed, 0x44, 0xc9, 0x01, 0xb8, 0x03, 0xb7,
+	0xe2, 0x18, 0x5c, 0x81, 0x47, 0x64, 0x7b, 0x9d, 0xbf, 0x52, 0xa4, 0x3e, 0xed, 0x7a, 0xe6, 0x9b,
+	0xf9, 0xc6, 0x33, 0xdf, 0x18, 0x6d, 0x25, 0x00, 0x69, 0x0b, 0xc6, 0xc0, 0xa4, 0x68, 0x26, 0x29,
+	0x97, 0x1c, 0x17, 0xf4, 0x47, 0xd4, 0xb7, 0x7d, 0x1e, 0xc7, 0x9c, 0xb5, 0xcc, 0xc7, 0x38, 0xeb,
+	0x07, 0x03, 0xce, 0x07, 0x11, 0xb4, 0xf4, 0xa9, 0x37, 0xea, 0xb7, 0x64, 0x18, 0x83, 0x90, 0x34,
+	0x4e, 0x32, 0x40, 0x5d, 0x27, 0xf4, 0x87, 0x34, 0x64, 0x3e, 0x0f, 0xc0, 0xd3, 0xa9, 0x33, 0xdf,
+	0xae, 0xf6, 0xc9, 0x94, 0x32, 0x41, 0x7d, 0x19, 0xda, 0xa4, 0xee, 0x4f, 0x07, 0x95, 0x4f, 0xc3,
+	0x48, 0x42, 0x0a, 0x41, 0x27, 0xe2, 0xfe, 0x35, 0x7e, 0x8c, 0x90, 0x3f, 0xa4, 0x8c, 0x41, 0xe4,
+	0x85, 0x41, 0xcd, 0x39, 0x74, 0x1a, 0x45, 0x52, 0xcc, 0x2c, 0x67, 0x01, 0xde, 0x45, 0x05, 0x36,
+	0x8a, 0x7b, 0x90, 0xd6, 0xee, 0x1d, 0x3a, 0x8d, 0x3c, 0xc9, 0x4e, 0xf8, 0x02, 0xed, 0xf4, 0xb3,
+	0x3c, 0xde, 0x02, 0x8d, 0xa8, 0xe5, 0x0f, 0xd7, 0x1a, 0xa5, 0xf6, 0x23, 0xc3, 0x27, 0x9a, 0x96,
+	0xec, 0x72, 0x8e, 0x21, 0x0f, 0xfb, 0x37, 0x8d, 0xc2, 0xfd, 0xe3, 0xa0, 0xed, 0x7f, 0xa0, 0x31,
+	0x46, 0x79, 0x39, 0x99, 0x95, 0xa6, 0xff, 0xf1, 0x33, 0x94, 0x97, 0xd3, 0x04, 0x74, 0x4d, 0x95,
+	0x36, 0x6e, 0x66, 0x8d, 0xeb, 0x02, 0x0d, 0x20, 0xbd, 0x9c, 0x26, 0x40, 0xb4, 0x1f, 0x9f, 0x22,
+	0x2c, 0x27, 0xde, 0x98, 0x46, 0x61, 0x40, 0x55, 0x32, 0x4f, 0x35, 0xaa, 0xb6, 0xa6, 0xa3, 0x6a,
+	0xb6, 0xc4, 0xcb, 0xc9, 0x97, 0x19, 0xe0, 0x88, 0x07, 0x40, 0xaa, 0x72, 0xc5, 0x82, 0x3f, 0xa3,
+	0xed, 0x85, 0x4b, 0x7a, 0xf3, 0xbb, 0x3a, 0x8d, 0x52, 0xdb, 0xfd, 0xcf, 0x5d, 0x3f, 0x18, 0x64,
+	0x37, 0x47, 0xb0, 0xbc, 0x61, 0xed, 0x14, 0x50, 0xfe, 0x98, 0x4a, 0xea, 0x7e, 0x47, 0xf5, 0xdb,
+	0x63, 0xf1, 0x47, 0xb4, 0x35, 0x1f, 0xb2, 0xa5, 0x76, 0x74, 0x9b, 0x0f, 0x56, 0xa9, 0x8f, 0x2c,
+	0xd0, 0x04, 0x93, 0xaa, 0xbf, 0x6c, 0x10, 0xee, 0x15, 0xda, 0xbb, 0x05, 0x8c, 0xdf, 0xa3, 0xcd,
+	0x15, 0x35, 0xe9, 0xa6, 0x97, 0xda, 0xbb, 0x96, 0x66, 0x16, 0x71, 0xa2, 0xbc, 0xa4, 0xe2, 0x2f,
+	0x9d, 0xdd, 0x1f, 0x0e, 0x2a, 0x1f, 0x43, 0x14, 0x8e, 0x21, 0x35, 0x1c, 0xf8, 0xf9, 0x62, 0x4a,
+	0x46, 0x63, 0x30, 0x95, 0x17, 0x17, 0x42, 0xcf, 0x95, 0x15, 0xbf, 0x44, 0x58, 0x33, 0x6a, 0x90,
+	0x97, 0x50, 0x29, 0x21, 0x65, 0x7a, 0xbe, 0x45, 0x52, 0xd5, 0x1e, 0x85, 0xbb, 0x30, 0x76, 0x25,
+	0x5a, 0xce, 0xa2, 0xa9, 0x99, 0xac, 0x9e, 0xe7, 0x06, 0x29, 0x2a, 0x8b, 0x9e, 0x9b, 0x9b, 0xa2,
+	0xad, 0xac, 0x8c, 0xa3, 0x21, 0xf8, 0xd7, 0x09, 0x0f, 0x99, 0xc4, 0x07, 0xa8, 0xe4, 0x73, 0x26,
+	0x46, 0x31, 0xa4, 0x73, 0xa5, 0x23, 0x6b, 0x3a, 0x0b, 0xf0, 0x13, 0xf4, 0xa0, 0xa7, 0x56, 0xc2,
+	0x5b, 0x12, 0x7c, 0x49, 0xdb, 0xce, 0x8d, 0xea, 0xf7, 0xd1, 0x86, 0x9c, 0x78, 0x21, 0x0b, 0x60,
+	0xa2, 0x59, 0xf3, 0x64, 0x5d, 0x4e, 0xce, 0xd4, 0xd1, 0xfd, 0xed, 0xa0, 0xcd, 0x8c, 0x94, 0x80,
+	0x48, 0x38, 0x13, 0x80, 0x1b, 0xa8, 0x20, 0x24, 0x95, 0x23, 0xa1, 0xd9, 0x2a, 0xed, 0x8a, 0x15,
+	0xea, 0x27, 0x6d, 0xed, 0xe6, 0x48, 0xe6, 0xc7, 0x4f, 0xd1, 0x7d, 0xcd, 0xa3, 0x49, 0x4b, 0xed,
+	0xb2, 0x05, 0xea, 0x1d, 0xed, 0xe6, 0x88, 0xf1, 0xe2, 0x77, 0xa8, 0x32, 0xdb, 0x3a, 0x83, 0x5f,
+	0xd3, 0xf8, 0x9d, 0x55, 0x1d, 0xd8, 0xb8, 0x72, 0x7f, 0x69, 0xd9, 0xdf, 0xaa, 0x65, 0xb7, 0x1d,
+	0xc9, 0xe4, 0xbb, 0x6f, 0x63, 0x6f, 0xb4, 0xac, 0x9b, 0x23, 0x0b, 0x70, 0xa5, 0x56, 0xb5, 0x5a,
+	0xed, 0x5f, 0x0e, 0x5a, 0xcf, 0xb0, 0xf8, 0xcd, 0xfc, 0xb7, 0x6a, 0x6b, 0x3e, 0x61, 0x63, 0x88,
+	0x78, 0x02, 0xf5, 0xbd, 0x95, 0xcc, 0xb6, 0x2f, 0x6e, 0xae, 0xe1, 0xbc, 0x72, 0x70, 0x67, 0xd6,
+	0x30, 0x5b, 0xf5, 0xdd, 0x73, 0x1c, 0xcf, 0x26, 0xfd, 0x35, 0x94, 0xc3, 0x4c, 0x74, 0x77, 0xcd,
+	0xd2, 0xf9, 0x86, 0x5c, 0x9e, 0x0e, 0x9a, 0xc3, 0x69, 0x02, 0x69, 0x04, 0xc1, 0x00, 0xd2, 0x66,
+	0x9f, 0xf6, 0xd2, 0xd0, 0xb7, 0x61, 0xea, 0x35, 0xed, 0x94, 0xb5, 0xc8, 0xc5, 0x05, 0xf5, 0xaf,
+	0xe9, 0x00, 0xae, 0x5e, 0x0c, 0x42, 0x39, 0x1c, 0xf5, 0x14, 0x57, 0x6b, 0x21, 0xb2, 0x65, 0x22,
+	0xcd, 0xb3, 0x2d, 0x5a, 0x2a, 0xb2, 0x67, 0xde, 0xf9, 0xd7, 0x7f, 0x07, 0x00, 0xd4, 0x35, 0x49,
+	0x8e, 0x03, 0x06, 0x00, 0x00,
 }
@@ -90,7 +90,7 @@ cat docs/wrappers/cryptogen_preamble.md > $DOC
 
 echo "" >> $DOC
 
-for x in "cryptogen help" "cryptogen generate" "cryptogen showtemplate" "cryptogen extend" "cryptogen version"; do
+for x in "cryptogen help" "cryptogen generate" "cryptogen showtemplate" "cryptogen extend" "cryptogen rotate" "cryptogen reissue" "cryptogen version"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -104,7 +104,7 @@ DOC=docs/source/commands/configtxlator.md
 
 cat docs/wrappers/configtxlator_preamble.md > $DOC
 
-for x in "configtxlator start" "configtxlator proto_encode" "configtxlator proto_decode" "configtxlator compute_update" "configtxlator version"; do
+for x in "configtxlator start" "configtxlator proto_encode" "configtxlator proto_decode" "configtxlator compute_update" "configtxlator collect_signatures" "configtxlator evaluate_policy" "configtxlator version"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC